@@ -192,6 +192,123 @@ func (*DepositReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{3}
 }
 
+type DepositBuyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DepositBuyRequest) Reset() {
+	*x = DepositBuyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositBuyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositBuyRequest) ProtoMessage() {}
+
+func (x *DepositBuyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositBuyRequest.ProtoReflect.Descriptor instead.
+func (*DepositBuyRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{4}
+}
+
+type DepositBuyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract string                  `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Length   int64                   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	From     int64                   `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To       int64                   `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	Items    []*DepositBuyReply_Item `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *DepositBuyReply) Reset() {
+	*x = DepositBuyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositBuyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositBuyReply) ProtoMessage() {}
+
+func (x *DepositBuyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositBuyReply.ProtoReflect.Descriptor instead.
+func (*DepositBuyReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DepositBuyReply) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *DepositBuyReply) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *DepositBuyReply) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DepositBuyReply) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *DepositBuyReply) GetItems() []*DepositBuyReply_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type VipCheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -201,7 +318,7 @@ type VipCheckRequest struct {
 func (x *VipCheckRequest) Reset() {
 	*x = VipCheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[4]
+		mi := &file_app_app_api_app_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -214,7 +331,7 @@ func (x *VipCheckRequest) String() string {
 func (*VipCheckRequest) ProtoMessage() {}
 
 func (x *VipCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[4]
+	mi := &file_app_app_api_app_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +344,7 @@ func (x *VipCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VipCheckRequest.ProtoReflect.Descriptor instead.
 func (*VipCheckRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{4}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{6}
 }
 
 type VipCheckReply struct {
@@ -239,7 +356,7 @@ type VipCheckReply struct {
 func (x *VipCheckReply) Reset() {
 	*x = VipCheckReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[5]
+		mi := &file_app_app_api_app_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -252,7 +369,7 @@ func (x *VipCheckReply) String() string {
 func (*VipCheckReply) ProtoMessage() {}
 
 func (x *VipCheckReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[5]
+	mi := &file_app_app_api_app_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,7 +382,7 @@ func (x *VipCheckReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VipCheckReply.ProtoReflect.Descriptor instead.
 func (*VipCheckReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{5}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{7}
 }
 
 type UserInfoRequest struct {
@@ -277,7 +394,7 @@ type UserInfoRequest struct {
 func (x *UserInfoRequest) Reset() {
 	*x = UserInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[6]
+		mi := &file_app_app_api_app_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -290,7 +407,7 @@ func (x *UserInfoRequest) String() string {
 func (*UserInfoRequest) ProtoMessage() {}
 
 func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[6]
+	mi := &file_app_app_api_app_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +420,7 @@ func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
 func (*UserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{6}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{8}
 }
 
 type UserInfoReply struct {
@@ -343,7 +460,7 @@ type UserInfoReply struct {
 func (x *UserInfoReply) Reset() {
 	*x = UserInfoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[7]
+		mi := &file_app_app_api_app_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +473,7 @@ func (x *UserInfoReply) String() string {
 func (*UserInfoReply) ProtoMessage() {}
 
 func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[7]
+	mi := &file_app_app_api_app_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +486,7 @@ func (x *UserInfoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoReply.ProtoReflect.Descriptor instead.
 func (*UserInfoReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{7}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserInfoReply) GetAddress() string {
@@ -566,13 +683,15 @@ type RewardListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Page   int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *RewardListRequest) Reset() {
 	*x = RewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[8]
+		mi := &file_app_app_api_app_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -585,7 +704,7 @@ func (x *RewardListRequest) String() string {
 func (*RewardListRequest) ProtoMessage() {}
 
 func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[8]
+	mi := &file_app_app_api_app_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +717,7 @@ func (x *RewardListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RewardListRequest.ProtoReflect.Descriptor instead.
 func (*RewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{8}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RewardListRequest) GetType() string {
@@ -608,18 +727,33 @@ func (x *RewardListRequest) GetType() string {
 	return ""
 }
 
+func (x *RewardListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RewardListRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type RewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Rewards []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *RewardListReply) Reset() {
 	*x = RewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[9]
+		mi := &file_app_app_api_app_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -632,7 +766,7 @@ func (x *RewardListReply) String() string {
 func (*RewardListReply) ProtoMessage() {}
 
 func (x *RewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[9]
+	mi := &file_app_app_api_app_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +779,7 @@ func (x *RewardListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RewardListReply.ProtoReflect.Descriptor instead.
 func (*RewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{9}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RewardListReply) GetRewards() []*RewardListReply_List {
@@ -655,16 +789,26 @@ func (x *RewardListReply) GetRewards() []*RewardListReply_List {
 	return nil
 }
 
+func (x *RewardListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type RecommendRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Page   int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *RecommendRewardListRequest) Reset() {
 	*x = RecommendRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[10]
+		mi := &file_app_app_api_app_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -677,7 +821,7 @@ func (x *RecommendRewardListRequest) String() string {
 func (*RecommendRewardListRequest) ProtoMessage() {}
 
 func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[10]
+	mi := &file_app_app_api_app_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +834,21 @@ func (x *RecommendRewardListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecommendRewardListRequest.ProtoReflect.Descriptor instead.
 func (*RecommendRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{10}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RecommendRewardListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RecommendRewardListRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
 type RecommendRewardListReply struct {
@@ -699,12 +857,13 @@ type RecommendRewardListReply struct {
 	unknownFields protoimpl.UnknownFields
 
 	Rewards []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *RecommendRewardListReply) Reset() {
 	*x = RecommendRewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[11]
+		mi := &file_app_app_api_app_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -717,7 +876,7 @@ func (x *RecommendRewardListReply) String() string {
 func (*RecommendRewardListReply) ProtoMessage() {}
 
 func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[11]
+	mi := &file_app_app_api_app_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +889,7 @@ func (x *RecommendRewardListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecommendRewardListReply.ProtoReflect.Descriptor instead.
 func (*RecommendRewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{11}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List {
@@ -740,16 +899,26 @@ func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List
 	return nil
 }
 
+func (x *RecommendRewardListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type FeeRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Page   int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *FeeRewardListRequest) Reset() {
 	*x = FeeRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[12]
+		mi := &file_app_app_api_app_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +931,7 @@ func (x *FeeRewardListRequest) String() string {
 func (*FeeRewardListRequest) ProtoMessage() {}
 
 func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[12]
+	mi := &file_app_app_api_app_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +944,21 @@ func (x *FeeRewardListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeRewardListRequest.ProtoReflect.Descriptor instead.
 func (*FeeRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{12}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FeeRewardListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *FeeRewardListRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
 type FeeRewardListReply struct {
@@ -784,12 +967,13 @@ type FeeRewardListReply struct {
 	unknownFields protoimpl.UnknownFields
 
 	Rewards []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *FeeRewardListReply) Reset() {
 	*x = FeeRewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[13]
+		mi := &file_app_app_api_app_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -802,7 +986,7 @@ func (x *FeeRewardListReply) String() string {
 func (*FeeRewardListReply) ProtoMessage() {}
 
 func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[13]
+	mi := &file_app_app_api_app_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +999,7 @@ func (x *FeeRewardListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeRewardListReply.ProtoReflect.Descriptor instead.
 func (*FeeRewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{13}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
@@ -825,18 +1009,26 @@ func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
 	return nil
 }
 
+func (x *FeeRewardListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type WithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Page int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (x *WithdrawListRequest) Reset() {
 	*x = WithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[14]
+		mi := &file_app_app_api_app_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +1041,7 @@ func (x *WithdrawListRequest) String() string {
 func (*WithdrawListRequest) ProtoMessage() {}
 
 func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[14]
+	mi := &file_app_app_api_app_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +1054,7 @@ func (x *WithdrawListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawListRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{14}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *WithdrawListRequest) GetType() string {
@@ -872,18 +1064,26 @@ func (x *WithdrawListRequest) GetType() string {
 	return ""
 }
 
+func (x *WithdrawListRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
 type WithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count    int64                     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *WithdrawListReply) Reset() {
 	*x = WithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[15]
+		mi := &file_app_app_api_app_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1096,7 @@ func (x *WithdrawListReply) String() string {
 func (*WithdrawListReply) ProtoMessage() {}
 
 func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[15]
+	mi := &file_app_app_api_app_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1109,7 @@ func (x *WithdrawListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawListReply.ProtoReflect.Descriptor instead.
 func (*WithdrawListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{15}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
@@ -919,6 +1119,13 @@ func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
 	return nil
 }
 
+func (x *WithdrawListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type RecommendListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -930,7 +1137,7 @@ type RecommendListRequest struct {
 func (x *RecommendListRequest) Reset() {
 	*x = RecommendListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[16]
+		mi := &file_app_app_api_app_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +1150,7 @@ func (x *RecommendListRequest) String() string {
 func (*RecommendListRequest) ProtoMessage() {}
 
 func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[16]
+	mi := &file_app_app_api_app_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1163,7 @@ func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
 func (*RecommendListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{16}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RecommendListRequest) GetType() string {
@@ -977,7 +1184,7 @@ type RecommendListReply struct {
 func (x *RecommendListReply) Reset() {
 	*x = RecommendListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[17]
+		mi := &file_app_app_api_app_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -990,7 +1197,7 @@ func (x *RecommendListReply) String() string {
 func (*RecommendListReply) ProtoMessage() {}
 
 func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[17]
+	mi := &file_app_app_api_app_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1210,7 @@ func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
 func (*RecommendListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{17}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
@@ -1024,7 +1231,7 @@ type WithdrawRequest struct {
 func (x *WithdrawRequest) Reset() {
 	*x = WithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[18]
+		mi := &file_app_app_api_app_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1037,7 +1244,7 @@ func (x *WithdrawRequest) String() string {
 func (*WithdrawRequest) ProtoMessage() {}
 
 func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[18]
+	mi := &file_app_app_api_app_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,7 +1257,7 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{18}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
@@ -1065,13 +1272,17 @@ type WithdrawReply struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee       string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	RelAmount string `protobuf:"bytes,5,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"`
 }
 
 func (x *WithdrawReply) Reset() {
 	*x = WithdrawReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[19]
+		mi := &file_app_app_api_app_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1084,7 +1295,7 @@ func (x *WithdrawReply) String() string {
 func (*WithdrawReply) ProtoMessage() {}
 
 func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[19]
+	mi := &file_app_app_api_app_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1308,7 @@ func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
 func (*WithdrawReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{19}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WithdrawReply) GetStatus() string {
@@ -1107,20 +1318,187 @@ func (x *WithdrawReply) GetStatus() string {
 	return ""
 }
 
-type AdminRewardListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WithdrawReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+func (x *WithdrawReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawReply) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *WithdrawReply) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+// ListFilter 后台列表的筛选和排序，零值不筛选，列表不支持的字段返回错误
+type ListFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start       string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`                                 // 北京时间，包含
+	End         string   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`                                     // 北京时间，只给日期时包含当天
+	WithinHours int64    `protobuf:"varint,3,opt,name=within_hours,json=withinHours,proto3" json:"within_hours,omitempty"` // 最近多少小时，和start都给时取较晚的
+	DateField   string   `protobuf:"bytes,4,opt,name=date_field,json=dateField,proto3" json:"date_field,omitempty"`        // 时间筛选的字段，默认created_at，stop_date只有出局列表有
+	Status      []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	AmountMin   string   `protobuf:"bytes,6,opt,name=amount_min,json=amountMin,proto3" json:"amount_min,omitempty"` // 金额，包含
+	AmountMax   string   `protobuf:"bytes,7,opt,name=amount_max,json=amountMax,proto3" json:"amount_max,omitempty"` // 金额，包含
+	VipMin      int64    `protobuf:"varint,8,opt,name=vip_min,json=vipMin,proto3" json:"vip_min,omitempty"`
+	CoinType    string   `protobuf:"bytes,9,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Type        string   `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	Sort        string   `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`   // 默认id
+	Order       string   `protobuf:"bytes,12,opt,name=order,proto3" json:"order,omitempty"` // 默认desc
+}
+
+func (x *ListFilter) Reset() {
+	*x = ListFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilter) ProtoMessage() {}
+
+func (x *ListFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilter.ProtoReflect.Descriptor instead.
+func (*ListFilter) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFilter) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ListFilter) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ListFilter) GetWithinHours() int64 {
+	if x != nil {
+		return x.WithinHours
+	}
+	return 0
+}
+
+func (x *ListFilter) GetDateField() string {
+	if x != nil {
+		return x.DateField
+	}
+	return ""
+}
+
+func (x *ListFilter) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListFilter) GetAmountMin() string {
+	if x != nil {
+		return x.AmountMin
+	}
+	return ""
+}
+
+func (x *ListFilter) GetAmountMax() string {
+	if x != nil {
+		return x.AmountMax
+	}
+	return ""
+}
+
+func (x *ListFilter) GetVipMin() int64 {
+	if x != nil {
+		return x.VipMin
+	}
+	return 0
+}
+
+func (x *ListFilter) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *ListFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListFilter) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+type AdminRewardListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page      int64       `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address   string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cursor    string      `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`                        // 上一页返回的next_cursor，非空时忽略page
+	CountMode string      `protobuf:"bytes,11,opt,name=count_mode,json=countMode,proto3" json:"count_mode,omitempty"` // 总数：默认exact，approx无筛选时取表统计的估算值，none不返回
+	Filter    *ListFilter `protobuf:"bytes,12,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *AdminRewardListRequest) Reset() {
 	*x = AdminRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[20]
+		mi := &file_app_app_api_app_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1133,7 +1511,7 @@ func (x *AdminRewardListRequest) String() string {
 func (*AdminRewardListRequest) ProtoMessage() {}
 
 func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[20]
+	mi := &file_app_app_api_app_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,7 +1524,7 @@ func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
 func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{20}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AdminRewardListRequest) GetPage() int64 {
@@ -1170,19 +1548,42 @@ func (x *AdminRewardListRequest) GetReason() string {
 	return ""
 }
 
+func (x *AdminRewardListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminRewardListRequest) GetCountMode() string {
+	if x != nil {
+		return x.CountMode
+	}
+	return ""
+}
+
+func (x *AdminRewardListRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type AdminRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Rewards     []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count       int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	NextCursor  string                       `protobuf:"bytes,10,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`     // 下一页游标，空为没有更多
+	CountApprox bool                         `protobuf:"varint,11,opt,name=count_approx,json=countApprox,proto3" json:"count_approx,omitempty"` // count是否为估算值
 }
 
 func (x *AdminRewardListReply) Reset() {
 	*x = AdminRewardListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[21]
+		mi := &file_app_app_api_app_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1195,7 +1596,7 @@ func (x *AdminRewardListReply) String() string {
 func (*AdminRewardListReply) ProtoMessage() {}
 
 func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[21]
+	mi := &file_app_app_api_app_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +1609,7 @@ func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
 func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{21}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
@@ -1225,6 +1626,20 @@ func (x *AdminRewardListReply) GetCount() int64 {
 	return 0
 }
 
+func (x *AdminRewardListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply) GetCountApprox() bool {
+	if x != nil {
+		return x.CountApprox
+	}
+	return false
+}
+
 type AdminTradeListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1237,7 +1652,7 @@ type AdminTradeListRequest struct {
 func (x *AdminTradeListRequest) Reset() {
 	*x = AdminTradeListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[22]
+		mi := &file_app_app_api_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1250,7 +1665,7 @@ func (x *AdminTradeListRequest) String() string {
 func (*AdminTradeListRequest) ProtoMessage() {}
 
 func (x *AdminTradeListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[22]
+	mi := &file_app_app_api_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +1678,7 @@ func (x *AdminTradeListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTradeListRequest.ProtoReflect.Descriptor instead.
 func (*AdminTradeListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{22}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AdminTradeListRequest) GetPage() int64 {
@@ -1292,7 +1707,7 @@ type AdminTradeListReply struct {
 func (x *AdminTradeListReply) Reset() {
 	*x = AdminTradeListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[23]
+		mi := &file_app_app_api_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1305,7 +1720,7 @@ func (x *AdminTradeListReply) String() string {
 func (*AdminTradeListReply) ProtoMessage() {}
 
 func (x *AdminTradeListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[23]
+	mi := &file_app_app_api_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,7 +1733,7 @@ func (x *AdminTradeListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTradeListReply.ProtoReflect.Descriptor instead.
 func (*AdminTradeListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{23}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AdminTradeListReply) GetTrades() []*AdminTradeListReply_List {
@@ -1344,7 +1759,7 @@ type LockSystemRequest struct {
 func (x *LockSystemRequest) Reset() {
 	*x = LockSystemRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[24]
+		mi := &file_app_app_api_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1357,7 +1772,7 @@ func (x *LockSystemRequest) String() string {
 func (*LockSystemRequest) ProtoMessage() {}
 
 func (x *LockSystemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[24]
+	mi := &file_app_app_api_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1370,7 +1785,7 @@ func (x *LockSystemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LockSystemRequest.ProtoReflect.Descriptor instead.
 func (*LockSystemRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{24}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{27}
 }
 
 type LockSystemReply struct {
@@ -1382,7 +1797,7 @@ type LockSystemReply struct {
 func (x *LockSystemReply) Reset() {
 	*x = LockSystemReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[25]
+		mi := &file_app_app_api_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1395,7 +1810,7 @@ func (x *LockSystemReply) String() string {
 func (*LockSystemReply) ProtoMessage() {}
 
 func (x *LockSystemReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[25]
+	mi := &file_app_app_api_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +1823,7 @@ func (x *LockSystemReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LockSystemReply.ProtoReflect.Descriptor instead.
 func (*LockSystemReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{25}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{28}
 }
 
 type CheckAdminUserAreaRequest struct {
@@ -1420,7 +1835,7 @@ type CheckAdminUserAreaRequest struct {
 func (x *CheckAdminUserAreaRequest) Reset() {
 	*x = CheckAdminUserAreaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[26]
+		mi := &file_app_app_api_app_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1433,7 +1848,7 @@ func (x *CheckAdminUserAreaRequest) String() string {
 func (*CheckAdminUserAreaRequest) ProtoMessage() {}
 
 func (x *CheckAdminUserAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[26]
+	mi := &file_app_app_api_app_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,7 +1861,7 @@ func (x *CheckAdminUserAreaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAdminUserAreaRequest.ProtoReflect.Descriptor instead.
 func (*CheckAdminUserAreaRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{26}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{29}
 }
 
 type CheckAdminUserAreaReply struct {
@@ -1458,7 +1873,7 @@ type CheckAdminUserAreaReply struct {
 func (x *CheckAdminUserAreaReply) Reset() {
 	*x = CheckAdminUserAreaReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[27]
+		mi := &file_app_app_api_app_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1471,7 +1886,7 @@ func (x *CheckAdminUserAreaReply) String() string {
 func (*CheckAdminUserAreaReply) ProtoMessage() {}
 
 func (x *CheckAdminUserAreaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[27]
+	mi := &file_app_app_api_app_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +1899,7 @@ func (x *CheckAdminUserAreaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckAdminUserAreaReply.ProtoReflect.Descriptor instead.
 func (*CheckAdminUserAreaReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{27}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{30}
 }
 
 type CheckAndInsertLocationsRecommendUserRequest struct {
@@ -1496,7 +1911,7 @@ type CheckAndInsertLocationsRecommendUserRequest struct {
 func (x *CheckAndInsertLocationsRecommendUserRequest) Reset() {
 	*x = CheckAndInsertLocationsRecommendUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[28]
+		mi := &file_app_app_api_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1924,7 @@ func (x *CheckAndInsertLocationsRecommendUserRequest) String() string {
 func (*CheckAndInsertLocationsRecommendUserRequest) ProtoMessage() {}
 
 func (x *CheckAndInsertLocationsRecommendUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[28]
+	mi := &file_app_app_api_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1937,7 @@ func (x *CheckAndInsertLocationsRecommendUserRequest) ProtoReflect() protoreflec
 
 // Deprecated: Use CheckAndInsertLocationsRecommendUserRequest.ProtoReflect.Descriptor instead.
 func (*CheckAndInsertLocationsRecommendUserRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{28}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{31}
 }
 
 type CheckAndInsertLocationsRecommendUserReply struct {
@@ -1534,7 +1949,7 @@ type CheckAndInsertLocationsRecommendUserReply struct {
 func (x *CheckAndInsertLocationsRecommendUserReply) Reset() {
 	*x = CheckAndInsertLocationsRecommendUserReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[29]
+		mi := &file_app_app_api_app_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1547,7 +1962,7 @@ func (x *CheckAndInsertLocationsRecommendUserReply) String() string {
 func (*CheckAndInsertLocationsRecommendUserReply) ProtoMessage() {}
 
 func (x *CheckAndInsertLocationsRecommendUserReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[29]
+	mi := &file_app_app_api_app_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,7 +1975,7 @@ func (x *CheckAndInsertLocationsRecommendUserReply) ProtoReflect() protoreflect.
 
 // Deprecated: Use CheckAndInsertLocationsRecommendUserReply.ProtoReflect.Descriptor instead.
 func (*CheckAndInsertLocationsRecommendUserReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{29}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{32}
 }
 
 type AdminUserListRequest struct {
@@ -1568,15 +1983,18 @@ type AdminUserListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Vip     int64  `protobuf:"varint,4,opt,name=vip,proto3" json:"vip,omitempty"`
+	Page      int64       `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address   string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Vip       int64       `protobuf:"varint,4,opt,name=vip,proto3" json:"vip,omitempty"`
+	Cursor    string      `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`                        // 上一页返回的next_cursor，非空时忽略page
+	CountMode string      `protobuf:"bytes,11,opt,name=count_mode,json=countMode,proto3" json:"count_mode,omitempty"` // 总数：默认exact，approx无筛选时取表统计的估算值，none不返回
+	Filter    *ListFilter `protobuf:"bytes,12,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *AdminUserListRequest) Reset() {
 	*x = AdminUserListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[30]
+		mi := &file_app_app_api_app_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +2007,7 @@ func (x *AdminUserListRequest) String() string {
 func (*AdminUserListRequest) ProtoMessage() {}
 
 func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[30]
+	mi := &file_app_app_api_app_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +2020,7 @@ func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
 func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{30}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *AdminUserListRequest) GetPage() int64 {
@@ -1626,19 +2044,42 @@ func (x *AdminUserListRequest) GetVip() int64 {
 	return 0
 }
 
+func (x *AdminUserListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminUserListRequest) GetCountMode() string {
+	if x != nil {
+		return x.CountMode
+	}
+	return ""
+}
+
+func (x *AdminUserListRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type AdminUserListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Users       []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count       int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	NextCursor  string                         `protobuf:"bytes,10,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`     // 下一页游标，空为没有更多
+	CountApprox bool                           `protobuf:"varint,11,opt,name=count_approx,json=countApprox,proto3" json:"count_approx,omitempty"` // count是否为估算值
 }
 
 func (x *AdminUserListReply) Reset() {
 	*x = AdminUserListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[31]
+		mi := &file_app_app_api_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1651,7 +2092,7 @@ func (x *AdminUserListReply) String() string {
 func (*AdminUserListReply) ProtoMessage() {}
 
 func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[31]
+	mi := &file_app_app_api_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,7 +2105,7 @@ func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
 func (*AdminUserListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{31}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
@@ -1681,6 +2122,20 @@ func (x *AdminUserListReply) GetCount() int64 {
 	return 0
 }
 
+func (x *AdminUserListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AdminUserListReply) GetCountApprox() bool {
+	if x != nil {
+		return x.CountApprox
+	}
+	return false
+}
+
 type RecordListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1693,7 +2148,7 @@ type RecordListRequest struct {
 func (x *RecordListRequest) Reset() {
 	*x = RecordListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[32]
+		mi := &file_app_app_api_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1706,7 +2161,7 @@ func (x *RecordListRequest) String() string {
 func (*RecordListRequest) ProtoMessage() {}
 
 func (x *RecordListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[32]
+	mi := &file_app_app_api_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +2174,7 @@ func (x *RecordListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordListRequest.ProtoReflect.Descriptor instead.
 func (*RecordListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{32}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RecordListRequest) GetPage() int64 {
@@ -1748,7 +2203,7 @@ type RecordListReply struct {
 func (x *RecordListReply) Reset() {
 	*x = RecordListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[33]
+		mi := &file_app_app_api_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1761,7 +2216,7 @@ func (x *RecordListReply) String() string {
 func (*RecordListReply) ProtoMessage() {}
 
 func (x *RecordListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[33]
+	mi := &file_app_app_api_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,7 +2229,7 @@ func (x *RecordListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordListReply.ProtoReflect.Descriptor instead.
 func (*RecordListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{33}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RecordListReply) GetLocations() []*RecordListReply_LocationList {
@@ -1796,15 +2251,18 @@ type AdminLocationListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Status  string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Page      int64       `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Status    string      `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Address   string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor    string      `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`                        // 上一页返回的next_cursor，非空时忽略page
+	CountMode string      `protobuf:"bytes,11,opt,name=count_mode,json=countMode,proto3" json:"count_mode,omitempty"` // 总数：默认exact，approx无筛选时取表统计的估算值，none不返回
+	Filter    *ListFilter `protobuf:"bytes,12,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *AdminLocationListRequest) Reset() {
 	*x = AdminLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[34]
+		mi := &file_app_app_api_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1817,7 +2275,7 @@ func (x *AdminLocationListRequest) String() string {
 func (*AdminLocationListRequest) ProtoMessage() {}
 
 func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[34]
+	mi := &file_app_app_api_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,7 +2288,7 @@ func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
 func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{34}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AdminLocationListRequest) GetPage() int64 {
@@ -1854,19 +2312,42 @@ func (x *AdminLocationListRequest) GetAddress() string {
 	return ""
 }
 
+func (x *AdminLocationListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminLocationListRequest) GetCountMode() string {
+	if x != nil {
+		return x.CountMode
+	}
+	return ""
+}
+
+func (x *AdminLocationListRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type AdminLocationListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Locations   []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Count       int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	NextCursor  string                                 `protobuf:"bytes,10,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`     // 下一页游标，空为没有更多
+	CountApprox bool                                   `protobuf:"varint,11,opt,name=count_approx,json=countApprox,proto3" json:"count_approx,omitempty"` // count是否为估算值
 }
 
 func (x *AdminLocationListReply) Reset() {
 	*x = AdminLocationListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[35]
+		mi := &file_app_app_api_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1879,7 +2360,7 @@ func (x *AdminLocationListReply) String() string {
 func (*AdminLocationListReply) ProtoMessage() {}
 
 func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[35]
+	mi := &file_app_app_api_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1892,7 +2373,7 @@ func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
 func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{35}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
@@ -1909,6 +2390,20 @@ func (x *AdminLocationListReply) GetCount() int64 {
 	return 0
 }
 
+func (x *AdminLocationListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply) GetCountApprox() bool {
+	if x != nil {
+		return x.CountApprox
+	}
+	return false
+}
+
 type AdminLocationAllListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1921,7 +2416,7 @@ type AdminLocationAllListRequest struct {
 func (x *AdminLocationAllListRequest) Reset() {
 	*x = AdminLocationAllListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[36]
+		mi := &file_app_app_api_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1934,7 +2429,7 @@ func (x *AdminLocationAllListRequest) String() string {
 func (*AdminLocationAllListRequest) ProtoMessage() {}
 
 func (x *AdminLocationAllListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[36]
+	mi := &file_app_app_api_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +2442,7 @@ func (x *AdminLocationAllListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationAllListRequest.ProtoReflect.Descriptor instead.
 func (*AdminLocationAllListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{36}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AdminLocationAllListRequest) GetPage() int64 {
@@ -1976,7 +2471,7 @@ type AdminLocationAllListReply struct {
 func (x *AdminLocationAllListReply) Reset() {
 	*x = AdminLocationAllListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[37]
+		mi := &file_app_app_api_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1989,7 +2484,7 @@ func (x *AdminLocationAllListReply) String() string {
 func (*AdminLocationAllListReply) ProtoMessage() {}
 
 func (x *AdminLocationAllListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[37]
+	mi := &file_app_app_api_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2002,7 +2497,7 @@ func (x *AdminLocationAllListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminLocationAllListReply.ProtoReflect.Descriptor instead.
 func (*AdminLocationAllListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{37}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AdminLocationAllListReply) GetLocations() []*AdminLocationAllListReply_LocationList {
@@ -2024,15 +2519,18 @@ type AdminWithdrawListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WithDrawType string `protobuf:"bytes,3,opt,name=withDrawType,proto3" json:"withDrawType,omitempty"`
-	Page         int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	WithDrawType string      `protobuf:"bytes,3,opt,name=withDrawType,proto3" json:"withDrawType,omitempty"`
+	Page         int64       `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address      string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor       string      `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`                        // 上一页返回的next_cursor，非空时忽略page
+	CountMode    string      `protobuf:"bytes,11,opt,name=count_mode,json=countMode,proto3" json:"count_mode,omitempty"` // 总数：默认exact，approx无筛选时取表统计的估算值，none不返回
+	Filter       *ListFilter `protobuf:"bytes,12,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *AdminWithdrawListRequest) Reset() {
 	*x = AdminWithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[38]
+		mi := &file_app_app_api_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2045,7 +2543,7 @@ func (x *AdminWithdrawListRequest) String() string {
 func (*AdminWithdrawListRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[38]
+	mi := &file_app_app_api_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,7 +2556,7 @@ func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{38}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AdminWithdrawListRequest) GetWithDrawType() string {
@@ -2082,19 +2580,42 @@ func (x *AdminWithdrawListRequest) GetAddress() string {
 	return ""
 }
 
+func (x *AdminWithdrawListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListRequest) GetCountMode() string {
+	if x != nil {
+		return x.CountMode
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type AdminWithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
-	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Withdraw    []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count       int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	NextCursor  string                         `protobuf:"bytes,10,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`     // 下一页游标，空为没有更多
+	CountApprox bool                           `protobuf:"varint,11,opt,name=count_approx,json=countApprox,proto3" json:"count_approx,omitempty"` // count是否为估算值
 }
 
 func (x *AdminWithdrawListReply) Reset() {
 	*x = AdminWithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[39]
+		mi := &file_app_app_api_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2107,7 +2628,7 @@ func (x *AdminWithdrawListReply) String() string {
 func (*AdminWithdrawListReply) ProtoMessage() {}
 
 func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[39]
+	mi := &file_app_app_api_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2641,7 @@ func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{39}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
@@ -2137,6 +2658,20 @@ func (x *AdminWithdrawListReply) GetCount() int64 {
 	return 0
 }
 
+func (x *AdminWithdrawListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply) GetCountApprox() bool {
+	if x != nil {
+		return x.CountApprox
+	}
+	return false
+}
+
 type AdminWithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2146,7 +2681,7 @@ type AdminWithdrawRequest struct {
 func (x *AdminWithdrawRequest) Reset() {
 	*x = AdminWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[40]
+		mi := &file_app_app_api_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2159,7 +2694,7 @@ func (x *AdminWithdrawRequest) String() string {
 func (*AdminWithdrawRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[40]
+	mi := &file_app_app_api_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2707,7 @@ func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{40}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{43}
 }
 
 type AdminWithdrawReply struct {
@@ -2184,7 +2719,7 @@ type AdminWithdrawReply struct {
 func (x *AdminWithdrawReply) Reset() {
 	*x = AdminWithdrawReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[41]
+		mi := &file_app_app_api_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2197,7 +2732,7 @@ func (x *AdminWithdrawReply) String() string {
 func (*AdminWithdrawReply) ProtoMessage() {}
 
 func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[41]
+	mi := &file_app_app_api_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,7 +2745,7 @@ func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{41}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{44}
 }
 
 type AdminTradeRequest struct {
@@ -2222,7 +2757,7 @@ type AdminTradeRequest struct {
 func (x *AdminTradeRequest) Reset() {
 	*x = AdminTradeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[42]
+		mi := &file_app_app_api_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2235,7 +2770,7 @@ func (x *AdminTradeRequest) String() string {
 func (*AdminTradeRequest) ProtoMessage() {}
 
 func (x *AdminTradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[42]
+	mi := &file_app_app_api_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2248,7 +2783,7 @@ func (x *AdminTradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTradeRequest.ProtoReflect.Descriptor instead.
 func (*AdminTradeRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{42}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{45}
 }
 
 type AdminTradeReply struct {
@@ -2260,7 +2795,7 @@ type AdminTradeReply struct {
 func (x *AdminTradeReply) Reset() {
 	*x = AdminTradeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[43]
+		mi := &file_app_app_api_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2273,7 +2808,7 @@ func (x *AdminTradeReply) String() string {
 func (*AdminTradeReply) ProtoMessage() {}
 
 func (x *AdminTradeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[43]
+	mi := &file_app_app_api_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,7 +2821,7 @@ func (x *AdminTradeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTradeReply.ProtoReflect.Descriptor instead.
 func (*AdminTradeReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{43}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{46}
 }
 
 type AdminWithdrawPassRequest struct {
@@ -2300,7 +2835,7 @@ type AdminWithdrawPassRequest struct {
 func (x *AdminWithdrawPassRequest) Reset() {
 	*x = AdminWithdrawPassRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[44]
+		mi := &file_app_app_api_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2313,7 +2848,7 @@ func (x *AdminWithdrawPassRequest) String() string {
 func (*AdminWithdrawPassRequest) ProtoMessage() {}
 
 func (x *AdminWithdrawPassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[44]
+	mi := &file_app_app_api_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,7 +2861,7 @@ func (x *AdminWithdrawPassRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawPassRequest.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawPassRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{44}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *AdminWithdrawPassRequest) GetSendBody() *AdminWithdrawPassRequest_SendBody {
@@ -2340,12 +2875,15 @@ type AdminWithdrawPassReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Flags  []string `protobuf:"bytes,2,rep,name=flags,proto3" json:"flags,omitempty"`
 }
 
 func (x *AdminWithdrawPassReply) Reset() {
 	*x = AdminWithdrawPassReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[45]
+		mi := &file_app_app_api_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2358,7 +2896,7 @@ func (x *AdminWithdrawPassReply) String() string {
 func (*AdminWithdrawPassReply) ProtoMessage() {}
 
 func (x *AdminWithdrawPassReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[45]
+	mi := &file_app_app_api_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,32 +2909,48 @@ func (x *AdminWithdrawPassReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithdrawPassReply.ProtoReflect.Descriptor instead.
 func (*AdminWithdrawPassReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{45}
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{48}
 }
 
-type AdminWithdrawEthRequest struct {
+func (x *AdminWithdrawPassReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWithdrawPassReply) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type AdminWithdrawRejectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminWithdrawRejectRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawEthRequest) Reset() {
-	*x = AdminWithdrawEthRequest{}
+func (x *AdminWithdrawRejectRequest) Reset() {
+	*x = AdminWithdrawRejectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[46]
+		mi := &file_app_app_api_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawEthRequest) String() string {
+func (x *AdminWithdrawRejectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthRequest) ProtoMessage() {}
+func (*AdminWithdrawRejectRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[46]
+func (x *AdminWithdrawRejectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,34 +2961,44 @@ func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AdminWithdrawRejectRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{49}
 }
 
-type AdminWithdrawEthReply struct {
+func (x *AdminWithdrawRejectRequest) GetSendBody() *AdminWithdrawRejectRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminWithdrawRejectReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Refund string `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"`
 }
 
-func (x *AdminWithdrawEthReply) Reset() {
-	*x = AdminWithdrawEthReply{}
+func (x *AdminWithdrawRejectReply) Reset() {
+	*x = AdminWithdrawRejectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[47]
+		mi := &file_app_app_api_app_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawEthReply) String() string {
+func (x *AdminWithdrawRejectReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthReply) ProtoMessage() {}
+func (*AdminWithdrawRejectReply) ProtoMessage() {}
 
-func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[47]
+func (x *AdminWithdrawRejectReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,34 +3009,50 @@ func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AdminWithdrawRejectReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{50}
 }
 
-type AdminFeeRequest struct {
+func (x *AdminWithdrawRejectReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWithdrawRejectReply) GetRefund() string {
+	if x != nil {
+		return x.Refund
+	}
+	return ""
+}
+
+type AdminWithdrawBatchPassRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminWithdrawBatchPassRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminFeeRequest) Reset() {
-	*x = AdminFeeRequest{}
+func (x *AdminWithdrawBatchPassRequest) Reset() {
+	*x = AdminWithdrawBatchPassRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[48]
+		mi := &file_app_app_api_app_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminFeeRequest) String() string {
+func (x *AdminWithdrawBatchPassRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeRequest) ProtoMessage() {}
+func (*AdminWithdrawBatchPassRequest) ProtoMessage() {}
 
-func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[48]
+func (x *AdminWithdrawBatchPassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2483,34 +3063,43 @@ func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
-func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use AdminWithdrawBatchPassRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBatchPassRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{51}
 }
 
-type AdminFeeReply struct {
+func (x *AdminWithdrawBatchPassRequest) GetSendBody() *AdminWithdrawBatchPassRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminWithdrawBatchPassReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Items []*AdminWithdrawBatchPassReply_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *AdminFeeReply) Reset() {
-	*x = AdminFeeReply{}
+func (x *AdminWithdrawBatchPassReply) Reset() {
+	*x = AdminWithdrawBatchPassReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[49]
+		mi := &file_app_app_api_app_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminFeeReply) String() string {
+func (x *AdminWithdrawBatchPassReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeReply) ProtoMessage() {}
+func (*AdminWithdrawBatchPassReply) ProtoMessage() {}
 
-func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[49]
+func (x *AdminWithdrawBatchPassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,36 +3110,43 @@ func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
-func (*AdminFeeReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use AdminWithdrawBatchPassReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBatchPassReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{52}
 }
 
-type AdminDailyFeeRequest struct {
+func (x *AdminWithdrawBatchPassReply) GetItems() []*AdminWithdrawBatchPassReply_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AdminWithdrawReviewListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Day int32 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Page int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *AdminDailyFeeRequest) Reset() {
-	*x = AdminDailyFeeRequest{}
+func (x *AdminWithdrawReviewListRequest) Reset() {
+	*x = AdminWithdrawReviewListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[50]
+		mi := &file_app_app_api_app_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyFeeRequest) String() string {
+func (x *AdminWithdrawReviewListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyFeeRequest) ProtoMessage() {}
+func (*AdminWithdrawReviewListRequest) ProtoMessage() {}
 
-func (x *AdminDailyFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[50]
+func (x *AdminWithdrawReviewListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2561,41 +3157,44 @@ func (x *AdminDailyFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyFeeRequest.ProtoReflect.Descriptor instead.
-func (*AdminDailyFeeRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use AdminWithdrawReviewListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *AdminDailyFeeRequest) GetDay() int32 {
+func (x *AdminWithdrawReviewListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Day
+		return x.Page
 	}
 	return 0
 }
 
-type AdminDailyFeeReply struct {
+type AdminWithdrawReviewListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Withdraw []*AdminWithdrawReviewListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count    int64                                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminDailyFeeReply) Reset() {
-	*x = AdminDailyFeeReply{}
+func (x *AdminWithdrawReviewListReply) Reset() {
+	*x = AdminWithdrawReviewListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[51]
+		mi := &file_app_app_api_app_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyFeeReply) String() string {
+func (x *AdminWithdrawReviewListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyFeeReply) ProtoMessage() {}
+func (*AdminWithdrawReviewListReply) ProtoMessage() {}
 
-func (x *AdminDailyFeeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[51]
+func (x *AdminWithdrawReviewListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2606,34 +3205,50 @@ func (x *AdminDailyFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyFeeReply.ProtoReflect.Descriptor instead.
-func (*AdminDailyFeeReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use AdminWithdrawReviewListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{54}
 }
 
-type AdminAllRequest struct {
+func (x *AdminWithdrawReviewListReply) GetWithdraw() []*AdminWithdrawReviewListReply_List {
+	if x != nil {
+		return x.Withdraw
+	}
+	return nil
+}
+
+func (x *AdminWithdrawReviewListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminWithdrawReviewsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	WithdrawId int64 `protobuf:"varint,1,opt,name=withdraw_id,json=withdrawId,proto3" json:"withdraw_id,omitempty"`
 }
 
-func (x *AdminAllRequest) Reset() {
-	*x = AdminAllRequest{}
+func (x *AdminWithdrawReviewsRequest) Reset() {
+	*x = AdminWithdrawReviewsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[52]
+		mi := &file_app_app_api_app_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminAllRequest) String() string {
+func (x *AdminWithdrawReviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllRequest) ProtoMessage() {}
+func (*AdminWithdrawReviewsRequest) ProtoMessage() {}
 
-func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[52]
+func (x *AdminWithdrawReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2644,50 +3259,43 @@ func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
-func (*AdminAllRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use AdminWithdrawReviewsRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{55}
 }
 
-type AdminAllReply struct {
+func (x *AdminWithdrawReviewsRequest) GetWithdrawId() int64 {
+	if x != nil {
+		return x.WithdrawId
+	}
+	return 0
+}
+
+type AdminWithdrawReviewsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalUser                    int64   `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`                                      // 激活人数
-	TodayLocation                int64   `protobuf:"varint,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`                              // 今日入金u数量
-	AllLocation                  int64   `protobuf:"varint,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`                                  // 总入金u数量
-	TodayLocationReward          string  `protobuf:"bytes,1,opt,name=todayLocationReward,proto3" json:"todayLocationReward,omitempty"`                   // 今日静态释放
-	TodayRecommendReward         string  `protobuf:"bytes,3,opt,name=todayRecommendReward,proto3" json:"todayRecommendReward,omitempty"`                 // 今日动态释放
-	TodayRecommendLocationReward string  `protobuf:"bytes,8,opt,name=todayRecommendLocationReward,proto3" json:"todayRecommendLocationReward,omitempty"` // 今日直推秒结释放
-	TodayAreaReward              string  `protobuf:"bytes,16,opt,name=todayAreaReward,proto3" json:"todayAreaReward,omitempty"`                          // 今日矩阵释放
-	TodayFourReward              string  `protobuf:"bytes,15,opt,name=todayFourReward,proto3" json:"todayFourReward,omitempty"`                          // 今日前四名分红u数量
-	TotalIsps                    string  `protobuf:"bytes,6,opt,name=totalIsps,proto3" json:"totalIsps,omitempty"`                                       // 全网ISPS数量
-	TotalUsdt                    float64 `protobuf:"fixed64,7,opt,name=totalUsdt,proto3" json:"totalUsdt,omitempty"`                                     // 全网可提U数量
-	TodayWithdrawUsdt            float64 `protobuf:"fixed64,18,opt,name=todayWithdrawUsdt,proto3" json:"todayWithdrawUsdt,omitempty"`                    // 今日提U数量
-	TotalWithdrawUsdt            float64 `protobuf:"fixed64,19,opt,name=totalWithdrawUsdt,proto3" json:"totalWithdrawUsdt,omitempty"`                    // 总提U数量
-	TotalFour                    float64 `protobuf:"fixed64,20,opt,name=totalFour,proto3" json:"totalFour,omitempty"`                                    // 今日节点总奖励
-	TotalFive                    float64 `protobuf:"fixed64,21,opt,name=totalFive,proto3" json:"totalFive,omitempty"`                                    // 今日超级节点总奖励
-	Total                        float64 `protobuf:"fixed64,22,opt,name=total,proto3" json:"total,omitempty"`                                            // 今日挖矿奖励
+	Reviews []*AdminWithdrawReviewsReply_List `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
 }
 
-func (x *AdminAllReply) Reset() {
-	*x = AdminAllReply{}
+func (x *AdminWithdrawReviewsReply) Reset() {
+	*x = AdminWithdrawReviewsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[53]
+		mi := &file_app_app_api_app_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminAllReply) String() string {
+func (x *AdminWithdrawReviewsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllReply) ProtoMessage() {}
+func (*AdminWithdrawReviewsReply) ProtoMessage() {}
 
-func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[53]
+func (x *AdminWithdrawReviewsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,142 +3306,117 @@ func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
-func (*AdminAllReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *AdminAllReply) GetTotalUser() int64 {
-	if x != nil {
-		return x.TotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetTodayLocation() int64 {
-	if x != nil {
-		return x.TodayLocation
-	}
-	return 0
+// Deprecated: Use AdminWithdrawReviewsReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewsReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AdminAllReply) GetAllLocation() int64 {
+func (x *AdminWithdrawReviewsReply) GetReviews() []*AdminWithdrawReviewsReply_List {
 	if x != nil {
-		return x.AllLocation
+		return x.Reviews
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminAllReply) GetTodayLocationReward() string {
-	if x != nil {
-		return x.TodayLocationReward
-	}
-	return ""
+type AdminWithdrawEthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminAllReply) GetTodayRecommendReward() string {
-	if x != nil {
-		return x.TodayRecommendReward
+func (x *AdminWithdrawEthRequest) Reset() {
+	*x = AdminWithdrawEthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminAllReply) GetTodayRecommendLocationReward() string {
-	if x != nil {
-		return x.TodayRecommendLocationReward
-	}
-	return ""
+func (x *AdminWithdrawEthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminAllReply) GetTodayAreaReward() string {
-	if x != nil {
-		return x.TodayAreaReward
-	}
-	return ""
-}
+func (*AdminWithdrawEthRequest) ProtoMessage() {}
 
-func (x *AdminAllReply) GetTodayFourReward() string {
-	if x != nil {
-		return x.TodayFourReward
+func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminAllReply) GetTotalIsps() string {
-	if x != nil {
-		return x.TotalIsps
-	}
-	return ""
+// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AdminAllReply) GetTotalUsdt() float64 {
-	if x != nil {
-		return x.TotalUsdt
-	}
-	return 0
+type AdminWithdrawEthReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminAllReply) GetTodayWithdrawUsdt() float64 {
-	if x != nil {
-		return x.TodayWithdrawUsdt
+func (x *AdminWithdrawEthReply) Reset() {
+	*x = AdminWithdrawEthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminAllReply) GetTotalWithdrawUsdt() float64 {
-	if x != nil {
-		return x.TotalWithdrawUsdt
-	}
-	return 0
+func (x *AdminWithdrawEthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminAllReply) GetTotalFour() float64 {
-	if x != nil {
-		return x.TotalFour
-	}
-	return 0
-}
+func (*AdminWithdrawEthReply) ProtoMessage() {}
 
-func (x *AdminAllReply) GetTotalFive() float64 {
-	if x != nil {
-		return x.TotalFive
+func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminAllReply) GetTotal() float64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{58}
 }
 
-type AdminUserRecommendRequest struct {
+type AdminFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminUserRecommendRequest) Reset() {
-	*x = AdminUserRecommendRequest{}
+func (x *AdminFeeRequest) Reset() {
+	*x = AdminFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[54]
+		mi := &file_app_app_api_app_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendRequest) String() string {
+func (x *AdminFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendRequest) ProtoMessage() {}
+func (*AdminFeeRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[54]
+func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2844,50 +3427,34 @@ func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *AdminUserRecommendRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
+func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{59}
 }
 
-type AdminUserRecommendReply struct {
+type AdminFeeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *AdminUserRecommendReply) Reset() {
-	*x = AdminUserRecommendReply{}
+func (x *AdminFeeReply) Reset() {
+	*x = AdminFeeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[55]
+		mi := &file_app_app_api_app_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendReply) String() string {
+func (x *AdminFeeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply) ProtoMessage() {}
+func (*AdminFeeReply) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[55]
+func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,44 +3465,36 @@ func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
+func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{60}
 }
 
-type AdminMonthRecommendRequest struct {
+type AdminDailyFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Day int32 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
 }
 
-func (x *AdminMonthRecommendRequest) Reset() {
-	*x = AdminMonthRecommendRequest{}
+func (x *AdminDailyFeeRequest) Reset() {
+	*x = AdminDailyFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[56]
+		mi := &file_app_app_api_app_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendRequest) String() string {
+func (x *AdminDailyFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendRequest) ProtoMessage() {}
+func (*AdminDailyFeeRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[56]
+func (x *AdminDailyFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2946,51 +3505,41 @@ func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *AdminMonthRecommendRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use AdminDailyFeeRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyFeeRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AdminMonthRecommendRequest) GetPage() int64 {
+func (x *AdminDailyFeeRequest) GetDay() int32 {
 	if x != nil {
-		return x.Page
+		return x.Day
 	}
 	return 0
 }
 
-type AdminMonthRecommendReply struct {
+type AdminDailyFeeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply) Reset() {
-	*x = AdminMonthRecommendReply{}
+func (x *AdminDailyFeeReply) Reset() {
+	*x = AdminDailyFeeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[57]
+		mi := &file_app_app_api_app_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendReply) String() string {
+func (x *AdminDailyFeeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply) ProtoMessage() {}
+func (*AdminDailyFeeReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[57]
+func (x *AdminDailyFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3001,51 +3550,34 @@ func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *AdminMonthRecommendReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// Deprecated: Use AdminDailyFeeReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyFeeReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{62}
 }
 
-type AdminConfigRequest struct {
+type AdminAllRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *AdminConfigRequest) Reset() {
-	*x = AdminConfigRequest{}
+func (x *AdminAllRequest) Reset() {
+	*x = AdminAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[58]
+		mi := &file_app_app_api_app_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigRequest) String() string {
+func (x *AdminAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigRequest) ProtoMessage() {}
+func (*AdminAllRequest) ProtoMessage() {}
 
-func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[58]
+func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3056,51 +3588,50 @@ func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *AdminConfigRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminConfigRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
+func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{63}
 }
 
-type AdminConfigReply struct {
+type AdminAllReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
-	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalUser                    int64   `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`                                      // 激活人数
+	TodayLocation                int64   `protobuf:"varint,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`                              // 今日入金u数量
+	AllLocation                  int64   `protobuf:"varint,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`                                  // 总入金u数量
+	TodayLocationReward          string  `protobuf:"bytes,1,opt,name=todayLocationReward,proto3" json:"todayLocationReward,omitempty"`                   // 今日静态释放
+	TodayRecommendReward         string  `protobuf:"bytes,3,opt,name=todayRecommendReward,proto3" json:"todayRecommendReward,omitempty"`                 // 今日动态释放
+	TodayRecommendLocationReward string  `protobuf:"bytes,8,opt,name=todayRecommendLocationReward,proto3" json:"todayRecommendLocationReward,omitempty"` // 今日直推秒结释放
+	TodayAreaReward              string  `protobuf:"bytes,16,opt,name=todayAreaReward,proto3" json:"todayAreaReward,omitempty"`                          // 今日矩阵释放
+	TodayFourReward              string  `protobuf:"bytes,15,opt,name=todayFourReward,proto3" json:"todayFourReward,omitempty"`                          // 今日前四名分红u数量
+	TotalIsps                    string  `protobuf:"bytes,6,opt,name=totalIsps,proto3" json:"totalIsps,omitempty"`                                       // 全网ISPS数量
+	TotalUsdt                    float64 `protobuf:"fixed64,7,opt,name=totalUsdt,proto3" json:"totalUsdt,omitempty"`                                     // 全网可提U数量
+	TodayWithdrawUsdt            float64 `protobuf:"fixed64,18,opt,name=todayWithdrawUsdt,proto3" json:"todayWithdrawUsdt,omitempty"`                    // 今日提U数量
+	TotalWithdrawUsdt            float64 `protobuf:"fixed64,19,opt,name=totalWithdrawUsdt,proto3" json:"totalWithdrawUsdt,omitempty"`                    // 总提U数量
+	TotalFour                    float64 `protobuf:"fixed64,20,opt,name=totalFour,proto3" json:"totalFour,omitempty"`                                    // 今日节点总奖励
+	TotalFive                    float64 `protobuf:"fixed64,21,opt,name=totalFive,proto3" json:"totalFive,omitempty"`                                    // 今日超级节点总奖励
+	Total                        float64 `protobuf:"fixed64,22,opt,name=total,proto3" json:"total,omitempty"`                                            // 今日挖矿奖励
 }
 
-func (x *AdminConfigReply) Reset() {
-	*x = AdminConfigReply{}
+func (x *AdminAllReply) Reset() {
+	*x = AdminAllReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[59]
+		mi := &file_app_app_api_app_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply) String() string {
+func (x *AdminAllReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply) ProtoMessage() {}
+func (*AdminAllReply) ProtoMessage() {}
 
-func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[59]
+func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3111,133 +3642,142 @@ func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
+func (*AdminAllReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+func (x *AdminAllReply) GetTotalUser() int64 {
 	if x != nil {
-		return x.Config
+		return x.TotalUser
 	}
-	return nil
+	return 0
 }
 
-func (x *AdminConfigReply) GetCount() int64 {
+func (x *AdminAllReply) GetTodayLocation() int64 {
 	if x != nil {
-		return x.Count
+		return x.TodayLocation
 	}
 	return 0
 }
 
-type AdminListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AdminAllReply) GetAllLocation() int64 {
+	if x != nil {
+		return x.AllLocation
+	}
+	return 0
 }
 
-func (x *AdminListRequest) Reset() {
-	*x = AdminListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[60]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminAllReply) GetTodayLocationReward() string {
+	if x != nil {
+		return x.TodayLocationReward
 	}
+	return ""
 }
 
-func (x *AdminListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminAllReply) GetTodayRecommendReward() string {
+	if x != nil {
+		return x.TodayRecommendReward
+	}
+	return ""
 }
 
-func (*AdminListRequest) ProtoMessage() {}
-
-func (x *AdminListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[60]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminAllReply) GetTodayRecommendLocationReward() string {
+	if x != nil {
+		return x.TodayRecommendLocationReward
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AdminListRequest.ProtoReflect.Descriptor instead.
-func (*AdminListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{60}
+func (x *AdminAllReply) GetTodayAreaReward() string {
+	if x != nil {
+		return x.TodayAreaReward
+	}
+	return ""
 }
 
-type AdminListReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Account []*AdminListReply_List `protobuf:"bytes,1,rep,name=account,proto3" json:"account,omitempty"`
+func (x *AdminAllReply) GetTodayFourReward() string {
+	if x != nil {
+		return x.TodayFourReward
+	}
+	return ""
 }
 
-func (x *AdminListReply) Reset() {
-	*x = AdminListReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[61]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminAllReply) GetTotalIsps() string {
+	if x != nil {
+		return x.TotalIsps
 	}
+	return ""
 }
 
-func (x *AdminListReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminAllReply) GetTotalUsdt() float64 {
+	if x != nil {
+		return x.TotalUsdt
+	}
+	return 0
 }
 
-func (*AdminListReply) ProtoMessage() {}
-
-func (x *AdminListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[61]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminAllReply) GetTodayWithdrawUsdt() float64 {
+	if x != nil {
+		return x.TodayWithdrawUsdt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AdminListReply.ProtoReflect.Descriptor instead.
-func (*AdminListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{61}
+func (x *AdminAllReply) GetTotalWithdrawUsdt() float64 {
+	if x != nil {
+		return x.TotalWithdrawUsdt
+	}
+	return 0
 }
 
-func (x *AdminListReply) GetAccount() []*AdminListReply_List {
+func (x *AdminAllReply) GetTotalFour() float64 {
 	if x != nil {
-		return x.Account
+		return x.TotalFour
 	}
-	return nil
+	return 0
 }
 
-type AuthListRequest struct {
+func (x *AdminAllReply) GetTotalFive() float64 {
+	if x != nil {
+		return x.TotalFive
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AdminUserRecommendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AuthListRequest) Reset() {
-	*x = AuthListRequest{}
+func (x *AdminUserRecommendRequest) Reset() {
+	*x = AdminUserRecommendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[62]
+		mi := &file_app_app_api_app_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthListRequest) String() string {
+func (x *AdminUserRecommendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthListRequest) ProtoMessage() {}
+func (*AdminUserRecommendRequest) ProtoMessage() {}
 
-func (x *AuthListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[62]
+func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,36 +3788,50 @@ func (x *AuthListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthListRequest.ProtoReflect.Descriptor instead.
-func (*AuthListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{65}
 }
 
-type AuthListReply struct {
+func (x *AdminUserRecommendRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminUserRecommendReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth []*AuthListReply_List `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
+	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *AuthListReply) Reset() {
-	*x = AuthListReply{}
+func (x *AdminUserRecommendReply) Reset() {
+	*x = AdminUserRecommendReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[63]
+		mi := &file_app_app_api_app_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthListReply) String() string {
+func (x *AdminUserRecommendReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthListReply) ProtoMessage() {}
+func (*AdminUserRecommendReply) ProtoMessage() {}
 
-func (x *AuthListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[63]
+func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3288,43 +3842,44 @@ func (x *AuthListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthListReply.ProtoReflect.Descriptor instead.
-func (*AuthListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *AuthListReply) GetAuth() []*AuthListReply_List {
+func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
 	if x != nil {
-		return x.Auth
+		return x.Users
 	}
 	return nil
 }
 
-type UserAuthListRequest struct {
+type AdminMonthRecommendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *UserAuthListRequest) Reset() {
-	*x = UserAuthListRequest{}
+func (x *AdminMonthRecommendRequest) Reset() {
+	*x = AdminMonthRecommendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[64]
+		mi := &file_app_app_api_app_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserAuthListRequest) String() string {
+func (x *AdminMonthRecommendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAuthListRequest) ProtoMessage() {}
+func (*AdminMonthRecommendRequest) ProtoMessage() {}
 
-func (x *UserAuthListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[64]
+func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3335,43 +3890,51 @@ func (x *UserAuthListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAuthListRequest.ProtoReflect.Descriptor instead.
-func (*UserAuthListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UserAuthListRequest) GetAdminId() int64 {
+func (x *AdminMonthRecommendRequest) GetAddress() string {
 	if x != nil {
-		return x.AdminId
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-type UserAuthListReply struct {
+type AdminMonthRecommendReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth []*UserAuthListReply_List `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
+	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *UserAuthListReply) Reset() {
-	*x = UserAuthListReply{}
+func (x *AdminMonthRecommendReply) Reset() {
+	*x = AdminMonthRecommendReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[65]
+		mi := &file_app_app_api_app_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserAuthListReply) String() string {
+func (x *AdminMonthRecommendReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAuthListReply) ProtoMessage() {}
+func (*AdminMonthRecommendReply) ProtoMessage() {}
 
-func (x *UserAuthListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[65]
+func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3382,41 +3945,51 @@ func (x *UserAuthListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAuthListReply.ProtoReflect.Descriptor instead.
-func (*UserAuthListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UserAuthListReply) GetAuth() []*UserAuthListReply_List {
+func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
 	if x != nil {
-		return x.Auth
+		return x.Users
 	}
 	return nil
 }
 
-type MyAuthListRequest struct {
+func (x *AdminMonthRecommendReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *MyAuthListRequest) Reset() {
-	*x = MyAuthListRequest{}
+func (x *AdminConfigRequest) Reset() {
+	*x = AdminConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[66]
+		mi := &file_app_app_api_app_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MyAuthListRequest) String() string {
+func (x *AdminConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyAuthListRequest) ProtoMessage() {}
+func (*AdminConfigRequest) ProtoMessage() {}
 
-func (x *MyAuthListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[66]
+func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3427,37 +4000,51 @@ func (x *MyAuthListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyAuthListRequest.ProtoReflect.Descriptor instead.
-func (*MyAuthListRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{69}
 }
 
-type MyAuthListReply struct {
+func (x *AdminConfigRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminConfigRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminConfigReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth  []*MyAuthListReply_List `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
-	Super int64                   `protobuf:"varint,2,opt,name=super,proto3" json:"super,omitempty"`
+	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
+	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *MyAuthListReply) Reset() {
-	*x = MyAuthListReply{}
+func (x *AdminConfigReply) Reset() {
+	*x = AdminConfigReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[67]
+		mi := &file_app_app_api_app_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MyAuthListReply) String() string {
+func (x *AdminConfigReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyAuthListReply) ProtoMessage() {}
+func (*AdminConfigReply) ProtoMessage() {}
 
-func (x *MyAuthListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[67]
+func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3468,51 +4055,49 @@ func (x *MyAuthListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyAuthListReply.ProtoReflect.Descriptor instead.
-func (*MyAuthListReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *MyAuthListReply) GetAuth() []*MyAuthListReply_List {
+func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
 	if x != nil {
-		return x.Auth
+		return x.Config
 	}
 	return nil
 }
 
-func (x *MyAuthListReply) GetSuper() int64 {
+func (x *AdminConfigReply) GetCount() int64 {
 	if x != nil {
-		return x.Super
+		return x.Count
 	}
 	return 0
 }
 
-type AdminConfigUpdateRequest struct {
+type AdminListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest) Reset() {
-	*x = AdminConfigUpdateRequest{}
+func (x *AdminListRequest) Reset() {
+	*x = AdminListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[68]
+		mi := &file_app_app_api_app_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateRequest) String() string {
+func (x *AdminListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest) ProtoMessage() {}
+func (*AdminListRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[68]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *AdminListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3522,41 +4107,36 @@ func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
-	if x != nil {
-		return x.SendBody
-	}
-	return nil
+// Deprecated: Use AdminListRequest.ProtoReflect.Descriptor instead.
+func (*AdminListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{71}
 }
 
-type AdminConfigUpdateReply struct {
+type AdminListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Account []*AdminListReply_List `protobuf:"bytes,1,rep,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *AdminConfigUpdateReply) Reset() {
-	*x = AdminConfigUpdateReply{}
+func (x *AdminListReply) Reset() {
+	*x = AdminListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[69]
+		mi := &file_app_app_api_app_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateReply) String() string {
+func (x *AdminListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateReply) ProtoMessage() {}
+func (*AdminListReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[69]
+func (x *AdminListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3567,34 +4147,41 @@ func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use AdminListReply.ProtoReflect.Descriptor instead.
+func (*AdminListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{72}
 }
 
-type AdminConfigUpdateListenRequest struct {
+func (x *AdminListReply) GetAccount() []*AdminListReply_List {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AuthListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminConfigUpdateListenRequest) Reset() {
-	*x = AdminConfigUpdateListenRequest{}
+func (x *AuthListRequest) Reset() {
+	*x = AuthListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[70]
+		mi := &file_app_app_api_app_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateListenRequest) String() string {
+func (x *AuthListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateListenRequest) ProtoMessage() {}
+func (*AuthListRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateListenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[70]
+func (x *AuthListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3605,34 +4192,36 @@ func (x *AdminConfigUpdateListenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateListenRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateListenRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use AuthListRequest.ProtoReflect.Descriptor instead.
+func (*AuthListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{73}
 }
 
-type AdminConfigUpdateListenReply struct {
+type AuthListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Auth []*AuthListReply_List `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
 }
 
-func (x *AdminConfigUpdateListenReply) Reset() {
-	*x = AdminConfigUpdateListenReply{}
+func (x *AuthListReply) Reset() {
+	*x = AuthListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[71]
+		mi := &file_app_app_api_app_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateListenReply) String() string {
+func (x *AuthListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateListenReply) ProtoMessage() {}
+func (*AuthListReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateListenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[71]
+func (x *AuthListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3643,36 +4232,43 @@ func (x *AdminConfigUpdateListenReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateListenReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateListenReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use AuthListReply.ProtoReflect.Descriptor instead.
+func (*AuthListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{74}
 }
 
-type AdminPasswordUpdateRequest struct {
+func (x *AuthListReply) GetAuth() []*AuthListReply_List {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type UserAuthListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminPasswordUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
 }
 
-func (x *AdminPasswordUpdateRequest) Reset() {
-	*x = AdminPasswordUpdateRequest{}
+func (x *UserAuthListRequest) Reset() {
+	*x = UserAuthListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[72]
+		mi := &file_app_app_api_app_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminPasswordUpdateRequest) String() string {
+func (x *UserAuthListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminPasswordUpdateRequest) ProtoMessage() {}
+func (*UserAuthListRequest) ProtoMessage() {}
 
-func (x *AdminPasswordUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[72]
+func (x *UserAuthListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3683,41 +4279,43 @@ func (x *AdminPasswordUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminPasswordUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminPasswordUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use UserAuthListRequest.ProtoReflect.Descriptor instead.
+func (*UserAuthListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AdminPasswordUpdateRequest) GetSendBody() *AdminPasswordUpdateRequest_SendBody {
+func (x *UserAuthListRequest) GetAdminId() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.AdminId
 	}
-	return nil
+	return 0
 }
 
-type AdminPasswordUpdateReply struct {
+type UserAuthListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Auth []*UserAuthListReply_List `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
 }
 
-func (x *AdminPasswordUpdateReply) Reset() {
-	*x = AdminPasswordUpdateReply{}
+func (x *UserAuthListReply) Reset() {
+	*x = UserAuthListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[73]
+		mi := &file_app_app_api_app_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminPasswordUpdateReply) String() string {
+func (x *UserAuthListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminPasswordUpdateReply) ProtoMessage() {}
+func (*UserAuthListReply) ProtoMessage() {}
 
-func (x *AdminPasswordUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[73]
+func (x *UserAuthListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3728,36 +4326,41 @@ func (x *AdminPasswordUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminPasswordUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminPasswordUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use UserAuthListReply.ProtoReflect.Descriptor instead.
+func (*UserAuthListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{76}
 }
 
-type AdminUpdateLocationNewMaxRequest struct {
+func (x *UserAuthListReply) GetAuth() []*UserAuthListReply_List {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type MyAuthListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SendBody *AdminUpdateLocationNewMaxRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUpdateLocationNewMaxRequest) Reset() {
-	*x = AdminUpdateLocationNewMaxRequest{}
+func (x *MyAuthListRequest) Reset() {
+	*x = MyAuthListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[74]
+		mi := &file_app_app_api_app_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUpdateLocationNewMaxRequest) String() string {
+func (x *MyAuthListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateLocationNewMaxRequest) ProtoMessage() {}
+func (*MyAuthListRequest) ProtoMessage() {}
 
-func (x *AdminUpdateLocationNewMaxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[74]
+func (x *MyAuthListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3768,41 +4371,37 @@ func (x *AdminUpdateLocationNewMaxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateLocationNewMaxRequest.ProtoReflect.Descriptor instead.
-func (*AdminUpdateLocationNewMaxRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *AdminUpdateLocationNewMaxRequest) GetSendBody() *AdminUpdateLocationNewMaxRequest_SendBody {
-	if x != nil {
-		return x.SendBody
-	}
-	return nil
+// Deprecated: Use MyAuthListRequest.ProtoReflect.Descriptor instead.
+func (*MyAuthListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{77}
 }
 
-type AdminUpdateLocationNewMaxReply struct {
+type MyAuthListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Auth  []*MyAuthListReply_List `protobuf:"bytes,1,rep,name=auth,proto3" json:"auth,omitempty"`
+	Super int64                   `protobuf:"varint,2,opt,name=super,proto3" json:"super,omitempty"`
 }
 
-func (x *AdminUpdateLocationNewMaxReply) Reset() {
-	*x = AdminUpdateLocationNewMaxReply{}
+func (x *MyAuthListReply) Reset() {
+	*x = MyAuthListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[75]
+		mi := &file_app_app_api_app_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUpdateLocationNewMaxReply) String() string {
+func (x *MyAuthListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateLocationNewMaxReply) ProtoMessage() {}
+func (*MyAuthListReply) ProtoMessage() {}
 
-func (x *AdminUpdateLocationNewMaxReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[75]
+func (x *MyAuthListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,36 +4412,50 @@ func (x *AdminUpdateLocationNewMaxReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateLocationNewMaxReply.ProtoReflect.Descriptor instead.
-func (*AdminUpdateLocationNewMaxReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use MyAuthListReply.ProtoReflect.Descriptor instead.
+func (*MyAuthListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{78}
 }
 
-type AdminVipDeleteRequest struct {
+func (x *MyAuthListReply) GetAuth() []*MyAuthListReply_List {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *MyAuthListReply) GetSuper() int64 {
+	if x != nil {
+		return x.Super
+	}
+	return 0
+}
+
+type AdminConfigUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminVipDeleteRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminVipDeleteRequest) Reset() {
-	*x = AdminVipDeleteRequest{}
+func (x *AdminConfigUpdateRequest) Reset() {
+	*x = AdminConfigUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[76]
+		mi := &file_app_app_api_app_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminVipDeleteRequest) String() string {
+func (x *AdminConfigUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminVipDeleteRequest) ProtoMessage() {}
+func (*AdminConfigUpdateRequest) ProtoMessage() {}
 
-func (x *AdminVipDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[76]
+func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3853,81 +4466,43 @@ func (x *AdminVipDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminVipDeleteRequest.ProtoReflect.Descriptor instead.
-func (*AdminVipDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *AdminVipDeleteRequest) GetSendBody() *AdminVipDeleteRequest_SendBody {
+func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
 	if x != nil {
 		return x.SendBody
 	}
 	return nil
 }
 
-type AdminVipDeleteReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *AdminVipDeleteReply) Reset() {
-	*x = AdminVipDeleteReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[77]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AdminVipDeleteReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AdminVipDeleteReply) ProtoMessage() {}
-
-func (x *AdminVipDeleteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[77]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AdminVipDeleteReply.ProtoReflect.Descriptor instead.
-func (*AdminVipDeleteReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{77}
-}
-
-type AdminVipUpdateRequest struct {
+type AdminConfigUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminVipUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	VersionId int64 `protobuf:"varint,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 }
 
-func (x *AdminVipUpdateRequest) Reset() {
-	*x = AdminVipUpdateRequest{}
+func (x *AdminConfigUpdateReply) Reset() {
+	*x = AdminConfigUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[78]
+		mi := &file_app_app_api_app_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminVipUpdateRequest) String() string {
+func (x *AdminConfigUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminVipUpdateRequest) ProtoMessage() {}
+func (*AdminConfigUpdateReply) ProtoMessage() {}
 
-func (x *AdminVipUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[78]
+func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,41 +4513,44 @@ func (x *AdminVipUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminVipUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminVipUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *AdminVipUpdateRequest) GetSendBody() *AdminVipUpdateRequest_SendBody {
+func (x *AdminConfigUpdateReply) GetVersionId() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.VersionId
 	}
-	return nil
+	return 0
 }
 
-type AdminVipUpdateReply struct {
+type AdminConfigHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyName string `protobuf:"bytes,2,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"` // id和key_name任选一个
 }
 
-func (x *AdminVipUpdateReply) Reset() {
-	*x = AdminVipUpdateReply{}
+func (x *AdminConfigHistoryRequest) Reset() {
+	*x = AdminConfigHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[79]
+		mi := &file_app_app_api_app_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminVipUpdateReply) String() string {
+func (x *AdminConfigHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminVipUpdateReply) ProtoMessage() {}
+func (*AdminConfigHistoryRequest) ProtoMessage() {}
 
-func (x *AdminVipUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[79]
+func (x *AdminConfigHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3983,81 +4561,54 @@ func (x *AdminVipUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminVipUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminVipUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{79}
-}
-
-type AdminKkdtUpdateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SendBody *AdminKkdtUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
-}
-
-func (x *AdminKkdtUpdateRequest) Reset() {
-	*x = AdminKkdtUpdateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[80]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AdminKkdtUpdateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use AdminConfigHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{81}
 }
 
-func (*AdminKkdtUpdateRequest) ProtoMessage() {}
-
-func (x *AdminKkdtUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[80]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminConfigHistoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AdminKkdtUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminKkdtUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{80}
+	return 0
 }
 
-func (x *AdminKkdtUpdateRequest) GetSendBody() *AdminKkdtUpdateRequest_SendBody {
+func (x *AdminConfigHistoryRequest) GetKeyName() string {
 	if x != nil {
-		return x.SendBody
+		return x.KeyName
 	}
-	return nil
+	return ""
 }
 
-type AdminKkdtUpdateReply struct {
+type AdminConfigHistoryReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id       int64                              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyName  string                             `protobuf:"bytes,2,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	Name     string                             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value    string                             `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"` // 当前生效的值
+	Versions []*AdminConfigHistoryReply_Version `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *AdminKkdtUpdateReply) Reset() {
-	*x = AdminKkdtUpdateReply{}
+func (x *AdminConfigHistoryReply) Reset() {
+	*x = AdminConfigHistoryReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[81]
+		mi := &file_app_app_api_app_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminKkdtUpdateReply) String() string {
+func (x *AdminConfigHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminKkdtUpdateReply) ProtoMessage() {}
+func (*AdminConfigHistoryReply) ProtoMessage() {}
 
-func (x *AdminKkdtUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[81]
+func (x *AdminConfigHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4068,66 +4619,56 @@ func (x *AdminKkdtUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminKkdtUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminKkdtUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{81}
-}
-
-type AdminUndoUpdateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SendBody *AdminUndoUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+// Deprecated: Use AdminConfigHistoryReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigHistoryReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *AdminUndoUpdateRequest) Reset() {
-	*x = AdminUndoUpdateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[82]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminConfigHistoryReply) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-func (x *AdminUndoUpdateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminConfigHistoryReply) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
 }
 
-func (*AdminUndoUpdateRequest) ProtoMessage() {}
-
-func (x *AdminUndoUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[82]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminConfigHistoryReply) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AdminUndoUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminUndoUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{82}
+func (x *AdminConfigHistoryReply) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-func (x *AdminUndoUpdateRequest) GetSendBody() *AdminUndoUpdateRequest_SendBody {
+func (x *AdminConfigHistoryReply) GetVersions() []*AdminConfigHistoryReply_Version {
 	if x != nil {
-		return x.SendBody
+		return x.Versions
 	}
 	return nil
 }
 
-type AdminUndoUpdateReply struct {
+type AdminConfigRollbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminConfigRollbackRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUndoUpdateReply) Reset() {
-	*x = AdminUndoUpdateReply{}
+func (x *AdminConfigRollbackRequest) Reset() {
+	*x = AdminConfigRollbackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4135,13 +4676,13 @@ func (x *AdminUndoUpdateReply) Reset() {
 	}
 }
 
-func (x *AdminUndoUpdateReply) String() string {
+func (x *AdminConfigRollbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUndoUpdateReply) ProtoMessage() {}
+func (*AdminConfigRollbackRequest) ProtoMessage() {}
 
-func (x *AdminUndoUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *AdminConfigRollbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4153,21 +4694,28 @@ func (x *AdminUndoUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUndoUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminUndoUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminConfigRollbackRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigRollbackRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{83}
 }
 
-type AdminAreaLevelUpdateRequest struct {
+func (x *AdminConfigRollbackRequest) GetSendBody() *AdminConfigRollbackRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminConfigRollbackReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminAreaLevelUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	VersionId int64 `protobuf:"varint,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
 }
 
-func (x *AdminAreaLevelUpdateRequest) Reset() {
-	*x = AdminAreaLevelUpdateRequest{}
+func (x *AdminConfigRollbackReply) Reset() {
+	*x = AdminConfigRollbackReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4175,13 +4723,13 @@ func (x *AdminAreaLevelUpdateRequest) Reset() {
 	}
 }
 
-func (x *AdminAreaLevelUpdateRequest) String() string {
+func (x *AdminConfigRollbackReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAreaLevelUpdateRequest) ProtoMessage() {}
+func (*AdminConfigRollbackReply) ProtoMessage() {}
 
-func (x *AdminAreaLevelUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminConfigRollbackReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4193,26 +4741,28 @@ func (x *AdminAreaLevelUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAreaLevelUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminAreaLevelUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminConfigRollbackReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigRollbackReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AdminAreaLevelUpdateRequest) GetSendBody() *AdminAreaLevelUpdateRequest_SendBody {
+func (x *AdminConfigRollbackReply) GetVersionId() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.VersionId
 	}
-	return nil
+	return 0
 }
 
-type AdminAreaLevelUpdateReply struct {
+type AdminConfigUpdateListenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *AdminAreaLevelUpdateReply) Reset() {
-	*x = AdminAreaLevelUpdateReply{}
+func (x *AdminConfigUpdateListenRequest) Reset() {
+	*x = AdminConfigUpdateListenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4220,13 +4770,13 @@ func (x *AdminAreaLevelUpdateReply) Reset() {
 	}
 }
 
-func (x *AdminAreaLevelUpdateReply) String() string {
+func (x *AdminConfigUpdateListenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAreaLevelUpdateReply) ProtoMessage() {}
+func (*AdminConfigUpdateListenRequest) ProtoMessage() {}
 
-func (x *AdminAreaLevelUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *AdminConfigUpdateListenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4238,21 +4788,41 @@ func (x *AdminAreaLevelUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAreaLevelUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminAreaLevelUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminConfigUpdateListenRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateListenRequest) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{85}
 }
 
-type AdminLocationInsertRequest struct {
+func (x *AdminConfigUpdateListenRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AdminConfigUpdateListenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminLocationInsertRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	PriceChangeId int64   `protobuf:"varint,1,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"`
+	Origin        int64   `protobuf:"varint,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Price         int64   `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	DryRun        bool    `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Users         int64   `protobuf:"varint,5,opt,name=users,proto3" json:"users,omitempty"`
+	Up            int64   `protobuf:"varint,6,opt,name=up,proto3" json:"up,omitempty"`
+	Down          int64   `protobuf:"varint,7,opt,name=down,proto3" json:"down,omitempty"`
+	Stopped       int64   `protobuf:"varint,8,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	Amount        string  `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Failed        []int64 `protobuf:"varint,10,rep,packed,name=failed,proto3" json:"failed,omitempty"`
+	RunId         int64   `protobuf:"varint,11,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status        string  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Resumed       bool    `protobuf:"varint,13,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	Retried       int64   `protobuf:"varint,14,opt,name=retried,proto3" json:"retried,omitempty"`
 }
 
-func (x *AdminLocationInsertRequest) Reset() {
-	*x = AdminLocationInsertRequest{}
+func (x *AdminConfigUpdateListenReply) Reset() {
+	*x = AdminConfigUpdateListenReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4260,13 +4830,13 @@ func (x *AdminLocationInsertRequest) Reset() {
 	}
 }
 
-func (x *AdminLocationInsertRequest) String() string {
+func (x *AdminConfigUpdateListenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationInsertRequest) ProtoMessage() {}
+func (*AdminConfigUpdateListenReply) ProtoMessage() {}
 
-func (x *AdminLocationInsertRequest) ProtoReflect() protoreflect.Message {
+func (x *AdminConfigUpdateListenReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4278,126 +4848,134 @@ func (x *AdminLocationInsertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationInsertRequest.ProtoReflect.Descriptor instead.
-func (*AdminLocationInsertRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminConfigUpdateListenReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateListenReply) Descriptor() ([]byte, []int) {
 	return file_app_app_api_app_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *AdminLocationInsertRequest) GetSendBody() *AdminLocationInsertRequest_SendBody {
+func (x *AdminConfigUpdateListenReply) GetPriceChangeId() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.PriceChangeId
 	}
-	return nil
+	return 0
 }
 
-type AdminLocationInsertReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AdminConfigUpdateListenReply) GetOrigin() int64 {
+	if x != nil {
+		return x.Origin
+	}
+	return 0
 }
 
-func (x *AdminLocationInsertReply) Reset() {
-	*x = AdminLocationInsertReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[87]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminConfigUpdateListenReply) GetPrice() int64 {
+	if x != nil {
+		return x.Price
 	}
+	return 0
 }
 
-func (x *AdminLocationInsertReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminConfigUpdateListenReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (*AdminLocationInsertReply) ProtoMessage() {}
-
-func (x *AdminLocationInsertReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[87]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminConfigUpdateListenReply) GetUsers() int64 {
+	if x != nil {
+		return x.Users
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AdminLocationInsertReply.ProtoReflect.Descriptor instead.
-func (*AdminLocationInsertReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{87}
+func (x *AdminConfigUpdateListenReply) GetUp() int64 {
+	if x != nil {
+		return x.Up
+	}
+	return 0
 }
 
-type AdminBalanceUpdateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AdminConfigUpdateListenReply) GetDown() int64 {
+	if x != nil {
+		return x.Down
+	}
+	return 0
+}
 
-	SendBody *AdminBalanceUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+func (x *AdminConfigUpdateListenReply) GetStopped() int64 {
+	if x != nil {
+		return x.Stopped
+	}
+	return 0
 }
 
-func (x *AdminBalanceUpdateRequest) Reset() {
-	*x = AdminBalanceUpdateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[88]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminConfigUpdateListenReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
+	return ""
 }
 
-func (x *AdminBalanceUpdateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminConfigUpdateListenReply) GetFailed() []int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
 }
 
-func (*AdminBalanceUpdateRequest) ProtoMessage() {}
+func (x *AdminConfigUpdateListenReply) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
 
-func (x *AdminBalanceUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[88]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminConfigUpdateListenReply) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AdminBalanceUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminBalanceUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{88}
+func (x *AdminConfigUpdateListenReply) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
 }
 
-func (x *AdminBalanceUpdateRequest) GetSendBody() *AdminBalanceUpdateRequest_SendBody {
+func (x *AdminConfigUpdateListenReply) GetRetried() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.Retried
 	}
-	return nil
+	return 0
 }
 
-type AdminBalanceUpdateReply struct {
+type AdminPriceRevalueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PriceChangeId int64 `protobuf:"varint,1,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"`
 }
 
-func (x *AdminBalanceUpdateReply) Reset() {
-	*x = AdminBalanceUpdateReply{}
+func (x *AdminPriceRevalueRequest) Reset() {
+	*x = AdminPriceRevalueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[89]
+		mi := &file_app_app_api_app_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminBalanceUpdateReply) String() string {
+func (x *AdminPriceRevalueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminBalanceUpdateReply) ProtoMessage() {}
+func (*AdminPriceRevalueRequest) ProtoMessage() {}
 
-func (x *AdminBalanceUpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[89]
+func (x *AdminPriceRevalueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4408,36 +4986,56 @@ func (x *AdminBalanceUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminBalanceUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminBalanceUpdateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use AdminPriceRevalueRequest.ProtoReflect.Descriptor instead.
+func (*AdminPriceRevalueRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{87}
 }
 
-type AuthAdminCreateRequest struct {
+func (x *AdminPriceRevalueRequest) GetPriceChangeId() int64 {
+	if x != nil {
+		return x.PriceChangeId
+	}
+	return 0
+}
+
+type AdminPriceRevalueReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AuthAdminCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
-}
-
-func (x *AuthAdminCreateRequest) Reset() {
-	*x = AuthAdminCreateRequest{}
+	RunId         int64                          `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	PriceChangeId int64                          `protobuf:"varint,2,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"`
+	Status        string                         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	LastUserId    int64                          `protobuf:"varint,4,opt,name=last_user_id,json=lastUserId,proto3" json:"last_user_id,omitempty"`
+	Users         int64                          `protobuf:"varint,5,opt,name=users,proto3" json:"users,omitempty"`
+	Up            int64                          `protobuf:"varint,6,opt,name=up,proto3" json:"up,omitempty"`
+	Down          int64                          `protobuf:"varint,7,opt,name=down,proto3" json:"down,omitempty"`
+	Stopped       int64                          `protobuf:"varint,8,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	Amount        string                         `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Failed        int64                          `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error         string                         `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     string                         `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                         `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Items         []*AdminPriceRevalueReply_Item `protobuf:"bytes,14,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *AdminPriceRevalueReply) Reset() {
+	*x = AdminPriceRevalueReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[90]
+		mi := &file_app_app_api_app_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthAdminCreateRequest) String() string {
+func (x *AdminPriceRevalueReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthAdminCreateRequest) ProtoMessage() {}
+func (*AdminPriceRevalueReply) ProtoMessage() {}
 
-func (x *AuthAdminCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[90]
+func (x *AdminPriceRevalueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4448,126 +5046,134 @@ func (x *AuthAdminCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthAdminCreateRequest.ProtoReflect.Descriptor instead.
-func (*AuthAdminCreateRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use AdminPriceRevalueReply.ProtoReflect.Descriptor instead.
+func (*AdminPriceRevalueReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *AuthAdminCreateRequest) GetSendBody() *AuthAdminCreateRequest_SendBody {
+func (x *AdminPriceRevalueReply) GetRunId() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.RunId
 	}
-	return nil
+	return 0
 }
 
-type AuthAdminCreateReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AdminPriceRevalueReply) GetPriceChangeId() int64 {
+	if x != nil {
+		return x.PriceChangeId
+	}
+	return 0
 }
 
-func (x *AuthAdminCreateReply) Reset() {
-	*x = AuthAdminCreateReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[91]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminPriceRevalueReply) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
+	return ""
 }
 
-func (x *AuthAdminCreateReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminPriceRevalueReply) GetLastUserId() int64 {
+	if x != nil {
+		return x.LastUserId
+	}
+	return 0
 }
 
-func (*AuthAdminCreateReply) ProtoMessage() {}
-
-func (x *AuthAdminCreateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[91]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminPriceRevalueReply) GetUsers() int64 {
+	if x != nil {
+		return x.Users
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AuthAdminCreateReply.ProtoReflect.Descriptor instead.
-func (*AuthAdminCreateReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{91}
+func (x *AdminPriceRevalueReply) GetUp() int64 {
+	if x != nil {
+		return x.Up
+	}
+	return 0
 }
 
-type AuthAdminDeleteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AdminPriceRevalueReply) GetDown() int64 {
+	if x != nil {
+		return x.Down
+	}
+	return 0
+}
 
-	SendBody *AuthAdminDeleteRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+func (x *AdminPriceRevalueReply) GetStopped() int64 {
+	if x != nil {
+		return x.Stopped
+	}
+	return 0
 }
 
-func (x *AuthAdminDeleteRequest) Reset() {
-	*x = AuthAdminDeleteRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[92]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminPriceRevalueReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
+	return ""
 }
 
-func (x *AuthAdminDeleteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminPriceRevalueReply) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
 }
 
-func (*AuthAdminDeleteRequest) ProtoMessage() {}
+func (x *AdminPriceRevalueReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-func (x *AuthAdminDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[92]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminPriceRevalueReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AuthAdminDeleteRequest.ProtoReflect.Descriptor instead.
-func (*AuthAdminDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{92}
+func (x *AdminPriceRevalueReply) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
 }
 
-func (x *AuthAdminDeleteRequest) GetSendBody() *AuthAdminDeleteRequest_SendBody {
+func (x *AdminPriceRevalueReply) GetItems() []*AdminPriceRevalueReply_Item {
 	if x != nil {
-		return x.SendBody
+		return x.Items
 	}
 	return nil
 }
 
-type AuthAdminDeleteReply struct {
+type AdminPriceTickRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *AuthAdminDeleteReply) Reset() {
-	*x = AuthAdminDeleteReply{}
+func (x *AdminPriceTickRequest) Reset() {
+	*x = AdminPriceTickRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[93]
+		mi := &file_app_app_api_app_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthAdminDeleteReply) String() string {
+func (x *AdminPriceTickRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthAdminDeleteReply) ProtoMessage() {}
+func (*AdminPriceTickRequest) ProtoMessage() {}
 
-func (x *AuthAdminDeleteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[93]
+func (x *AdminPriceTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4578,34 +5184,50 @@ func (x *AuthAdminDeleteReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthAdminDeleteReply.ProtoReflect.Descriptor instead.
-func (*AuthAdminDeleteReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use AdminPriceTickRequest.ProtoReflect.Descriptor instead.
+func (*AdminPriceTickRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{89}
 }
 
-type CheckAndInsertRecommendAreaRequest struct {
+func (x *AdminPriceTickRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AdminPriceTickReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Source        string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Quote         string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Current       int64  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Target        int64  `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`
+	Next          int64  `protobuf:"varint,5,opt,name=next,proto3" json:"next,omitempty"`
+	Scheduled     bool   `protobuf:"varint,6,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	PriceChangeId int64  `protobuf:"varint,7,opt,name=price_change_id,json=priceChangeId,proto3" json:"price_change_id,omitempty"`
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *CheckAndInsertRecommendAreaRequest) Reset() {
-	*x = CheckAndInsertRecommendAreaRequest{}
+func (x *AdminPriceTickReply) Reset() {
+	*x = AdminPriceTickReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[94]
+		mi := &file_app_app_api_app_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckAndInsertRecommendAreaRequest) String() string {
+func (x *AdminPriceTickReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAndInsertRecommendAreaRequest) ProtoMessage() {}
+func (*AdminPriceTickReply) ProtoMessage() {}
 
-func (x *CheckAndInsertRecommendAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[94]
+func (x *AdminPriceTickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4616,74 +5238,92 @@ func (x *CheckAndInsertRecommendAreaRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAndInsertRecommendAreaRequest.ProtoReflect.Descriptor instead.
-func (*CheckAndInsertRecommendAreaRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use AdminPriceTickReply.ProtoReflect.Descriptor instead.
+func (*AdminPriceTickReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{90}
 }
 
-type CheckAndInsertRecommendAreaReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AdminPriceTickReply) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
 }
 
-func (x *CheckAndInsertRecommendAreaReply) Reset() {
-	*x = CheckAndInsertRecommendAreaReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[95]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminPriceTickReply) GetQuote() string {
+	if x != nil {
+		return x.Quote
 	}
+	return ""
 }
 
-func (x *CheckAndInsertRecommendAreaReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminPriceTickReply) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
 }
 
-func (*CheckAndInsertRecommendAreaReply) ProtoMessage() {}
+func (x *AdminPriceTickReply) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
 
-func (x *CheckAndInsertRecommendAreaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[95]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AdminPriceTickReply) GetNext() int64 {
+	if x != nil {
+		return x.Next
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CheckAndInsertRecommendAreaReply.ProtoReflect.Descriptor instead.
-func (*CheckAndInsertRecommendAreaReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{95}
+func (x *AdminPriceTickReply) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
 }
 
-type AdminDailyRecommendRewardRequest struct {
+func (x *AdminPriceTickReply) GetPriceChangeId() int64 {
+	if x != nil {
+		return x.PriceChangeId
+	}
+	return 0
+}
+
+func (x *AdminPriceTickReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminPasswordUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Day int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	SendBody *AdminPasswordUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminDailyRecommendRewardRequest) Reset() {
-	*x = AdminDailyRecommendRewardRequest{}
+func (x *AdminPasswordUpdateRequest) Reset() {
+	*x = AdminPasswordUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[96]
+		mi := &file_app_app_api_app_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyRecommendRewardRequest) String() string {
+func (x *AdminPasswordUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyRecommendRewardRequest) ProtoMessage() {}
+func (*AdminPasswordUpdateRequest) ProtoMessage() {}
 
-func (x *AdminDailyRecommendRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[96]
+func (x *AdminPasswordUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4694,41 +5334,41 @@ func (x *AdminDailyRecommendRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyRecommendRewardRequest.ProtoReflect.Descriptor instead.
-func (*AdminDailyRecommendRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use AdminPasswordUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminPasswordUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *AdminDailyRecommendRewardRequest) GetDay() int64 {
+func (x *AdminPasswordUpdateRequest) GetSendBody() *AdminPasswordUpdateRequest_SendBody {
 	if x != nil {
-		return x.Day
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminDailyRecommendRewardReply struct {
+type AdminPasswordUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminDailyRecommendRewardReply) Reset() {
-	*x = AdminDailyRecommendRewardReply{}
+func (x *AdminPasswordUpdateReply) Reset() {
+	*x = AdminPasswordUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[97]
+		mi := &file_app_app_api_app_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyRecommendRewardReply) String() string {
+func (x *AdminPasswordUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyRecommendRewardReply) ProtoMessage() {}
+func (*AdminPasswordUpdateReply) ProtoMessage() {}
 
-func (x *AdminDailyRecommendRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[97]
+func (x *AdminPasswordUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4739,36 +5379,36 @@ func (x *AdminDailyRecommendRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyRecommendRewardReply.ProtoReflect.Descriptor instead.
-func (*AdminDailyRecommendRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use AdminPasswordUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminPasswordUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{92}
 }
 
-type AdminDailyBalanceRewardRequest struct {
+type AdminUpdateLocationNewMaxRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	SendBody *AdminUpdateLocationNewMaxRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminDailyBalanceRewardRequest) Reset() {
-	*x = AdminDailyBalanceRewardRequest{}
+func (x *AdminUpdateLocationNewMaxRequest) Reset() {
+	*x = AdminUpdateLocationNewMaxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[98]
+		mi := &file_app_app_api_app_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyBalanceRewardRequest) String() string {
+func (x *AdminUpdateLocationNewMaxRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyBalanceRewardRequest) ProtoMessage() {}
+func (*AdminUpdateLocationNewMaxRequest) ProtoMessage() {}
 
-func (x *AdminDailyBalanceRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[98]
+func (x *AdminUpdateLocationNewMaxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4779,41 +5419,41 @@ func (x *AdminDailyBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyBalanceRewardRequest.ProtoReflect.Descriptor instead.
-func (*AdminDailyBalanceRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use AdminUpdateLocationNewMaxRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateLocationNewMaxRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *AdminDailyBalanceRewardRequest) GetDate() string {
+func (x *AdminUpdateLocationNewMaxRequest) GetSendBody() *AdminUpdateLocationNewMaxRequest_SendBody {
 	if x != nil {
-		return x.Date
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminDailyBalanceRewardReply struct {
+type AdminUpdateLocationNewMaxReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminDailyBalanceRewardReply) Reset() {
-	*x = AdminDailyBalanceRewardReply{}
+func (x *AdminUpdateLocationNewMaxReply) Reset() {
+	*x = AdminUpdateLocationNewMaxReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[99]
+		mi := &file_app_app_api_app_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyBalanceRewardReply) String() string {
+func (x *AdminUpdateLocationNewMaxReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyBalanceRewardReply) ProtoMessage() {}
+func (*AdminUpdateLocationNewMaxReply) ProtoMessage() {}
 
-func (x *AdminDailyBalanceRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[99]
+func (x *AdminUpdateLocationNewMaxReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4824,36 +5464,36 @@ func (x *AdminDailyBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyBalanceRewardReply.ProtoReflect.Descriptor instead.
-func (*AdminDailyBalanceRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use AdminUpdateLocationNewMaxReply.ProtoReflect.Descriptor instead.
+func (*AdminUpdateLocationNewMaxReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{94}
 }
 
-type AdminLoginRequest struct {
+type AdminVipDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminLoginRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	SendBody *AdminVipDeleteRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLoginRequest) Reset() {
-	*x = AdminLoginRequest{}
+func (x *AdminVipDeleteRequest) Reset() {
+	*x = AdminVipDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[100]
+		mi := &file_app_app_api_app_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLoginRequest) String() string {
+func (x *AdminVipDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLoginRequest) ProtoMessage() {}
+func (*AdminVipDeleteRequest) ProtoMessage() {}
 
-func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[100]
+func (x *AdminVipDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4864,43 +5504,41 @@ func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
-func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use AdminVipDeleteRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *AdminLoginRequest) GetSendBody() *AdminLoginRequest_SendBody {
+func (x *AdminVipDeleteRequest) GetSendBody() *AdminVipDeleteRequest_SendBody {
 	if x != nil {
 		return x.SendBody
 	}
 	return nil
 }
 
-type AdminLoginReply struct {
+type AdminVipDeleteReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *AdminLoginReply) Reset() {
-	*x = AdminLoginReply{}
+func (x *AdminVipDeleteReply) Reset() {
+	*x = AdminVipDeleteReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[101]
+		mi := &file_app_app_api_app_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLoginReply) String() string {
+func (x *AdminVipDeleteReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLoginReply) ProtoMessage() {}
+func (*AdminVipDeleteReply) ProtoMessage() {}
 
-func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[101]
+func (x *AdminVipDeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4911,43 +5549,36 @@ func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLoginReply.ProtoReflect.Descriptor instead.
-func (*AdminLoginReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *AdminLoginReply) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use AdminVipDeleteReply.ProtoReflect.Descriptor instead.
+func (*AdminVipDeleteReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{96}
 }
 
-type AdminChangePasswordRequest struct {
+type AdminVipUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminChangePasswordRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	SendBody *AdminVipUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminChangePasswordRequest) Reset() {
-	*x = AdminChangePasswordRequest{}
+func (x *AdminVipUpdateRequest) Reset() {
+	*x = AdminVipUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[102]
+		mi := &file_app_app_api_app_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminChangePasswordRequest) String() string {
+func (x *AdminVipUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminChangePasswordRequest) ProtoMessage() {}
+func (*AdminVipUpdateRequest) ProtoMessage() {}
 
-func (x *AdminChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[102]
+func (x *AdminVipUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4958,41 +5589,41 @@ func (x *AdminChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*AdminChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use AdminVipUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminVipUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *AdminChangePasswordRequest) GetSendBody() *AdminChangePasswordRequest_SendBody {
+func (x *AdminVipUpdateRequest) GetSendBody() *AdminVipUpdateRequest_SendBody {
 	if x != nil {
 		return x.SendBody
 	}
 	return nil
 }
 
-type AdminChangePasswordReply struct {
+type AdminVipUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminChangePasswordReply) Reset() {
-	*x = AdminChangePasswordReply{}
+func (x *AdminVipUpdateReply) Reset() {
+	*x = AdminVipUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[103]
+		mi := &file_app_app_api_app_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminChangePasswordReply) String() string {
+func (x *AdminVipUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminChangePasswordReply) ProtoMessage() {}
+func (*AdminVipUpdateReply) ProtoMessage() {}
 
-func (x *AdminChangePasswordReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[103]
+func (x *AdminVipUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5003,36 +5634,36 @@ func (x *AdminChangePasswordReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminChangePasswordReply.ProtoReflect.Descriptor instead.
-func (*AdminChangePasswordReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use AdminVipUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminVipUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{98}
 }
 
-type AdminCreateAccountRequest struct {
+type AdminKkdtUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminCreateAccountRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	SendBody *AdminKkdtUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminCreateAccountRequest) Reset() {
-	*x = AdminCreateAccountRequest{}
+func (x *AdminKkdtUpdateRequest) Reset() {
+	*x = AdminKkdtUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[104]
+		mi := &file_app_app_api_app_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminCreateAccountRequest) String() string {
+func (x *AdminKkdtUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCreateAccountRequest) ProtoMessage() {}
+func (*AdminKkdtUpdateRequest) ProtoMessage() {}
 
-func (x *AdminCreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[104]
+func (x *AdminKkdtUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5043,41 +5674,41 @@ func (x *AdminCreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*AdminCreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use AdminKkdtUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminKkdtUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *AdminCreateAccountRequest) GetSendBody() *AdminCreateAccountRequest_SendBody {
+func (x *AdminKkdtUpdateRequest) GetSendBody() *AdminKkdtUpdateRequest_SendBody {
 	if x != nil {
 		return x.SendBody
 	}
 	return nil
 }
 
-type AdminCreateAccountReply struct {
+type AdminKkdtUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminCreateAccountReply) Reset() {
-	*x = AdminCreateAccountReply{}
+func (x *AdminKkdtUpdateReply) Reset() {
+	*x = AdminKkdtUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[105]
+		mi := &file_app_app_api_app_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminCreateAccountReply) String() string {
+func (x *AdminKkdtUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCreateAccountReply) ProtoMessage() {}
+func (*AdminKkdtUpdateReply) ProtoMessage() {}
 
-func (x *AdminCreateAccountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[105]
+func (x *AdminKkdtUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5088,37 +5719,36 @@ func (x *AdminCreateAccountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCreateAccountReply.ProtoReflect.Descriptor instead.
-func (*AdminCreateAccountReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use AdminKkdtUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminKkdtUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{100}
 }
 
-type AdminDailyLocationRewardRequest struct {
+type AdminUndoUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Day  int64  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	SendBody *AdminUndoUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminDailyLocationRewardRequest) Reset() {
-	*x = AdminDailyLocationRewardRequest{}
+func (x *AdminUndoUpdateRequest) Reset() {
+	*x = AdminUndoUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[106]
+		mi := &file_app_app_api_app_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyLocationRewardRequest) String() string {
+func (x *AdminUndoUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyLocationRewardRequest) ProtoMessage() {}
+func (*AdminUndoUpdateRequest) ProtoMessage() {}
 
-func (x *AdminDailyLocationRewardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[106]
+func (x *AdminUndoUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5129,48 +5759,41 @@ func (x *AdminDailyLocationRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyLocationRewardRequest.ProtoReflect.Descriptor instead.
-func (*AdminDailyLocationRewardRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{106}
-}
-
-func (x *AdminDailyLocationRewardRequest) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use AdminUndoUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminUndoUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *AdminDailyLocationRewardRequest) GetDay() int64 {
+func (x *AdminUndoUpdateRequest) GetSendBody() *AdminUndoUpdateRequest_SendBody {
 	if x != nil {
-		return x.Day
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminDailyLocationRewardReply struct {
+type AdminUndoUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminDailyLocationRewardReply) Reset() {
-	*x = AdminDailyLocationRewardReply{}
+func (x *AdminUndoUpdateReply) Reset() {
+	*x = AdminUndoUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[107]
+		mi := &file_app_app_api_app_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyLocationRewardReply) String() string {
+func (x *AdminUndoUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyLocationRewardReply) ProtoMessage() {}
+func (*AdminUndoUpdateReply) ProtoMessage() {}
 
-func (x *AdminDailyLocationRewardReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[107]
+func (x *AdminUndoUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5181,34 +5804,36 @@ func (x *AdminDailyLocationRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyLocationRewardReply.ProtoReflect.Descriptor instead.
-func (*AdminDailyLocationRewardReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use AdminUndoUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminUndoUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{102}
 }
 
-type AdminDailyLocationRewardNewRequest struct {
+type AdminAreaLevelUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminAreaLevelUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminDailyLocationRewardNewRequest) Reset() {
-	*x = AdminDailyLocationRewardNewRequest{}
+func (x *AdminAreaLevelUpdateRequest) Reset() {
+	*x = AdminAreaLevelUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[108]
+		mi := &file_app_app_api_app_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyLocationRewardNewRequest) String() string {
+func (x *AdminAreaLevelUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyLocationRewardNewRequest) ProtoMessage() {}
+func (*AdminAreaLevelUpdateRequest) ProtoMessage() {}
 
-func (x *AdminDailyLocationRewardNewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[108]
+func (x *AdminAreaLevelUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5219,34 +5844,41 @@ func (x *AdminDailyLocationRewardNewRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyLocationRewardNewRequest.ProtoReflect.Descriptor instead.
-func (*AdminDailyLocationRewardNewRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use AdminAreaLevelUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{103}
 }
 
-type AdminDailyLocationRewardNewReply struct {
+func (x *AdminAreaLevelUpdateRequest) GetSendBody() *AdminAreaLevelUpdateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminAreaLevelUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminDailyLocationRewardNewReply) Reset() {
-	*x = AdminDailyLocationRewardNewReply{}
+func (x *AdminAreaLevelUpdateReply) Reset() {
+	*x = AdminAreaLevelUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[109]
+		mi := &file_app_app_api_app_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminDailyLocationRewardNewReply) String() string {
+func (x *AdminAreaLevelUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminDailyLocationRewardNewReply) ProtoMessage() {}
+func (*AdminAreaLevelUpdateReply) ProtoMessage() {}
 
-func (x *AdminDailyLocationRewardNewReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[109]
+func (x *AdminAreaLevelUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5257,34 +5889,36 @@ func (x *AdminDailyLocationRewardNewReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminDailyLocationRewardNewReply.ProtoReflect.Descriptor instead.
-func (*AdminDailyLocationRewardNewReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use AdminAreaLevelUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{104}
 }
 
-type TestCreateAccountRequest struct {
+type AdminLocationInsertRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminLocationInsertRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *TestCreateAccountRequest) Reset() {
-	*x = TestCreateAccountRequest{}
+func (x *AdminLocationInsertRequest) Reset() {
+	*x = AdminLocationInsertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[110]
+		mi := &file_app_app_api_app_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCreateAccountRequest) String() string {
+func (x *AdminLocationInsertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCreateAccountRequest) ProtoMessage() {}
+func (*AdminLocationInsertRequest) ProtoMessage() {}
 
-func (x *TestCreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[110]
+func (x *AdminLocationInsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5295,34 +5929,45 @@ func (x *TestCreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*TestCreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use AdminLocationInsertRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationInsertRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{105}
 }
 
-type TestCreateAccountReply struct {
+func (x *AdminLocationInsertRequest) GetSendBody() *AdminLocationInsertRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminLocationInsertReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RecordId   int64 `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	LocationId int64 `protobuf:"varint,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Existed    bool  `protobuf:"varint,3,opt,name=existed,proto3" json:"existed,omitempty"` // 已补过单，未重复处理
 }
 
-func (x *TestCreateAccountReply) Reset() {
-	*x = TestCreateAccountReply{}
+func (x *AdminLocationInsertReply) Reset() {
+	*x = AdminLocationInsertReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[111]
+		mi := &file_app_app_api_app_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCreateAccountReply) String() string {
+func (x *AdminLocationInsertReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCreateAccountReply) ProtoMessage() {}
+func (*AdminLocationInsertReply) ProtoMessage() {}
 
-func (x *TestCreateAccountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[111]
+func (x *AdminLocationInsertReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5333,37 +5978,57 @@ func (x *TestCreateAccountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCreateAccountReply.ProtoReflect.Descriptor instead.
-func (*TestCreateAccountReply) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use AdminLocationInsertReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationInsertReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{106}
 }
 
-type EthAuthorizeRequest_SendBody struct {
+func (x *AdminLocationInsertReply) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *AdminLocationInsertReply) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminLocationInsertReply) GetExisted() bool {
+	if x != nil {
+		return x.Existed
+	}
+	return false
+}
+
+type AdminBalanceUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	SendBody *AdminBalanceUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *EthAuthorizeRequest_SendBody) Reset() {
-	*x = EthAuthorizeRequest_SendBody{}
+func (x *AdminBalanceUpdateRequest) Reset() {
+	*x = AdminBalanceUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[112]
+		mi := &file_app_app_api_app_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EthAuthorizeRequest_SendBody) String() string {
+func (x *AdminBalanceUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+func (*AdminBalanceUpdateRequest) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[112]
+func (x *AdminBalanceUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5374,53 +6039,41 @@ func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use AdminBalanceUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminBalanceUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+func (x *AdminBalanceUpdateRequest) GetSendBody() *AdminBalanceUpdateRequest_SendBody {
 	if x != nil {
-		return x.Code
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type RewardListReply_List struct {
+type AdminBalanceUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *RewardListReply_List) Reset() {
-	*x = RewardListReply_List{}
+func (x *AdminBalanceUpdateReply) Reset() {
+	*x = AdminBalanceUpdateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[113]
+		mi := &file_app_app_api_app_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardListReply_List) String() string {
+func (x *AdminBalanceUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply_List) ProtoMessage() {}
+func (*AdminBalanceUpdateReply) ProtoMessage() {}
 
-func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[113]
+func (x *AdminBalanceUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5431,67 +6084,36 @@ func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *RewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetLocationStatus() string {
-	if x != nil {
-		return x.LocationStatus
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminBalanceUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminBalanceUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{108}
 }
 
-type RecommendRewardListReply_List struct {
+type AuthAdminCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	SendBody *AuthAdminCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *RecommendRewardListReply_List) Reset() {
-	*x = RecommendRewardListReply_List{}
+func (x *AuthAdminCreateRequest) Reset() {
+	*x = AuthAdminCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[114]
+		mi := &file_app_app_api_app_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecommendRewardListReply_List) String() string {
+func (x *AuthAdminCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply_List) ProtoMessage() {}
+func (*AuthAdminCreateRequest) ProtoMessage() {}
 
-func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[114]
+func (x *AuthAdminCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5502,65 +6124,41 @@ func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{11, 0}
-}
-
-func (x *RecommendRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AuthAdminCreateRequest.ProtoReflect.Descriptor instead.
+func (*AuthAdminCreateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *RecommendRewardListReply_List) GetReason() string {
+func (x *AuthAdminCreateRequest) GetSendBody() *AuthAdminCreateRequest_SendBody {
 	if x != nil {
-		return x.Reason
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type FeeRewardListReply_List struct {
+type AuthAdminCreateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *FeeRewardListReply_List) Reset() {
-	*x = FeeRewardListReply_List{}
+func (x *AuthAdminCreateReply) Reset() {
+	*x = AuthAdminCreateReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[115]
+		mi := &file_app_app_api_app_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeRewardListReply_List) String() string {
+func (x *AuthAdminCreateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply_List) ProtoMessage() {}
+func (*AuthAdminCreateReply) ProtoMessage() {}
 
-func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[115]
+func (x *AuthAdminCreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5571,53 +6169,36 @@ func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *FeeRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *FeeRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AuthAdminCreateReply.ProtoReflect.Descriptor instead.
+func (*AuthAdminCreateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{110}
 }
 
-type WithdrawListReply_List struct {
+type AuthAdminDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *AuthAdminDeleteRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *WithdrawListReply_List) Reset() {
-	*x = WithdrawListReply_List{}
+func (x *AuthAdminDeleteRequest) Reset() {
+	*x = AuthAdminDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[116]
+		mi := &file_app_app_api_app_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawListReply_List) String() string {
+func (x *AuthAdminDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply_List) ProtoMessage() {}
+func (*AuthAdminDeleteRequest) ProtoMessage() {}
 
-func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[116]
+func (x *AuthAdminDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5628,65 +6209,79 @@ func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use AuthAdminDeleteRequest.ProtoReflect.Descriptor instead.
+func (*AuthAdminDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *WithdrawListReply_List) GetCreatedAt() string {
+func (x *AuthAdminDeleteRequest) GetSendBody() *AuthAdminDeleteRequest_SendBody {
 	if x != nil {
-		return x.CreatedAt
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *WithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+type AuthAdminDeleteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *WithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *AuthAdminDeleteReply) Reset() {
+	*x = AuthAdminDeleteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *WithdrawListReply_List) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *AuthAdminDeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAdminDeleteReply) ProtoMessage() {}
+
+func (x *AuthAdminDeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type RecommendListReply_List struct {
+// Deprecated: Use AuthAdminDeleteReply.ProtoReflect.Descriptor instead.
+func (*AuthAdminDeleteReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{112}
+}
+
+type CheckAndInsertRecommendAreaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *RecommendListReply_List) Reset() {
-	*x = RecommendListReply_List{}
+func (x *CheckAndInsertRecommendAreaRequest) Reset() {
+	*x = CheckAndInsertRecommendAreaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[117]
+		mi := &file_app_app_api_app_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecommendListReply_List) String() string {
+func (x *CheckAndInsertRecommendAreaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply_List) ProtoMessage() {}
+func (*CheckAndInsertRecommendAreaRequest) ProtoMessage() {}
 
-func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[117]
+func (x *CheckAndInsertRecommendAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5697,51 +6292,75 @@ func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use CheckAndInsertRecommendAreaRequest.ProtoReflect.Descriptor instead.
+func (*CheckAndInsertRecommendAreaRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *RecommendListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
+type CheckAndInsertRecommendAreaReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckAndInsertRecommendAreaReply) Reset() {
+	*x = CheckAndInsertRecommendAreaReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RecommendListReply_List) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *CheckAndInsertRecommendAreaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAndInsertRecommendAreaReply) ProtoMessage() {}
+
+func (x *CheckAndInsertRecommendAreaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type WithdrawRequest_SendBody struct {
+// Deprecated: Use CheckAndInsertRecommendAreaReply.ProtoReflect.Descriptor instead.
+func (*CheckAndInsertRecommendAreaReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{114}
+}
+
+type AdminDailyRecommendRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Day    int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	DryRun bool  `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *WithdrawRequest_SendBody) Reset() {
-	*x = WithdrawRequest_SendBody{}
+func (x *AdminDailyRecommendRewardRequest) Reset() {
+	*x = AdminDailyRecommendRewardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[118]
+		mi := &file_app_app_api_app_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawRequest_SendBody) String() string {
+func (x *AdminDailyRecommendRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest_SendBody) ProtoMessage() {}
+func (*AdminDailyRecommendRewardRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[118]
+func (x *AdminDailyRecommendRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5752,55 +6371,50 @@ func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use AdminDailyRecommendRewardRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyRecommendRewardRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *WithdrawRequest_SendBody) GetType() string {
+func (x *AdminDailyRecommendRewardRequest) GetDay() int64 {
 	if x != nil {
-		return x.Type
+		return x.Day
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawRequest_SendBody) GetAmount() string {
+func (x *AdminDailyRecommendRewardRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Amount
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type AdminRewardListReply_List struct {
+type AdminDailyRecommendRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64   `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 时间
-	Amount    int64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                       // 数量
-	AmountB   float64 `protobuf:"fixed64,3,opt,name=amountB,proto3" json:"amountB,omitempty"`                    // 数量
-	Address   string  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`                      // 地址
-	Reason    string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                        // 原因 每一条替换文案 认购reason=buy，提现reason=withdraw，兑换reason=exchange，前四名reason=four，挖矿reason=location，矩阵reason=area，直推reason=recommend
+	Report *RewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) Reset() {
-	*x = AdminRewardListReply_List{}
+func (x *AdminDailyRecommendRewardReply) Reset() {
+	*x = AdminDailyRecommendRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[119]
+		mi := &file_app_app_api_app_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminRewardListReply_List) String() string {
+func (x *AdminDailyRecommendRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply_List) ProtoMessage() {}
+func (*AdminDailyRecommendRewardReply) ProtoMessage() {}
 
-func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[119]
+func (x *AdminDailyRecommendRewardReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5811,81 +6425,98 @@ func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use AdminDailyRecommendRewardReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyRecommendRewardReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *AdminRewardListReply_List) GetId() int64 {
+func (x *AdminDailyRecommendRewardReply) GetReport() *RewardReport {
 	if x != nil {
-		return x.Id
+		return x.Report
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+type AdminDailyBalanceRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date   string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 只在试算时使用，实际发放按当天结算
+	DryRun bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
+func (x *AdminDailyBalanceRewardRequest) Reset() {
+	*x = AdminDailyBalanceRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminRewardListReply_List) GetAmountB() float64 {
-	if x != nil {
-		return x.AmountB
+func (x *AdminDailyBalanceRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDailyBalanceRewardRequest) ProtoMessage() {}
+
+func (x *AdminDailyBalanceRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminRewardListReply_List) GetAddress() string {
+// Deprecated: Use AdminDailyBalanceRewardRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyBalanceRewardRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AdminDailyBalanceRewardRequest) GetDate() string {
 	if x != nil {
-		return x.Address
+		return x.Date
 	}
 	return ""
 }
 
-func (x *AdminRewardListReply_List) GetReason() string {
+func (x *AdminDailyBalanceRewardRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Reason
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type AdminTradeListReply_List struct {
+type AdminDailyBalanceRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	AmountCsd string `protobuf:"bytes,2,opt,name=amountCsd,proto3" json:"amountCsd,omitempty"`
-	AmountHbs string `protobuf:"bytes,3,opt,name=amountHbs,proto3" json:"amountHbs,omitempty"`
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Report *RewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *AdminTradeListReply_List) Reset() {
-	*x = AdminTradeListReply_List{}
+func (x *AdminDailyBalanceRewardReply) Reset() {
+	*x = AdminDailyBalanceRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[120]
+		mi := &file_app_app_api_app_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminTradeListReply_List) String() string {
+func (x *AdminDailyBalanceRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminTradeListReply_List) ProtoMessage() {}
+func (*AdminDailyBalanceRewardReply) ProtoMessage() {}
 
-func (x *AdminTradeListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[120]
+func (x *AdminDailyBalanceRewardReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5896,76 +6527,43 @@ func (x *AdminTradeListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminTradeListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminTradeListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{23, 0}
-}
-
-func (x *AdminTradeListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminTradeListReply_List) GetAmountCsd() string {
-	if x != nil {
-		return x.AmountCsd
-	}
-	return ""
-}
-
-func (x *AdminTradeListReply_List) GetAmountHbs() string {
-	if x != nil {
-		return x.AmountHbs
-	}
-	return ""
+// Deprecated: Use AdminDailyBalanceRewardReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyBalanceRewardReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *AdminTradeListReply_List) GetAddress() string {
+func (x *AdminDailyBalanceRewardReply) GetReport() *RewardReport {
 	if x != nil {
-		return x.Address
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-type AdminUserListReply_UserList struct {
+type AdminLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           int64   `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt        string  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间
-	Address          string  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                      // 地址
-	BalanceUsdt      int64   `protobuf:"varint,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`             // 可提usdt数量
-	BalanceDhb       float64 `protobuf:"fixed64,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`              // isps数量
-	Out              int64   `protobuf:"varint,7,opt,name=out,proto3" json:"out,omitempty"`                             // 出局次数
-	AreaTotal        int64   `protobuf:"varint,9,opt,name=areaTotal,proto3" json:"areaTotal,omitempty"`                 // 总业绩
-	AreaMin          int64   `protobuf:"varint,10,opt,name=areaMin,proto3" json:"areaMin,omitempty"`                    // 小区
-	AreaMax          int64   `protobuf:"varint,11,opt,name=areaMax,proto3" json:"areaMax,omitempty"`                    // 大区
-	Vip              int64   `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`                             // 会员等级
-	HistoryRecommend int64   `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`   // 历史推荐人数
-	Amount           int64   `protobuf:"varint,19,opt,name=amount,proto3" json:"amount,omitempty"`
-	Kkdt             int64   `protobuf:"varint,20,opt,name=kkdt,proto3" json:"kkdt,omitempty"`
+	SendBody *AdminLoginRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) Reset() {
-	*x = AdminUserListReply_UserList{}
+func (x *AdminLoginRequest) Reset() {
+	*x = AdminLoginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[121]
+		mi := &file_app_app_api_app_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserListReply_UserList) String() string {
+func (x *AdminLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply_UserList) ProtoMessage() {}
+func (*AdminLoginRequest) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[121]
+func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,114 +6574,120 @@ func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *AdminUserListReply_UserList) GetUserId() int64 {
+func (x *AdminLoginRequest) GetSendBody() *AdminLoginRequest_SendBody {
 	if x != nil {
-		return x.UserId
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminUserListReply_UserList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+type AdminLoginReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (x *AdminLoginReply) Reset() {
+	*x = AdminLoginReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceUsdt() int64 {
-	if x != nil {
-		return x.BalanceUsdt
-	}
-	return 0
+func (x *AdminLoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetBalanceDhb() float64 {
-	if x != nil {
-		return x.BalanceDhb
+func (*AdminLoginReply) ProtoMessage() {}
+
+func (x *AdminLoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetOut() int64 {
-	if x != nil {
-		return x.Out
-	}
-	return 0
+// Deprecated: Use AdminLoginReply.ProtoReflect.Descriptor instead.
+func (*AdminLoginReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *AdminUserListReply_UserList) GetAreaTotal() int64 {
+func (x *AdminLoginReply) GetToken() string {
 	if x != nil {
-		return x.AreaTotal
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminUserListReply_UserList) GetAreaMin() int64 {
-	if x != nil {
-		return x.AreaMin
-	}
-	return 0
+type AdminChangePasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminChangePasswordRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) GetAreaMax() int64 {
-	if x != nil {
-		return x.AreaMax
+func (x *AdminChangePasswordRequest) Reset() {
+	*x = AdminChangePasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminUserListReply_UserList) GetVip() int64 {
-	if x != nil {
-		return x.Vip
-	}
-	return 0
+func (x *AdminChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
-	if x != nil {
-		return x.HistoryRecommend
+func (*AdminChangePasswordRequest) ProtoMessage() {}
+
+func (x *AdminChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminUserListReply_UserList) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+// Deprecated: Use AdminChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*AdminChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *AdminUserListReply_UserList) GetKkdt() int64 {
+func (x *AdminChangePasswordRequest) GetSendBody() *AdminChangePasswordRequest_SendBody {
 	if x != nil {
-		return x.Kkdt
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type RecordListReply_LocationList struct {
+type AdminChangePasswordReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 时间
-	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                      // 地址
-	Amount    uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *RecordListReply_LocationList) Reset() {
-	*x = RecordListReply_LocationList{}
+func (x *AdminChangePasswordReply) Reset() {
+	*x = AdminChangePasswordReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6091,13 +6695,13 @@ func (x *RecordListReply_LocationList) Reset() {
 	}
 }
 
-func (x *RecordListReply_LocationList) String() string {
+func (x *AdminChangePasswordReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordListReply_LocationList) ProtoMessage() {}
+func (*AdminChangePasswordReply) ProtoMessage() {}
 
-func (x *RecordListReply_LocationList) ProtoReflect() protoreflect.Message {
+func (x *AdminChangePasswordReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6109,47 +6713,21 @@ func (x *RecordListReply_LocationList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*RecordListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{33, 0}
-}
-
-func (x *RecordListReply_LocationList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecordListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *RecordListReply_LocationList) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+// Deprecated: Use AdminChangePasswordReply.ProtoReflect.Descriptor instead.
+func (*AdminChangePasswordReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{122}
 }
 
-type AdminLocationListReply_LocationList struct {
+type AdminCreateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`              // 地址
-	Current       string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`              // 当前收益, 挖矿
-	CurrentMax    string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`        // 最大收益
-	Usdt          string `protobuf:"bytes,9,opt,name=usdt,proto3" json:"usdt,omitempty"`                    // 节点
-	CurrentMaxSub string `protobuf:"bytes,10,opt,name=currentMaxSub,proto3" json:"currentMaxSub,omitempty"` // 收益
-	Out           int64  `protobuf:"varint,11,opt,name=out,proto3" json:"out,omitempty"`                    // 出局
+	SendBody *AdminCreateAccountRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) Reset() {
-	*x = AdminLocationListReply_LocationList{}
+func (x *AdminCreateAccountRequest) Reset() {
+	*x = AdminCreateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6157,13 +6735,13 @@ func (x *AdminLocationListReply_LocationList) Reset() {
 	}
 }
 
-func (x *AdminLocationListReply_LocationList) String() string {
+func (x *AdminCreateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+func (*AdminCreateAccountRequest) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+func (x *AdminCreateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6175,83 +6753,83 @@ func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use AdminCreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *AdminLocationListReply_LocationList) GetAddress() string {
+func (x *AdminCreateAccountRequest) GetSendBody() *AdminCreateAccountRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
+type AdminCreateAccountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
-	if x != nil {
-		return x.CurrentMax
+func (x *AdminCreateAccountReply) Reset() {
+	*x = AdminCreateAccountReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminLocationListReply_LocationList) GetUsdt() string {
-	if x != nil {
-		return x.Usdt
-	}
-	return ""
+func (x *AdminCreateAccountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentMaxSub() string {
-	if x != nil {
-		return x.CurrentMaxSub
+func (*AdminCreateAccountReply) ProtoMessage() {}
+
+func (x *AdminCreateAccountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminLocationListReply_LocationList) GetOut() int64 {
-	if x != nil {
-		return x.Out
-	}
-	return 0
+// Deprecated: Use AdminCreateAccountReply.ProtoReflect.Descriptor instead.
+func (*AdminCreateAccountReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{124}
 }
 
-type AdminLocationAllListReply_LocationList struct {
+type AdminDailyLocationRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+	Date   string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 只在试算时使用，实际发放按当天结算
+	Day    int64  `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	DryRun bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *AdminLocationAllListReply_LocationList) Reset() {
-	*x = AdminLocationAllListReply_LocationList{}
+func (x *AdminDailyLocationRewardRequest) Reset() {
+	*x = AdminDailyLocationRewardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[124]
+		mi := &file_app_app_api_app_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminLocationAllListReply_LocationList) String() string {
+func (x *AdminDailyLocationRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationAllListReply_LocationList) ProtoMessage() {}
+func (*AdminDailyLocationRewardRequest) ProtoMessage() {}
 
-func (x *AdminLocationAllListReply_LocationList) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[124]
+func (x *AdminDailyLocationRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6262,84 +6840,57 @@ func (x *AdminLocationAllListReply_LocationList) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationAllListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationAllListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use AdminDailyLocationRewardRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyLocationRewardRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetCreatedAt() string {
+func (x *AdminDailyLocationRewardRequest) GetDate() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Date
 	}
 	return ""
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetAddress() string {
+func (x *AdminDailyLocationRewardRequest) GetDay() int64 {
 	if x != nil {
-		return x.Address
+		return x.Day
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminLocationAllListReply_LocationList) GetStatus() string {
+func (x *AdminDailyLocationRewardRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Status
+		return x.DryRun
 	}
-	return ""
-}
-
-func (x *AdminLocationAllListReply_LocationList) GetCurrentLevel() int64 {
-	if x != nil {
-		return x.CurrentLevel
-	}
-	return 0
-}
-
-func (x *AdminLocationAllListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
-}
-
-func (x *AdminLocationAllListReply_LocationList) GetCurrentMax() string {
-	if x != nil {
-		return x.CurrentMax
-	}
-	return ""
+	return false
 }
 
-type AdminWithdrawListReply_List struct {
+type AdminDailyLocationRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"` // 地址
-	Id        int64   `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 时间
-	Amount    float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                      // 到账金额
-	RelAmount float64 `protobuf:"fixed64,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`                // 提现金额
-	Type      string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                            // 类型usdt是模块1提现，usdt_2是模块2提现
-	Status    string  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // success成功，doing正在处理
+	Report *RewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) Reset() {
-	*x = AdminWithdrawListReply_List{}
+func (x *AdminDailyLocationRewardReply) Reset() {
+	*x = AdminDailyLocationRewardReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[125]
+		mi := &file_app_app_api_app_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawListReply_List) String() string {
+func (x *AdminDailyLocationRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply_List) ProtoMessage() {}
+func (*AdminDailyLocationRewardReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[125]
+func (x *AdminDailyLocationRewardReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6350,85 +6901,90 @@ func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use AdminDailyLocationRewardReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyLocationRewardReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *AdminWithdrawListReply_List) GetAddress() string {
+func (x *AdminDailyLocationRewardReply) GetReport() *RewardReport {
 	if x != nil {
-		return x.Address
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminWithdrawListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+type AdminDailyLocationRewardNewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *AdminDailyLocationRewardNewRequest) Reset() {
+	*x = AdminDailyLocationRewardNewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AdminWithdrawListReply_List) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *AdminDailyLocationRewardNewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetRelAmount() float64 {
-	if x != nil {
-		return x.RelAmount
+func (*AdminDailyLocationRewardNewRequest) ProtoMessage() {}
+
+func (x *AdminDailyLocationRewardNewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AdminWithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminDailyLocationRewardNewRequest.ProtoReflect.Descriptor instead.
+func (*AdminDailyLocationRewardNewRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *AdminWithdrawListReply_List) GetStatus() string {
+func (x *AdminDailyLocationRewardNewRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Status
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type AdminWithdrawPassRequest_SendBody struct {
+type AdminDailyLocationRewardNewReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Report *RewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *AdminWithdrawPassRequest_SendBody) Reset() {
-	*x = AdminWithdrawPassRequest_SendBody{}
+func (x *AdminDailyLocationRewardNewReply) Reset() {
+	*x = AdminDailyLocationRewardNewReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[126]
+		mi := &file_app_app_api_app_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminWithdrawPassRequest_SendBody) String() string {
+func (x *AdminDailyLocationRewardNewReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawPassRequest_SendBody) ProtoMessage() {}
+func (*AdminDailyLocationRewardNewReply) ProtoMessage() {}
 
-func (x *AdminWithdrawPassRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[126]
+func (x *AdminDailyLocationRewardNewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6439,49 +6995,46 @@ func (x *AdminWithdrawPassRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawPassRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawPassRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{44, 0}
+// Deprecated: Use AdminDailyLocationRewardNewReply.ProtoReflect.Descriptor instead.
+func (*AdminDailyLocationRewardNewReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *AdminWithdrawPassRequest_SendBody) GetId() int64 {
+func (x *AdminDailyLocationRewardNewReply) GetReport() *RewardReport {
 	if x != nil {
-		return x.Id
+		return x.Report
 	}
-	return 0
+	return nil
 }
 
-type AdminUserRecommendReply_List struct {
+type AdminRewardRunRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId             int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address            string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Id                 int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt          string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount             string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	RelAmount          string `protobuf:"bytes,6,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"`
-	RecommendAllAmount string `protobuf:"bytes,7,opt,name=recommend_all_amount,json=recommendAllAmount,proto3" json:"recommend_all_amount,omitempty"`
+	Job    string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // 只在试算时使用，实际发放按当天结算
+	Day    int64  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	DryRun bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) Reset() {
-	*x = AdminUserRecommendReply_List{}
+func (x *AdminRewardRunRequest) Reset() {
+	*x = AdminRewardRunRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[127]
+		mi := &file_app_app_api_app_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserRecommendReply_List) String() string {
+func (x *AdminRewardRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply_List) ProtoMessage() {}
+func (*AdminRewardRunRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[127]
+func (x *AdminRewardRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6492,88 +7045,64 @@ func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{55, 0}
-}
-
-func (x *AdminUserRecommendReply_List) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminUserRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use AdminRewardRunRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardRunRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+func (x *AdminRewardRunRequest) GetJob() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Job
 	}
 	return ""
 }
 
-func (x *AdminUserRecommendReply_List) GetAmount() string {
+func (x *AdminRewardRunRequest) GetDate() string {
 	if x != nil {
-		return x.Amount
+		return x.Date
 	}
 	return ""
 }
 
-func (x *AdminUserRecommendReply_List) GetRelAmount() string {
+func (x *AdminRewardRunRequest) GetDay() int64 {
 	if x != nil {
-		return x.RelAmount
+		return x.Day
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminUserRecommendReply_List) GetRecommendAllAmount() string {
+func (x *AdminRewardRunRequest) GetDryRun() bool {
 	if x != nil {
-		return x.RecommendAllAmount
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type AdminMonthRecommendReply_List struct {
+type AdminRewardRunReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
-	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Report *RewardReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply_List) Reset() {
-	*x = AdminMonthRecommendReply_List{}
+func (x *AdminRewardRunReply) Reset() {
+	*x = AdminRewardRunReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[128]
+		mi := &file_app_app_api_app_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminMonthRecommendReply_List) String() string {
+func (x *AdminRewardRunReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+func (*AdminRewardRunReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[128]
+func (x *AdminRewardRunReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6584,66 +7113,59 @@ func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{57, 0}
-}
-
-func (x *AdminMonthRecommendReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
-	if x != nil {
-		return x.RecommendAddress
-	}
-	return ""
-}
-
-func (x *AdminMonthRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use AdminRewardRunReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardRunReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+func (x *AdminRewardRunReply) GetReport() *RewardReport {
 	if x != nil {
-		return x.CreatedAt
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-type AdminConfigReply_List struct {
+type RewardReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`   // 名称
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // 值
-}
-
-func (x *AdminConfigReply_List) Reset() {
-	*x = AdminConfigReply_List{}
+	Job            string               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	DryRun         bool                 `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Count          int64                `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Budget         string               `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget,omitempty"`
+	Amount         string               `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Users          []*RewardReport_User `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
+	Vips           []*RewardReport_Vip  `protobuf:"bytes,7,rep,name=vips,proto3" json:"vips,omitempty"`
+	Stopped        []int64              `protobuf:"varint,8,rep,packed,name=stopped,proto3" json:"stopped,omitempty"`
+	RunId          int64                `protobuf:"varint,9,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status         string               `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Resumed        int64                `protobuf:"varint,11,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	Chunks         int64                `protobuf:"varint,12,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Statements     int64                `protobuf:"varint,13,opt,name=statements,proto3" json:"statements,omitempty"`
+	ElapsedMs      int64                `protobuf:"varint,14,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	ItemsPerSecond string               `protobuf:"bytes,15,opt,name=items_per_second,json=itemsPerSecond,proto3" json:"items_per_second,omitempty"`
+	Excluded       int64                `protobuf:"varint,16,opt,name=excluded,proto3" json:"excluded,omitempty"` // 占位产品不参与本任务跳过的条数
+	Error          string               `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`        // status为failed时的失败原因，本次未发放完成
+}
+
+func (x *RewardReport) Reset() {
+	*x = RewardReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[129]
+		mi := &file_app_app_api_app_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply_List) String() string {
+func (x *RewardReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply_List) ProtoMessage() {}
+func (*RewardReport) ProtoMessage() {}
 
-func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[129]
+func (x *RewardReport) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6654,162 +7176,140 @@ func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{59, 0}
+// Deprecated: Use RewardReport.ProtoReflect.Descriptor instead.
+func (*RewardReport) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *AdminConfigReply_List) GetId() int64 {
+func (x *RewardReport) GetJob() string {
 	if x != nil {
-		return x.Id
+		return x.Job
+	}
+	return ""
+}
+
+func (x *RewardReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RewardReport) GetCount() int64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AdminConfigReply_List) GetName() string {
+func (x *RewardReport) GetBudget() string {
 	if x != nil {
-		return x.Name
+		return x.Budget
 	}
 	return ""
 }
 
-func (x *AdminConfigReply_List) GetValue() string {
+func (x *RewardReport) GetAmount() string {
 	if x != nil {
-		return x.Value
+		return x.Amount
 	}
 	return ""
 }
 
-type AdminListReply_List struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-}
-
-func (x *AdminListReply_List) Reset() {
-	*x = AdminListReply_List{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[130]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RewardReport) GetUsers() []*RewardReport_User {
+	if x != nil {
+		return x.Users
 	}
+	return nil
 }
 
-func (x *AdminListReply_List) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AdminListReply_List) ProtoMessage() {}
-
-func (x *AdminListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[130]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RewardReport) GetVips() []*RewardReport_Vip {
+	if x != nil {
+		return x.Vips
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AdminListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{61, 0}
+func (x *RewardReport) GetStopped() []int64 {
+	if x != nil {
+		return x.Stopped
+	}
+	return nil
 }
 
-func (x *AdminListReply_List) GetId() int64 {
+func (x *RewardReport) GetRunId() int64 {
 	if x != nil {
-		return x.Id
+		return x.RunId
 	}
 	return 0
 }
 
-func (x *AdminListReply_List) GetAccount() string {
+func (x *RewardReport) GetStatus() string {
 	if x != nil {
-		return x.Account
+		return x.Status
 	}
 	return ""
 }
 
-type AuthListReply_List struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-}
-
-func (x *AuthListReply_List) Reset() {
-	*x = AuthListReply_List{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[131]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RewardReport) GetResumed() int64 {
+	if x != nil {
+		return x.Resumed
 	}
+	return 0
 }
 
-func (x *AuthListReply_List) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AuthListReply_List) ProtoMessage() {}
-
-func (x *AuthListReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[131]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RewardReport) GetChunks() int64 {
+	if x != nil {
+		return x.Chunks
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AuthListReply_List.ProtoReflect.Descriptor instead.
-func (*AuthListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{63, 0}
+func (x *RewardReport) GetStatements() int64 {
+	if x != nil {
+		return x.Statements
+	}
+	return 0
 }
 
-func (x *AuthListReply_List) GetId() int64 {
+func (x *RewardReport) GetElapsedMs() int64 {
 	if x != nil {
-		return x.Id
+		return x.ElapsedMs
 	}
 	return 0
 }
 
-func (x *AuthListReply_List) GetName() string {
+func (x *RewardReport) GetItemsPerSecond() string {
 	if x != nil {
-		return x.Name
+		return x.ItemsPerSecond
 	}
 	return ""
 }
 
-func (x *AuthListReply_List) GetPath() string {
+func (x *RewardReport) GetExcluded() int64 {
 	if x != nil {
-		return x.Path
+		return x.Excluded
+	}
+	return 0
+}
+
+func (x *RewardReport) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type UserAuthListReply_List struct {
+type AdminRewardRollbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	SendBody *AdminRewardRollbackRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserAuthListReply_List) Reset() {
-	*x = UserAuthListReply_List{}
+func (x *AdminRewardRollbackRequest) Reset() {
+	*x = AdminRewardRollbackRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6817,13 +7317,13 @@ func (x *UserAuthListReply_List) Reset() {
 	}
 }
 
-func (x *UserAuthListReply_List) String() string {
+func (x *AdminRewardRollbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAuthListReply_List) ProtoMessage() {}
+func (*AdminRewardRollbackRequest) ProtoMessage() {}
 
-func (x *UserAuthListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardRollbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6835,44 +7335,28 @@ func (x *UserAuthListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAuthListReply_List.ProtoReflect.Descriptor instead.
-func (*UserAuthListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{65, 0}
-}
-
-func (x *UserAuthListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UserAuthListReply_List) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use AdminRewardRollbackRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *UserAuthListReply_List) GetPath() string {
+func (x *AdminRewardRollbackRequest) GetSendBody() *AdminRewardRollbackRequest_SendBody {
 	if x != nil {
-		return x.Path
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type MyAuthListReply_List struct {
+type AdminRewardRollbackReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Report *RewardRollbackReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *MyAuthListReply_List) Reset() {
-	*x = MyAuthListReply_List{}
+func (x *AdminRewardRollbackReply) Reset() {
+	*x = AdminRewardRollbackReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6880,13 +7364,13 @@ func (x *MyAuthListReply_List) Reset() {
 	}
 }
 
-func (x *MyAuthListReply_List) String() string {
+func (x *AdminRewardRollbackReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyAuthListReply_List) ProtoMessage() {}
+func (*AdminRewardRollbackReply) ProtoMessage() {}
 
-func (x *MyAuthListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardRollbackReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6898,43 +7382,32 @@ func (x *MyAuthListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyAuthListReply_List.ProtoReflect.Descriptor instead.
-func (*MyAuthListReply_List) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{67, 0}
-}
-
-func (x *MyAuthListReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *MyAuthListReply_List) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use AdminRewardRollbackReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardRollbackReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *MyAuthListReply_List) GetPath() string {
+func (x *AdminRewardRollbackReply) GetReport() *RewardRollbackReport {
 	if x != nil {
-		return x.Path
+		return x.Report
 	}
-	return ""
+	return nil
 }
 
-type AdminConfigUpdateRequest_SendBody struct {
+type RewardRollbackReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Reason    string                      `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Runs      []*RewardRollbackReport_Run `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
+	Users     []*RewardReport_User        `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Restarted []int64                     `protobuf:"varint,4,rep,packed,name=restarted,proto3" json:"restarted,omitempty"`
+	Warnings  []string                    `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) Reset() {
-	*x = AdminConfigUpdateRequest_SendBody{}
+func (x *RewardRollbackReport) Reset() {
+	*x = RewardRollbackReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6942,13 +7415,13 @@ func (x *AdminConfigUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) String() string {
+func (x *RewardRollbackReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+func (*RewardRollbackReport) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *RewardRollbackReport) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6960,36 +7433,56 @@ func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{68, 0}
+// Deprecated: Use RewardRollbackReport.ProtoReflect.Descriptor instead.
+func (*RewardRollbackReport) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+func (x *RewardRollbackReport) GetReason() string {
 	if x != nil {
-		return x.Id
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+func (x *RewardRollbackReport) GetRuns() []*RewardRollbackReport_Run {
 	if x != nil {
-		return x.Value
+		return x.Runs
 	}
-	return ""
+	return nil
 }
 
-type AdminPasswordUpdateRequest_SendBody struct {
+func (x *RewardRollbackReport) GetUsers() []*RewardReport_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *RewardRollbackReport) GetRestarted() []int64 {
+	if x != nil {
+		return x.Restarted
+	}
+	return nil
+}
+
+func (x *RewardRollbackReport) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type AdminLocationTreeCheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Fix bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
 }
 
-func (x *AdminPasswordUpdateRequest_SendBody) Reset() {
-	*x = AdminPasswordUpdateRequest_SendBody{}
+func (x *AdminLocationTreeCheckRequest) Reset() {
+	*x = AdminLocationTreeCheckRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6997,13 +7490,13 @@ func (x *AdminPasswordUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminPasswordUpdateRequest_SendBody) String() string {
+func (x *AdminLocationTreeCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminPasswordUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationTreeCheckRequest) ProtoMessage() {}
 
-func (x *AdminPasswordUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationTreeCheckRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7015,36 +7508,32 @@ func (x *AdminPasswordUpdateRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminPasswordUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminPasswordUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{72, 0}
-}
-
-func (x *AdminPasswordUpdateRequest_SendBody) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use AdminLocationTreeCheckRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationTreeCheckRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *AdminPasswordUpdateRequest_SendBody) GetPassword() string {
+func (x *AdminLocationTreeCheckRequest) GetFix() bool {
 	if x != nil {
-		return x.Password
+		return x.Fix
 	}
-	return ""
+	return false
 }
 
-type AdminUpdateLocationNewMaxRequest_SendBody struct {
+type AdminLocationTreeCheckReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Count  int64                               `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Paths  int64                               `protobuf:"varint,2,opt,name=paths,proto3" json:"paths,omitempty"`
+	Fixed  bool                                `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Broken []int64                             `protobuf:"varint,4,rep,packed,name=broken,proto3" json:"broken,omitempty"`
+	Diffs  []*AdminLocationTreeCheckReply_Diff `protobuf:"bytes,5,rep,name=diffs,proto3" json:"diffs,omitempty"`
 }
 
-func (x *AdminUpdateLocationNewMaxRequest_SendBody) Reset() {
-	*x = AdminUpdateLocationNewMaxRequest_SendBody{}
+func (x *AdminLocationTreeCheckReply) Reset() {
+	*x = AdminLocationTreeCheckReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7052,13 +7541,13 @@ func (x *AdminUpdateLocationNewMaxRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminUpdateLocationNewMaxRequest_SendBody) String() string {
+func (x *AdminLocationTreeCheckReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateLocationNewMaxRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationTreeCheckReply) ProtoMessage() {}
 
-func (x *AdminUpdateLocationNewMaxRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationTreeCheckReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7070,37 +7559,65 @@ func (x *AdminUpdateLocationNewMaxRequest_SendBody) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateLocationNewMaxRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminUpdateLocationNewMaxRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use AdminLocationTreeCheckReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationTreeCheckReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *AdminUpdateLocationNewMaxRequest_SendBody) GetUserId() int64 {
+func (x *AdminLocationTreeCheckReply) GetCount() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AdminUpdateLocationNewMaxRequest_SendBody) GetAmount() string {
+func (x *AdminLocationTreeCheckReply) GetPaths() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Paths
 	}
-	return ""
+	return 0
 }
 
-type AdminVipDeleteRequest_SendBody struct {
+func (x *AdminLocationTreeCheckReply) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+func (x *AdminLocationTreeCheckReply) GetBroken() []int64 {
+	if x != nil {
+		return x.Broken
+	}
+	return nil
+}
+
+func (x *AdminLocationTreeCheckReply) GetDiffs() []*AdminLocationTreeCheckReply_Diff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type LocationProduct struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Kkdt   int64 `protobuf:"varint,3,opt,name=kkdt,proto3" json:"kkdt,omitempty"`
+	Id        int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MinAmount int64    `protobuf:"varint,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` // usdt
+	MaxAmount int64    `protobuf:"varint,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // 0为不限
+	OutRate   int64    `protobuf:"varint,5,opt,name=out_rate,json=outRate,proto3" json:"out_rate,omitempty"`       // 出局倍率，25为2.5倍
+	Policies  []string `protobuf:"bytes,6,rep,name=policies,proto3" json:"policies,omitempty"`                     // 可参与的奖励任务，空为全部
+	TimeAgain int64    `protobuf:"varint,7,opt,name=time_again,json=timeAgain,proto3" json:"time_again,omitempty"` // 出局后再次入金间隔，分钟
+	Sort      int64    `protobuf:"varint,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status    int64    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"` // 1启用
+	CreatedAt string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *AdminVipDeleteRequest_SendBody) Reset() {
-	*x = AdminVipDeleteRequest_SendBody{}
+func (x *LocationProduct) Reset() {
+	*x = LocationProduct{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7108,13 +7625,13 @@ func (x *AdminVipDeleteRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminVipDeleteRequest_SendBody) String() string {
+func (x *LocationProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminVipDeleteRequest_SendBody) ProtoMessage() {}
+func (*LocationProduct) ProtoMessage() {}
 
-func (x *AdminVipDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *LocationProduct) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7126,43 +7643,89 @@ func (x *AdminVipDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminVipDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminVipDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{76, 0}
+// Deprecated: Use LocationProduct.ProtoReflect.Descriptor instead.
+func (*LocationProduct) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *AdminVipDeleteRequest_SendBody) GetId() int64 {
+func (x *LocationProduct) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *AdminVipDeleteRequest_SendBody) GetAmount() int64 {
+func (x *LocationProduct) GetName() string {
 	if x != nil {
-		return x.Amount
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LocationProduct) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
 	}
 	return 0
 }
 
-func (x *AdminVipDeleteRequest_SendBody) GetKkdt() int64 {
+func (x *LocationProduct) GetMaxAmount() int64 {
 	if x != nil {
-		return x.Kkdt
+		return x.MaxAmount
 	}
 	return 0
 }
 
-type AdminVipUpdateRequest_SendBody struct {
+func (x *LocationProduct) GetOutRate() int64 {
+	if x != nil {
+		return x.OutRate
+	}
+	return 0
+}
+
+func (x *LocationProduct) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *LocationProduct) GetTimeAgain() int64 {
+	if x != nil {
+		return x.TimeAgain
+	}
+	return 0
+}
+
+func (x *LocationProduct) GetSort() int64 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *LocationProduct) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *LocationProduct) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminLocationProductListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Vip    int64 `protobuf:"varint,2,opt,name=vip,proto3" json:"vip,omitempty"`
 }
 
-func (x *AdminVipUpdateRequest_SendBody) Reset() {
-	*x = AdminVipUpdateRequest_SendBody{}
+func (x *AdminLocationProductListRequest) Reset() {
+	*x = AdminLocationProductListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7170,13 +7733,13 @@ func (x *AdminVipUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminVipUpdateRequest_SendBody) String() string {
+func (x *AdminLocationProductListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminVipUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationProductListRequest) ProtoMessage() {}
 
-func (x *AdminVipUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationProductListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7188,36 +7751,21 @@ func (x *AdminVipUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminVipUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminVipUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{78, 0}
-}
-
-func (x *AdminVipUpdateRequest_SendBody) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminVipUpdateRequest_SendBody) GetVip() int64 {
-	if x != nil {
-		return x.Vip
-	}
-	return 0
+// Deprecated: Use AdminLocationProductListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationProductListRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{138}
 }
 
-type AdminKkdtUpdateRequest_SendBody struct {
+type AdminLocationProductListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Products []*LocationProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 }
 
-func (x *AdminKkdtUpdateRequest_SendBody) Reset() {
-	*x = AdminKkdtUpdateRequest_SendBody{}
+func (x *AdminLocationProductListReply) Reset() {
+	*x = AdminLocationProductListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7225,13 +7773,13 @@ func (x *AdminKkdtUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminKkdtUpdateRequest_SendBody) String() string {
+func (x *AdminLocationProductListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminKkdtUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationProductListReply) ProtoMessage() {}
 
-func (x *AdminKkdtUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationProductListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7243,36 +7791,28 @@ func (x *AdminKkdtUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminKkdtUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminKkdtUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{80, 0}
-}
-
-func (x *AdminKkdtUpdateRequest_SendBody) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use AdminLocationProductListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationProductListReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *AdminKkdtUpdateRequest_SendBody) GetAmount() int64 {
+func (x *AdminLocationProductListReply) GetProducts() []*LocationProduct {
 	if x != nil {
-		return x.Amount
+		return x.Products
 	}
-	return 0
+	return nil
 }
 
-type AdminUndoUpdateRequest_SendBody struct {
+type AdminLocationProductUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Undo   int64 `protobuf:"varint,2,opt,name=undo,proto3" json:"undo,omitempty"`
+	SendBody *LocationProduct `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"` // id为0时新增
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) Reset() {
-	*x = AdminUndoUpdateRequest_SendBody{}
+func (x *AdminLocationProductUpdateRequest) Reset() {
+	*x = AdminLocationProductUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7280,13 +7820,13 @@ func (x *AdminUndoUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) String() string {
+func (x *AdminLocationProductUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUndoUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationProductUpdateRequest) ProtoMessage() {}
 
-func (x *AdminUndoUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationProductUpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7298,36 +7838,28 @@ func (x *AdminUndoUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUndoUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminUndoUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{82, 0}
-}
-
-func (x *AdminUndoUpdateRequest_SendBody) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use AdminLocationProductUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationProductUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *AdminUndoUpdateRequest_SendBody) GetUndo() int64 {
+func (x *AdminLocationProductUpdateRequest) GetSendBody() *LocationProduct {
 	if x != nil {
-		return x.Undo
+		return x.SendBody
 	}
-	return 0
+	return nil
 }
 
-type AdminAreaLevelUpdateRequest_SendBody struct {
+type AdminLocationProductUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Level  int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) Reset() {
-	*x = AdminAreaLevelUpdateRequest_SendBody{}
+func (x *AdminLocationProductUpdateReply) Reset() {
+	*x = AdminLocationProductUpdateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7335,13 +7867,13 @@ func (x *AdminAreaLevelUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) String() string {
+func (x *AdminLocationProductUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAreaLevelUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationProductUpdateReply) ProtoMessage() {}
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationProductUpdateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7353,36 +7885,29 @@ func (x *AdminAreaLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAreaLevelUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminAreaLevelUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{84, 0}
-}
-
-func (x *AdminAreaLevelUpdateRequest_SendBody) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use AdminLocationProductUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationProductUpdateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *AdminAreaLevelUpdateRequest_SendBody) GetLevel() int64 {
+func (x *AdminLocationProductUpdateReply) GetId() int64 {
 	if x != nil {
-		return x.Level
+		return x.Id
 	}
 	return 0
 }
 
-type AdminLocationInsertRequest_SendBody struct {
+type AdminLocationHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationId int64  `protobuf:"varint,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Table      string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"` // location_new或location_new_2，默认location_new
 }
 
-func (x *AdminLocationInsertRequest_SendBody) Reset() {
-	*x = AdminLocationInsertRequest_SendBody{}
+func (x *AdminLocationHistoryRequest) Reset() {
+	*x = AdminLocationHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7390,13 +7915,13 @@ func (x *AdminLocationInsertRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminLocationInsertRequest_SendBody) String() string {
+func (x *AdminLocationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationInsertRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationHistoryRequest) ProtoMessage() {}
 
-func (x *AdminLocationInsertRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7408,36 +7933,40 @@ func (x *AdminLocationInsertRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationInsertRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminLocationInsertRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{86, 0}
+// Deprecated: Use AdminLocationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *AdminLocationInsertRequest_SendBody) GetUserId() int64 {
+func (x *AdminLocationHistoryRequest) GetLocationId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.LocationId
 	}
 	return 0
 }
 
-func (x *AdminLocationInsertRequest_SendBody) GetAmount() int64 {
+func (x *AdminLocationHistoryRequest) GetTable() string {
 	if x != nil {
-		return x.Amount
+		return x.Table
 	}
-	return 0
+	return ""
 }
 
-type AdminBalanceUpdateRequest_SendBody struct {
+type AdminLocationHistoryReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationId int64                              `protobuf:"varint,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	UserId     int64                              `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status     string                             `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Current    string                             `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax string                             `protobuf:"bytes,5,opt,name=current_max,json=currentMax,proto3" json:"current_max,omitempty"`
+	Events     []*AdminLocationHistoryReply_Event `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) Reset() {
-	*x = AdminBalanceUpdateRequest_SendBody{}
+func (x *AdminLocationHistoryReply) Reset() {
+	*x = AdminLocationHistoryReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7445,13 +7974,13 @@ func (x *AdminBalanceUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) String() string {
+func (x *AdminLocationHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminBalanceUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminLocationHistoryReply) ProtoMessage() {}
 
-func (x *AdminBalanceUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationHistoryReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7463,36 +7992,61 @@ func (x *AdminBalanceUpdateRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminBalanceUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminBalanceUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{88, 0}
+// Deprecated: Use AdminLocationHistoryReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationHistoryReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) GetUserId() int64 {
+func (x *AdminLocationHistoryReply) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminLocationHistoryReply) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *AdminBalanceUpdateRequest_SendBody) GetAmount() string {
+func (x *AdminLocationHistoryReply) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-type AuthAdminCreateRequest_SendBody struct {
+func (x *AdminLocationHistoryReply) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply) GetEvents() []*AdminLocationHistoryReply_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TestCreateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
 }
 
-func (x *AuthAdminCreateRequest_SendBody) Reset() {
-	*x = AuthAdminCreateRequest_SendBody{}
+func (x *TestCreateAccountRequest) Reset() {
+	*x = TestCreateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7500,13 +8054,13 @@ func (x *AuthAdminCreateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AuthAdminCreateRequest_SendBody) String() string {
+func (x *TestCreateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthAdminCreateRequest_SendBody) ProtoMessage() {}
+func (*TestCreateAccountRequest) ProtoMessage() {}
 
-func (x *AuthAdminCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *TestCreateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7518,36 +8072,19 @@ func (x *AuthAdminCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthAdminCreateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AuthAdminCreateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{90, 0}
-}
-
-func (x *AuthAdminCreateRequest_SendBody) GetAdminId() int64 {
-	if x != nil {
-		return x.AdminId
-	}
-	return 0
-}
-
-func (x *AuthAdminCreateRequest_SendBody) GetAuthId() int64 {
-	if x != nil {
-		return x.AuthId
-	}
-	return 0
+// Deprecated: Use TestCreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*TestCreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{144}
 }
 
-type AuthAdminDeleteRequest_SendBody struct {
+type TestCreateAccountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
 }
 
-func (x *AuthAdminDeleteRequest_SendBody) Reset() {
-	*x = AuthAdminDeleteRequest_SendBody{}
+func (x *TestCreateAccountReply) Reset() {
+	*x = TestCreateAccountReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7555,13 +8092,13 @@ func (x *AuthAdminDeleteRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AuthAdminDeleteRequest_SendBody) String() string {
+func (x *TestCreateAccountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthAdminDeleteRequest_SendBody) ProtoMessage() {}
+func (*TestCreateAccountReply) ProtoMessage() {}
 
-func (x *AuthAdminDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *TestCreateAccountReply) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7573,36 +8110,21 @@ func (x *AuthAdminDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthAdminDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AuthAdminDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{92, 0}
-}
-
-func (x *AuthAdminDeleteRequest_SendBody) GetAdminId() int64 {
-	if x != nil {
-		return x.AdminId
-	}
-	return 0
-}
-
-func (x *AuthAdminDeleteRequest_SendBody) GetAuthId() int64 {
-	if x != nil {
-		return x.AuthId
-	}
-	return 0
+// Deprecated: Use TestCreateAccountReply.ProtoReflect.Descriptor instead.
+func (*TestCreateAccountReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{145}
 }
 
-type AdminLoginRequest_SendBody struct {
+type AdminExportJobCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	SendBody *AdminExportJobCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLoginRequest_SendBody) Reset() {
-	*x = AdminLoginRequest_SendBody{}
+func (x *AdminExportJobCreateRequest) Reset() {
+	*x = AdminExportJobCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_app_api_app_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7610,13 +8132,13 @@ func (x *AdminLoginRequest_SendBody) Reset() {
 	}
 }
 
-func (x *AdminLoginRequest_SendBody) String() string {
+func (x *AdminExportJobCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLoginRequest_SendBody) ProtoMessage() {}
+func (*AdminExportJobCreateRequest) ProtoMessage() {}
 
-func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminExportJobCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_app_api_app_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7628,51 +8150,257 @@ func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLoginRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminLoginRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{100, 0}
+// Deprecated: Use AdminExportJobCreateRequest.ProtoReflect.Descriptor instead.
+func (*AdminExportJobCreateRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *AdminLoginRequest_SendBody) GetAccount() string {
+func (x *AdminExportJobCreateRequest) GetSendBody() *AdminExportJobCreateRequest_SendBody {
 	if x != nil {
-		return x.Account
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminExportJobCreateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminExportJobCreateReply) Reset() {
+	*x = AdminExportJobCreateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminExportJobCreateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminExportJobCreateReply) ProtoMessage() {}
+
+func (x *AdminExportJobCreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminExportJobCreateReply.ProtoReflect.Descriptor instead.
+func (*AdminExportJobCreateReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *AdminExportJobCreateReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminExportJobCreateReply) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AdminLoginRequest_SendBody) GetPassword() string {
+type AdminExportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminExportJobRequest) Reset() {
+	*x = AdminExportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminExportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminExportJobRequest) ProtoMessage() {}
+
+func (x *AdminExportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminExportJobRequest.ProtoReflect.Descriptor instead.
+func (*AdminExportJobRequest) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *AdminExportJobRequest) GetId() int64 {
 	if x != nil {
-		return x.Password
+		return x.Id
+	}
+	return 0
+}
+
+type AdminExportJobReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending等待，running导出中，done完成，failed失败
+	Rows      int64  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	Error     string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Download  string `protobuf:"bytes,7,opt,name=download,proto3" json:"download,omitempty"` // 完成后的下载地址
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AdminExportJobReply) Reset() {
+	*x = AdminExportJobReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminExportJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminExportJobReply) ProtoMessage() {}
+
+func (x *AdminExportJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminExportJobReply.ProtoReflect.Descriptor instead.
+func (*AdminExportJobReply) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AdminExportJobReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminExportJobReply) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
 	return ""
 }
 
-type AdminChangePasswordRequest_SendBody struct {
+func (x *AdminExportJobReply) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *AdminExportJobReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminExportJobReply) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *AdminExportJobReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AdminExportJobReply) GetDownload() string {
+	if x != nil {
+		return x.Download
+	}
+	return ""
+}
+
+func (x *AdminExportJobReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminExportJobReply) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type EthAuthorizeRequest_SendBody struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // bsc或BFMeta地址
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *AdminChangePasswordRequest_SendBody) Reset() {
-	*x = AdminChangePasswordRequest_SendBody{}
+func (x *EthAuthorizeRequest_SendBody) Reset() {
+	*x = EthAuthorizeRequest_SendBody{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[147]
+		mi := &file_app_app_api_app_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminChangePasswordRequest_SendBody) String() string {
+func (x *EthAuthorizeRequest_SendBody) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminChangePasswordRequest_SendBody) ProtoMessage() {}
+func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
 
-func (x *AdminChangePasswordRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[147]
+func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7683,51 +8411,56 @@ func (x *AdminChangePasswordRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminChangePasswordRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminChangePasswordRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{102, 0}
+// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *AdminChangePasswordRequest_SendBody) GetAccount() string {
+func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
 	if x != nil {
-		return x.Account
+		return x.Address
 	}
 	return ""
 }
 
-func (x *AdminChangePasswordRequest_SendBody) GetPassword() string {
+func (x *EthAuthorizeRequest_SendBody) GetCode() string {
 	if x != nil {
-		return x.Password
+		return x.Code
 	}
 	return ""
 }
 
-type AdminCreateAccountRequest_SendBody struct {
+type DepositBuyReply_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Hash       string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	UserId     int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Stage      string `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RecordId   int64  `protobuf:"varint,6,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	LocationId int64  `protobuf:"varint,7,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
 }
 
-func (x *AdminCreateAccountRequest_SendBody) Reset() {
-	*x = AdminCreateAccountRequest_SendBody{}
+func (x *DepositBuyReply_Item) Reset() {
+	*x = DepositBuyReply_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_app_api_app_proto_msgTypes[148]
+		mi := &file_app_app_api_app_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminCreateAccountRequest_SendBody) String() string {
+func (x *DepositBuyReply_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCreateAccountRequest_SendBody) ProtoMessage() {}
+func (*DepositBuyReply_Item) ProtoMessage() {}
 
-func (x *AdminCreateAccountRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_app_app_api_app_proto_msgTypes[148]
+func (x *DepositBuyReply_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7738,983 +8471,5718 @@ func (x *AdminCreateAccountRequest_SendBody) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCreateAccountRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminCreateAccountRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_app_app_api_app_proto_rawDescGZIP(), []int{104, 0}
+// Deprecated: Use DepositBuyReply_Item.ProtoReflect.Descriptor instead.
+func (*DepositBuyReply_Item) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *AdminCreateAccountRequest_SendBody) GetAccount() string {
+func (x *DepositBuyReply_Item) GetHash() string {
 	if x != nil {
-		return x.Account
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *AdminCreateAccountRequest_SendBody) GetPassword() string {
+func (x *DepositBuyReply_Item) GetUserId() int64 {
 	if x != nil {
-		return x.Password
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DepositBuyReply_Item) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-var File_app_app_api_app_proto protoreflect.FileDescriptor
+func (x *DepositBuyReply_Item) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
 
-var file_app_app_api_app_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x38, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x06, 0x0a, 0x0d, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a,
-	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75,
-	0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72,
-	0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a,
-	0x03, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12,
-	0x3a, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x32, 0x44, 0x68, 0x62, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x32, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33, 0x44,
-	0x68, 0x62, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33,
-	0x44, 0x68, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x68, 0x62, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x68, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a,
-	0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c,
-	0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x33, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01,
-	0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a,
-	0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a,
-	0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x27, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x99, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x45, 0x0a,
-	0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+func (x *DepositBuyReply_Item) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DepositBuyReply_Item) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *DepositBuyReply_Item) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+type RewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	AmountB        string `protobuf:"bytes,6,opt,name=amount_b,json=amountB,proto3" json:"amount_b,omitempty"`
+}
+
+func (x *RewardListReply_List) Reset() {
+	*x = RewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardListReply_List) ProtoMessage() {}
+
+func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *RewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmountB() string {
+	if x != nil {
+		return x.AmountB
+	}
+	return ""
+}
+
+type RecommendRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RecommendRewardListReply_List) Reset() {
+	*x = RecommendRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRewardListReply_List) ProtoMessage() {}
+
+func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *RecommendRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FeeRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *FeeRewardListReply_List) Reset() {
+	*x = FeeRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRewardListReply_List) ProtoMessage() {}
+
+func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *FeeRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Id        int64  `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	RelAmount string `protobuf:"bytes,6,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"`
+}
+
+func (x *WithdrawListReply_List) Reset() {
+	*x = WithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListReply_List) ProtoMessage() {}
+
+func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *WithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+type RecommendListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RecommendListReply_List) Reset() {
+	*x = RecommendListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendListReply_List) ProtoMessage() {}
+
+func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *RecommendListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *WithdrawRequest_SendBody) Reset() {
+	*x = WithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *WithdrawRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64   `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 时间
+	Amount    int64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                       // 数量
+	AmountB   float64 `protobuf:"fixed64,3,opt,name=amountB,proto3" json:"amountB,omitempty"`                    // 数量
+	Address   string  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`                      // 地址
+	Reason    string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                        // 原因 每一条替换文案 认购reason=buy，提现reason=withdraw，兑换reason=exchange，前四名reason=four，挖矿reason=location，矩阵reason=area，直推reason=recommend
+}
+
+func (x *AdminRewardListReply_List) Reset() {
+	*x = AdminRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *AdminRewardListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminRewardListReply_List) GetAmountB() float64 {
+	if x != nil {
+		return x.AmountB
+	}
+	return 0
+}
+
+func (x *AdminRewardListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminTradeListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AmountCsd string `protobuf:"bytes,2,opt,name=amountCsd,proto3" json:"amountCsd,omitempty"`
+	AmountHbs string `protobuf:"bytes,3,opt,name=amountHbs,proto3" json:"amountHbs,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AdminTradeListReply_List) Reset() {
+	*x = AdminTradeListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminTradeListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTradeListReply_List) ProtoMessage() {}
+
+func (x *AdminTradeListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTradeListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminTradeListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *AdminTradeListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminTradeListReply_List) GetAmountCsd() string {
+	if x != nil {
+		return x.AmountCsd
+	}
+	return ""
+}
+
+func (x *AdminTradeListReply_List) GetAmountHbs() string {
+	if x != nil {
+		return x.AmountHbs
+	}
+	return ""
+}
+
+func (x *AdminTradeListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminUserListReply_UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64   `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        string  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间
+	Address          string  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                      // 地址
+	BalanceUsdt      int64   `protobuf:"varint,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`             // 可提usdt数量
+	BalanceDhb       float64 `protobuf:"fixed64,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`              // isps数量
+	Out              int64   `protobuf:"varint,7,opt,name=out,proto3" json:"out,omitempty"`                             // 出局次数
+	AreaTotal        int64   `protobuf:"varint,9,opt,name=areaTotal,proto3" json:"areaTotal,omitempty"`                 // 总业绩
+	AreaMin          int64   `protobuf:"varint,10,opt,name=areaMin,proto3" json:"areaMin,omitempty"`                    // 小区
+	AreaMax          int64   `protobuf:"varint,11,opt,name=areaMax,proto3" json:"areaMax,omitempty"`                    // 大区
+	Vip              int64   `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`                             // 会员等级
+	HistoryRecommend int64   `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`   // 历史推荐人数
+	Amount           int64   `protobuf:"varint,19,opt,name=amount,proto3" json:"amount,omitempty"`
+	Kkdt             int64   `protobuf:"varint,20,opt,name=kkdt,proto3" json:"kkdt,omitempty"`
+}
+
+func (x *AdminUserListReply_UserList) Reset() {
+	*x = AdminUserListReply_UserList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserListReply_UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply_UserList) ProtoMessage() {}
+
+func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *AdminUserListReply_UserList) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceUsdt() int64 {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceDhb() float64 {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetOut() int64 {
+	if x != nil {
+		return x.Out
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetAreaTotal() int64 {
+	if x != nil {
+		return x.AreaTotal
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetAreaMin() int64 {
+	if x != nil {
+		return x.AreaMin
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetAreaMax() int64 {
+	if x != nil {
+		return x.AreaMax
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+	if x != nil {
+		return x.HistoryRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetKkdt() int64 {
+	if x != nil {
+		return x.Kkdt
+	}
+	return 0
+}
+
+type RecordListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 时间
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                      // 地址
+	Amount    uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RecordListReply_LocationList) Reset() {
+	*x = RecordListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordListReply_LocationList) ProtoMessage() {}
+
+func (x *RecordListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*RecordListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *RecordListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecordListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecordListReply_LocationList) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AdminLocationListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`              // 地址
+	Current       string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`              // 当前收益, 挖矿
+	CurrentMax    string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`        // 最大收益
+	Usdt          string `protobuf:"bytes,9,opt,name=usdt,proto3" json:"usdt,omitempty"`                    // 节点
+	CurrentMaxSub string `protobuf:"bytes,10,opt,name=currentMaxSub,proto3" json:"currentMaxSub,omitempty"` // 收益
+	Out           int64  `protobuf:"varint,11,opt,name=out,proto3" json:"out,omitempty"`                    // 出局
+}
+
+func (x *AdminLocationListReply_LocationList) Reset() {
+	*x = AdminLocationListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *AdminLocationListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetUsdt() string {
+	if x != nil {
+		return x.Usdt
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMaxSub() string {
+	if x != nil {
+		return x.CurrentMaxSub
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetOut() int64 {
+	if x != nil {
+		return x.Out
+	}
+	return 0
+}
+
+type AdminLocationAllListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+}
+
+func (x *AdminLocationAllListReply_LocationList) Reset() {
+	*x = AdminLocationAllListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationAllListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationAllListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationAllListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationAllListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationAllListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationAllListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type AdminWithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string  `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"` // 地址
+	Id        int64   `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 时间
+	Amount    float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`                      // 到账金额
+	RelAmount float64 `protobuf:"fixed64,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`                // 提现金额
+	Type      string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                            // 类型usdt是模块1提现，usdt_2是模块2提现
+	Status    string  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // success成功，doing正在处理
+}
+
+func (x *AdminWithdrawListReply_List) Reset() {
+	*x = AdminWithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *AdminWithdrawListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetRelAmount() float64 {
+	if x != nil {
+		return x.RelAmount
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminWithdrawPassRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AdminWithdrawPassRequest_SendBody) Reset() {
+	*x = AdminWithdrawPassRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawPassRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawPassRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawPassRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawPassRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawPassRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *AdminWithdrawPassRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminWithdrawRejectRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) Reset() {
+	*x = AdminWithdrawRejectRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawRejectRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawRejectRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawRejectRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRejectRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawRejectRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminWithdrawBatchPassRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *AdminWithdrawBatchPassRequest_SendBody) Reset() {
+	*x = AdminWithdrawBatchPassRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawBatchPassRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawBatchPassRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawBatchPassRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawBatchPassRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBatchPassRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *AdminWithdrawBatchPassRequest_SendBody) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AdminWithdrawBatchPassReply_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Flags  []string `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
+	Error  string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AdminWithdrawBatchPassReply_Item) Reset() {
+	*x = AdminWithdrawBatchPassReply_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawBatchPassReply_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawBatchPassReply_Item) ProtoMessage() {}
+
+func (x *AdminWithdrawBatchPassReply_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawBatchPassReply_Item.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawBatchPassReply_Item) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *AdminWithdrawBatchPassReply_Item) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawBatchPassReply_Item) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWithdrawBatchPassReply_Item) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *AdminWithdrawBatchPassReply_Item) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AdminWithdrawReviewListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     string   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount        string   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount     string   `protobuf:"bytes,5,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"`
+	AccountDays   int64    `protobuf:"varint,6,opt,name=account_days,json=accountDays,proto3" json:"account_days,omitempty"`      // 注册天数
+	DepositTotal  string   `protobuf:"bytes,7,opt,name=deposit_total,json=depositTotal,proto3" json:"deposit_total,omitempty"`    // 入金合计
+	WithdrawTotal string   `protobuf:"bytes,8,opt,name=withdraw_total,json=withdrawTotal,proto3" json:"withdraw_total,omitempty"` // 之前的提现合计，不含驳回
+	WithdrawCount int64    `protobuf:"varint,9,opt,name=withdraw_count,json=withdrawCount,proto3" json:"withdraw_count,omitempty"`
+	WithdrawAvg   string   `protobuf:"bytes,10,opt,name=withdraw_avg,json=withdrawAvg,proto3" json:"withdraw_avg,omitempty"`
+	Flags         []string `protobuf:"bytes,11,rep,name=flags,proto3" json:"flags,omitempty"` // new_account新账户，withdraw_over_deposit提现超过入金比例，first_withdraw首次提现，large_amount超过历史平均倍数
+}
+
+func (x *AdminWithdrawReviewListReply_List) Reset() {
+	*x = AdminWithdrawReviewListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawReviewListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawReviewListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawReviewListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetAccountDays() int64 {
+	if x != nil {
+		return x.AccountDays
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetDepositTotal() string {
+	if x != nil {
+		return x.DepositTotal
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetWithdrawTotal() string {
+	if x != nil {
+		return x.WithdrawTotal
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetWithdrawCount() int64 {
+	if x != nil {
+		return x.WithdrawCount
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetWithdrawAvg() string {
+	if x != nil {
+		return x.WithdrawAvg
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewListReply_List) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type AdminWithdrawReviewsReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WithdrawId   int64    `protobuf:"varint,2,opt,name=withdraw_id,json=withdrawId,proto3" json:"withdraw_id,omitempty"`
+	UserId       int64    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdminId      int64    `protobuf:"varint,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Action       string   `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	StatusBefore string   `protobuf:"bytes,6,opt,name=status_before,json=statusBefore,proto3" json:"status_before,omitempty"`
+	StatusAfter  string   `protobuf:"bytes,7,opt,name=status_after,json=statusAfter,proto3" json:"status_after,omitempty"`
+	Amount       string   `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason       string   `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Flags        []string `protobuf:"bytes,10,rep,name=flags,proto3" json:"flags,omitempty"`
+	CreatedAt    string   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminWithdrawReviewsReply_List) Reset() {
+	*x = AdminWithdrawReviewsReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawReviewsReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawReviewsReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawReviewsReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawReviewsReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReviewsReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetWithdrawId() int64 {
+	if x != nil {
+		return x.WithdrawId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetStatusBefore() string {
+	if x != nil {
+		return x.StatusBefore
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetStatusAfter() string {
+	if x != nil {
+		return x.StatusAfter
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *AdminWithdrawReviewsReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminUserRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId             int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address            string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Id                 int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt          string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount             string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount          string `protobuf:"bytes,6,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"`
+	RecommendAllAmount string `protobuf:"bytes,7,opt,name=recommend_all_amount,json=recommendAllAmount,proto3" json:"recommend_all_amount,omitempty"`
+}
+
+func (x *AdminUserRecommendReply_List) Reset() {
+	*x = AdminUserRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *AdminUserRecommendReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetRecommendAllAmount() string {
+	if x != nil {
+		return x.RecommendAllAmount
+	}
+	return ""
+}
+
+type AdminMonthRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
+	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply_List) Reset() {
+	*x = AdminMonthRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *AdminMonthRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+	if x != nil {
+		return x.RecommendAddress
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminConfigReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`   // 名称
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // 值
+	KeyName string `protobuf:"bytes,4,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	Type    string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"` // int、float、string
+	Unit    string `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Min     string `protobuf:"bytes,7,opt,name=min,proto3" json:"min,omitempty"` // 最小值，min、max都为空时不限
+	Max     string `protobuf:"bytes,8,opt,name=max,proto3" json:"max,omitempty"`
+	Default string `protobuf:"bytes,9,opt,name=default,proto3" json:"default,omitempty"` // 未配置时的默认值
+}
+
+func (x *AdminConfigReply_List) Reset() {
+	*x = AdminConfigReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply_List) ProtoMessage() {}
+
+func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *AdminConfigReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+type AdminListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *AdminListReply_List) Reset() {
+	*x = AdminListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListReply_List) ProtoMessage() {}
+
+func (x *AdminListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *AdminListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminListReply_List) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type AuthListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *AuthListReply_List) Reset() {
+	*x = AuthListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthListReply_List) ProtoMessage() {}
+
+func (x *AuthListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthListReply_List.ProtoReflect.Descriptor instead.
+func (*AuthListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *AuthListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuthListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthListReply_List) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UserAuthListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *UserAuthListReply_List) Reset() {
+	*x = UserAuthListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAuthListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAuthListReply_List) ProtoMessage() {}
+
+func (x *UserAuthListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAuthListReply_List.ProtoReflect.Descriptor instead.
+func (*UserAuthListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *UserAuthListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserAuthListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserAuthListReply_List) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type MyAuthListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MyAuthListReply_List) Reset() {
+	*x = MyAuthListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MyAuthListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyAuthListReply_List) ProtoMessage() {}
+
+func (x *MyAuthListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyAuthListReply_List.ProtoReflect.Descriptor instead.
+func (*MyAuthListReply_List) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{78, 0}
+}
+
+func (x *MyAuthListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MyAuthListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MyAuthListReply_List) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type AdminConfigUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	EffectiveFrom string `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // 生效时间 2006-01-02 15:04:05，空为立即生效
+	Note          string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) Reset() {
+	*x = AdminConfigUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminConfigHistoryReply_Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	PreviousValue string `protobuf:"bytes,3,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	Diff          string `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"` // 数值配置的变化量
+	AdminId       int64  `protobuf:"varint,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	EffectiveFrom string `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	Note          string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	RollbackOf    int64  `protobuf:"varint,8,opt,name=rollback_of,json=rollbackOf,proto3" json:"rollback_of,omitempty"`
+	Current       bool   `protobuf:"varint,9,opt,name=current,proto3" json:"current,omitempty"`  // 当前生效
+	Pending       bool   `protobuf:"varint,10,opt,name=pending,proto3" json:"pending,omitempty"` // 未到生效时间
+	CreatedAt     string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminConfigHistoryReply_Version) Reset() {
+	*x = AdminConfigHistoryReply_Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigHistoryReply_Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigHistoryReply_Version) ProtoMessage() {}
+
+func (x *AdminConfigHistoryReply_Version) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigHistoryReply_Version.ProtoReflect.Descriptor instead.
+func (*AdminConfigHistoryReply_Version) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *AdminConfigHistoryReply_Version) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigHistoryReply_Version) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AdminConfigHistoryReply_Version) GetPreviousValue() string {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return ""
+}
+
+func (x *AdminConfigHistoryReply_Version) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *AdminConfigHistoryReply_Version) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminConfigHistoryReply_Version) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *AdminConfigHistoryReply_Version) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AdminConfigHistoryReply_Version) GetRollbackOf() int64 {
+	if x != nil {
+		return x.RollbackOf
+	}
+	return 0
+}
+
+func (x *AdminConfigHistoryReply_Version) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *AdminConfigHistoryReply_Version) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *AdminConfigHistoryReply_Version) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminConfigRollbackRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionId int64  `protobuf:"varint,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Note      string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *AdminConfigRollbackRequest_SendBody) Reset() {
+	*x = AdminConfigRollbackRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigRollbackRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigRollbackRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigRollbackRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigRollbackRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigRollbackRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{83, 0}
+}
+
+func (x *AdminConfigRollbackRequest_SendBody) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *AdminConfigRollbackRequest_SendBody) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminPriceRevalueReply_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LocationId int64  `protobuf:"varint,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Direction  string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Amount     string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Stopped    bool   `protobuf:"varint,5,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	Status     string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Error      string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	UpdatedAt  string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AdminPriceRevalueReply_Item) Reset() {
+	*x = AdminPriceRevalueReply_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPriceRevalueReply_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPriceRevalueReply_Item) ProtoMessage() {}
+
+func (x *AdminPriceRevalueReply_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPriceRevalueReply_Item.ProtoReflect.Descriptor instead.
+func (*AdminPriceRevalueReply_Item) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{88, 0}
+}
+
+func (x *AdminPriceRevalueReply_Item) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminPriceRevalueReply_Item) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminPriceRevalueReply_Item) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *AdminPriceRevalueReply_Item) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminPriceRevalueReply_Item) GetStopped() bool {
+	if x != nil {
+		return x.Stopped
+	}
+	return false
+}
+
+func (x *AdminPriceRevalueReply_Item) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminPriceRevalueReply_Item) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AdminPriceRevalueReply_Item) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AdminPasswordUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) Reset() {
+	*x = AdminPasswordUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPasswordUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminPasswordUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPasswordUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminPasswordUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{91, 0}
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminUpdateLocationNewMaxRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminUpdateLocationNewMaxRequest_SendBody) Reset() {
+	*x = AdminUpdateLocationNewMaxRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUpdateLocationNewMaxRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateLocationNewMaxRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminUpdateLocationNewMaxRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateLocationNewMaxRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminUpdateLocationNewMaxRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{93, 0}
+}
+
+func (x *AdminUpdateLocationNewMaxRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUpdateLocationNewMaxRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminVipDeleteRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Kkdt   int64 `protobuf:"varint,3,opt,name=kkdt,proto3" json:"kkdt,omitempty"`
+}
+
+func (x *AdminVipDeleteRequest_SendBody) Reset() {
+	*x = AdminVipDeleteRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipDeleteRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipDeleteRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminVipDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminVipDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{95, 0}
+}
+
+func (x *AdminVipDeleteRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminVipDeleteRequest_SendBody) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminVipDeleteRequest_SendBody) GetKkdt() int64 {
+	if x != nil {
+		return x.Kkdt
+	}
+	return 0
+}
+
+type AdminVipUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Vip    int64 `protobuf:"varint,2,opt,name=vip,proto3" json:"vip,omitempty"`
+}
+
+func (x *AdminVipUpdateRequest_SendBody) Reset() {
+	*x = AdminVipUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminVipUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVipUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminVipUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVipUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminVipUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{97, 0}
+}
+
+func (x *AdminVipUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminVipUpdateRequest_SendBody) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+type AdminKkdtUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminKkdtUpdateRequest_SendBody) Reset() {
+	*x = AdminKkdtUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminKkdtUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminKkdtUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminKkdtUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminKkdtUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminKkdtUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{99, 0}
+}
+
+func (x *AdminKkdtUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminKkdtUpdateRequest_SendBody) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AdminUndoUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Undo   int64 `protobuf:"varint,2,opt,name=undo,proto3" json:"undo,omitempty"`
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) Reset() {
+	*x = AdminUndoUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUndoUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminUndoUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUndoUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminUndoUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{101, 0}
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUndoUpdateRequest_SendBody) GetUndo() int64 {
+	if x != nil {
+		return x.Undo
+	}
+	return 0
+}
+
+type AdminAreaLevelUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level  int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) Reset() {
+	*x = AdminAreaLevelUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAreaLevelUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAreaLevelUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{103, 0}
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminAreaLevelUpdateRequest_SendBody) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type AdminLocationInsertRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // usdt
+	Ref    string `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`        // 外部单号，同一单号只补一次
+	Note   string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *AdminLocationInsertRequest_SendBody) Reset() {
+	*x = AdminLocationInsertRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationInsertRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationInsertRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLocationInsertRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationInsertRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLocationInsertRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{105, 0}
+}
+
+func (x *AdminLocationInsertRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminLocationInsertRequest_SendBody) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdminLocationInsertRequest_SendBody) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *AdminLocationInsertRequest_SendBody) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdminBalanceUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) Reset() {
+	*x = AdminBalanceUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBalanceUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminBalanceUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBalanceUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminBalanceUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{107, 0}
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminBalanceUpdateRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AuthAdminCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+}
+
+func (x *AuthAdminCreateRequest_SendBody) Reset() {
+	*x = AuthAdminCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthAdminCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAdminCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *AuthAdminCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAdminCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AuthAdminCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{109, 0}
+}
+
+func (x *AuthAdminCreateRequest_SendBody) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AuthAdminCreateRequest_SendBody) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type AuthAdminDeleteRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminId int64 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AuthId  int64 `protobuf:"varint,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) Reset() {
+	*x = AuthAdminDeleteRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAdminDeleteRequest_SendBody) ProtoMessage() {}
+
+func (x *AuthAdminDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAdminDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AuthAdminDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{111, 0}
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AuthAdminDeleteRequest_SendBody) GetAuthId() int64 {
+	if x != nil {
+		return x.AuthId
+	}
+	return 0
+}
+
+type AdminLoginRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminLoginRequest_SendBody) Reset() {
+	*x = AdminLoginRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLoginRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminLoginRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{119, 0}
+}
+
+func (x *AdminLoginRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminLoginRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminChangePasswordRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminChangePasswordRequest_SendBody) Reset() {
+	*x = AdminChangePasswordRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminChangePasswordRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminChangePasswordRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminChangePasswordRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminChangePasswordRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminChangePasswordRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{121, 0}
+}
+
+func (x *AdminChangePasswordRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminChangePasswordRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminCreateAccountRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminCreateAccountRequest_SendBody) Reset() {
+	*x = AdminCreateAccountRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCreateAccountRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateAccountRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminCreateAccountRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateAccountRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminCreateAccountRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{123, 0}
+}
+
+func (x *AdminCreateAccountRequest_SendBody) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminCreateAccountRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RewardReport_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Vip     int64  `protobuf:"varint,3,opt,name=vip,proto3" json:"vip,omitempty"`
+	Amount  string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RewardReport_User) Reset() {
+	*x = RewardReport_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardReport_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardReport_User) ProtoMessage() {}
+
+func (x *RewardReport_User) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardReport_User.ProtoReflect.Descriptor instead.
+func (*RewardReport_User) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{131, 0}
+}
+
+func (x *RewardReport_User) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RewardReport_User) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RewardReport_User) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *RewardReport_User) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type RewardReport_Vip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vip    int64  `protobuf:"varint,1,opt,name=vip,proto3" json:"vip,omitempty"`
+	Count  int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RewardReport_Vip) Reset() {
+	*x = RewardReport_Vip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardReport_Vip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardReport_Vip) ProtoMessage() {}
+
+func (x *RewardReport_Vip) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardReport_Vip.ProtoReflect.Descriptor instead.
+func (*RewardReport_Vip) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{131, 1}
+}
+
+func (x *RewardReport_Vip) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *RewardReport_Vip) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RewardReport_Vip) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminRewardRollbackRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId     int64  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // 为0时按日期区间回滚
+	Job       string `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) Reset() {
+	*x = AdminRewardRollbackRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardRollbackRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminRewardRollbackRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardRollbackRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminRewardRollbackRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{132, 0}
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AdminRewardRollbackRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RewardRollbackReport_Run struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId        int64  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Job          string `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	BusinessDate string `protobuf:"bytes,3,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	Items        int64  `protobuf:"varint,4,opt,name=items,proto3" json:"items,omitempty"`
+	Rewards      int64  `protobuf:"varint,5,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	Records      int64  `protobuf:"varint,6,opt,name=records,proto3" json:"records,omitempty"`
+	Amount       string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RewardRollbackReport_Run) Reset() {
+	*x = RewardRollbackReport_Run{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardRollbackReport_Run) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardRollbackReport_Run) ProtoMessage() {}
+
+func (x *RewardRollbackReport_Run) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardRollbackReport_Run.ProtoReflect.Descriptor instead.
+func (*RewardRollbackReport_Run) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{134, 0}
+}
+
+func (x *RewardRollbackReport_Run) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *RewardRollbackReport_Run) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *RewardRollbackReport_Run) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *RewardRollbackReport_Run) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *RewardRollbackReport_Run) GetRewards() int64 {
+	if x != nil {
+		return x.Rewards
+	}
+	return 0
+}
+
+func (x *RewardRollbackReport_Run) GetRecords() int64 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+func (x *RewardRollbackReport_Run) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AdminLocationTreeCheckReply_Diff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId       int64 `protobuf:"varint,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Total            int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	TotalTwo         int64 `protobuf:"varint,3,opt,name=total_two,json=totalTwo,proto3" json:"total_two,omitempty"`
+	TotalThree       int64 `protobuf:"varint,4,opt,name=total_three,json=totalThree,proto3" json:"total_three,omitempty"`
+	ExpectTotal      int64 `protobuf:"varint,5,opt,name=expect_total,json=expectTotal,proto3" json:"expect_total,omitempty"`
+	ExpectTotalTwo   int64 `protobuf:"varint,6,opt,name=expect_total_two,json=expectTotalTwo,proto3" json:"expect_total_two,omitempty"`
+	ExpectTotalThree int64 `protobuf:"varint,7,opt,name=expect_total_three,json=expectTotalThree,proto3" json:"expect_total_three,omitempty"`
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) Reset() {
+	*x = AdminLocationTreeCheckReply_Diff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationTreeCheckReply_Diff) ProtoMessage() {}
+
+func (x *AdminLocationTreeCheckReply_Diff) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationTreeCheckReply_Diff.ProtoReflect.Descriptor instead.
+func (*AdminLocationTreeCheckReply_Diff) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{136, 0}
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetTotalTwo() int64 {
+	if x != nil {
+		return x.TotalTwo
+	}
+	return 0
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetTotalThree() int64 {
+	if x != nil {
+		return x.TotalThree
+	}
+	return 0
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetExpectTotal() int64 {
+	if x != nil {
+		return x.ExpectTotal
+	}
+	return 0
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetExpectTotalTwo() int64 {
+	if x != nil {
+		return x.ExpectTotalTwo
+	}
+	return 0
+}
+
+func (x *AdminLocationTreeCheckReply_Diff) GetExpectTotalThree() int64 {
+	if x != nil {
+		return x.ExpectTotalThree
+	}
+	return 0
+}
+
+type AdminLocationHistoryReply_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event            string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Source           string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	RunId            int64  `protobuf:"varint,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	AdminId          int64  `protobuf:"varint,5,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	StatusBefore     string `protobuf:"bytes,6,opt,name=status_before,json=statusBefore,proto3" json:"status_before,omitempty"`
+	StatusAfter      string `protobuf:"bytes,7,opt,name=status_after,json=statusAfter,proto3" json:"status_after,omitempty"`
+	CurrentBefore    string `protobuf:"bytes,8,opt,name=current_before,json=currentBefore,proto3" json:"current_before,omitempty"`
+	CurrentAfter     string `protobuf:"bytes,9,opt,name=current_after,json=currentAfter,proto3" json:"current_after,omitempty"`
+	CurrentMaxBefore string `protobuf:"bytes,10,opt,name=current_max_before,json=currentMaxBefore,proto3" json:"current_max_before,omitempty"`
+	CurrentMaxAfter  string `protobuf:"bytes,11,opt,name=current_max_after,json=currentMaxAfter,proto3" json:"current_max_after,omitempty"`
+	Amount           string `protobuf:"bytes,12,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note             string `protobuf:"bytes,13,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt        string `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminLocationHistoryReply_Event) Reset() {
+	*x = AdminLocationHistoryReply_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationHistoryReply_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationHistoryReply_Event) ProtoMessage() {}
+
+func (x *AdminLocationHistoryReply_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationHistoryReply_Event.ProtoReflect.Descriptor instead.
+func (*AdminLocationHistoryReply_Event) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{143, 0}
+}
+
+func (x *AdminLocationHistoryReply_Event) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminLocationHistoryReply_Event) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *AdminLocationHistoryReply_Event) GetAdminId() int64 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AdminLocationHistoryReply_Event) GetStatusBefore() string {
+	if x != nil {
+		return x.StatusBefore
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetStatusAfter() string {
+	if x != nil {
+		return x.StatusAfter
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetCurrentBefore() string {
+	if x != nil {
+		return x.CurrentBefore
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetCurrentAfter() string {
+	if x != nil {
+		return x.CurrentAfter
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetCurrentMaxBefore() string {
+	if x != nil {
+		return x.CurrentMaxBefore
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetCurrentMaxAfter() string {
+	if x != nil {
+		return x.CurrentMaxAfter
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AdminLocationHistoryReply_Event) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminExportJobCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Format  string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Type    string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Status  string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Start   string `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"` // 北京时间，包含
+	End     string `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`     // 北京时间，包含
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) Reset() {
+	*x = AdminExportJobCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_app_api_app_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminExportJobCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminExportJobCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_app_app_api_app_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminExportJobCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminExportJobCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_app_app_api_app_proto_rawDescGZIP(), []int{146, 0}
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *AdminExportJobCreateRequest_SendBody) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+var File_app_app_api_app_proto protoreflect.FileDescriptor
+
+var file_app_app_api_app_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x73, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x53, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x39, 0xfa, 0x42, 0x36, 0x72, 0x34, 0x32, 0x32, 0x5e, 0x28, 0x30, 0x78, 0x5b,
+	0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x34, 0x30, 0x7d, 0x7c, 0x62,
+	0x5b, 0x31, 0x2d, 0x39, 0x41, 0x2d, 0x48, 0x4a, 0x2d, 0x4e, 0x50, 0x2d, 0x5a, 0x61, 0x2d, 0x6b,
+	0x6d, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x35, 0x2c, 0x34, 0x35, 0x7d, 0x29, 0x24, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x74,
+	0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x02, 0x0a,
+	0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x75, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x75, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xb7, 0x01, 0x0a, 0x04, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x06, 0x0a, 0x0d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a,
+	0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e,
+	0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c,
+	0x12, 0x3a, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x31, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x32, 0x44, 0x68, 0x62, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x32, 0x44, 0x68, 0x62, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x33,
+	0x44, 0x68, 0x62, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x33, 0x44, 0x68, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x68, 0x62, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x68, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41,
+	0x6c, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22,
+	0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa,
+	0x42, 0x0f, 0x72, 0x0d, 0x18, 0x2d, 0x32, 0x09, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x2a,
+	0x24, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8b, 0x02, 0x0a, 0x0f, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xac, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4c,
-	0x6f, 0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b,
-	0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65,
-	0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x0a, 0x2b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x29, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e,
-	0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x56, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x22, 0xd1, 0x03, 0x0a, 0x12, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x22, 0x69, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x18, 0x2d, 0x32,
+	0x09, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x63,
+	0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28,
+	0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x18, 0x2d,
+	0x32, 0x09, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x55, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x5d, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xfa, 0x42, 0x0e, 0x72, 0x0c, 0x18, 0x2d, 0x32, 0x08, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xfd,
+	0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x98, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2a,
+	0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a,
+	0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x5b, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x23, 0xfa, 0x42, 0x20, 0x72, 0x1e, 0x32, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x31, 0x2c, 0x31, 0x32, 0x7d, 0x28, 0x5c, 0x2e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31,
+	0x2c, 0x35, 0x7d, 0x29, 0x3f, 0x24, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80,
+	0x01, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xe1, 0x05, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x5a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x44, 0xfa, 0x42, 0x41, 0x72, 0x3f, 0x32, 0x3d, 0x5e, 0x28, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x7d, 0x28, 0x20, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x3a, 0x5b,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x3a, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d,
+	0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x56, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xfa, 0x42, 0x41, 0x72, 0x3f,
+	0x32, 0x3d, 0x5e, 0x28, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x28, 0x20,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x3a, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x7d, 0x3a, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x22,
+	0x05, 0x18, 0xb8, 0x44, 0x28, 0x00, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x72, 0x19, 0x52, 0x00,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x09, 0x73, 0x74,
+	0x6f, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x92, 0x01, 0x18, 0x10, 0x0a, 0x22, 0x14, 0x72, 0x12,
+	0x10, 0x01, 0x18, 0x2d, 0x32, 0x0c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x30, 0x2d, 0x39, 0x5d,
+	0x2a, 0x24, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26,
+	0xfa, 0x42, 0x23, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31,
+	0x2c, 0x31, 0x32, 0x7d, 0x28, 0x5c, 0x2e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x35,
+	0x7d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69,
+	0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x31, 0x32, 0x7d, 0x28, 0x5c, 0x2e, 0x5b, 0x30,
+	0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x35, 0x7d, 0x29, 0x3f, 0x29, 0x3f, 0x24, 0x52, 0x09, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x22, 0x0a, 0x07, 0x76, 0x69, 0x70, 0x5f,
+	0x6d, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x22, 0x04,
+	0x18, 0x64, 0x28, 0x00, 0x52, 0x06, 0x76, 0x69, 0x70, 0x4d, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x09,
+	0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x15, 0xfa, 0x42, 0x12, 0x72, 0x10, 0x18, 0x2d, 0x32, 0x0c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f,
+	0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15,
+	0xfa, 0x42, 0x12, 0x72, 0x10, 0x18, 0x2d, 0x32, 0x0c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x30,
+	0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xfa, 0x42, 0x27, 0x72, 0x25,
+	0x52, 0x00, 0x52, 0x02, 0x69, 0x64, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x72,
+	0x0d, 0x52, 0x00, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x52, 0x03, 0x61, 0x73, 0x63, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x9e, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b,
+	0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32, 0x0e, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42,
+	0x17, 0x72, 0x15, 0x18, 0xc8, 0x01, 0x32, 0x10, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17, 0x52, 0x00, 0x52, 0x05, 0x65,
+	0x78, 0x61, 0x63, 0x74, 0x52, 0x06, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x52, 0x04, 0x6e, 0x6f,
+	0x6e, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc6, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x78, 0x1a, 0x99, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x6b, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84,
+	0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72,
+	0x12, 0x18, 0x64, 0x32, 0x0e, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x5d, 0x2a, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xdf, 0x01, 0x0a,
+	0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x48, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x48, 0x62, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x13,
+	0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d,
+	0x0a, 0x2b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a,
+	0x29, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x96, 0x02, 0x0a, 0x14, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32, 0x0e,
+	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15,
+	0x18, 0xc8, 0x01, 0x32, 0x10, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3b, 0x0a,
+	0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17, 0x52, 0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x52, 0x06, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x52,
+	0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x95, 0x04, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x1a, 0xec, 0x02, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10, 0x0a,
+	0x03, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x61, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x72, 0x65, 0x61, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d,
+	0x61, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61,
+	0x78, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x76, 0x69, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x6b, 0x64, 0x74, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x6b, 0x64, 0x74, 0x22, 0x67, 0x0a, 0x11, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b,
+	0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32, 0x0e, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
-	0xec, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62,
-	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f,
-	0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x72, 0x65, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72,
-	0x65, 0x61, 0x4d, 0x61, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x72, 0x65,
-	0x61, 0x4d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x6b,
-	0x64, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x6b, 0x64, 0x74, 0x22, 0x41,
-	0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x5f, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa0, 0x02, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64,
+	0x32, 0x0e, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15,
+	0x18, 0xc8, 0x01, 0x32, 0x10, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x5f, 0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3b, 0x0a,
+	0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17, 0x52, 0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x52, 0x06, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x52,
+	0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0xeb, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
 	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x5f, 0x0a, 0x0c,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a,
-	0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0xa7, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xae, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53,
-	0x75, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x4b, 0x0a, 0x1b, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xbd, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78,
+	0x1a, 0xae, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
 	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75,
 	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
 	0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x6c, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x44, 0x72, 0x61, 0x77, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x44, 0x72, 0x61,
-	0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c,
-	0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x12,
+	0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x75,
+	0x74, 0x22, 0x71, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b,
+	0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32, 0x0e, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x49, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0xbd, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x4d, 0x61, 0x78, 0x22, 0xac, 0x02, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x44, 0x72, 0x61, 0x77, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x44, 0x72, 0x61, 0x77,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32,
+	0x0e, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x18,
+	0xc8, 0x01, 0x32, 0x10, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5f,
+	0x2d, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x0a,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17, 0x52, 0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74,
+	0x52, 0x06, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0xe4, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
+	0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x78, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x8e, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x23, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x47, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xc8, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x4a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0xa3, 0x01,
+	0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x52, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x2e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x22, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x10, 0xfa, 0x42,
+	0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x10, 0x64, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x1a, 0x5a, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x1e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42,
+	0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22,
+	0xd0, 0x03, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x42, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xd5, 0x02, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x76, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x76, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x22, 0x47, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x49, 0x64, 0x22, 0x8d, 0x03, 0x0a, 0x19,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x1a, 0xb0, 0x02, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x28, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x14, 0x0a,
+	0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x04, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74,
+	0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x13, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x6f, 0x64,
+	0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x32, 0x0a, 0x14, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x42, 0x0a, 0x1c, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x46, 0x6f, 0x75, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x64,
+	0x61, 0x79, 0x46, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x73, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x73, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x55, 0x73, 0x64, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x64, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x73, 0x64, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x55, 0x73, 0x64, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x73, 0x64, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x55, 0x73, 0x64, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x75,
+	0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f,
+	0x75, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x76, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x76, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x67, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa,
+	0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32, 0x0e, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xa6, 0x02, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x1a, 0xd1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x70, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64,
+	0x32, 0x0e, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0,
+	0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
 	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14,
-	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7b, 0x0a, 0x18,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
-	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50,
-	0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x1a, 0x0a,
-	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17,
-	0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
-	0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x0a, 0x14, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61,
-	0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd,
-	0x04, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x24,
-	0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x6f, 0x64, 0x61,
-	0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x42, 0x0a, 0x1c,
-	0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1c, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x64, 0x61, 0x79,
-	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f,
-	0x64, 0x61, 0x79, 0x46, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x46, 0x6f, 0x75, 0x72, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x73, 0x70,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x73,
-	0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x64, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x64, 0x74,
-	0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x55, 0x73, 0x64, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x64,
-	0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x73, 0x64, 0x74, 0x12, 0x2c,
-	0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55,
-	0x73, 0x64, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x75, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x75, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x46, 0x69, 0x76, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x4e,
-	0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa6,
-	0x02, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x1a, 0xd1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e,
-	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a,
-	0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22,
-	0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x12, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x30, 0x0a, 0x04, 0x4c, 0x69,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x4d, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0b, 0xfa, 0x42, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0xc1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
+	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x76, 0x0a, 0x0e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0x30, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x30, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3e, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x13, 0x0a, 0x11,
+	0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x75, 0x70, 0x65, 0x72, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69,
 	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x11, 0x0a, 0x0f,
-	0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x7c, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x2b, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3e, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x30, 0x0a,
-	0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x22,
-	0x84, 0x01, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0f,
-	0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x2d, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x75, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
-	0x75, 0x70, 0x65, 0x72, 0x1a, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0xa4, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
-	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3f, 0x0a, 0x08, 0x53, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xdf, 0x01, 0x0a, 0x18, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x74, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x16,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe7, 0x03,
+	0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0xb4, 0x02, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x4f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x46, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x26, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22,
+	0x39, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x1e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xf4, 0x02, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x75,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x6f, 0x77, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x18,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x81, 0x05, 0x0a, 0x16, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xdd, 0x01,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xac, 0x01, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x65, 0x77, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x78, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x30, 0x0a,
+	0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0xe7, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x48, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xe4, 0x01, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x69, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xfa, 0x42, 0x20, 0x72, 0x1e,
+	0x32, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x31, 0x32, 0x7d, 0x28, 0x5c,
+	0x2e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x35, 0x7d, 0x29, 0x3f, 0x24, 0x52, 0x06,
 	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77,
-	0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d,
+	0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xbd, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d,
 	0x69, 0x6e, 0x56, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x73, 0x74, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
 	0x6e, 0x56, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x1a, 0x46, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x6b, 0x64, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6b, 0x6b, 0x64, 0x74, 0x22, 0x15, 0x0a, 0x13,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x58,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x6b, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x6b, 0x6b, 0x64, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x56, 0x69, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xa3, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x76, 0x69, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69,
+	0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb4, 0x01, 0x0a,
+	0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x6b, 0x64, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x6b, 0x64, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x1a, 0x4d, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x6b, 0x64, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa7, 0x01, 0x0a, 0x16,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x75, 0x6e, 0x64, 0x6f, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb3, 0x01,
+	0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a,
 	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x70, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
-	0x35, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56,
-	0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x98, 0x01,
-	0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x6b, 0x64, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x42, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0xe2, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0x73, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65,
+	0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x72, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x19, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x69, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xfa, 0x42, 0x20, 0x72, 0x1e, 0x32, 0x1c, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x31, 0x32, 0x7d, 0x28, 0x5c, 0x2e, 0x5b, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x31, 0x2c, 0x35, 0x7d, 0x29, 0x3f, 0x24, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb7, 0x01,
+	0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
 	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4b, 0x6b, 0x64, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4b, 0x6b, 0x64, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x94, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x37,
-	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x55, 0x6e, 0x64, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0xa0, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72,
-	0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x39, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0xa0, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45,
-	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9e,
-	0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x1a, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x41,
-	0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08,
-	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x9b, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73,
-	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x3e,
-	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x16,
-	0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x0a, 0x22, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
-	0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x22, 0x0a, 0x20,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x34, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
-	0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x1e,
-	0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x93,
-	0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa5, 0x01,
-	0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0xa3, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x47, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x0a, 0x22,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4e, 0x65,
-	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xff, 0x39, 0x0a,
-	0x03, 0x41, 0x70, 0x70, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a,
-	0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84,
-	0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x50, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x22, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xb7, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x50, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x22, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x75, 0x74,
+	0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x24, 0x0a, 0x22, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x72, 0x65, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d, 0x0a, 0x20, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x4b, 0x0a, 0x1e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4d, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x49, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1a, 0x0a, 0x18,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xad, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x40, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x60, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x4a, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x3d, 0x0a, 0x22, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4e, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x4d, 0x0a, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4e, 0x65, 0x77, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x68, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64, 0x61, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x40, 0x0a, 0x13, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x9a, 0x05, 0x0a, 0x0c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x76,
+	0x69, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x56, 0x69, 0x70,
+	0x52, 0x04, 0x76, 0x69, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x63, 0x0a, 0x04,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x45, 0x0a, 0x03, 0x56, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x8e, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05,
+	0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x81, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x75, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x1a, 0xb5, 0x01, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75,
+	0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x1d,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x66, 0x69, 0x78, 0x22,
+	0xad, 0x03, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x65, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x05, 0x64, 0x69, 0x66,
+	0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x1a, 0xf6, 0x01, 0x0a, 0x04, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x74, 0x77, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x77, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54,
+	0x68, 0x72, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x77,
+	0x6f, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x65, 0x22,
+	0x94, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x1d, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x21,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x22, 0x31, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x99, 0x05, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x78, 0x12, 0x3c, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a,
+	0xb0, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a,
+	0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x4d, 0x61, 0x78, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d,
+	0x61, 0x78, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18,
+	0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa6, 0x04, 0x0a, 0x1b, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0xb4, 0x03, 0x0a, 0x08, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x50, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xfa, 0x42, 0x39, 0x72, 0x37, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x09, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x73, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x72, 0x0b,
+	0x52, 0x03, 0x63, 0x73, 0x76, 0x52, 0x04, 0x78, 0x6c, 0x73, 0x78, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x18, 0x64, 0x32, 0x0e, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x18, 0x2d, 0x32,
+	0x09, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x15, 0xfa, 0x42, 0x12, 0x72, 0x10, 0x18, 0x2d, 0x32, 0x0c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x5f, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa,
+	0x42, 0x0f, 0x72, 0x0d, 0x18, 0x2d, 0x32, 0x09, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x2a,
+	0x24, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x32,
+	0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x29, 0x3f, 0x24,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d,
+	0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x29, 0x3f, 0x24, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0x43, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xb7, 0x4b, 0x0a, 0x03, 0x41, 0x70, 0x70,
+	0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
-	0x64, 0x79, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x51, 0x0a, 0x07,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x18,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x5e, 0x0a, 0x0a, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x75, 0x79, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42,
+	0x75, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x62, 0x75, 0x79, 0x12, 0x62, 0x0a, 0x0f, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
+	0x54, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x35, 0x12, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x35, 0x12, 0x54, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x33, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x33, 0x12, 0x54, 0x0a, 0x08, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x34, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
 	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
-	0x62, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x12, 0x54, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x35, 0x12,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x34, 0x12, 0x56, 0x0a, 0x08, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
 	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x35, 0x12, 0x54, 0x0a, 0x08, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x33, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f,
+	0x76, 0x69, 0x70, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x54, 0x0a, 0x08, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x32, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f,
 	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82,
 	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x33, 0x12,
-	0x54, 0x0a, 0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x34, 0x12, 0x13, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x5f, 0x34, 0x12, 0x56, 0x0a, 0x08, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x70, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69,
-	0x70, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x76, 0x69, 0x70, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x54, 0x0a,
-	0x08, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x32, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x5f, 0x32, 0x12, 0x6d, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x69, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a,
-	0x0a, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x65, 0x0a,
-	0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0xca, 0x01, 0x0a, 0x24, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49,
-	0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e,
-	0x64, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x32, 0x12,
+	0x6d, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x69,
+	0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a, 0x0a, 0x4c, 0x6f, 0x63,
+	0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x14, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x65, 0x77, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x32, 0x12, 0x63, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
-	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x82, 0x01, 0x0a,
-	0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
-	0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x58,
-	0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x68, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x73, 0x73, 0x12, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x29, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1c, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x12, 0x71, 0x0a, 0x10, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12,
-	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
-	0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50,
-	0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65,
-	0x12, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65,
-	0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69,
-	0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61,
-	0x69, 0x6c, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x6c, 0x6c, 0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12,
-	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x12, 0x7d, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e,
-	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x63, 0x6b, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x12, 0xca, 0x01, 0x0a, 0x24, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x49, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x40,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x77, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x64, 0x68, 0x62, 0x2f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x12, 0x5c, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x09, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
-	0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62,
-	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x32, 0x12, 0x63, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a,
+	0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68,
+	0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x58, 0x0a, 0x0a, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x61, 0x73,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x12, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x14,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12,
+	0x86, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x19, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x12, 0x69, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6a, 0x6f, 0x62, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x12,
+	0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x6c,
+	0x6c, 0x12, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x7d, 0x0a, 0x13,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x5c, 0x0a, 0x0b, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x12, 0x69, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x75,
+	0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
 	0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73,
 	0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -8918,16 +14386,68 @@ var file_app_app_api_app_proto_rawDesc = []byte{
 	0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x61, 0x69,
 	0x6c, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x6e, 0x65, 0x77, 0x12, 0x76, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
-	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11,
-	0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70,
-	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x64, 0x5f, 0x6e, 0x65, 0x77, 0x12, 0x69, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x75,
+	0x6e, 0x12, 0x88, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1e, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x8a, 0x01, 0x0a,
+	0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x65, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x72, 0x65, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x72, 0x65, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x92, 0x01, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xa5,
+	0x01, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x33, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x26,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x76, 0x0a, 0x11, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x68, 0x62, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70,
+	0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8942,327 +14462,444 @@ func file_app_app_api_app_proto_rawDescGZIP() []byte {
 	return file_app_app_api_app_proto_rawDescData
 }
 
-var file_app_app_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 149)
+var file_app_app_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 203)
 var file_app_app_api_app_proto_goTypes = []interface{}{
 	(*EthAuthorizeRequest)(nil),                         // 0: api.EthAuthorizeRequest
 	(*EthAuthorizeReply)(nil),                           // 1: api.EthAuthorizeReply
 	(*DepositRequest)(nil),                              // 2: api.DepositRequest
 	(*DepositReply)(nil),                                // 3: api.DepositReply
-	(*VipCheckRequest)(nil),                             // 4: api.VipCheckRequest
-	(*VipCheckReply)(nil),                               // 5: api.VipCheckReply
-	(*UserInfoRequest)(nil),                             // 6: api.UserInfoRequest
-	(*UserInfoReply)(nil),                               // 7: api.UserInfoReply
-	(*RewardListRequest)(nil),                           // 8: api.RewardListRequest
-	(*RewardListReply)(nil),                             // 9: api.RewardListReply
-	(*RecommendRewardListRequest)(nil),                  // 10: api.RecommendRewardListRequest
-	(*RecommendRewardListReply)(nil),                    // 11: api.RecommendRewardListReply
-	(*FeeRewardListRequest)(nil),                        // 12: api.FeeRewardListRequest
-	(*FeeRewardListReply)(nil),                          // 13: api.FeeRewardListReply
-	(*WithdrawListRequest)(nil),                         // 14: api.WithdrawListRequest
-	(*WithdrawListReply)(nil),                           // 15: api.WithdrawListReply
-	(*RecommendListRequest)(nil),                        // 16: api.RecommendListRequest
-	(*RecommendListReply)(nil),                          // 17: api.RecommendListReply
-	(*WithdrawRequest)(nil),                             // 18: api.WithdrawRequest
-	(*WithdrawReply)(nil),                               // 19: api.WithdrawReply
-	(*AdminRewardListRequest)(nil),                      // 20: api.AdminRewardListRequest
-	(*AdminRewardListReply)(nil),                        // 21: api.AdminRewardListReply
-	(*AdminTradeListRequest)(nil),                       // 22: api.AdminTradeListRequest
-	(*AdminTradeListReply)(nil),                         // 23: api.AdminTradeListReply
-	(*LockSystemRequest)(nil),                           // 24: api.LockSystemRequest
-	(*LockSystemReply)(nil),                             // 25: api.LockSystemReply
-	(*CheckAdminUserAreaRequest)(nil),                   // 26: api.CheckAdminUserAreaRequest
-	(*CheckAdminUserAreaReply)(nil),                     // 27: api.CheckAdminUserAreaReply
-	(*CheckAndInsertLocationsRecommendUserRequest)(nil), // 28: api.CheckAndInsertLocationsRecommendUserRequest
-	(*CheckAndInsertLocationsRecommendUserReply)(nil),   // 29: api.CheckAndInsertLocationsRecommendUserReply
-	(*AdminUserListRequest)(nil),                        // 30: api.AdminUserListRequest
-	(*AdminUserListReply)(nil),                          // 31: api.AdminUserListReply
-	(*RecordListRequest)(nil),                           // 32: api.RecordListRequest
-	(*RecordListReply)(nil),                             // 33: api.RecordListReply
-	(*AdminLocationListRequest)(nil),                    // 34: api.AdminLocationListRequest
-	(*AdminLocationListReply)(nil),                      // 35: api.AdminLocationListReply
-	(*AdminLocationAllListRequest)(nil),                 // 36: api.AdminLocationAllListRequest
-	(*AdminLocationAllListReply)(nil),                   // 37: api.AdminLocationAllListReply
-	(*AdminWithdrawListRequest)(nil),                    // 38: api.AdminWithdrawListRequest
-	(*AdminWithdrawListReply)(nil),                      // 39: api.AdminWithdrawListReply
-	(*AdminWithdrawRequest)(nil),                        // 40: api.AdminWithdrawRequest
-	(*AdminWithdrawReply)(nil),                          // 41: api.AdminWithdrawReply
-	(*AdminTradeRequest)(nil),                           // 42: api.AdminTradeRequest
-	(*AdminTradeReply)(nil),                             // 43: api.AdminTradeReply
-	(*AdminWithdrawPassRequest)(nil),                    // 44: api.AdminWithdrawPassRequest
-	(*AdminWithdrawPassReply)(nil),                      // 45: api.AdminWithdrawPassReply
-	(*AdminWithdrawEthRequest)(nil),                     // 46: api.AdminWithdrawEthRequest
-	(*AdminWithdrawEthReply)(nil),                       // 47: api.AdminWithdrawEthReply
-	(*AdminFeeRequest)(nil),                             // 48: api.AdminFeeRequest
-	(*AdminFeeReply)(nil),                               // 49: api.AdminFeeReply
-	(*AdminDailyFeeRequest)(nil),                        // 50: api.AdminDailyFeeRequest
-	(*AdminDailyFeeReply)(nil),                          // 51: api.AdminDailyFeeReply
-	(*AdminAllRequest)(nil),                             // 52: api.AdminAllRequest
-	(*AdminAllReply)(nil),                               // 53: api.AdminAllReply
-	(*AdminUserRecommendRequest)(nil),                   // 54: api.AdminUserRecommendRequest
-	(*AdminUserRecommendReply)(nil),                     // 55: api.AdminUserRecommendReply
-	(*AdminMonthRecommendRequest)(nil),                  // 56: api.AdminMonthRecommendRequest
-	(*AdminMonthRecommendReply)(nil),                    // 57: api.AdminMonthRecommendReply
-	(*AdminConfigRequest)(nil),                          // 58: api.AdminConfigRequest
-	(*AdminConfigReply)(nil),                            // 59: api.AdminConfigReply
-	(*AdminListRequest)(nil),                            // 60: api.AdminListRequest
-	(*AdminListReply)(nil),                              // 61: api.AdminListReply
-	(*AuthListRequest)(nil),                             // 62: api.AuthListRequest
-	(*AuthListReply)(nil),                               // 63: api.AuthListReply
-	(*UserAuthListRequest)(nil),                         // 64: api.UserAuthListRequest
-	(*UserAuthListReply)(nil),                           // 65: api.UserAuthListReply
-	(*MyAuthListRequest)(nil),                           // 66: api.MyAuthListRequest
-	(*MyAuthListReply)(nil),                             // 67: api.MyAuthListReply
-	(*AdminConfigUpdateRequest)(nil),                    // 68: api.AdminConfigUpdateRequest
-	(*AdminConfigUpdateReply)(nil),                      // 69: api.AdminConfigUpdateReply
-	(*AdminConfigUpdateListenRequest)(nil),              // 70: api.AdminConfigUpdateListenRequest
-	(*AdminConfigUpdateListenReply)(nil),                // 71: api.AdminConfigUpdateListenReply
-	(*AdminPasswordUpdateRequest)(nil),                  // 72: api.AdminPasswordUpdateRequest
-	(*AdminPasswordUpdateReply)(nil),                    // 73: api.AdminPasswordUpdateReply
-	(*AdminUpdateLocationNewMaxRequest)(nil),            // 74: api.AdminUpdateLocationNewMaxRequest
-	(*AdminUpdateLocationNewMaxReply)(nil),              // 75: api.AdminUpdateLocationNewMaxReply
-	(*AdminVipDeleteRequest)(nil),                       // 76: api.AdminVipDeleteRequest
-	(*AdminVipDeleteReply)(nil),                         // 77: api.AdminVipDeleteReply
-	(*AdminVipUpdateRequest)(nil),                       // 78: api.AdminVipUpdateRequest
-	(*AdminVipUpdateReply)(nil),                         // 79: api.AdminVipUpdateReply
-	(*AdminKkdtUpdateRequest)(nil),                      // 80: api.AdminKkdtUpdateRequest
-	(*AdminKkdtUpdateReply)(nil),                        // 81: api.AdminKkdtUpdateReply
-	(*AdminUndoUpdateRequest)(nil),                      // 82: api.AdminUndoUpdateRequest
-	(*AdminUndoUpdateReply)(nil),                        // 83: api.AdminUndoUpdateReply
-	(*AdminAreaLevelUpdateRequest)(nil),                 // 84: api.AdminAreaLevelUpdateRequest
-	(*AdminAreaLevelUpdateReply)(nil),                   // 85: api.AdminAreaLevelUpdateReply
-	(*AdminLocationInsertRequest)(nil),                  // 86: api.AdminLocationInsertRequest
-	(*AdminLocationInsertReply)(nil),                    // 87: api.AdminLocationInsertReply
-	(*AdminBalanceUpdateRequest)(nil),                   // 88: api.AdminBalanceUpdateRequest
-	(*AdminBalanceUpdateReply)(nil),                     // 89: api.AdminBalanceUpdateReply
-	(*AuthAdminCreateRequest)(nil),                      // 90: api.AuthAdminCreateRequest
-	(*AuthAdminCreateReply)(nil),                        // 91: api.AuthAdminCreateReply
-	(*AuthAdminDeleteRequest)(nil),                      // 92: api.AuthAdminDeleteRequest
-	(*AuthAdminDeleteReply)(nil),                        // 93: api.AuthAdminDeleteReply
-	(*CheckAndInsertRecommendAreaRequest)(nil),          // 94: api.CheckAndInsertRecommendAreaRequest
-	(*CheckAndInsertRecommendAreaReply)(nil),            // 95: api.CheckAndInsertRecommendAreaReply
-	(*AdminDailyRecommendRewardRequest)(nil),            // 96: api.AdminDailyRecommendRewardRequest
-	(*AdminDailyRecommendRewardReply)(nil),              // 97: api.AdminDailyRecommendRewardReply
-	(*AdminDailyBalanceRewardRequest)(nil),              // 98: api.AdminDailyBalanceRewardRequest
-	(*AdminDailyBalanceRewardReply)(nil),                // 99: api.AdminDailyBalanceRewardReply
-	(*AdminLoginRequest)(nil),                           // 100: api.AdminLoginRequest
-	(*AdminLoginReply)(nil),                             // 101: api.AdminLoginReply
-	(*AdminChangePasswordRequest)(nil),                  // 102: api.AdminChangePasswordRequest
-	(*AdminChangePasswordReply)(nil),                    // 103: api.AdminChangePasswordReply
-	(*AdminCreateAccountRequest)(nil),                   // 104: api.AdminCreateAccountRequest
-	(*AdminCreateAccountReply)(nil),                     // 105: api.AdminCreateAccountReply
-	(*AdminDailyLocationRewardRequest)(nil),             // 106: api.AdminDailyLocationRewardRequest
-	(*AdminDailyLocationRewardReply)(nil),               // 107: api.AdminDailyLocationRewardReply
-	(*AdminDailyLocationRewardNewRequest)(nil),          // 108: api.AdminDailyLocationRewardNewRequest
-	(*AdminDailyLocationRewardNewReply)(nil),            // 109: api.AdminDailyLocationRewardNewReply
-	(*TestCreateAccountRequest)(nil),                    // 110: api.TestCreateAccountRequest
-	(*TestCreateAccountReply)(nil),                      // 111: api.TestCreateAccountReply
-	(*EthAuthorizeRequest_SendBody)(nil),                // 112: api.EthAuthorizeRequest.SendBody
-	(*RewardListReply_List)(nil),                        // 113: api.RewardListReply.List
-	(*RecommendRewardListReply_List)(nil),               // 114: api.RecommendRewardListReply.List
-	(*FeeRewardListReply_List)(nil),                     // 115: api.FeeRewardListReply.List
-	(*WithdrawListReply_List)(nil),                      // 116: api.WithdrawListReply.List
-	(*RecommendListReply_List)(nil),                     // 117: api.RecommendListReply.List
-	(*WithdrawRequest_SendBody)(nil),                    // 118: api.WithdrawRequest.SendBody
-	(*AdminRewardListReply_List)(nil),                   // 119: api.AdminRewardListReply.List
-	(*AdminTradeListReply_List)(nil),                    // 120: api.AdminTradeListReply.List
-	(*AdminUserListReply_UserList)(nil),                 // 121: api.AdminUserListReply.UserList
-	(*RecordListReply_LocationList)(nil),                // 122: api.RecordListReply.LocationList
-	(*AdminLocationListReply_LocationList)(nil),         // 123: api.AdminLocationListReply.LocationList
-	(*AdminLocationAllListReply_LocationList)(nil),      // 124: api.AdminLocationAllListReply.LocationList
-	(*AdminWithdrawListReply_List)(nil),                 // 125: api.AdminWithdrawListReply.List
-	(*AdminWithdrawPassRequest_SendBody)(nil),           // 126: api.AdminWithdrawPassRequest.SendBody
-	(*AdminUserRecommendReply_List)(nil),                // 127: api.AdminUserRecommendReply.List
-	(*AdminMonthRecommendReply_List)(nil),               // 128: api.AdminMonthRecommendReply.List
-	(*AdminConfigReply_List)(nil),                       // 129: api.AdminConfigReply.List
-	(*AdminListReply_List)(nil),                         // 130: api.AdminListReply.List
-	(*AuthListReply_List)(nil),                          // 131: api.AuthListReply.List
-	(*UserAuthListReply_List)(nil),                      // 132: api.UserAuthListReply.List
-	(*MyAuthListReply_List)(nil),                        // 133: api.MyAuthListReply.List
-	(*AdminConfigUpdateRequest_SendBody)(nil),           // 134: api.AdminConfigUpdateRequest.SendBody
-	(*AdminPasswordUpdateRequest_SendBody)(nil),         // 135: api.AdminPasswordUpdateRequest.SendBody
-	(*AdminUpdateLocationNewMaxRequest_SendBody)(nil),   // 136: api.AdminUpdateLocationNewMaxRequest.SendBody
-	(*AdminVipDeleteRequest_SendBody)(nil),              // 137: api.AdminVipDeleteRequest.SendBody
-	(*AdminVipUpdateRequest_SendBody)(nil),              // 138: api.AdminVipUpdateRequest.SendBody
-	(*AdminKkdtUpdateRequest_SendBody)(nil),             // 139: api.AdminKkdtUpdateRequest.SendBody
-	(*AdminUndoUpdateRequest_SendBody)(nil),             // 140: api.AdminUndoUpdateRequest.SendBody
-	(*AdminAreaLevelUpdateRequest_SendBody)(nil),        // 141: api.AdminAreaLevelUpdateRequest.SendBody
-	(*AdminLocationInsertRequest_SendBody)(nil),         // 142: api.AdminLocationInsertRequest.SendBody
-	(*AdminBalanceUpdateRequest_SendBody)(nil),          // 143: api.AdminBalanceUpdateRequest.SendBody
-	(*AuthAdminCreateRequest_SendBody)(nil),             // 144: api.AuthAdminCreateRequest.SendBody
-	(*AuthAdminDeleteRequest_SendBody)(nil),             // 145: api.AuthAdminDeleteRequest.SendBody
-	(*AdminLoginRequest_SendBody)(nil),                  // 146: api.AdminLoginRequest.SendBody
-	(*AdminChangePasswordRequest_SendBody)(nil),         // 147: api.AdminChangePasswordRequest.SendBody
-	(*AdminCreateAccountRequest_SendBody)(nil),          // 148: api.AdminCreateAccountRequest.SendBody
+	(*DepositBuyRequest)(nil),                           // 4: api.DepositBuyRequest
+	(*DepositBuyReply)(nil),                             // 5: api.DepositBuyReply
+	(*VipCheckRequest)(nil),                             // 6: api.VipCheckRequest
+	(*VipCheckReply)(nil),                               // 7: api.VipCheckReply
+	(*UserInfoRequest)(nil),                             // 8: api.UserInfoRequest
+	(*UserInfoReply)(nil),                               // 9: api.UserInfoReply
+	(*RewardListRequest)(nil),                           // 10: api.RewardListRequest
+	(*RewardListReply)(nil),                             // 11: api.RewardListReply
+	(*RecommendRewardListRequest)(nil),                  // 12: api.RecommendRewardListRequest
+	(*RecommendRewardListReply)(nil),                    // 13: api.RecommendRewardListReply
+	(*FeeRewardListRequest)(nil),                        // 14: api.FeeRewardListRequest
+	(*FeeRewardListReply)(nil),                          // 15: api.FeeRewardListReply
+	(*WithdrawListRequest)(nil),                         // 16: api.WithdrawListRequest
+	(*WithdrawListReply)(nil),                           // 17: api.WithdrawListReply
+	(*RecommendListRequest)(nil),                        // 18: api.RecommendListRequest
+	(*RecommendListReply)(nil),                          // 19: api.RecommendListReply
+	(*WithdrawRequest)(nil),                             // 20: api.WithdrawRequest
+	(*WithdrawReply)(nil),                               // 21: api.WithdrawReply
+	(*ListFilter)(nil),                                  // 22: api.ListFilter
+	(*AdminRewardListRequest)(nil),                      // 23: api.AdminRewardListRequest
+	(*AdminRewardListReply)(nil),                        // 24: api.AdminRewardListReply
+	(*AdminTradeListRequest)(nil),                       // 25: api.AdminTradeListRequest
+	(*AdminTradeListReply)(nil),                         // 26: api.AdminTradeListReply
+	(*LockSystemRequest)(nil),                           // 27: api.LockSystemRequest
+	(*LockSystemReply)(nil),                             // 28: api.LockSystemReply
+	(*CheckAdminUserAreaRequest)(nil),                   // 29: api.CheckAdminUserAreaRequest
+	(*CheckAdminUserAreaReply)(nil),                     // 30: api.CheckAdminUserAreaReply
+	(*CheckAndInsertLocationsRecommendUserRequest)(nil), // 31: api.CheckAndInsertLocationsRecommendUserRequest
+	(*CheckAndInsertLocationsRecommendUserReply)(nil),   // 32: api.CheckAndInsertLocationsRecommendUserReply
+	(*AdminUserListRequest)(nil),                        // 33: api.AdminUserListRequest
+	(*AdminUserListReply)(nil),                          // 34: api.AdminUserListReply
+	(*RecordListRequest)(nil),                           // 35: api.RecordListRequest
+	(*RecordListReply)(nil),                             // 36: api.RecordListReply
+	(*AdminLocationListRequest)(nil),                    // 37: api.AdminLocationListRequest
+	(*AdminLocationListReply)(nil),                      // 38: api.AdminLocationListReply
+	(*AdminLocationAllListRequest)(nil),                 // 39: api.AdminLocationAllListRequest
+	(*AdminLocationAllListReply)(nil),                   // 40: api.AdminLocationAllListReply
+	(*AdminWithdrawListRequest)(nil),                    // 41: api.AdminWithdrawListRequest
+	(*AdminWithdrawListReply)(nil),                      // 42: api.AdminWithdrawListReply
+	(*AdminWithdrawRequest)(nil),                        // 43: api.AdminWithdrawRequest
+	(*AdminWithdrawReply)(nil),                          // 44: api.AdminWithdrawReply
+	(*AdminTradeRequest)(nil),                           // 45: api.AdminTradeRequest
+	(*AdminTradeReply)(nil),                             // 46: api.AdminTradeReply
+	(*AdminWithdrawPassRequest)(nil),                    // 47: api.AdminWithdrawPassRequest
+	(*AdminWithdrawPassReply)(nil),                      // 48: api.AdminWithdrawPassReply
+	(*AdminWithdrawRejectRequest)(nil),                  // 49: api.AdminWithdrawRejectRequest
+	(*AdminWithdrawRejectReply)(nil),                    // 50: api.AdminWithdrawRejectReply
+	(*AdminWithdrawBatchPassRequest)(nil),               // 51: api.AdminWithdrawBatchPassRequest
+	(*AdminWithdrawBatchPassReply)(nil),                 // 52: api.AdminWithdrawBatchPassReply
+	(*AdminWithdrawReviewListRequest)(nil),              // 53: api.AdminWithdrawReviewListRequest
+	(*AdminWithdrawReviewListReply)(nil),                // 54: api.AdminWithdrawReviewListReply
+	(*AdminWithdrawReviewsRequest)(nil),                 // 55: api.AdminWithdrawReviewsRequest
+	(*AdminWithdrawReviewsReply)(nil),                   // 56: api.AdminWithdrawReviewsReply
+	(*AdminWithdrawEthRequest)(nil),                     // 57: api.AdminWithdrawEthRequest
+	(*AdminWithdrawEthReply)(nil),                       // 58: api.AdminWithdrawEthReply
+	(*AdminFeeRequest)(nil),                             // 59: api.AdminFeeRequest
+	(*AdminFeeReply)(nil),                               // 60: api.AdminFeeReply
+	(*AdminDailyFeeRequest)(nil),                        // 61: api.AdminDailyFeeRequest
+	(*AdminDailyFeeReply)(nil),                          // 62: api.AdminDailyFeeReply
+	(*AdminAllRequest)(nil),                             // 63: api.AdminAllRequest
+	(*AdminAllReply)(nil),                               // 64: api.AdminAllReply
+	(*AdminUserRecommendRequest)(nil),                   // 65: api.AdminUserRecommendRequest
+	(*AdminUserRecommendReply)(nil),                     // 66: api.AdminUserRecommendReply
+	(*AdminMonthRecommendRequest)(nil),                  // 67: api.AdminMonthRecommendRequest
+	(*AdminMonthRecommendReply)(nil),                    // 68: api.AdminMonthRecommendReply
+	(*AdminConfigRequest)(nil),                          // 69: api.AdminConfigRequest
+	(*AdminConfigReply)(nil),                            // 70: api.AdminConfigReply
+	(*AdminListRequest)(nil),                            // 71: api.AdminListRequest
+	(*AdminListReply)(nil),                              // 72: api.AdminListReply
+	(*AuthListRequest)(nil),                             // 73: api.AuthListRequest
+	(*AuthListReply)(nil),                               // 74: api.AuthListReply
+	(*UserAuthListRequest)(nil),                         // 75: api.UserAuthListRequest
+	(*UserAuthListReply)(nil),                           // 76: api.UserAuthListReply
+	(*MyAuthListRequest)(nil),                           // 77: api.MyAuthListRequest
+	(*MyAuthListReply)(nil),                             // 78: api.MyAuthListReply
+	(*AdminConfigUpdateRequest)(nil),                    // 79: api.AdminConfigUpdateRequest
+	(*AdminConfigUpdateReply)(nil),                      // 80: api.AdminConfigUpdateReply
+	(*AdminConfigHistoryRequest)(nil),                   // 81: api.AdminConfigHistoryRequest
+	(*AdminConfigHistoryReply)(nil),                     // 82: api.AdminConfigHistoryReply
+	(*AdminConfigRollbackRequest)(nil),                  // 83: api.AdminConfigRollbackRequest
+	(*AdminConfigRollbackReply)(nil),                    // 84: api.AdminConfigRollbackReply
+	(*AdminConfigUpdateListenRequest)(nil),              // 85: api.AdminConfigUpdateListenRequest
+	(*AdminConfigUpdateListenReply)(nil),                // 86: api.AdminConfigUpdateListenReply
+	(*AdminPriceRevalueRequest)(nil),                    // 87: api.AdminPriceRevalueRequest
+	(*AdminPriceRevalueReply)(nil),                      // 88: api.AdminPriceRevalueReply
+	(*AdminPriceTickRequest)(nil),                       // 89: api.AdminPriceTickRequest
+	(*AdminPriceTickReply)(nil),                         // 90: api.AdminPriceTickReply
+	(*AdminPasswordUpdateRequest)(nil),                  // 91: api.AdminPasswordUpdateRequest
+	(*AdminPasswordUpdateReply)(nil),                    // 92: api.AdminPasswordUpdateReply
+	(*AdminUpdateLocationNewMaxRequest)(nil),            // 93: api.AdminUpdateLocationNewMaxRequest
+	(*AdminUpdateLocationNewMaxReply)(nil),              // 94: api.AdminUpdateLocationNewMaxReply
+	(*AdminVipDeleteRequest)(nil),                       // 95: api.AdminVipDeleteRequest
+	(*AdminVipDeleteReply)(nil),                         // 96: api.AdminVipDeleteReply
+	(*AdminVipUpdateRequest)(nil),                       // 97: api.AdminVipUpdateRequest
+	(*AdminVipUpdateReply)(nil),                         // 98: api.AdminVipUpdateReply
+	(*AdminKkdtUpdateRequest)(nil),                      // 99: api.AdminKkdtUpdateRequest
+	(*AdminKkdtUpdateReply)(nil),                        // 100: api.AdminKkdtUpdateReply
+	(*AdminUndoUpdateRequest)(nil),                      // 101: api.AdminUndoUpdateRequest
+	(*AdminUndoUpdateReply)(nil),                        // 102: api.AdminUndoUpdateReply
+	(*AdminAreaLevelUpdateRequest)(nil),                 // 103: api.AdminAreaLevelUpdateRequest
+	(*AdminAreaLevelUpdateReply)(nil),                   // 104: api.AdminAreaLevelUpdateReply
+	(*AdminLocationInsertRequest)(nil),                  // 105: api.AdminLocationInsertRequest
+	(*AdminLocationInsertReply)(nil),                    // 106: api.AdminLocationInsertReply
+	(*AdminBalanceUpdateRequest)(nil),                   // 107: api.AdminBalanceUpdateRequest
+	(*AdminBalanceUpdateReply)(nil),                     // 108: api.AdminBalanceUpdateReply
+	(*AuthAdminCreateRequest)(nil),                      // 109: api.AuthAdminCreateRequest
+	(*AuthAdminCreateReply)(nil),                        // 110: api.AuthAdminCreateReply
+	(*AuthAdminDeleteRequest)(nil),                      // 111: api.AuthAdminDeleteRequest
+	(*AuthAdminDeleteReply)(nil),                        // 112: api.AuthAdminDeleteReply
+	(*CheckAndInsertRecommendAreaRequest)(nil),          // 113: api.CheckAndInsertRecommendAreaRequest
+	(*CheckAndInsertRecommendAreaReply)(nil),            // 114: api.CheckAndInsertRecommendAreaReply
+	(*AdminDailyRecommendRewardRequest)(nil),            // 115: api.AdminDailyRecommendRewardRequest
+	(*AdminDailyRecommendRewardReply)(nil),              // 116: api.AdminDailyRecommendRewardReply
+	(*AdminDailyBalanceRewardRequest)(nil),              // 117: api.AdminDailyBalanceRewardRequest
+	(*AdminDailyBalanceRewardReply)(nil),                // 118: api.AdminDailyBalanceRewardReply
+	(*AdminLoginRequest)(nil),                           // 119: api.AdminLoginRequest
+	(*AdminLoginReply)(nil),                             // 120: api.AdminLoginReply
+	(*AdminChangePasswordRequest)(nil),                  // 121: api.AdminChangePasswordRequest
+	(*AdminChangePasswordReply)(nil),                    // 122: api.AdminChangePasswordReply
+	(*AdminCreateAccountRequest)(nil),                   // 123: api.AdminCreateAccountRequest
+	(*AdminCreateAccountReply)(nil),                     // 124: api.AdminCreateAccountReply
+	(*AdminDailyLocationRewardRequest)(nil),             // 125: api.AdminDailyLocationRewardRequest
+	(*AdminDailyLocationRewardReply)(nil),               // 126: api.AdminDailyLocationRewardReply
+	(*AdminDailyLocationRewardNewRequest)(nil),          // 127: api.AdminDailyLocationRewardNewRequest
+	(*AdminDailyLocationRewardNewReply)(nil),            // 128: api.AdminDailyLocationRewardNewReply
+	(*AdminRewardRunRequest)(nil),                       // 129: api.AdminRewardRunRequest
+	(*AdminRewardRunReply)(nil),                         // 130: api.AdminRewardRunReply
+	(*RewardReport)(nil),                                // 131: api.RewardReport
+	(*AdminRewardRollbackRequest)(nil),                  // 132: api.AdminRewardRollbackRequest
+	(*AdminRewardRollbackReply)(nil),                    // 133: api.AdminRewardRollbackReply
+	(*RewardRollbackReport)(nil),                        // 134: api.RewardRollbackReport
+	(*AdminLocationTreeCheckRequest)(nil),               // 135: api.AdminLocationTreeCheckRequest
+	(*AdminLocationTreeCheckReply)(nil),                 // 136: api.AdminLocationTreeCheckReply
+	(*LocationProduct)(nil),                             // 137: api.LocationProduct
+	(*AdminLocationProductListRequest)(nil),             // 138: api.AdminLocationProductListRequest
+	(*AdminLocationProductListReply)(nil),               // 139: api.AdminLocationProductListReply
+	(*AdminLocationProductUpdateRequest)(nil),           // 140: api.AdminLocationProductUpdateRequest
+	(*AdminLocationProductUpdateReply)(nil),             // 141: api.AdminLocationProductUpdateReply
+	(*AdminLocationHistoryRequest)(nil),                 // 142: api.AdminLocationHistoryRequest
+	(*AdminLocationHistoryReply)(nil),                   // 143: api.AdminLocationHistoryReply
+	(*TestCreateAccountRequest)(nil),                    // 144: api.TestCreateAccountRequest
+	(*TestCreateAccountReply)(nil),                      // 145: api.TestCreateAccountReply
+	(*AdminExportJobCreateRequest)(nil),                 // 146: api.AdminExportJobCreateRequest
+	(*AdminExportJobCreateReply)(nil),                   // 147: api.AdminExportJobCreateReply
+	(*AdminExportJobRequest)(nil),                       // 148: api.AdminExportJobRequest
+	(*AdminExportJobReply)(nil),                         // 149: api.AdminExportJobReply
+	(*EthAuthorizeRequest_SendBody)(nil),                // 150: api.EthAuthorizeRequest.SendBody
+	(*DepositBuyReply_Item)(nil),                        // 151: api.DepositBuyReply.Item
+	(*RewardListReply_List)(nil),                        // 152: api.RewardListReply.List
+	(*RecommendRewardListReply_List)(nil),               // 153: api.RecommendRewardListReply.List
+	(*FeeRewardListReply_List)(nil),                     // 154: api.FeeRewardListReply.List
+	(*WithdrawListReply_List)(nil),                      // 155: api.WithdrawListReply.List
+	(*RecommendListReply_List)(nil),                     // 156: api.RecommendListReply.List
+	(*WithdrawRequest_SendBody)(nil),                    // 157: api.WithdrawRequest.SendBody
+	(*AdminRewardListReply_List)(nil),                   // 158: api.AdminRewardListReply.List
+	(*AdminTradeListReply_List)(nil),                    // 159: api.AdminTradeListReply.List
+	(*AdminUserListReply_UserList)(nil),                 // 160: api.AdminUserListReply.UserList
+	(*RecordListReply_LocationList)(nil),                // 161: api.RecordListReply.LocationList
+	(*AdminLocationListReply_LocationList)(nil),         // 162: api.AdminLocationListReply.LocationList
+	(*AdminLocationAllListReply_LocationList)(nil),      // 163: api.AdminLocationAllListReply.LocationList
+	(*AdminWithdrawListReply_List)(nil),                 // 164: api.AdminWithdrawListReply.List
+	(*AdminWithdrawPassRequest_SendBody)(nil),           // 165: api.AdminWithdrawPassRequest.SendBody
+	(*AdminWithdrawRejectRequest_SendBody)(nil),         // 166: api.AdminWithdrawRejectRequest.SendBody
+	(*AdminWithdrawBatchPassRequest_SendBody)(nil),      // 167: api.AdminWithdrawBatchPassRequest.SendBody
+	(*AdminWithdrawBatchPassReply_Item)(nil),            // 168: api.AdminWithdrawBatchPassReply.Item
+	(*AdminWithdrawReviewListReply_List)(nil),           // 169: api.AdminWithdrawReviewListReply.List
+	(*AdminWithdrawReviewsReply_List)(nil),              // 170: api.AdminWithdrawReviewsReply.List
+	(*AdminUserRecommendReply_List)(nil),                // 171: api.AdminUserRecommendReply.List
+	(*AdminMonthRecommendReply_List)(nil),               // 172: api.AdminMonthRecommendReply.List
+	(*AdminConfigReply_List)(nil),                       // 173: api.AdminConfigReply.List
+	(*AdminListReply_List)(nil),                         // 174: api.AdminListReply.List
+	(*AuthListReply_List)(nil),                          // 175: api.AuthListReply.List
+	(*UserAuthListReply_List)(nil),                      // 176: api.UserAuthListReply.List
+	(*MyAuthListReply_List)(nil),                        // 177: api.MyAuthListReply.List
+	(*AdminConfigUpdateRequest_SendBody)(nil),           // 178: api.AdminConfigUpdateRequest.SendBody
+	(*AdminConfigHistoryReply_Version)(nil),             // 179: api.AdminConfigHistoryReply.Version
+	(*AdminConfigRollbackRequest_SendBody)(nil),         // 180: api.AdminConfigRollbackRequest.SendBody
+	(*AdminPriceRevalueReply_Item)(nil),                 // 181: api.AdminPriceRevalueReply.Item
+	(*AdminPasswordUpdateRequest_SendBody)(nil),         // 182: api.AdminPasswordUpdateRequest.SendBody
+	(*AdminUpdateLocationNewMaxRequest_SendBody)(nil),   // 183: api.AdminUpdateLocationNewMaxRequest.SendBody
+	(*AdminVipDeleteRequest_SendBody)(nil),              // 184: api.AdminVipDeleteRequest.SendBody
+	(*AdminVipUpdateRequest_SendBody)(nil),              // 185: api.AdminVipUpdateRequest.SendBody
+	(*AdminKkdtUpdateRequest_SendBody)(nil),             // 186: api.AdminKkdtUpdateRequest.SendBody
+	(*AdminUndoUpdateRequest_SendBody)(nil),             // 187: api.AdminUndoUpdateRequest.SendBody
+	(*AdminAreaLevelUpdateRequest_SendBody)(nil),        // 188: api.AdminAreaLevelUpdateRequest.SendBody
+	(*AdminLocationInsertRequest_SendBody)(nil),         // 189: api.AdminLocationInsertRequest.SendBody
+	(*AdminBalanceUpdateRequest_SendBody)(nil),          // 190: api.AdminBalanceUpdateRequest.SendBody
+	(*AuthAdminCreateRequest_SendBody)(nil),             // 191: api.AuthAdminCreateRequest.SendBody
+	(*AuthAdminDeleteRequest_SendBody)(nil),             // 192: api.AuthAdminDeleteRequest.SendBody
+	(*AdminLoginRequest_SendBody)(nil),                  // 193: api.AdminLoginRequest.SendBody
+	(*AdminChangePasswordRequest_SendBody)(nil),         // 194: api.AdminChangePasswordRequest.SendBody
+	(*AdminCreateAccountRequest_SendBody)(nil),          // 195: api.AdminCreateAccountRequest.SendBody
+	(*RewardReport_User)(nil),                           // 196: api.RewardReport.User
+	(*RewardReport_Vip)(nil),                            // 197: api.RewardReport.Vip
+	(*AdminRewardRollbackRequest_SendBody)(nil),         // 198: api.AdminRewardRollbackRequest.SendBody
+	(*RewardRollbackReport_Run)(nil),                    // 199: api.RewardRollbackReport.Run
+	(*AdminLocationTreeCheckReply_Diff)(nil),            // 200: api.AdminLocationTreeCheckReply.Diff
+	(*AdminLocationHistoryReply_Event)(nil),             // 201: api.AdminLocationHistoryReply.Event
+	(*AdminExportJobCreateRequest_SendBody)(nil),        // 202: api.AdminExportJobCreateRequest.SendBody
 }
 var file_app_app_api_app_proto_depIdxs = []int32{
-	112, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
-	113, // 1: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
-	114, // 2: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
-	115, // 3: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
-	116, // 4: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
-	117, // 5: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
-	118, // 6: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
-	119, // 7: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
-	120, // 8: api.AdminTradeListReply.trades:type_name -> api.AdminTradeListReply.List
-	121, // 9: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
-	122, // 10: api.RecordListReply.locations:type_name -> api.RecordListReply.LocationList
-	123, // 11: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
-	124, // 12: api.AdminLocationAllListReply.locations:type_name -> api.AdminLocationAllListReply.LocationList
-	125, // 13: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
-	126, // 14: api.AdminWithdrawPassRequest.send_body:type_name -> api.AdminWithdrawPassRequest.SendBody
-	127, // 15: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
-	128, // 16: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
-	129, // 17: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
-	130, // 18: api.AdminListReply.account:type_name -> api.AdminListReply.List
-	131, // 19: api.AuthListReply.auth:type_name -> api.AuthListReply.List
-	132, // 20: api.UserAuthListReply.auth:type_name -> api.UserAuthListReply.List
-	133, // 21: api.MyAuthListReply.auth:type_name -> api.MyAuthListReply.List
-	134, // 22: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
-	135, // 23: api.AdminPasswordUpdateRequest.send_body:type_name -> api.AdminPasswordUpdateRequest.SendBody
-	136, // 24: api.AdminUpdateLocationNewMaxRequest.send_body:type_name -> api.AdminUpdateLocationNewMaxRequest.SendBody
-	137, // 25: api.AdminVipDeleteRequest.send_body:type_name -> api.AdminVipDeleteRequest.SendBody
-	138, // 26: api.AdminVipUpdateRequest.send_body:type_name -> api.AdminVipUpdateRequest.SendBody
-	139, // 27: api.AdminKkdtUpdateRequest.send_body:type_name -> api.AdminKkdtUpdateRequest.SendBody
-	140, // 28: api.AdminUndoUpdateRequest.send_body:type_name -> api.AdminUndoUpdateRequest.SendBody
-	141, // 29: api.AdminAreaLevelUpdateRequest.send_body:type_name -> api.AdminAreaLevelUpdateRequest.SendBody
-	142, // 30: api.AdminLocationInsertRequest.send_body:type_name -> api.AdminLocationInsertRequest.SendBody
-	143, // 31: api.AdminBalanceUpdateRequest.send_body:type_name -> api.AdminBalanceUpdateRequest.SendBody
-	144, // 32: api.AuthAdminCreateRequest.send_body:type_name -> api.AuthAdminCreateRequest.SendBody
-	145, // 33: api.AuthAdminDeleteRequest.send_body:type_name -> api.AuthAdminDeleteRequest.SendBody
-	146, // 34: api.AdminLoginRequest.send_body:type_name -> api.AdminLoginRequest.SendBody
-	147, // 35: api.AdminChangePasswordRequest.send_body:type_name -> api.AdminChangePasswordRequest.SendBody
-	148, // 36: api.AdminCreateAccountRequest.send_body:type_name -> api.AdminCreateAccountRequest.SendBody
-	6,   // 37: api.App.UserInfo:input_type -> api.UserInfoRequest
-	8,   // 38: api.App.RewardList:input_type -> api.RewardListRequest
-	10,  // 39: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
-	12,  // 40: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
-	14,  // 41: api.App.WithdrawList:input_type -> api.WithdrawListRequest
-	16,  // 42: api.App.RecommendList:input_type -> api.RecommendListRequest
-	18,  // 43: api.App.Withdraw:input_type -> api.WithdrawRequest
-	2,   // 44: api.App.Deposit:input_type -> api.DepositRequest
-	2,   // 45: api.App.DepositWithdraw:input_type -> api.DepositRequest
-	2,   // 46: api.App.Deposit5:input_type -> api.DepositRequest
-	2,   // 47: api.App.Deposit3:input_type -> api.DepositRequest
-	2,   // 48: api.App.Deposit4:input_type -> api.DepositRequest
-	4,   // 49: api.App.VipCheck:input_type -> api.VipCheckRequest
-	2,   // 50: api.App.Deposit2:input_type -> api.DepositRequest
-	20,  // 51: api.App.AdminRewardList:input_type -> api.AdminRewardListRequest
-	22,  // 52: api.App.AdminTradeList:input_type -> api.AdminTradeListRequest
-	24,  // 53: api.App.LockSystem:input_type -> api.LockSystemRequest
-	30,  // 54: api.App.AdminUserList:input_type -> api.AdminUserListRequest
-	26,  // 55: api.App.CheckAdminUserArea:input_type -> api.CheckAdminUserAreaRequest
-	28,  // 56: api.App.CheckAndInsertLocationsRecommendUser:input_type -> api.CheckAndInsertLocationsRecommendUserRequest
-	34,  // 57: api.App.AdminLocationList:input_type -> api.AdminLocationListRequest
-	34,  // 58: api.App.AdminLocationListNew:input_type -> api.AdminLocationListRequest
-	32,  // 59: api.App.AdminRecordList:input_type -> api.RecordListRequest
-	36,  // 60: api.App.AdminLocationAllList:input_type -> api.AdminLocationAllListRequest
-	38,  // 61: api.App.AdminWithdrawList:input_type -> api.AdminWithdrawListRequest
-	40,  // 62: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
-	42,  // 63: api.App.AdminTrade:input_type -> api.AdminTradeRequest
-	44,  // 64: api.App.AdminWithdrawPass:input_type -> api.AdminWithdrawPassRequest
-	46,  // 65: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
-	48,  // 66: api.App.AdminFee:input_type -> api.AdminFeeRequest
-	50,  // 67: api.App.AdminDailyFee:input_type -> api.AdminDailyFeeRequest
-	52,  // 68: api.App.AdminAll:input_type -> api.AdminAllRequest
-	54,  // 69: api.App.AdminUserRecommend:input_type -> api.AdminUserRecommendRequest
-	56,  // 70: api.App.AdminMonthRecommend:input_type -> api.AdminMonthRecommendRequest
-	58,  // 71: api.App.AdminConfig:input_type -> api.AdminConfigRequest
-	68,  // 72: api.App.AdminConfigUpdate:input_type -> api.AdminConfigUpdateRequest
-	70,  // 73: api.App.AdminConfigUpdateListen:input_type -> api.AdminConfigUpdateListenRequest
-	72,  // 74: api.App.AdminUserPasswordUpdate:input_type -> api.AdminPasswordUpdateRequest
-	74,  // 75: api.App.AdminUpdateLocationNewMax:input_type -> api.AdminUpdateLocationNewMaxRequest
-	78,  // 76: api.App.AdminVipUpdate:input_type -> api.AdminVipUpdateRequest
-	76,  // 77: api.App.AdminVipDelete:input_type -> api.AdminVipDeleteRequest
-	80,  // 78: api.App.AdminKkdtUpdate:input_type -> api.AdminKkdtUpdateRequest
-	82,  // 79: api.App.AdminUndoUpdate:input_type -> api.AdminUndoUpdateRequest
-	84,  // 80: api.App.AdminAreaLevelUpdate:input_type -> api.AdminAreaLevelUpdateRequest
-	86,  // 81: api.App.AdminLocationInsert:input_type -> api.AdminLocationInsertRequest
-	88,  // 82: api.App.AdminBalanceUpdate:input_type -> api.AdminBalanceUpdateRequest
-	100, // 83: api.App.AdminLogin:input_type -> api.AdminLoginRequest
-	104, // 84: api.App.AdminCreateAccount:input_type -> api.AdminCreateAccountRequest
-	102, // 85: api.App.AdminChangePassword:input_type -> api.AdminChangePasswordRequest
-	60,  // 86: api.App.AdminList:input_type -> api.AdminListRequest
-	62,  // 87: api.App.AuthList:input_type -> api.AuthListRequest
-	66,  // 88: api.App.MyAuthList:input_type -> api.MyAuthListRequest
-	64,  // 89: api.App.UserAuthList:input_type -> api.UserAuthListRequest
-	90,  // 90: api.App.AuthAdminCreate:input_type -> api.AuthAdminCreateRequest
-	92,  // 91: api.App.AuthAdminDelete:input_type -> api.AuthAdminDeleteRequest
-	94,  // 92: api.App.CheckAndInsertRecommendArea:input_type -> api.CheckAndInsertRecommendAreaRequest
-	96,  // 93: api.App.AdminDailyRecommendReward:input_type -> api.AdminDailyRecommendRewardRequest
-	98,  // 94: api.App.AdminDailyBalanceReward:input_type -> api.AdminDailyBalanceRewardRequest
-	106, // 95: api.App.AdminDailyLocationReward:input_type -> api.AdminDailyLocationRewardRequest
-	106, // 96: api.App.AdminDailyBuyReward:input_type -> api.AdminDailyLocationRewardRequest
-	106, // 97: api.App.AdminDailyAreaReward:input_type -> api.AdminDailyLocationRewardRequest
-	108, // 98: api.App.AdminDailyLocationRewardNew:input_type -> api.AdminDailyLocationRewardNewRequest
-	110, // 99: api.App.TestCreateAccount:input_type -> api.TestCreateAccountRequest
-	7,   // 100: api.App.UserInfo:output_type -> api.UserInfoReply
-	9,   // 101: api.App.RewardList:output_type -> api.RewardListReply
-	11,  // 102: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
-	13,  // 103: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
-	15,  // 104: api.App.WithdrawList:output_type -> api.WithdrawListReply
-	17,  // 105: api.App.RecommendList:output_type -> api.RecommendListReply
-	19,  // 106: api.App.Withdraw:output_type -> api.WithdrawReply
-	3,   // 107: api.App.Deposit:output_type -> api.DepositReply
-	3,   // 108: api.App.DepositWithdraw:output_type -> api.DepositReply
-	3,   // 109: api.App.Deposit5:output_type -> api.DepositReply
-	3,   // 110: api.App.Deposit3:output_type -> api.DepositReply
-	3,   // 111: api.App.Deposit4:output_type -> api.DepositReply
-	5,   // 112: api.App.VipCheck:output_type -> api.VipCheckReply
-	3,   // 113: api.App.Deposit2:output_type -> api.DepositReply
-	21,  // 114: api.App.AdminRewardList:output_type -> api.AdminRewardListReply
-	23,  // 115: api.App.AdminTradeList:output_type -> api.AdminTradeListReply
-	25,  // 116: api.App.LockSystem:output_type -> api.LockSystemReply
-	31,  // 117: api.App.AdminUserList:output_type -> api.AdminUserListReply
-	27,  // 118: api.App.CheckAdminUserArea:output_type -> api.CheckAdminUserAreaReply
-	29,  // 119: api.App.CheckAndInsertLocationsRecommendUser:output_type -> api.CheckAndInsertLocationsRecommendUserReply
-	35,  // 120: api.App.AdminLocationList:output_type -> api.AdminLocationListReply
-	35,  // 121: api.App.AdminLocationListNew:output_type -> api.AdminLocationListReply
-	33,  // 122: api.App.AdminRecordList:output_type -> api.RecordListReply
-	37,  // 123: api.App.AdminLocationAllList:output_type -> api.AdminLocationAllListReply
-	39,  // 124: api.App.AdminWithdrawList:output_type -> api.AdminWithdrawListReply
-	41,  // 125: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
-	43,  // 126: api.App.AdminTrade:output_type -> api.AdminTradeReply
-	45,  // 127: api.App.AdminWithdrawPass:output_type -> api.AdminWithdrawPassReply
-	47,  // 128: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
-	49,  // 129: api.App.AdminFee:output_type -> api.AdminFeeReply
-	51,  // 130: api.App.AdminDailyFee:output_type -> api.AdminDailyFeeReply
-	53,  // 131: api.App.AdminAll:output_type -> api.AdminAllReply
-	55,  // 132: api.App.AdminUserRecommend:output_type -> api.AdminUserRecommendReply
-	57,  // 133: api.App.AdminMonthRecommend:output_type -> api.AdminMonthRecommendReply
-	59,  // 134: api.App.AdminConfig:output_type -> api.AdminConfigReply
-	69,  // 135: api.App.AdminConfigUpdate:output_type -> api.AdminConfigUpdateReply
-	71,  // 136: api.App.AdminConfigUpdateListen:output_type -> api.AdminConfigUpdateListenReply
-	73,  // 137: api.App.AdminUserPasswordUpdate:output_type -> api.AdminPasswordUpdateReply
-	75,  // 138: api.App.AdminUpdateLocationNewMax:output_type -> api.AdminUpdateLocationNewMaxReply
-	79,  // 139: api.App.AdminVipUpdate:output_type -> api.AdminVipUpdateReply
-	77,  // 140: api.App.AdminVipDelete:output_type -> api.AdminVipDeleteReply
-	81,  // 141: api.App.AdminKkdtUpdate:output_type -> api.AdminKkdtUpdateReply
-	83,  // 142: api.App.AdminUndoUpdate:output_type -> api.AdminUndoUpdateReply
-	85,  // 143: api.App.AdminAreaLevelUpdate:output_type -> api.AdminAreaLevelUpdateReply
-	87,  // 144: api.App.AdminLocationInsert:output_type -> api.AdminLocationInsertReply
-	89,  // 145: api.App.AdminBalanceUpdate:output_type -> api.AdminBalanceUpdateReply
-	101, // 146: api.App.AdminLogin:output_type -> api.AdminLoginReply
-	105, // 147: api.App.AdminCreateAccount:output_type -> api.AdminCreateAccountReply
-	103, // 148: api.App.AdminChangePassword:output_type -> api.AdminChangePasswordReply
-	61,  // 149: api.App.AdminList:output_type -> api.AdminListReply
-	63,  // 150: api.App.AuthList:output_type -> api.AuthListReply
-	67,  // 151: api.App.MyAuthList:output_type -> api.MyAuthListReply
-	65,  // 152: api.App.UserAuthList:output_type -> api.UserAuthListReply
-	91,  // 153: api.App.AuthAdminCreate:output_type -> api.AuthAdminCreateReply
-	93,  // 154: api.App.AuthAdminDelete:output_type -> api.AuthAdminDeleteReply
-	95,  // 155: api.App.CheckAndInsertRecommendArea:output_type -> api.CheckAndInsertRecommendAreaReply
-	97,  // 156: api.App.AdminDailyRecommendReward:output_type -> api.AdminDailyRecommendRewardReply
-	99,  // 157: api.App.AdminDailyBalanceReward:output_type -> api.AdminDailyBalanceRewardReply
-	107, // 158: api.App.AdminDailyLocationReward:output_type -> api.AdminDailyLocationRewardReply
-	107, // 159: api.App.AdminDailyBuyReward:output_type -> api.AdminDailyLocationRewardReply
-	107, // 160: api.App.AdminDailyAreaReward:output_type -> api.AdminDailyLocationRewardReply
-	109, // 161: api.App.AdminDailyLocationRewardNew:output_type -> api.AdminDailyLocationRewardNewReply
-	111, // 162: api.App.TestCreateAccount:output_type -> api.TestCreateAccountReply
-	100, // [100:163] is the sub-list for method output_type
-	37,  // [37:100] is the sub-list for method input_type
-	37,  // [37:37] is the sub-list for extension type_name
-	37,  // [37:37] is the sub-list for extension extendee
-	0,   // [0:37] is the sub-list for field type_name
+	150, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
+	151, // 1: api.DepositBuyReply.items:type_name -> api.DepositBuyReply.Item
+	152, // 2: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
+	153, // 3: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
+	154, // 4: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
+	155, // 5: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
+	156, // 6: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
+	157, // 7: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
+	22,  // 8: api.AdminRewardListRequest.filter:type_name -> api.ListFilter
+	158, // 9: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
+	159, // 10: api.AdminTradeListReply.trades:type_name -> api.AdminTradeListReply.List
+	22,  // 11: api.AdminUserListRequest.filter:type_name -> api.ListFilter
+	160, // 12: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
+	161, // 13: api.RecordListReply.locations:type_name -> api.RecordListReply.LocationList
+	22,  // 14: api.AdminLocationListRequest.filter:type_name -> api.ListFilter
+	162, // 15: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
+	163, // 16: api.AdminLocationAllListReply.locations:type_name -> api.AdminLocationAllListReply.LocationList
+	22,  // 17: api.AdminWithdrawListRequest.filter:type_name -> api.ListFilter
+	164, // 18: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
+	165, // 19: api.AdminWithdrawPassRequest.send_body:type_name -> api.AdminWithdrawPassRequest.SendBody
+	166, // 20: api.AdminWithdrawRejectRequest.send_body:type_name -> api.AdminWithdrawRejectRequest.SendBody
+	167, // 21: api.AdminWithdrawBatchPassRequest.send_body:type_name -> api.AdminWithdrawBatchPassRequest.SendBody
+	168, // 22: api.AdminWithdrawBatchPassReply.items:type_name -> api.AdminWithdrawBatchPassReply.Item
+	169, // 23: api.AdminWithdrawReviewListReply.withdraw:type_name -> api.AdminWithdrawReviewListReply.List
+	170, // 24: api.AdminWithdrawReviewsReply.reviews:type_name -> api.AdminWithdrawReviewsReply.List
+	171, // 25: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
+	172, // 26: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
+	173, // 27: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
+	174, // 28: api.AdminListReply.account:type_name -> api.AdminListReply.List
+	175, // 29: api.AuthListReply.auth:type_name -> api.AuthListReply.List
+	176, // 30: api.UserAuthListReply.auth:type_name -> api.UserAuthListReply.List
+	177, // 31: api.MyAuthListReply.auth:type_name -> api.MyAuthListReply.List
+	178, // 32: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
+	179, // 33: api.AdminConfigHistoryReply.versions:type_name -> api.AdminConfigHistoryReply.Version
+	180, // 34: api.AdminConfigRollbackRequest.send_body:type_name -> api.AdminConfigRollbackRequest.SendBody
+	181, // 35: api.AdminPriceRevalueReply.items:type_name -> api.AdminPriceRevalueReply.Item
+	182, // 36: api.AdminPasswordUpdateRequest.send_body:type_name -> api.AdminPasswordUpdateRequest.SendBody
+	183, // 37: api.AdminUpdateLocationNewMaxRequest.send_body:type_name -> api.AdminUpdateLocationNewMaxRequest.SendBody
+	184, // 38: api.AdminVipDeleteRequest.send_body:type_name -> api.AdminVipDeleteRequest.SendBody
+	185, // 39: api.AdminVipUpdateRequest.send_body:type_name -> api.AdminVipUpdateRequest.SendBody
+	186, // 40: api.AdminKkdtUpdateRequest.send_body:type_name -> api.AdminKkdtUpdateRequest.SendBody
+	187, // 41: api.AdminUndoUpdateRequest.send_body:type_name -> api.AdminUndoUpdateRequest.SendBody
+	188, // 42: api.AdminAreaLevelUpdateRequest.send_body:type_name -> api.AdminAreaLevelUpdateRequest.SendBody
+	189, // 43: api.AdminLocationInsertRequest.send_body:type_name -> api.AdminLocationInsertRequest.SendBody
+	190, // 44: api.AdminBalanceUpdateRequest.send_body:type_name -> api.AdminBalanceUpdateRequest.SendBody
+	191, // 45: api.AuthAdminCreateRequest.send_body:type_name -> api.AuthAdminCreateRequest.SendBody
+	192, // 46: api.AuthAdminDeleteRequest.send_body:type_name -> api.AuthAdminDeleteRequest.SendBody
+	131, // 47: api.AdminDailyRecommendRewardReply.report:type_name -> api.RewardReport
+	131, // 48: api.AdminDailyBalanceRewardReply.report:type_name -> api.RewardReport
+	193, // 49: api.AdminLoginRequest.send_body:type_name -> api.AdminLoginRequest.SendBody
+	194, // 50: api.AdminChangePasswordRequest.send_body:type_name -> api.AdminChangePasswordRequest.SendBody
+	195, // 51: api.AdminCreateAccountRequest.send_body:type_name -> api.AdminCreateAccountRequest.SendBody
+	131, // 52: api.AdminDailyLocationRewardReply.report:type_name -> api.RewardReport
+	131, // 53: api.AdminDailyLocationRewardNewReply.report:type_name -> api.RewardReport
+	131, // 54: api.AdminRewardRunReply.report:type_name -> api.RewardReport
+	196, // 55: api.RewardReport.users:type_name -> api.RewardReport.User
+	197, // 56: api.RewardReport.vips:type_name -> api.RewardReport.Vip
+	198, // 57: api.AdminRewardRollbackRequest.send_body:type_name -> api.AdminRewardRollbackRequest.SendBody
+	134, // 58: api.AdminRewardRollbackReply.report:type_name -> api.RewardRollbackReport
+	199, // 59: api.RewardRollbackReport.runs:type_name -> api.RewardRollbackReport.Run
+	196, // 60: api.RewardRollbackReport.users:type_name -> api.RewardReport.User
+	200, // 61: api.AdminLocationTreeCheckReply.diffs:type_name -> api.AdminLocationTreeCheckReply.Diff
+	137, // 62: api.AdminLocationProductListReply.products:type_name -> api.LocationProduct
+	137, // 63: api.AdminLocationProductUpdateRequest.send_body:type_name -> api.LocationProduct
+	201, // 64: api.AdminLocationHistoryReply.events:type_name -> api.AdminLocationHistoryReply.Event
+	202, // 65: api.AdminExportJobCreateRequest.send_body:type_name -> api.AdminExportJobCreateRequest.SendBody
+	8,   // 66: api.App.UserInfo:input_type -> api.UserInfoRequest
+	10,  // 67: api.App.RewardList:input_type -> api.RewardListRequest
+	12,  // 68: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
+	14,  // 69: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
+	16,  // 70: api.App.WithdrawList:input_type -> api.WithdrawListRequest
+	18,  // 71: api.App.RecommendList:input_type -> api.RecommendListRequest
+	20,  // 72: api.App.Withdraw:input_type -> api.WithdrawRequest
+	2,   // 73: api.App.Deposit:input_type -> api.DepositRequest
+	4,   // 74: api.App.DepositBuy:input_type -> api.DepositBuyRequest
+	2,   // 75: api.App.DepositWithdraw:input_type -> api.DepositRequest
+	2,   // 76: api.App.Deposit5:input_type -> api.DepositRequest
+	2,   // 77: api.App.Deposit3:input_type -> api.DepositRequest
+	2,   // 78: api.App.Deposit4:input_type -> api.DepositRequest
+	6,   // 79: api.App.VipCheck:input_type -> api.VipCheckRequest
+	2,   // 80: api.App.Deposit2:input_type -> api.DepositRequest
+	23,  // 81: api.App.AdminRewardList:input_type -> api.AdminRewardListRequest
+	25,  // 82: api.App.AdminTradeList:input_type -> api.AdminTradeListRequest
+	27,  // 83: api.App.LockSystem:input_type -> api.LockSystemRequest
+	33,  // 84: api.App.AdminUserList:input_type -> api.AdminUserListRequest
+	29,  // 85: api.App.CheckAdminUserArea:input_type -> api.CheckAdminUserAreaRequest
+	31,  // 86: api.App.CheckAndInsertLocationsRecommendUser:input_type -> api.CheckAndInsertLocationsRecommendUserRequest
+	37,  // 87: api.App.AdminLocationList:input_type -> api.AdminLocationListRequest
+	37,  // 88: api.App.AdminLocationListNew:input_type -> api.AdminLocationListRequest
+	35,  // 89: api.App.AdminRecordList:input_type -> api.RecordListRequest
+	39,  // 90: api.App.AdminLocationAllList:input_type -> api.AdminLocationAllListRequest
+	41,  // 91: api.App.AdminWithdrawList:input_type -> api.AdminWithdrawListRequest
+	43,  // 92: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
+	45,  // 93: api.App.AdminTrade:input_type -> api.AdminTradeRequest
+	47,  // 94: api.App.AdminWithdrawPass:input_type -> api.AdminWithdrawPassRequest
+	49,  // 95: api.App.AdminWithdrawReject:input_type -> api.AdminWithdrawRejectRequest
+	51,  // 96: api.App.AdminWithdrawBatchPass:input_type -> api.AdminWithdrawBatchPassRequest
+	53,  // 97: api.App.AdminWithdrawReviewList:input_type -> api.AdminWithdrawReviewListRequest
+	55,  // 98: api.App.AdminWithdrawReviews:input_type -> api.AdminWithdrawReviewsRequest
+	146, // 99: api.App.AdminExportJobCreate:input_type -> api.AdminExportJobCreateRequest
+	148, // 100: api.App.AdminExportJob:input_type -> api.AdminExportJobRequest
+	57,  // 101: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
+	59,  // 102: api.App.AdminFee:input_type -> api.AdminFeeRequest
+	61,  // 103: api.App.AdminDailyFee:input_type -> api.AdminDailyFeeRequest
+	63,  // 104: api.App.AdminAll:input_type -> api.AdminAllRequest
+	65,  // 105: api.App.AdminUserRecommend:input_type -> api.AdminUserRecommendRequest
+	67,  // 106: api.App.AdminMonthRecommend:input_type -> api.AdminMonthRecommendRequest
+	69,  // 107: api.App.AdminConfig:input_type -> api.AdminConfigRequest
+	79,  // 108: api.App.AdminConfigUpdate:input_type -> api.AdminConfigUpdateRequest
+	81,  // 109: api.App.AdminConfigHistory:input_type -> api.AdminConfigHistoryRequest
+	83,  // 110: api.App.AdminConfigRollback:input_type -> api.AdminConfigRollbackRequest
+	85,  // 111: api.App.AdminConfigUpdateListen:input_type -> api.AdminConfigUpdateListenRequest
+	89,  // 112: api.App.AdminPriceTick:input_type -> api.AdminPriceTickRequest
+	87,  // 113: api.App.AdminPriceRevalue:input_type -> api.AdminPriceRevalueRequest
+	91,  // 114: api.App.AdminUserPasswordUpdate:input_type -> api.AdminPasswordUpdateRequest
+	93,  // 115: api.App.AdminUpdateLocationNewMax:input_type -> api.AdminUpdateLocationNewMaxRequest
+	97,  // 116: api.App.AdminVipUpdate:input_type -> api.AdminVipUpdateRequest
+	95,  // 117: api.App.AdminVipDelete:input_type -> api.AdminVipDeleteRequest
+	99,  // 118: api.App.AdminKkdtUpdate:input_type -> api.AdminKkdtUpdateRequest
+	101, // 119: api.App.AdminUndoUpdate:input_type -> api.AdminUndoUpdateRequest
+	103, // 120: api.App.AdminAreaLevelUpdate:input_type -> api.AdminAreaLevelUpdateRequest
+	105, // 121: api.App.AdminLocationInsert:input_type -> api.AdminLocationInsertRequest
+	107, // 122: api.App.AdminBalanceUpdate:input_type -> api.AdminBalanceUpdateRequest
+	119, // 123: api.App.AdminLogin:input_type -> api.AdminLoginRequest
+	123, // 124: api.App.AdminCreateAccount:input_type -> api.AdminCreateAccountRequest
+	121, // 125: api.App.AdminChangePassword:input_type -> api.AdminChangePasswordRequest
+	71,  // 126: api.App.AdminList:input_type -> api.AdminListRequest
+	73,  // 127: api.App.AuthList:input_type -> api.AuthListRequest
+	77,  // 128: api.App.MyAuthList:input_type -> api.MyAuthListRequest
+	75,  // 129: api.App.UserAuthList:input_type -> api.UserAuthListRequest
+	109, // 130: api.App.AuthAdminCreate:input_type -> api.AuthAdminCreateRequest
+	111, // 131: api.App.AuthAdminDelete:input_type -> api.AuthAdminDeleteRequest
+	113, // 132: api.App.CheckAndInsertRecommendArea:input_type -> api.CheckAndInsertRecommendAreaRequest
+	115, // 133: api.App.AdminDailyRecommendReward:input_type -> api.AdminDailyRecommendRewardRequest
+	117, // 134: api.App.AdminDailyBalanceReward:input_type -> api.AdminDailyBalanceRewardRequest
+	125, // 135: api.App.AdminDailyLocationReward:input_type -> api.AdminDailyLocationRewardRequest
+	125, // 136: api.App.AdminDailyBuyReward:input_type -> api.AdminDailyLocationRewardRequest
+	125, // 137: api.App.AdminDailyAreaReward:input_type -> api.AdminDailyLocationRewardRequest
+	127, // 138: api.App.AdminDailyLocationRewardNew:input_type -> api.AdminDailyLocationRewardNewRequest
+	129, // 139: api.App.AdminRewardRun:input_type -> api.AdminRewardRunRequest
+	132, // 140: api.App.AdminRewardRollback:input_type -> api.AdminRewardRollbackRequest
+	135, // 141: api.App.AdminLocationTreeCheck:input_type -> api.AdminLocationTreeCheckRequest
+	138, // 142: api.App.AdminLocationProductList:input_type -> api.AdminLocationProductListRequest
+	140, // 143: api.App.AdminLocationProductUpdate:input_type -> api.AdminLocationProductUpdateRequest
+	142, // 144: api.App.AdminLocationHistory:input_type -> api.AdminLocationHistoryRequest
+	144, // 145: api.App.TestCreateAccount:input_type -> api.TestCreateAccountRequest
+	9,   // 146: api.App.UserInfo:output_type -> api.UserInfoReply
+	11,  // 147: api.App.RewardList:output_type -> api.RewardListReply
+	13,  // 148: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
+	15,  // 149: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
+	17,  // 150: api.App.WithdrawList:output_type -> api.WithdrawListReply
+	19,  // 151: api.App.RecommendList:output_type -> api.RecommendListReply
+	21,  // 152: api.App.Withdraw:output_type -> api.WithdrawReply
+	3,   // 153: api.App.Deposit:output_type -> api.DepositReply
+	5,   // 154: api.App.DepositBuy:output_type -> api.DepositBuyReply
+	3,   // 155: api.App.DepositWithdraw:output_type -> api.DepositReply
+	3,   // 156: api.App.Deposit5:output_type -> api.DepositReply
+	3,   // 157: api.App.Deposit3:output_type -> api.DepositReply
+	3,   // 158: api.App.Deposit4:output_type -> api.DepositReply
+	7,   // 159: api.App.VipCheck:output_type -> api.VipCheckReply
+	3,   // 160: api.App.Deposit2:output_type -> api.DepositReply
+	24,  // 161: api.App.AdminRewardList:output_type -> api.AdminRewardListReply
+	26,  // 162: api.App.AdminTradeList:output_type -> api.AdminTradeListReply
+	28,  // 163: api.App.LockSystem:output_type -> api.LockSystemReply
+	34,  // 164: api.App.AdminUserList:output_type -> api.AdminUserListReply
+	30,  // 165: api.App.CheckAdminUserArea:output_type -> api.CheckAdminUserAreaReply
+	32,  // 166: api.App.CheckAndInsertLocationsRecommendUser:output_type -> api.CheckAndInsertLocationsRecommendUserReply
+	38,  // 167: api.App.AdminLocationList:output_type -> api.AdminLocationListReply
+	38,  // 168: api.App.AdminLocationListNew:output_type -> api.AdminLocationListReply
+	36,  // 169: api.App.AdminRecordList:output_type -> api.RecordListReply
+	40,  // 170: api.App.AdminLocationAllList:output_type -> api.AdminLocationAllListReply
+	42,  // 171: api.App.AdminWithdrawList:output_type -> api.AdminWithdrawListReply
+	44,  // 172: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
+	46,  // 173: api.App.AdminTrade:output_type -> api.AdminTradeReply
+	48,  // 174: api.App.AdminWithdrawPass:output_type -> api.AdminWithdrawPassReply
+	50,  // 175: api.App.AdminWithdrawReject:output_type -> api.AdminWithdrawRejectReply
+	52,  // 176: api.App.AdminWithdrawBatchPass:output_type -> api.AdminWithdrawBatchPassReply
+	54,  // 177: api.App.AdminWithdrawReviewList:output_type -> api.AdminWithdrawReviewListReply
+	56,  // 178: api.App.AdminWithdrawReviews:output_type -> api.AdminWithdrawReviewsReply
+	147, // 179: api.App.AdminExportJobCreate:output_type -> api.AdminExportJobCreateReply
+	149, // 180: api.App.AdminExportJob:output_type -> api.AdminExportJobReply
+	58,  // 181: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
+	60,  // 182: api.App.AdminFee:output_type -> api.AdminFeeReply
+	62,  // 183: api.App.AdminDailyFee:output_type -> api.AdminDailyFeeReply
+	64,  // 184: api.App.AdminAll:output_type -> api.AdminAllReply
+	66,  // 185: api.App.AdminUserRecommend:output_type -> api.AdminUserRecommendReply
+	68,  // 186: api.App.AdminMonthRecommend:output_type -> api.AdminMonthRecommendReply
+	70,  // 187: api.App.AdminConfig:output_type -> api.AdminConfigReply
+	80,  // 188: api.App.AdminConfigUpdate:output_type -> api.AdminConfigUpdateReply
+	82,  // 189: api.App.AdminConfigHistory:output_type -> api.AdminConfigHistoryReply
+	84,  // 190: api.App.AdminConfigRollback:output_type -> api.AdminConfigRollbackReply
+	86,  // 191: api.App.AdminConfigUpdateListen:output_type -> api.AdminConfigUpdateListenReply
+	90,  // 192: api.App.AdminPriceTick:output_type -> api.AdminPriceTickReply
+	88,  // 193: api.App.AdminPriceRevalue:output_type -> api.AdminPriceRevalueReply
+	92,  // 194: api.App.AdminUserPasswordUpdate:output_type -> api.AdminPasswordUpdateReply
+	94,  // 195: api.App.AdminUpdateLocationNewMax:output_type -> api.AdminUpdateLocationNewMaxReply
+	98,  // 196: api.App.AdminVipUpdate:output_type -> api.AdminVipUpdateReply
+	96,  // 197: api.App.AdminVipDelete:output_type -> api.AdminVipDeleteReply
+	100, // 198: api.App.AdminKkdtUpdate:output_type -> api.AdminKkdtUpdateReply
+	102, // 199: api.App.AdminUndoUpdate:output_type -> api.AdminUndoUpdateReply
+	104, // 200: api.App.AdminAreaLevelUpdate:output_type -> api.AdminAreaLevelUpdateReply
+	106, // 201: api.App.AdminLocationInsert:output_type -> api.AdminLocationInsertReply
+	108, // 202: api.App.AdminBalanceUpdate:output_type -> api.AdminBalanceUpdateReply
+	120, // 203: api.App.AdminLogin:output_type -> api.AdminLoginReply
+	124, // 204: api.App.AdminCreateAccount:output_type -> api.AdminCreateAccountReply
+	122, // 205: api.App.AdminChangePassword:output_type -> api.AdminChangePasswordReply
+	72,  // 206: api.App.AdminList:output_type -> api.AdminListReply
+	74,  // 207: api.App.AuthList:output_type -> api.AuthListReply
+	78,  // 208: api.App.MyAuthList:output_type -> api.MyAuthListReply
+	76,  // 209: api.App.UserAuthList:output_type -> api.UserAuthListReply
+	110, // 210: api.App.AuthAdminCreate:output_type -> api.AuthAdminCreateReply
+	112, // 211: api.App.AuthAdminDelete:output_type -> api.AuthAdminDeleteReply
+	114, // 212: api.App.CheckAndInsertRecommendArea:output_type -> api.CheckAndInsertRecommendAreaReply
+	116, // 213: api.App.AdminDailyRecommendReward:output_type -> api.AdminDailyRecommendRewardReply
+	118, // 214: api.App.AdminDailyBalanceReward:output_type -> api.AdminDailyBalanceRewardReply
+	126, // 215: api.App.AdminDailyLocationReward:output_type -> api.AdminDailyLocationRewardReply
+	126, // 216: api.App.AdminDailyBuyReward:output_type -> api.AdminDailyLocationRewardReply
+	126, // 217: api.App.AdminDailyAreaReward:output_type -> api.AdminDailyLocationRewardReply
+	128, // 218: api.App.AdminDailyLocationRewardNew:output_type -> api.AdminDailyLocationRewardNewReply
+	130, // 219: api.App.AdminRewardRun:output_type -> api.AdminRewardRunReply
+	133, // 220: api.App.AdminRewardRollback:output_type -> api.AdminRewardRollbackReply
+	136, // 221: api.App.AdminLocationTreeCheck:output_type -> api.AdminLocationTreeCheckReply
+	139, // 222: api.App.AdminLocationProductList:output_type -> api.AdminLocationProductListReply
+	141, // 223: api.App.AdminLocationProductUpdate:output_type -> api.AdminLocationProductUpdateReply
+	143, // 224: api.App.AdminLocationHistory:output_type -> api.AdminLocationHistoryReply
+	145, // 225: api.App.TestCreateAccount:output_type -> api.TestCreateAccountReply
+	146, // [146:226] is the sub-list for method output_type
+	66,  // [66:146] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_app_app_api_app_proto_init() }
@@ -9283,8 +14920,644 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeReply); i {
+		file_app_app_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositBuyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositBuyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VipCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VipCheckReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTradeListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTradeListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockSystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockSystemReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminUserAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminUserAreaReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertLocationsRecommendUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertLocationsRecommendUserReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationAllListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationAllListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTradeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawPassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawPassReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBatchPassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBatchPassReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9295,8 +15568,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_app_app_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9307,8 +15580,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositReply); i {
+		file_app_app_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9319,8 +15592,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VipCheckRequest); i {
+		file_app_app_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9331,8 +15604,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VipCheckReply); i {
+		file_app_app_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9343,8 +15616,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoRequest); i {
+		file_app_app_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9355,8 +15628,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply); i {
+		file_app_app_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9367,8 +15640,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9379,8 +15652,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9391,8 +15664,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9403,8 +15676,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9415,8 +15688,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9427,8 +15700,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9439,8 +15712,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListRequest); i {
+		file_app_app_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9451,8 +15724,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply); i {
+		file_app_app_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9463,8 +15736,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListRequest); i {
+		file_app_app_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9475,8 +15748,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply); i {
+		file_app_app_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9487,8 +15760,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+		file_app_app_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9499,8 +15772,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawReply); i {
+		file_app_app_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9511,8 +15784,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListRequest); i {
+		file_app_app_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9523,8 +15796,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply); i {
+		file_app_app_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9535,8 +15808,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminTradeListRequest); i {
+		file_app_app_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9547,8 +15820,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminTradeListReply); i {
+		file_app_app_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9559,8 +15832,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockSystemRequest); i {
+		file_app_app_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyAuthListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9571,8 +15844,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockSystemReply); i {
+		file_app_app_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyAuthListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9583,8 +15856,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAdminUserAreaRequest); i {
+		file_app_app_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9595,8 +15868,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAdminUserAreaReply); i {
+		file_app_app_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9607,8 +15880,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertLocationsRecommendUserRequest); i {
+		file_app_app_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9619,8 +15892,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertLocationsRecommendUserReply); i {
+		file_app_app_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigHistoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9631,8 +15904,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListRequest); i {
+		file_app_app_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRollbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9643,8 +15916,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply); i {
+		file_app_app_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRollbackReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9655,8 +15928,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordListRequest); i {
+		file_app_app_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateListenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9667,8 +15940,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordListReply); i {
+		file_app_app_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateListenReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9679,8 +15952,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListRequest); i {
+		file_app_app_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPriceRevalueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9691,8 +15964,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply); i {
+		file_app_app_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPriceRevalueReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9703,8 +15976,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationAllListRequest); i {
+		file_app_app_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPriceTickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9715,8 +15988,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationAllListReply); i {
+		file_app_app_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPriceTickReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9727,8 +16000,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListRequest); i {
+		file_app_app_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPasswordUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9739,8 +16012,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply); i {
+		file_app_app_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPasswordUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9751,8 +16024,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawRequest); i {
+		file_app_app_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUpdateLocationNewMaxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9763,8 +16036,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawReply); i {
+		file_app_app_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUpdateLocationNewMaxReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9775,8 +16048,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminTradeRequest); i {
+		file_app_app_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9787,8 +16060,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminTradeReply); i {
+		file_app_app_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipDeleteReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9799,8 +16072,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawPassRequest); i {
+		file_app_app_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9811,8 +16084,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawPassReply); i {
+		file_app_app_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9823,8 +16096,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthRequest); i {
+		file_app_app_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminKkdtUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9835,8 +16108,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthReply); i {
+		file_app_app_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminKkdtUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9847,8 +16120,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeRequest); i {
+		file_app_app_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUndoUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9859,8 +16132,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeReply); i {
+		file_app_app_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUndoUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9871,8 +16144,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyFeeRequest); i {
+		file_app_app_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9883,8 +16156,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyFeeReply); i {
+		file_app_app_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9895,8 +16168,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllRequest); i {
+		file_app_app_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationInsertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9907,8 +16180,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllReply); i {
+		file_app_app_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationInsertReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9919,8 +16192,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendRequest); i {
+		file_app_app_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9931,8 +16204,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply); i {
+		file_app_app_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9943,8 +16216,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendRequest); i {
+		file_app_app_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9955,8 +16228,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply); i {
+		file_app_app_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminCreateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9967,8 +16240,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigRequest); i {
+		file_app_app_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9979,8 +16252,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply); i {
+		file_app_app_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminDeleteReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9991,8 +16264,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminListRequest); i {
+		file_app_app_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertRecommendAreaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10003,8 +16276,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminListReply); i {
+		file_app_app_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAndInsertRecommendAreaReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10015,8 +16288,20 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthListRequest); i {
+		file_app_app_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyRecommendRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_app_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyRecommendRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10027,8 +16312,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthListReply); i {
+		file_app_app_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyBalanceRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10039,8 +16324,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthListRequest); i {
+		file_app_app_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyBalanceRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10051,8 +16336,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthListReply); i {
+		file_app_app_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10063,8 +16348,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyAuthListRequest); i {
+		file_app_app_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10075,8 +16360,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyAuthListReply); i {
+		file_app_app_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChangePasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10087,8 +16372,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChangePasswordReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10099,8 +16384,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCreateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10111,8 +16396,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateListenRequest); i {
+		file_app_app_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCreateAccountReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10123,8 +16408,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateListenReply); i {
+		file_app_app_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyLocationRewardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10135,8 +16420,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminPasswordUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyLocationRewardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10147,8 +16432,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminPasswordUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyLocationRewardNewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10159,8 +16444,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUpdateLocationNewMaxRequest); i {
+		file_app_app_api_app_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminDailyLocationRewardNewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10171,8 +16456,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUpdateLocationNewMaxReply); i {
+		file_app_app_api_app_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardRunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10183,8 +16468,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipDeleteRequest); i {
+		file_app_app_api_app_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardRunReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10195,8 +16480,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipDeleteReply); i {
+		file_app_app_api_app_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10207,8 +16492,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardRollbackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10219,8 +16504,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardRollbackReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10231,8 +16516,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminKkdtUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardRollbackReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10243,8 +16528,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminKkdtUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationTreeCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10255,8 +16540,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUndoUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationTreeCheckReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10267,8 +16552,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUndoUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationProduct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10279,8 +16564,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAreaLevelUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationProductListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10291,8 +16576,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAreaLevelUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationProductListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10303,8 +16588,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationInsertRequest); i {
+		file_app_app_api_app_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationProductUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10315,8 +16600,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationInsertReply); i {
+		file_app_app_api_app_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationProductUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10327,8 +16612,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminBalanceUpdateRequest); i {
+		file_app_app_api_app_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10339,8 +16624,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminBalanceUpdateReply); i {
+		file_app_app_api_app_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationHistoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10351,8 +16636,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminCreateRequest); i {
+		file_app_app_api_app_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCreateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10363,8 +16648,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminCreateReply); i {
+		file_app_app_api_app_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCreateAccountReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10375,8 +16660,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminDeleteRequest); i {
+		file_app_app_api_app_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminExportJobCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10387,8 +16672,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminDeleteReply); i {
+		file_app_app_api_app_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminExportJobCreateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10399,8 +16684,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertRecommendAreaRequest); i {
+		file_app_app_api_app_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminExportJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10411,8 +16696,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAndInsertRecommendAreaReply); i {
+		file_app_app_api_app_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminExportJobReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10423,8 +16708,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyRecommendRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10435,8 +16720,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyRecommendRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositBuyReply_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10447,8 +16732,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyBalanceRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10459,8 +16744,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyBalanceRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10471,8 +16756,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLoginRequest); i {
+		file_app_app_api_app_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10483,8 +16768,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLoginReply); i {
+		file_app_app_api_app_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10495,8 +16780,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminChangePasswordRequest); i {
+		file_app_app_api_app_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10507,8 +16792,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminChangePasswordReply); i {
+		file_app_app_api_app_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10519,8 +16804,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCreateAccountRequest); i {
+		file_app_app_api_app_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10531,8 +16816,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCreateAccountReply); i {
+		file_app_app_api_app_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminTradeListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10543,8 +16828,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyLocationRewardRequest); i {
+		file_app_app_api_app_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply_UserList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10555,8 +16840,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyLocationRewardReply); i {
+		file_app_app_api_app_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10567,8 +16852,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyLocationRewardNewRequest); i {
+		file_app_app_api_app_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10579,8 +16864,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminDailyLocationRewardNewReply); i {
+		file_app_app_api_app_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationAllListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10591,8 +16876,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestCreateAccountRequest); i {
+		file_app_app_api_app_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10603,8 +16888,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestCreateAccountReply); i {
+		file_app_app_api_app_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawPassRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10615,8 +16900,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRejectRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10627,8 +16912,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBatchPassRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10639,8 +16924,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawBatchPassReply_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10651,8 +16936,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10663,8 +16948,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReviewsReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10675,8 +16960,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10687,8 +16972,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10699,8 +16984,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10711,8 +16996,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminTradeListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10723,8 +17008,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply_UserList); i {
+		file_app_app_api_app_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10735,8 +17020,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordListReply_LocationList); i {
+		file_app_app_api_app_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10747,8 +17032,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply_LocationList); i {
+		file_app_app_api_app_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MyAuthListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10759,8 +17044,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationAllListReply_LocationList); i {
+		file_app_app_api_app_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10771,8 +17056,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigHistoryReply_Version); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10783,8 +17068,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawPassRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRollbackRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10795,8 +17080,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply_List); i {
+		file_app_app_api_app_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPriceRevalueReply_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10807,8 +17092,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply_List); i {
+		file_app_app_api_app_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPasswordUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10819,8 +17104,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply_List); i {
+		file_app_app_api_app_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUpdateLocationNewMaxRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10831,8 +17116,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipDeleteRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10843,8 +17128,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminVipUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10855,8 +17140,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminKkdtUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10867,8 +17152,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MyAuthListReply_List); i {
+		file_app_app_api_app_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUndoUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10879,8 +17164,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10891,8 +17176,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminPasswordUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationInsertRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10903,8 +17188,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUpdateLocationNewMaxRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminBalanceUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10915,8 +17200,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipDeleteRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10927,8 +17212,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminVipUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthAdminDeleteRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10939,8 +17224,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminKkdtUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLoginRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10951,8 +17236,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUndoUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminChangePasswordRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10963,8 +17248,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAreaLevelUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCreateAccountRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10975,8 +17260,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationInsertRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardReport_User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10987,8 +17272,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminBalanceUpdateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardReport_Vip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10999,8 +17284,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminCreateRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardRollbackRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11011,8 +17296,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthAdminDeleteRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardRollbackReport_Run); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11023,8 +17308,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLoginRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationTreeCheckReply_Diff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11035,8 +17320,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminChangePasswordRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationHistoryReply_Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11047,8 +17332,8 @@ func file_app_app_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_app_app_api_app_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminCreateAccountRequest_SendBody); i {
+		file_app_app_api_app_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminExportJobCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11066,7 +17351,7 @@ func file_app_app_api_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_app_api_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   149,
+			NumMessages:   203,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,15 +36,15 @@ var (
 )
 
 // Validate checks the field values on EthAuthorizeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *EthAuthorizeRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on EthAuthorizeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // EthAuthorizeRequestMultiError, or nil if none found.
 func (m *EthAuthorizeRequest) ValidateAll() error {
 	return m.validate(true)
@@ -57,6 +57,17 @@ func (m *EthAuthorizeRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := EthAuthorizeRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
@@ -469,6 +480,250 @@ var _ interface {
 	ErrorName() string
 } = DepositReplyValidationError{}
 
+// Validate checks the field values on DepositBuyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DepositBuyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositBuyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DepositBuyRequestMultiError, or nil if none found.
+func (m *DepositBuyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositBuyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DepositBuyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositBuyRequestMultiError is an error wrapping multiple validation errors
+// returned by DepositBuyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DepositBuyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositBuyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositBuyRequestMultiError) AllErrors() []error { return m }
+
+// DepositBuyRequestValidationError is the validation error returned by
+// DepositBuyRequest.Validate if the designated constraints aren't met.
+type DepositBuyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositBuyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositBuyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositBuyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositBuyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositBuyRequestValidationError) ErrorName() string {
+	return "DepositBuyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DepositBuyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositBuyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositBuyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositBuyRequestValidationError{}
+
+// Validate checks the field values on DepositBuyReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DepositBuyReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DepositBuyReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DepositBuyReplyMultiError, or nil if none found.
+func (m *DepositBuyReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DepositBuyReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Contract
+
+	// no validation rules for Length
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DepositBuyReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DepositBuyReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DepositBuyReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DepositBuyReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositBuyReplyMultiError is an error wrapping multiple validation errors
+// returned by DepositBuyReply.ValidateAll() if the designated constraints
+// aren't met.
+type DepositBuyReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositBuyReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositBuyReplyMultiError) AllErrors() []error { return m }
+
+// DepositBuyReplyValidationError is the validation error returned by
+// DepositBuyReply.Validate if the designated constraints aren't met.
+type DepositBuyReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositBuyReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositBuyReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositBuyReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositBuyReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositBuyReplyValidationError) ErrorName() string { return "DepositBuyReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepositBuyReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDepositBuyReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositBuyReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositBuyReplyValidationError{}
+
 // Validate checks the field values on VipCheckRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -931,8 +1186,8 @@ func (m *RewardListRequest) Validate() error {
 }
 
 // ValidateAll checks the field values on RewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // RewardListRequestMultiError, or nil if none found.
 func (m *RewardListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -947,6 +1202,39 @@ func (m *RewardListRequest) validate(all bool) error {
 
 	// no validation rules for Type
 
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := RewardListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 45 {
+		err := RewardListRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RewardListRequest_Reason_Pattern.MatchString(m.GetReason()) {
+		err := RewardListRequestValidationError{
+			field:  "Reason",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return RewardListRequestMultiError(errors)
 	}
@@ -1027,6 +1315,8 @@ var _ interface {
 	ErrorName() string
 } = RewardListRequestValidationError{}
 
+var _RewardListRequest_Reason_Pattern = regexp.MustCompile("^[a-z_]*$")
+
 // Validate checks the field values on RewardListReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1035,8 +1325,8 @@ func (m *RewardListReply) Validate() error {
 }
 
 // ValidateAll checks the field values on RewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // RewardListReplyMultiError, or nil if none found.
 func (m *RewardListReply) ValidateAll() error {
 	return m.validate(true)
@@ -1083,6 +1373,8 @@ func (m *RewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
 		return RewardListReplyMultiError(errors)
 	}
@@ -1183,6 +1475,39 @@ func (m *RecommendRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := RecommendRewardListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 45 {
+		err := RecommendRewardListRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RecommendRewardListRequest_Reason_Pattern.MatchString(m.GetReason()) {
+		err := RecommendRewardListRequestValidationError{
+			field:  "Reason",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return RecommendRewardListRequestMultiError(errors)
 	}
@@ -1208,7 +1533,8 @@ func (m RecommendRewardListRequestMultiError) Error() string {
 func (m RecommendRewardListRequestMultiError) AllErrors() []error { return m }
 
 // RecommendRewardListRequestValidationError is the validation error returned
-// by RecommendRewardListRequest.Validate if the designated constraints aren't met.
+// by RecommendRewardListRequest.Validate if the designated constraints aren't
+// met.
 type RecommendRewardListRequestValidationError struct {
 	field  string
 	reason string
@@ -1263,9 +1589,11 @@ var _ interface {
 	ErrorName() string
 } = RecommendRewardListRequestValidationError{}
 
+var _RecommendRewardListRequest_Reason_Pattern = regexp.MustCompile("^[a-z_]*$")
+
 // Validate checks the field values on RecommendRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *RecommendRewardListReply) Validate() error {
 	return m.validate(false)
 }
@@ -1319,6 +1647,8 @@ func (m *RecommendRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
 		return RecommendRewardListReplyMultiError(errors)
 	}
@@ -1400,15 +1730,15 @@ var _ interface {
 } = RecommendRewardListReplyValidationError{}
 
 // Validate checks the field values on FeeRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *FeeRewardListRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on FeeRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // FeeRewardListRequestMultiError, or nil if none found.
 func (m *FeeRewardListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -1421,6 +1751,39 @@ func (m *FeeRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := FeeRewardListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 45 {
+		err := FeeRewardListRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_FeeRewardListRequest_Reason_Pattern.MatchString(m.GetReason()) {
+		err := FeeRewardListRequestValidationError{
+			field:  "Reason",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return FeeRewardListRequestMultiError(errors)
 	}
@@ -1501,16 +1864,18 @@ var _ interface {
 	ErrorName() string
 } = FeeRewardListRequestValidationError{}
 
+var _FeeRewardListRequest_Reason_Pattern = regexp.MustCompile("^[a-z_]*$")
+
 // Validate checks the field values on FeeRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *FeeRewardListReply) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on FeeRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // FeeRewardListReplyMultiError, or nil if none found.
 func (m *FeeRewardListReply) ValidateAll() error {
 	return m.validate(true)
@@ -1557,6 +1922,8 @@ func (m *FeeRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
 		return FeeRewardListReplyMultiError(errors)
 	}
@@ -1638,15 +2005,15 @@ var _ interface {
 } = FeeRewardListReplyValidationError{}
 
 // Validate checks the field values on WithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *WithdrawListRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on WithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // WithdrawListRequestMultiError, or nil if none found.
 func (m *WithdrawListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -1659,9 +2026,40 @@ func (m *WithdrawListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Type
+	if utf8.RuneCountInString(m.GetType()) > 45 {
+		err := WithdrawListRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if len(errors) > 0 {
+	if !_WithdrawListRequest_Type_Pattern.MatchString(m.GetType()) {
+		err := WithdrawListRequestValidationError{
+			field:  "Type",
+			reason: "value does not match regex pattern \"^[a-z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := WithdrawListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
 		return WithdrawListRequestMultiError(errors)
 	}
 
@@ -1741,6 +2139,8 @@ var _ interface {
 	ErrorName() string
 } = WithdrawListRequestValidationError{}
 
+var _WithdrawListRequest_Type_Pattern = regexp.MustCompile("^[a-z]*$")
+
 // Validate checks the field values on WithdrawListReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1749,8 +2149,8 @@ func (m *WithdrawListReply) Validate() error {
 }
 
 // ValidateAll checks the field values on WithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // WithdrawListReplyMultiError, or nil if none found.
 func (m *WithdrawListReply) ValidateAll() error {
 	return m.validate(true)
@@ -1797,6 +2197,8 @@ func (m *WithdrawListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
 		return WithdrawListReplyMultiError(errors)
 	}
@@ -2125,8 +2527,8 @@ func (m *WithdrawRequest) Validate() error {
 }
 
 // ValidateAll checks the field values on WithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // WithdrawRequestMultiError, or nil if none found.
 func (m *WithdrawRequest) ValidateAll() error {
 	return m.validate(true)
@@ -2139,6 +2541,17 @@ func (m *WithdrawRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := WithdrawRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
@@ -2270,6 +2683,14 @@ func (m *WithdrawReply) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Id
+
+	// no validation rules for Amount
+
+	// no validation rules for Fee
+
+	// no validation rules for RelAmount
+
 	if len(errors) > 0 {
 		return WithdrawReplyMultiError(errors)
 	}
@@ -2278,8 +2699,8 @@ func (m *WithdrawReply) validate(all bool) error {
 }
 
 // WithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
+// returned by WithdrawReply.ValidateAll() if the designated constraints aren't
+// met.
 type WithdrawReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
@@ -2348,16 +2769,249 @@ var _ interface {
 	ErrorName() string
 } = WithdrawReplyValidationError{}
 
+// Validate checks the field values on ListFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ListFilterMultiError, or nil if
+// none found.
+func (m *ListFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ListFilter_Start_Pattern.MatchString(m.GetStart()) {
+		err := ListFilterValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{2}-[0-9]{2}( [0-9]{2}:[0-9]{2}:[0-9]{2})?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListFilter_End_Pattern.MatchString(m.GetEnd()) {
+		err := ListFilterValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{2}-[0-9]{2}( [0-9]{2}:[0-9]{2}:[0-9]{2})?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetWithinHours(); val < 0 || val > 8760 {
+		err := ListFilterValidationError{
+			field:  "WithinHours",
+			reason: "value must be inside range [0, 8760]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetStatus()) > 10 {
+		err := ListFilterValidationError{
+			field:  "Status",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListFilter_AmountMin_Pattern.MatchString(m.GetAmountMin()) {
+		err := ListFilterValidationError{
+			field:  "AmountMin",
+			reason: "value does not match regex pattern \"^([0-9]{1,12}(\\\\.[0-9]{1,5})?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListFilter_AmountMax_Pattern.MatchString(m.GetAmountMax()) {
+		err := ListFilterValidationError{
+			field:  "AmountMax",
+			reason: "value does not match regex pattern \"^([0-9]{1,12}(\\\\.[0-9]{1,5})?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetVipMin(); val < 0 || val > 100 {
+		err := ListFilterValidationError{
+			field:  "VipMin",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCoinType()) > 45 {
+		err := ListFilterValidationError{
+			field:  "CoinType",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListFilter_CoinType_Pattern.MatchString(m.GetCoinType()) {
+		err := ListFilterValidationError{
+			field:  "CoinType",
+			reason: "value does not match regex pattern \"^[a-z_0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetType()) > 45 {
+		err := ListFilterValidationError{
+			field:  "Type",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListFilter_Type_Pattern.MatchString(m.GetType()) {
+		err := ListFilterValidationError{
+			field:  "Type",
+			reason: "value does not match regex pattern \"^[a-z_0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFilterMultiError is an error wrapping multiple validation errors
+// returned by ListFilter.ValidateAll() if the designated constraints aren't
+// met.
+type ListFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFilterMultiError) AllErrors() []error { return m }
+
+// ListFilterValidationError is the validation error returned by
+// ListFilter.Validate if the designated constraints aren't met.
+type ListFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFilterValidationError) ErrorName() string { return "ListFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFilterValidationError{}
+
+var _ListFilter_Start_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2}( [0-9]{2}:[0-9]{2}:[0-9]{2})?)?$")
+
+var _ListFilter_End_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2}( [0-9]{2}:[0-9]{2}:[0-9]{2})?)?$")
+
+var _ListFilter_AmountMin_Pattern = regexp.MustCompile("^([0-9]{1,12}(\\.[0-9]{1,5})?)?$")
+
+var _ListFilter_AmountMax_Pattern = regexp.MustCompile("^([0-9]{1,12}(\\.[0-9]{1,5})?)?$")
+
+var _ListFilter_CoinType_Pattern = regexp.MustCompile("^[a-z_0-9]*$")
+
+var _ListFilter_Type_Pattern = regexp.MustCompile("^[a-z_0-9]*$")
+
 // Validate checks the field values on AdminRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminRewardListRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminRewardListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminRewardListRequestMultiError, or nil if none found.
 func (m *AdminRewardListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -2370,12 +3024,92 @@ func (m *AdminRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminRewardListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Address
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminRewardListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminRewardListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminRewardListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Reason
 
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := AdminRewardListRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminRewardListRequest_Cursor_Pattern.MatchString(m.GetCursor()) {
+		err := AdminRewardListRequestValidationError{
+			field:  "Cursor",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminRewardListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminRewardListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminRewardListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AdminRewardListRequestMultiError(errors)
 	}
@@ -2456,16 +3190,20 @@ var _ interface {
 	ErrorName() string
 } = AdminRewardListRequestValidationError{}
 
+var _AdminRewardListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+var _AdminRewardListRequest_Cursor_Pattern = regexp.MustCompile("^[0-9a-zA-Z_-]*$")
+
 // Validate checks the field values on AdminRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminRewardListReply) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminRewardListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminRewardListReplyMultiError, or nil if none found.
 func (m *AdminRewardListReply) ValidateAll() error {
 	return m.validate(true)
@@ -2514,6 +3252,10 @@ func (m *AdminRewardListReply) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
+	// no validation rules for CountApprox
+
 	if len(errors) > 0 {
 		return AdminRewardListReplyMultiError(errors)
 	}
@@ -2595,15 +3337,15 @@ var _ interface {
 } = AdminRewardListReplyValidationError{}
 
 // Validate checks the field values on AdminTradeListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminTradeListRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminTradeListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminTradeListRequestMultiError, or nil if none found.
 func (m *AdminTradeListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -2616,9 +3358,38 @@ func (m *AdminTradeListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminTradeListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminTradeListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Address
+	if !_AdminTradeListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminTradeListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return AdminTradeListRequestMultiError(errors)
@@ -2700,6 +3471,8 @@ var _ interface {
 	ErrorName() string
 } = AdminTradeListRequestValidationError{}
 
+var _AdminTradeListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
 // Validate checks the field values on AdminTradeListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3457,15 +4230,15 @@ var _ interface {
 } = CheckAndInsertLocationsRecommendUserReplyValidationError{}
 
 // Validate checks the field values on AdminUserListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminUserListRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminUserListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminUserListRequestMultiError, or nil if none found.
 func (m *AdminUserListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -3478,34 +4251,114 @@ func (m *AdminUserListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminUserListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Vip
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminUserListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if len(errors) > 0 {
-		return AdminUserListRequestMultiError(errors)
+	if !_AdminUserListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminUserListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	// no validation rules for Vip
 
-// AdminUserListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUserListRequestMultiError []error
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := AdminUserListRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if !_AdminUserListRequest_Cursor_Pattern.MatchString(m.GetCursor()) {
+		err := AdminUserListRequestValidationError{
+			field:  "Cursor",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUserListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUserListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUserListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminUserListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
 func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
 
 // AdminUserListRequestValidationError is the validation error returned by
@@ -3564,16 +4417,20 @@ var _ interface {
 	ErrorName() string
 } = AdminUserListRequestValidationError{}
 
+var _AdminUserListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+var _AdminUserListRequest_Cursor_Pattern = regexp.MustCompile("^[0-9a-zA-Z_-]*$")
+
 // Validate checks the field values on AdminUserListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminUserListReply) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminUserListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminUserListReplyMultiError, or nil if none found.
 func (m *AdminUserListReply) ValidateAll() error {
 	return m.validate(true)
@@ -3622,6 +4479,10 @@ func (m *AdminUserListReply) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
+	// no validation rules for CountApprox
+
 	if len(errors) > 0 {
 		return AdminUserListReplyMultiError(errors)
 	}
@@ -3710,8 +4571,8 @@ func (m *RecordListRequest) Validate() error {
 }
 
 // ValidateAll checks the field values on RecordListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // RecordListRequestMultiError, or nil if none found.
 func (m *RecordListRequest) ValidateAll() error {
 	return m.validate(true)
@@ -3724,9 +4585,38 @@ func (m *RecordListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := RecordListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := RecordListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Address
+	if !_RecordListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := RecordListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return RecordListRequestMultiError(errors)
@@ -3808,6 +4698,8 @@ var _ interface {
 	ErrorName() string
 } = RecordListRequestValidationError{}
 
+var _RecordListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
 // Validate checks the field values on RecordListReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -3945,8 +4837,8 @@ var _ interface {
 } = RecordListReplyValidationError{}
 
 // Validate checks the field values on AdminLocationListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminLocationListRequest) Validate() error {
 	return m.validate(false)
 }
@@ -3966,11 +4858,91 @@ func (m *AdminLocationListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminLocationListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Status
 
-	// no validation rules for Address
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminLocationListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminLocationListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminLocationListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := AdminLocationListRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminLocationListRequest_Cursor_Pattern.MatchString(m.GetCursor()) {
+		err := AdminLocationListRequestValidationError{
+			field:  "Cursor",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLocationListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLocationListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLocationListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return AdminLocationListRequestMultiError(errors)
@@ -4052,16 +5024,20 @@ var _ interface {
 	ErrorName() string
 } = AdminLocationListRequestValidationError{}
 
+var _AdminLocationListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+var _AdminLocationListRequest_Cursor_Pattern = regexp.MustCompile("^[0-9a-zA-Z_-]*$")
+
 // Validate checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminLocationListReply) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminLocationListReplyMultiError, or nil if none found.
 func (m *AdminLocationListReply) ValidateAll() error {
 	return m.validate(true)
@@ -4110,6 +5086,10 @@ func (m *AdminLocationListReply) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
+	// no validation rules for CountApprox
+
 	if len(errors) > 0 {
 		return AdminLocationListReplyMultiError(errors)
 	}
@@ -4212,9 +5192,38 @@ func (m *AdminLocationAllListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminLocationAllListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Address
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminLocationAllListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminLocationAllListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminLocationAllListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return AdminLocationAllListRequestMultiError(errors)
@@ -4241,8 +5250,8 @@ func (m AdminLocationAllListRequestMultiError) Error() string {
 func (m AdminLocationAllListRequestMultiError) AllErrors() []error { return m }
 
 // AdminLocationAllListRequestValidationError is the validation error returned
-// by AdminLocationAllListRequest.Validate if the designated constraints
-// aren't met.
+// by AdminLocationAllListRequest.Validate if the designated constraints aren't
+// met.
 type AdminLocationAllListRequestValidationError struct {
 	field  string
 	reason string
@@ -4297,6 +5306,8 @@ var _ interface {
 	ErrorName() string
 } = AdminLocationAllListRequestValidationError{}
 
+var _AdminLocationAllListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
 // Validate checks the field values on AdminLocationAllListReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -4436,8 +5447,8 @@ var _ interface {
 } = AdminLocationAllListReplyValidationError{}
 
 // Validate checks the field values on AdminWithdrawListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminWithdrawListRequest) Validate() error {
 	return m.validate(false)
 }
@@ -4459,9 +5470,89 @@ func (m *AdminWithdrawListRequest) validate(all bool) error {
 
 	// no validation rules for WithDrawType
 
-	// no validation rules for Page
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminWithdrawListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Address
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminWithdrawListRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminWithdrawListRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminWithdrawListRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := AdminWithdrawListRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminWithdrawListRequest_Cursor_Pattern.MatchString(m.GetCursor()) {
+		err := AdminWithdrawListRequestValidationError{
+			field:  "Cursor",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return AdminWithdrawListRequestMultiError(errors)
@@ -4543,16 +5634,20 @@ var _ interface {
 	ErrorName() string
 } = AdminWithdrawListRequestValidationError{}
 
+var _AdminWithdrawListRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+var _AdminWithdrawListRequest_Cursor_Pattern = regexp.MustCompile("^[0-9a-zA-Z_-]*$")
+
 // Validate checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminWithdrawListReply) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminWithdrawListReplyMultiError, or nil if none found.
 func (m *AdminWithdrawListReply) ValidateAll() error {
 	return m.validate(true)
@@ -4601,6 +5696,10 @@ func (m *AdminWithdrawListReply) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for NextCursor
+
+	// no validation rules for CountApprox
+
 	if len(errors) > 0 {
 		return AdminWithdrawListReplyMultiError(errors)
 	}
@@ -5088,8 +6187,8 @@ var _ interface {
 } = AdminTradeReplyValidationError{}
 
 // Validate checks the field values on AdminWithdrawPassRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminWithdrawPassRequest) Validate() error {
 	return m.validate(false)
 }
@@ -5109,6 +6208,17 @@ func (m *AdminWithdrawPassRequest) validate(all bool) error {
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := AdminWithdrawPassRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
@@ -5219,15 +6329,15 @@ var _ interface {
 } = AdminWithdrawPassRequestValidationError{}
 
 // Validate checks the field values on AdminWithdrawPassReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *AdminWithdrawPassReply) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on AdminWithdrawPassReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // AdminWithdrawPassReplyMultiError, or nil if none found.
 func (m *AdminWithdrawPassReply) ValidateAll() error {
 	return m.validate(true)
@@ -5240,6 +6350,8 @@ func (m *AdminWithdrawPassReply) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
 		return AdminWithdrawPassReplyMultiError(errors)
 	}
@@ -5320,42 +6432,82 @@ var _ interface {
 	ErrorName() string
 } = AdminWithdrawPassReplyValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawRejectRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthRequest) Validate() error {
+func (m *AdminWithdrawRejectRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthRequest with the
+// ValidateAll checks the field values on AdminWithdrawRejectRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthRequestMultiError, or nil if none found.
-func (m *AdminWithdrawEthRequest) ValidateAll() error {
+// AdminWithdrawRejectRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthRequest) validate(all bool) error {
+func (m *AdminWithdrawRejectRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := AdminWithdrawRejectRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRejectRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRejectRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthRequestMultiError(errors)
+		return AdminWithdrawRejectRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawEthRequestMultiError []error
+// AdminWithdrawRejectRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRejectRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawRejectRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthRequestMultiError) Error() string {
+func (m AdminWithdrawRejectRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5364,11 +6516,12 @@ func (m AdminWithdrawEthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRejectRequestMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthRequestValidationError is the validation error returned by
-// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawEthRequestValidationError struct {
+// AdminWithdrawRejectRequestValidationError is the validation error returned
+// by AdminWithdrawRejectRequest.Validate if the designated constraints aren't
+// met.
+type AdminWithdrawRejectRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5376,24 +6529,24 @@ type AdminWithdrawEthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawRejectRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRejectRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRejectRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRejectRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
-	return "AdminWithdrawEthRequestValidationError"
+func (e AdminWithdrawRejectRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthRequestValidationError) Error() string {
+func (e AdminWithdrawRejectRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5405,14 +6558,14 @@ func (e AdminWithdrawEthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		"invalid %sAdminWithdrawRejectRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthRequestValidationError{}
+var _ error = AdminWithdrawRejectRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5420,44 +6573,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthRequestValidationError{}
+} = AdminWithdrawRejectRequestValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthReply) Validate() error {
+// Validate checks the field values on AdminWithdrawRejectReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRejectReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawRejectReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthReplyMultiError, or nil if none found.
-func (m *AdminWithdrawEthReply) ValidateAll() error {
+// AdminWithdrawRejectReplyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthReply) validate(all bool) error {
+func (m *AdminWithdrawRejectReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Status
+
+	// no validation rules for Refund
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthReplyMultiError(errors)
+		return AdminWithdrawRejectReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// AdminWithdrawRejectReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRejectReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawEthReplyMultiError []error
+type AdminWithdrawRejectReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthReplyMultiError) Error() string {
+func (m AdminWithdrawRejectReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5466,11 +6623,11 @@ func (m AdminWithdrawEthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRejectReplyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthReplyValidationError is the validation error returned by
-// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
-type AdminWithdrawEthReplyValidationError struct {
+// AdminWithdrawRejectReplyValidationError is the validation error returned by
+// AdminWithdrawRejectReply.Validate if the designated constraints aren't met.
+type AdminWithdrawRejectReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5478,24 +6635,24 @@ type AdminWithdrawEthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawRejectReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRejectReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRejectReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRejectReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
-	return "AdminWithdrawEthReplyValidationError"
+func (e AdminWithdrawRejectReplyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthReplyValidationError) Error() string {
+func (e AdminWithdrawRejectReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5507,14 +6664,14 @@ func (e AdminWithdrawEthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		"invalid %sAdminWithdrawRejectReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthReplyValidationError{}
+var _ error = AdminWithdrawRejectReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5522,44 +6679,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthReplyValidationError{}
+} = AdminWithdrawRejectReplyValidationError{}
 
-// Validate checks the field values on AdminFeeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeRequest) Validate() error {
+// Validate checks the field values on AdminWithdrawBatchPassRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawBatchPassRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawBatchPassRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminFeeRequestMultiError, or nil if none found.
-func (m *AdminFeeRequest) ValidateAll() error {
+// AdminWithdrawBatchPassRequestMultiError, or nil if none found.
+func (m *AdminWithdrawBatchPassRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeRequest) validate(all bool) error {
+func (m *AdminWithdrawBatchPassRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := AdminWithdrawBatchPassRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawBatchPassRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawBatchPassRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawBatchPassRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminFeeRequestMultiError(errors)
+		return AdminWithdrawBatchPassRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeRequestMultiError []error
+// AdminWithdrawBatchPassRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawBatchPassRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawBatchPassRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeRequestMultiError) Error() string {
+func (m AdminWithdrawBatchPassRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5568,11 +6765,12 @@ func (m AdminFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawBatchPassRequestMultiError) AllErrors() []error { return m }
 
-// AdminFeeRequestValidationError is the validation error returned by
-// AdminFeeRequest.Validate if the designated constraints aren't met.
-type AdminFeeRequestValidationError struct {
+// AdminWithdrawBatchPassRequestValidationError is the validation error
+// returned by AdminWithdrawBatchPassRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawBatchPassRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5580,22 +6778,24 @@ type AdminFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawBatchPassRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawBatchPassRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawBatchPassRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawBatchPassRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+func (e AdminWithdrawBatchPassRequestValidationError) ErrorName() string {
+	return "AdminWithdrawBatchPassRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeRequestValidationError) Error() string {
+func (e AdminWithdrawBatchPassRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5607,14 +6807,14 @@ func (e AdminFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeRequest.%s: %s%s",
+		"invalid %sAdminWithdrawBatchPassRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeRequestValidationError{}
+var _ error = AdminWithdrawBatchPassRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5622,44 +6822,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeRequestValidationError{}
+} = AdminWithdrawBatchPassRequestValidationError{}
 
-// Validate checks the field values on AdminFeeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeReply) Validate() error {
+// Validate checks the field values on AdminWithdrawBatchPassReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawBatchPassReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
-// nil if none found.
-func (m *AdminFeeReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawBatchPassReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawBatchPassReplyMultiError, or nil if none found.
+func (m *AdminWithdrawBatchPassReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeReply) validate(all bool) error {
+func (m *AdminWithdrawBatchPassReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawBatchPassReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawBatchPassReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawBatchPassReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AdminFeeReplyMultiError(errors)
+		return AdminWithdrawBatchPassReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeReplyMultiError []error
+// AdminWithdrawBatchPassReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawBatchPassReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawBatchPassReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeReplyMultiError) Error() string {
+func (m AdminWithdrawBatchPassReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5668,11 +6902,12 @@ func (m AdminFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawBatchPassReplyMultiError) AllErrors() []error { return m }
 
-// AdminFeeReplyValidationError is the validation error returned by
-// AdminFeeReply.Validate if the designated constraints aren't met.
-type AdminFeeReplyValidationError struct {
+// AdminWithdrawBatchPassReplyValidationError is the validation error returned
+// by AdminWithdrawBatchPassReply.Validate if the designated constraints aren't
+// met.
+type AdminWithdrawBatchPassReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5680,22 +6915,24 @@ type AdminFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawBatchPassReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawBatchPassReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawBatchPassReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawBatchPassReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+func (e AdminWithdrawBatchPassReplyValidationError) ErrorName() string {
+	return "AdminWithdrawBatchPassReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeReplyValidationError) Error() string {
+func (e AdminWithdrawBatchPassReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5707,14 +6944,14 @@ func (e AdminFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeReply.%s: %s%s",
+		"invalid %sAdminWithdrawBatchPassReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeReplyValidationError{}
+var _ error = AdminWithdrawBatchPassReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5722,46 +6959,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeReplyValidationError{}
+} = AdminWithdrawBatchPassReplyValidationError{}
 
-// Validate checks the field values on AdminDailyFeeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawReviewListRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyFeeRequest) Validate() error {
+func (m *AdminWithdrawReviewListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyFeeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawReviewListRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminDailyFeeRequestMultiError, or nil if none found.
-func (m *AdminDailyFeeRequest) ValidateAll() error {
+// AdminWithdrawReviewListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawReviewListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyFeeRequest) validate(all bool) error {
+func (m *AdminWithdrawReviewListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Day
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminWithdrawReviewListRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AdminDailyFeeRequestMultiError(errors)
+		return AdminWithdrawReviewListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyFeeRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminDailyFeeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminDailyFeeRequestMultiError []error
+// AdminWithdrawReviewListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawReviewListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyFeeRequestMultiError) Error() string {
+func (m AdminWithdrawReviewListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5770,11 +7016,12 @@ func (m AdminDailyFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyFeeRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewListRequestMultiError) AllErrors() []error { return m }
 
-// AdminDailyFeeRequestValidationError is the validation error returned by
-// AdminDailyFeeRequest.Validate if the designated constraints aren't met.
-type AdminDailyFeeRequestValidationError struct {
+// AdminWithdrawReviewListRequestValidationError is the validation error
+// returned by AdminWithdrawReviewListRequest.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5782,24 +7029,24 @@ type AdminDailyFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyFeeRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyFeeRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyFeeRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyFeeRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyFeeRequestValidationError) ErrorName() string {
-	return "AdminDailyFeeRequestValidationError"
+func (e AdminWithdrawReviewListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawReviewListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyFeeRequestValidationError) Error() string {
+func (e AdminWithdrawReviewListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5811,14 +7058,14 @@ func (e AdminDailyFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyFeeRequest.%s: %s%s",
+		"invalid %sAdminWithdrawReviewListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyFeeRequestValidationError{}
+var _ error = AdminWithdrawReviewListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5826,44 +7073,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyFeeRequestValidationError{}
+} = AdminWithdrawReviewListRequestValidationError{}
 
-// Validate checks the field values on AdminDailyFeeReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawReviewListReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyFeeReply) Validate() error {
+func (m *AdminWithdrawReviewListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyFeeReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawReviewListReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminDailyFeeReplyMultiError, or nil if none found.
-func (m *AdminDailyFeeReply) ValidateAll() error {
+// AdminWithdrawReviewListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyFeeReply) validate(all bool) error {
+func (m *AdminWithdrawReviewListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawReviewListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawReviewListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawReviewListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return AdminDailyFeeReplyMultiError(errors)
+		return AdminWithdrawReviewListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminDailyFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminDailyFeeReplyMultiError []error
+// AdminWithdrawReviewListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawReviewListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyFeeReplyMultiError) Error() string {
+func (m AdminWithdrawReviewListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5872,11 +7155,12 @@ func (m AdminDailyFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyFeeReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewListReplyMultiError) AllErrors() []error { return m }
 
-// AdminDailyFeeReplyValidationError is the validation error returned by
-// AdminDailyFeeReply.Validate if the designated constraints aren't met.
-type AdminDailyFeeReplyValidationError struct {
+// AdminWithdrawReviewListReplyValidationError is the validation error returned
+// by AdminWithdrawReviewListReply.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawReviewListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5884,24 +7168,24 @@ type AdminDailyFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyFeeReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyFeeReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyFeeReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyFeeReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyFeeReplyValidationError) ErrorName() string {
-	return "AdminDailyFeeReplyValidationError"
+func (e AdminWithdrawReviewListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyFeeReplyValidationError) Error() string {
+func (e AdminWithdrawReviewListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5913,14 +7197,14 @@ func (e AdminDailyFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyFeeReply.%s: %s%s",
+		"invalid %sAdminWithdrawReviewListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyFeeReplyValidationError{}
+var _ error = AdminWithdrawReviewListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5928,44 +7212,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyFeeReplyValidationError{}
+} = AdminWithdrawReviewListReplyValidationError{}
 
-// Validate checks the field values on AdminAllRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminAllRequest) Validate() error {
+// Validate checks the field values on AdminWithdrawReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAllRequestMultiError, or nil if none found.
-func (m *AdminAllRequest) ValidateAll() error {
+// AdminWithdrawReviewsRequestMultiError, or nil if none found.
+func (m *AdminWithdrawReviewsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllRequest) validate(all bool) error {
+func (m *AdminWithdrawReviewsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetWithdrawId() <= 0 {
+		err := AdminWithdrawReviewsRequestValidationError{
+			field:  "WithdrawId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return AdminAllRequestMultiError(errors)
+		return AdminWithdrawReviewsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminAllRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllRequestMultiError []error
+// AdminWithdrawReviewsRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawReviewsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllRequestMultiError) Error() string {
+func (m AdminWithdrawReviewsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5974,11 +7269,12 @@ func (m AdminAllRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewsRequestMultiError) AllErrors() []error { return m }
 
-// AdminAllRequestValidationError is the validation error returned by
-// AdminAllRequest.Validate if the designated constraints aren't met.
-type AdminAllRequestValidationError struct {
+// AdminWithdrawReviewsRequestValidationError is the validation error returned
+// by AdminWithdrawReviewsRequest.Validate if the designated constraints aren't
+// met.
+type AdminWithdrawReviewsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5986,22 +7282,24 @@ type AdminAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+func (e AdminWithdrawReviewsRequestValidationError) ErrorName() string {
+	return "AdminWithdrawReviewsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllRequestValidationError) Error() string {
+func (e AdminWithdrawReviewsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6013,14 +7311,14 @@ func (e AdminAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllRequest.%s: %s%s",
+		"invalid %sAdminWithdrawReviewsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllRequestValidationError{}
+var _ error = AdminWithdrawReviewsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6028,74 +7326,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllRequestValidationError{}
+} = AdminWithdrawReviewsRequestValidationError{}
 
-// Validate checks the field values on AdminAllReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminAllReply) Validate() error {
+// Validate checks the field values on AdminWithdrawReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReviewsReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
-// nil if none found.
-func (m *AdminAllReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawReviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewsReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReviewsReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllReply) validate(all bool) error {
+func (m *AdminWithdrawReviewsReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TotalUser
-
-	// no validation rules for TodayLocation
-
-	// no validation rules for AllLocation
-
-	// no validation rules for TodayLocationReward
-
-	// no validation rules for TodayRecommendReward
-
-	// no validation rules for TodayRecommendLocationReward
-
-	// no validation rules for TodayAreaReward
-
-	// no validation rules for TodayFourReward
-
-	// no validation rules for TotalIsps
-
-	// no validation rules for TotalUsdt
-
-	// no validation rules for TodayWithdrawUsdt
-
-	// no validation rules for TotalWithdrawUsdt
-
-	// no validation rules for TotalFour
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
 
-	// no validation rules for TotalFive
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawReviewsReplyValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawReviewsReplyValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawReviewsReplyValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Total
+	}
 
 	if len(errors) > 0 {
-		return AdminAllReplyMultiError(errors)
+		return AdminWithdrawReviewsReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminAllReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllReplyMultiError []error
+// AdminWithdrawReviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawReviewsReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawReviewsReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllReplyMultiError) Error() string {
+func (m AdminWithdrawReviewsReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6104,11 +7406,11 @@ func (m AdminAllReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewsReplyMultiError) AllErrors() []error { return m }
 
-// AdminAllReplyValidationError is the validation error returned by
-// AdminAllReply.Validate if the designated constraints aren't met.
-type AdminAllReplyValidationError struct {
+// AdminWithdrawReviewsReplyValidationError is the validation error returned by
+// AdminWithdrawReviewsReply.Validate if the designated constraints aren't met.
+type AdminWithdrawReviewsReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6116,22 +7418,24 @@ type AdminAllReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewsReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewsReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewsReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewsReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+func (e AdminWithdrawReviewsReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReviewsReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllReplyValidationError) Error() string {
+func (e AdminWithdrawReviewsReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6143,14 +7447,14 @@ func (e AdminAllReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllReply.%s: %s%s",
+		"invalid %sAdminWithdrawReviewsReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllReplyValidationError{}
+var _ error = AdminWithdrawReviewsReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6158,48 +7462,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllReplyValidationError{}
+} = AdminWithdrawReviewsReplyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendRequest with the rules
+// Validate checks the field values on AdminWithdrawEthRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendRequest) Validate() error {
+func (m *AdminWithdrawEthRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// ValidateAll checks the field values on AdminWithdrawEthRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendRequestMultiError, or nil if none found.
-func (m *AdminUserRecommendRequest) ValidateAll() error {
+// AdminWithdrawEthRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEthRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendRequest) validate(all bool) error {
+func (m *AdminWithdrawEthRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Address
-
 	if len(errors) > 0 {
-		return AdminUserRecommendRequestMultiError(errors)
+		return AdminWithdrawEthRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminUserRecommendRequestMultiError []error
+// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendRequestMultiError) Error() string {
+func (m AdminWithdrawEthRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6208,11 +7508,11 @@ func (m AdminUserRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendRequestValidationError is the validation error returned by
-// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
-type AdminUserRecommendRequestValidationError struct {
+// AdminWithdrawEthRequestValidationError is the validation error returned by
+// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawEthRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6220,24 +7520,24 @@ type AdminUserRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendRequestValidationError) ErrorName() string {
-	return "AdminUserRecommendRequestValidationError"
+func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEthRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendRequestValidationError) Error() string {
+func (e AdminWithdrawEthRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6249,14 +7549,14 @@ func (e AdminUserRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendRequestValidationError{}
+var _ error = AdminWithdrawEthRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6264,78 +7564,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendRequestValidationError{}
+} = AdminWithdrawEthRequestValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply with the rules
+// Validate checks the field values on AdminWithdrawEthReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply) Validate() error {
+func (m *AdminWithdrawEthReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReplyMultiError, or nil if none found.
-func (m *AdminUserRecommendReply) ValidateAll() error {
+// AdminWithdrawEthReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEthReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply) validate(all bool) error {
+func (m *AdminWithdrawEthReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return AdminUserRecommendReplyMultiError(errors)
+		return AdminWithdrawEthReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUserRecommendReplyMultiError []error
+type AdminWithdrawEthReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReplyMultiError) Error() string {
+func (m AdminWithdrawEthReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6344,11 +7610,11 @@ func (m AdminUserRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReplyValidationError is the validation error returned by
-// AdminUserRecommendReply.Validate if the designated constraints aren't met.
-type AdminUserRecommendReplyValidationError struct {
+// AdminWithdrawEthReplyValidationError is the validation error returned by
+// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
+type AdminWithdrawEthReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6356,24 +7622,24 @@ type AdminUserRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReplyValidationError) ErrorName() string {
-	return "AdminUserRecommendReplyValidationError"
+func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEthReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReplyValidationError) Error() string {
+func (e AdminWithdrawEthReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6385,14 +7651,14 @@ func (e AdminUserRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		"invalid %sAdminWithdrawEthReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReplyValidationError{}
+var _ error = AdminWithdrawEthReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6400,48 +7666,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReplyValidationError{}
+} = AdminWithdrawEthReplyValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendRequest) Validate() error {
+// Validate checks the field values on AdminFeeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendRequestMultiError, or nil if none found.
-func (m *AdminMonthRecommendRequest) ValidateAll() error {
+// AdminFeeRequestMultiError, or nil if none found.
+func (m *AdminFeeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendRequest) validate(all bool) error {
+func (m *AdminFeeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Page
-
 	if len(errors) > 0 {
-		return AdminMonthRecommendRequestMultiError(errors)
+		return AdminFeeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminMonthRecommendRequestMultiError []error
+// AdminFeeRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendRequestMultiError) Error() string {
+func (m AdminFeeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6450,11 +7712,11 @@ func (m AdminMonthRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendRequestValidationError is the validation error returned
-// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
-type AdminMonthRecommendRequestValidationError struct {
+// AdminFeeRequestValidationError is the validation error returned by
+// AdminFeeRequest.Validate if the designated constraints aren't met.
+type AdminFeeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6462,24 +7724,22 @@ type AdminMonthRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+func (e AdminFeeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+func (e AdminFeeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
-	return "AdminMonthRecommendRequestValidationError"
-}
+func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendRequestValidationError) Error() string {
+func (e AdminFeeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6491,14 +7751,14 @@ func (e AdminMonthRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		"invalid %sAdminFeeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendRequestValidationError{}
+var _ error = AdminFeeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6506,80 +7766,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendRequestValidationError{}
+} = AdminFeeRequestValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply) Validate() error {
+// Validate checks the field values on AdminFeeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReplyMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminFeeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
+// nil if none found.
+func (m *AdminFeeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply) validate(all bool) error {
+func (m *AdminFeeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminMonthRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
 	if len(errors) > 0 {
-		return AdminMonthRecommendReplyMultiError(errors)
+		return AdminFeeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminMonthRecommendReplyMultiError []error
+// AdminFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReplyMultiError) Error() string {
+func (m AdminFeeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6588,11 +7812,11 @@ func (m AdminMonthRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReplyValidationError is the validation error returned by
-// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
-type AdminMonthRecommendReplyValidationError struct {
+// AdminFeeReplyValidationError is the validation error returned by
+// AdminFeeReply.Validate if the designated constraints aren't met.
+type AdminFeeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6600,24 +7824,22 @@ type AdminMonthRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+func (e AdminFeeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+func (e AdminFeeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
-	return "AdminMonthRecommendReplyValidationError"
-}
+func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReplyValidationError) Error() string {
+func (e AdminFeeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6629,14 +7851,14 @@ func (e AdminMonthRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		"invalid %sAdminFeeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReplyValidationError{}
+var _ error = AdminFeeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6644,48 +7866,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReplyValidationError{}
+} = AdminFeeReplyValidationError{}
 
-// Validate checks the field values on AdminConfigRequest with the rules
+// Validate checks the field values on AdminDailyFeeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigRequest) Validate() error {
+func (m *AdminDailyFeeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigRequest with the rules
+// ValidateAll checks the field values on AdminDailyFeeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigRequestMultiError, or nil if none found.
-func (m *AdminConfigRequest) ValidateAll() error {
+// AdminDailyFeeRequestMultiError, or nil if none found.
+func (m *AdminDailyFeeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigRequest) validate(all bool) error {
+func (m *AdminDailyFeeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Page
+	// no validation rules for Day
 
 	if len(errors) > 0 {
-		return AdminConfigRequestMultiError(errors)
+		return AdminDailyFeeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigRequestMultiError []error
+// AdminDailyFeeRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDailyFeeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDailyFeeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigRequestMultiError) Error() string {
+func (m AdminDailyFeeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6694,11 +7914,11 @@ func (m AdminConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyFeeRequestMultiError) AllErrors() []error { return m }
 
-// AdminConfigRequestValidationError is the validation error returned by
-// AdminConfigRequest.Validate if the designated constraints aren't met.
-type AdminConfigRequestValidationError struct {
+// AdminDailyFeeRequestValidationError is the validation error returned by
+// AdminDailyFeeRequest.Validate if the designated constraints aren't met.
+type AdminDailyFeeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6706,24 +7926,24 @@ type AdminConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigRequestValidationError) Field() string { return e.field }
+func (e AdminDailyFeeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyFeeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyFeeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyFeeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigRequestValidationError) ErrorName() string {
-	return "AdminConfigRequestValidationError"
+func (e AdminDailyFeeRequestValidationError) ErrorName() string {
+	return "AdminDailyFeeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigRequestValidationError) Error() string {
+func (e AdminDailyFeeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6735,14 +7955,14 @@ func (e AdminConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigRequest.%s: %s%s",
+		"invalid %sAdminDailyFeeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigRequestValidationError{}
+var _ error = AdminDailyFeeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6750,80 +7970,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigRequestValidationError{}
+} = AdminDailyFeeRequestValidationError{}
 
-// Validate checks the field values on AdminConfigReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply) Validate() error {
+// Validate checks the field values on AdminDailyFeeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyFeeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply with the rules
+// ValidateAll checks the field values on AdminDailyFeeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigReplyMultiError, or nil if none found.
-func (m *AdminConfigReply) ValidateAll() error {
+// AdminDailyFeeReplyMultiError, or nil if none found.
+func (m *AdminDailyFeeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply) validate(all bool) error {
+func (m *AdminDailyFeeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfig() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminConfigReplyValidationError{
-					field:  fmt.Sprintf("Config[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
-
-	if len(errors) > 0 {
-		return AdminConfigReplyMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return AdminDailyFeeReplyMultiError(errors)
+	}
 
 	return nil
 }
 
-// AdminConfigReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigReply.ValidateAll() if the designated constraints
+// AdminDailyFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminDailyFeeReply.ValidateAll() if the designated constraints
 // aren't met.
-type AdminConfigReplyMultiError []error
+type AdminDailyFeeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReplyMultiError) Error() string {
+func (m AdminDailyFeeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6832,11 +8016,11 @@ func (m AdminConfigReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+func (m AdminDailyFeeReplyMultiError) AllErrors() []error { return m }
 
-// AdminConfigReplyValidationError is the validation error returned by
-// AdminConfigReply.Validate if the designated constraints aren't met.
-type AdminConfigReplyValidationError struct {
+// AdminDailyFeeReplyValidationError is the validation error returned by
+// AdminDailyFeeReply.Validate if the designated constraints aren't met.
+type AdminDailyFeeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6844,22 +8028,24 @@ type AdminConfigReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReplyValidationError) Field() string { return e.field }
+func (e AdminDailyFeeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+func (e AdminDailyFeeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+func (e AdminDailyFeeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+func (e AdminDailyFeeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+func (e AdminDailyFeeReplyValidationError) ErrorName() string {
+	return "AdminDailyFeeReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReplyValidationError) Error() string {
+func (e AdminDailyFeeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6871,14 +8057,14 @@ func (e AdminConfigReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply.%s: %s%s",
+		"invalid %sAdminDailyFeeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReplyValidationError{}
+var _ error = AdminDailyFeeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6886,24 +8072,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReplyValidationError{}
+} = AdminDailyFeeReplyValidationError{}
 
-// Validate checks the field values on AdminListRequest with the rules defined
+// Validate checks the field values on AdminAllRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AdminListRequest) Validate() error {
+func (m *AdminAllRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminListRequest with the rules
+// ValidateAll checks the field values on AdminAllRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminListRequestMultiError, or nil if none found.
-func (m *AdminListRequest) ValidateAll() error {
+// AdminAllRequestMultiError, or nil if none found.
+func (m *AdminAllRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminListRequest) validate(all bool) error {
+func (m *AdminAllRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6911,19 +8097,19 @@ func (m *AdminListRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminListRequestMultiError(errors)
+		return AdminAllRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminListRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminListRequest.ValidateAll() if the designated constraints
+// AdminAllRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminAllRequest.ValidateAll() if the designated constraints
 // aren't met.
-type AdminListRequestMultiError []error
+type AdminAllRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminListRequestMultiError) Error() string {
+func (m AdminAllRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6932,11 +8118,11 @@ func (m AdminListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminListRequestMultiError) AllErrors() []error { return m }
+func (m AdminAllRequestMultiError) AllErrors() []error { return m }
 
-// AdminListRequestValidationError is the validation error returned by
-// AdminListRequest.Validate if the designated constraints aren't met.
-type AdminListRequestValidationError struct {
+// AdminAllRequestValidationError is the validation error returned by
+// AdminAllRequest.Validate if the designated constraints aren't met.
+type AdminAllRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6944,22 +8130,22 @@ type AdminListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminListRequestValidationError) Field() string { return e.field }
+func (e AdminAllRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminListRequestValidationError) Reason() string { return e.reason }
+func (e AdminAllRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminListRequestValidationError) Cause() error { return e.cause }
+func (e AdminAllRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminListRequestValidationError) Key() bool { return e.key }
+func (e AdminAllRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminListRequestValidationError) ErrorName() string { return "AdminListRequestValidationError" }
+func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminListRequestValidationError) Error() string {
+func (e AdminAllRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6971,14 +8157,14 @@ func (e AdminListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminListRequest.%s: %s%s",
+		"invalid %sAdminAllRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminListRequestValidationError{}
+var _ error = AdminAllRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6986,78 +8172,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminListRequestValidationError{}
+} = AdminAllRequestValidationError{}
 
-// Validate checks the field values on AdminListReply with the rules defined in
+// Validate checks the field values on AdminAllReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AdminListReply) Validate() error {
+func (m *AdminAllReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminListReply with the rules defined
+// ValidateAll checks the field values on AdminAllReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminListReplyMultiError,
-// or nil if none found.
-func (m *AdminListReply) ValidateAll() error {
+// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
+// nil if none found.
+func (m *AdminAllReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminListReply) validate(all bool) error {
+func (m *AdminAllReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAccount() {
-		_, _ = idx, item
+	// no validation rules for TotalUser
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminListReplyValidationError{
-						field:  fmt.Sprintf("Account[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminListReplyValidationError{
-						field:  fmt.Sprintf("Account[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminListReplyValidationError{
-					field:  fmt.Sprintf("Account[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for TodayLocation
 
-	}
+	// no validation rules for AllLocation
+
+	// no validation rules for TodayLocationReward
+
+	// no validation rules for TodayRecommendReward
+
+	// no validation rules for TodayRecommendLocationReward
+
+	// no validation rules for TodayAreaReward
+
+	// no validation rules for TodayFourReward
+
+	// no validation rules for TotalIsps
+
+	// no validation rules for TotalUsdt
+
+	// no validation rules for TodayWithdrawUsdt
+
+	// no validation rules for TotalWithdrawUsdt
+
+	// no validation rules for TotalFour
+
+	// no validation rules for TotalFive
+
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return AdminListReplyMultiError(errors)
+		return AdminAllReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminListReply.ValidateAll() if the designated constraints
+// AdminAllReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminAllReply.ValidateAll() if the designated constraints
 // aren't met.
-type AdminListReplyMultiError []error
+type AdminAllReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminListReplyMultiError) Error() string {
+func (m AdminAllReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7066,11 +8248,11 @@ func (m AdminListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminListReplyMultiError) AllErrors() []error { return m }
+func (m AdminAllReplyMultiError) AllErrors() []error { return m }
 
-// AdminListReplyValidationError is the validation error returned by
-// AdminListReply.Validate if the designated constraints aren't met.
-type AdminListReplyValidationError struct {
+// AdminAllReplyValidationError is the validation error returned by
+// AdminAllReply.Validate if the designated constraints aren't met.
+type AdminAllReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7078,22 +8260,22 @@ type AdminListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminListReplyValidationError) Field() string { return e.field }
+func (e AdminAllReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminListReplyValidationError) Reason() string { return e.reason }
+func (e AdminAllReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminListReplyValidationError) Cause() error { return e.cause }
+func (e AdminAllReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminListReplyValidationError) Key() bool { return e.key }
+func (e AdminAllReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminListReplyValidationError) ErrorName() string { return "AdminListReplyValidationError" }
+func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminListReplyValidationError) Error() string {
+func (e AdminAllReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7105,14 +8287,14 @@ func (e AdminListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminListReply.%s: %s%s",
+		"invalid %sAdminAllReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminListReplyValidationError{}
+var _ error = AdminAllReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7120,44 +8302,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminListReplyValidationError{}
+} = AdminAllReplyValidationError{}
 
-// Validate checks the field values on AuthListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuthListRequest) Validate() error {
+// Validate checks the field values on AdminUserRecommendRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthListRequestMultiError, or nil if none found.
-func (m *AuthListRequest) ValidateAll() error {
+// AdminUserRecommendRequestMultiError, or nil if none found.
+func (m *AdminUserRecommendRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthListRequest) validate(all bool) error {
+func (m *AdminUserRecommendRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for UserId
+
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminUserRecommendRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminUserRecommendRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminUserRecommendRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return AuthListRequestMultiError(errors)
+		return AdminUserRecommendRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthListRequestMultiError is an error wrapping multiple validation errors
-// returned by AuthListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuthListRequestMultiError []error
+// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserRecommendRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthListRequestMultiError) Error() string {
+func (m AdminUserRecommendRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7166,11 +8372,11 @@ func (m AuthListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthListRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
 
-// AuthListRequestValidationError is the validation error returned by
-// AuthListRequest.Validate if the designated constraints aren't met.
-type AuthListRequestValidationError struct {
+// AdminUserRecommendRequestValidationError is the validation error returned by
+// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
+type AdminUserRecommendRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7178,22 +8384,24 @@ type AuthListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthListRequestValidationError) Field() string { return e.field }
+func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthListRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthListRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthListRequestValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthListRequestValidationError) ErrorName() string { return "AuthListRequestValidationError" }
+func (e AdminUserRecommendRequestValidationError) ErrorName() string {
+	return "AdminUserRecommendRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthListRequestValidationError) Error() string {
+func (e AdminUserRecommendRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7205,14 +8413,14 @@ func (e AuthListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthListRequest.%s: %s%s",
+		"invalid %sAdminUserRecommendRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthListRequestValidationError{}
+var _ error = AdminUserRecommendRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7220,47 +8428,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthListRequestValidationError{}
+} = AdminUserRecommendRequestValidationError{}
 
-// Validate checks the field values on AuthListReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthListReply) Validate() error {
+var _AdminUserRecommendRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+// Validate checks the field values on AdminUserRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthListReplyMultiError, or
-// nil if none found.
-func (m *AuthListReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReplyMultiError, or nil if none found.
+func (m *AdminUserRecommendReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthListReply) validate(all bool) error {
+func (m *AdminUserRecommendReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAuth() {
+	for idx, item := range m.GetUsers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7268,8 +8478,8 @@ func (m *AuthListReply) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AuthListReplyValidationError{
-					field:  fmt.Sprintf("Auth[%v]", idx),
+				return AdminUserRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7279,19 +8489,19 @@ func (m *AuthListReply) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AuthListReplyMultiError(errors)
+		return AdminUserRecommendReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthListReplyMultiError is an error wrapping multiple validation errors
-// returned by AuthListReply.ValidateAll() if the designated constraints
-// aren't met.
-type AuthListReplyMultiError []error
+// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserRecommendReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthListReplyMultiError) Error() string {
+func (m AdminUserRecommendReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7300,11 +8510,11 @@ func (m AuthListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthListReplyMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
 
-// AuthListReplyValidationError is the validation error returned by
-// AuthListReply.Validate if the designated constraints aren't met.
-type AuthListReplyValidationError struct {
+// AdminUserRecommendReplyValidationError is the validation error returned by
+// AdminUserRecommendReply.Validate if the designated constraints aren't met.
+type AdminUserRecommendReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7312,22 +8522,24 @@ type AuthListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthListReplyValidationError) Field() string { return e.field }
+func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthListReplyValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthListReplyValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthListReplyValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthListReplyValidationError) ErrorName() string { return "AuthListReplyValidationError" }
+func (e AdminUserRecommendReplyValidationError) ErrorName() string {
+	return "AdminUserRecommendReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthListReplyValidationError) Error() string {
+func (e AdminUserRecommendReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7339,14 +8551,14 @@ func (e AuthListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthListReply.%s: %s%s",
+		"invalid %sAdminUserRecommendReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthListReplyValidationError{}
+var _ error = AdminUserRecommendReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7354,46 +8566,6271 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthListReplyValidationError{}
+} = AdminUserRecommendReplyValidationError{}
 
-// Validate checks the field values on UserAuthListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserAuthListRequest) Validate() error {
+func (m *AdminMonthRecommendRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAuthListRequest with the rules
+// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendRequestMultiError, or nil if none found.
+func (m *AdminMonthRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminMonthRecommendRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminMonthRecommendRequest_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminMonthRecommendRequestValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminMonthRecommendRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMonthRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendRequestValidationError is the validation error returned
+// by AdminMonthRecommendRequest.Validate if the designated constraints aren't
+// met.
+type AdminMonthRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
+	return "AdminMonthRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendRequestValidationError{}
+
+var _AdminMonthRecommendRequest_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+// Validate checks the field values on AdminMonthRecommendReply with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserAuthListRequestMultiError, or nil if none found.
-func (m *UserAuthListRequest) ValidateAll() error {
+// AdminMonthRecommendReplyMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAuthListRequest) validate(all bool) error {
+func (m *AdminMonthRecommendReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for AdminId
-
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminMonthRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMonthRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendReplyValidationError is the validation error returned by
+// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
+type AdminMonthRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
+	return "AdminMonthRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminConfigRequestMultiError, or nil if none found.
+func (m *AdminConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if val := m.GetPage(); val < 0 || val > 1000000 {
+		err := AdminConfigRequestValidationError{
+			field:  "Page",
+			reason: "value must be inside range [0, 1000000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigRequestValidationError is the validation error returned by
+// AdminConfigRequest.Validate if the designated constraints aren't met.
+type AdminConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigRequestValidationError) ErrorName() string {
+	return "AdminConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigRequestValidationError{}
+
+// Validate checks the field values on AdminConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigReplyMultiError, or nil if none found.
+func (m *AdminConfigReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConfig() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminConfigReplyValidationError{
+					field:  fmt.Sprintf("Config[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminConfigReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigReplyValidationError is the validation error returned by
+// AdminConfigReply.Validate if the designated constraints aren't met.
+type AdminConfigReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminConfigReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigReplyValidationError{}
+
+// Validate checks the field values on AdminListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListRequestMultiError, or nil if none found.
+func (m *AdminListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListRequestMultiError) AllErrors() []error { return m }
+
+// AdminListRequestValidationError is the validation error returned by
+// AdminListRequest.Validate if the designated constraints aren't met.
+type AdminListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListRequestValidationError) ErrorName() string { return "AdminListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListRequestValidationError{}
+
+// Validate checks the field values on AdminListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminListReplyMultiError,
+// or nil if none found.
+func (m *AdminListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccount() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminListReplyValidationError{
+						field:  fmt.Sprintf("Account[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminListReplyValidationError{
+						field:  fmt.Sprintf("Account[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminListReplyValidationError{
+					field:  fmt.Sprintf("Account[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListReplyMultiError) AllErrors() []error { return m }
+
+// AdminListReplyValidationError is the validation error returned by
+// AdminListReply.Validate if the designated constraints aren't met.
+type AdminListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListReplyValidationError) ErrorName() string { return "AdminListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListReplyValidationError{}
+
+// Validate checks the field values on AuthListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthListRequestMultiError, or nil if none found.
+func (m *AuthListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuthListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthListRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthListRequestMultiError) AllErrors() []error { return m }
+
+// AuthListRequestValidationError is the validation error returned by
+// AuthListRequest.Validate if the designated constraints aren't met.
+type AuthListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthListRequestValidationError) ErrorName() string { return "AuthListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthListRequestValidationError{}
+
+// Validate checks the field values on AuthListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthListReplyMultiError, or
+// nil if none found.
+func (m *AuthListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthListReplyValidationError{
+					field:  fmt.Sprintf("Auth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthListReplyMultiError is an error wrapping multiple validation errors
+// returned by AuthListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuthListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthListReplyMultiError) AllErrors() []error { return m }
+
+// AuthListReplyValidationError is the validation error returned by
+// AuthListReply.Validate if the designated constraints aren't met.
+type AuthListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthListReplyValidationError) ErrorName() string { return "AuthListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthListReplyValidationError{}
+
+// Validate checks the field values on UserAuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserAuthListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserAuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserAuthListRequestMultiError, or nil if none found.
+func (m *UserAuthListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserAuthListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AdminId
+
+	if len(errors) > 0 {
+		return UserAuthListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserAuthListRequestMultiError is an error wrapping multiple validation
+// errors returned by UserAuthListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserAuthListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserAuthListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserAuthListRequestMultiError) AllErrors() []error { return m }
+
+// UserAuthListRequestValidationError is the validation error returned by
+// UserAuthListRequest.Validate if the designated constraints aren't met.
+type UserAuthListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserAuthListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserAuthListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserAuthListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserAuthListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserAuthListRequestValidationError) ErrorName() string {
+	return "UserAuthListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserAuthListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserAuthListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserAuthListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserAuthListRequestValidationError{}
+
+// Validate checks the field values on UserAuthListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserAuthListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserAuthListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserAuthListReplyMultiError, or nil if none found.
+func (m *UserAuthListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserAuthListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserAuthListReplyValidationError{
+					field:  fmt.Sprintf("Auth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserAuthListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserAuthListReplyMultiError is an error wrapping multiple validation errors
+// returned by UserAuthListReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserAuthListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserAuthListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserAuthListReplyMultiError) AllErrors() []error { return m }
+
+// UserAuthListReplyValidationError is the validation error returned by
+// UserAuthListReply.Validate if the designated constraints aren't met.
+type UserAuthListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserAuthListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserAuthListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserAuthListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserAuthListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserAuthListReplyValidationError) ErrorName() string {
+	return "UserAuthListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserAuthListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserAuthListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserAuthListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserAuthListReplyValidationError{}
+
+// Validate checks the field values on MyAuthListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MyAuthListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MyAuthListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MyAuthListRequestMultiError, or nil if none found.
+func (m *MyAuthListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MyAuthListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MyAuthListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MyAuthListRequestMultiError is an error wrapping multiple validation errors
+// returned by MyAuthListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MyAuthListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MyAuthListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MyAuthListRequestMultiError) AllErrors() []error { return m }
+
+// MyAuthListRequestValidationError is the validation error returned by
+// MyAuthListRequest.Validate if the designated constraints aren't met.
+type MyAuthListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MyAuthListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MyAuthListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MyAuthListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MyAuthListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MyAuthListRequestValidationError) ErrorName() string {
+	return "MyAuthListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MyAuthListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMyAuthListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MyAuthListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MyAuthListRequestValidationError{}
+
+// Validate checks the field values on MyAuthListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MyAuthListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MyAuthListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MyAuthListReplyMultiError, or nil if none found.
+func (m *MyAuthListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MyAuthListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MyAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MyAuthListReplyValidationError{
+						field:  fmt.Sprintf("Auth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MyAuthListReplyValidationError{
+					field:  fmt.Sprintf("Auth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Super
+
+	if len(errors) > 0 {
+		return MyAuthListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MyAuthListReplyMultiError is an error wrapping multiple validation errors
+// returned by MyAuthListReply.ValidateAll() if the designated constraints
+// aren't met.
+type MyAuthListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MyAuthListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MyAuthListReplyMultiError) AllErrors() []error { return m }
+
+// MyAuthListReplyValidationError is the validation error returned by
+// MyAuthListReply.Validate if the designated constraints aren't met.
+type MyAuthListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MyAuthListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MyAuthListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MyAuthListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MyAuthListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MyAuthListReplyValidationError) ErrorName() string { return "MyAuthListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MyAuthListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMyAuthListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MyAuthListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MyAuthListReplyValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminConfigUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminConfigUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateRequestValidationError is the validation error returned by
+// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
+type AdminConfigUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminConfigUpdateReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VersionId
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateReplyValidationError is the validation error returned by
+// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
+type AdminConfigUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminConfigHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigHistoryRequestMultiError, or nil if none found.
+func (m *AdminConfigHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for KeyName
+
+	if len(errors) > 0 {
+		return AdminConfigHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigHistoryRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigHistoryRequestValidationError is the validation error returned by
+// AdminConfigHistoryRequest.Validate if the designated constraints aren't met.
+type AdminConfigHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigHistoryRequestValidationError) ErrorName() string {
+	return "AdminConfigHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigHistoryRequestValidationError{}
+
+// Validate checks the field values on AdminConfigHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigHistoryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigHistoryReplyMultiError, or nil if none found.
+func (m *AdminConfigHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for KeyName
+
+	// no validation rules for Name
+
+	// no validation rules for Value
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminConfigHistoryReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminConfigHistoryReplyValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminConfigHistoryReplyValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminConfigHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigHistoryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigHistoryReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigHistoryReplyValidationError is the validation error returned by
+// AdminConfigHistoryReply.Validate if the designated constraints aren't met.
+type AdminConfigHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigHistoryReplyValidationError) ErrorName() string {
+	return "AdminConfigHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigHistoryReplyValidationError{}
+
+// Validate checks the field values on AdminConfigRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigRollbackRequestMultiError, or nil if none found.
+func (m *AdminConfigRollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigRollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminConfigRollbackRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminConfigRollbackRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminConfigRollbackRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminConfigRollbackRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminConfigRollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigRollbackRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminConfigRollbackRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminConfigRollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigRollbackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigRollbackRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigRollbackRequestValidationError is the validation error returned
+// by AdminConfigRollbackRequest.Validate if the designated constraints aren't
+// met.
+type AdminConfigRollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigRollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigRollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigRollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigRollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigRollbackRequestValidationError) ErrorName() string {
+	return "AdminConfigRollbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigRollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigRollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigRollbackRequestValidationError{}
+
+// Validate checks the field values on AdminConfigRollbackReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRollbackReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigRollbackReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigRollbackReplyMultiError, or nil if none found.
+func (m *AdminConfigRollbackReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigRollbackReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VersionId
+
+	if len(errors) > 0 {
+		return AdminConfigRollbackReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigRollbackReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigRollbackReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigRollbackReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigRollbackReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigRollbackReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigRollbackReplyValidationError is the validation error returned by
+// AdminConfigRollbackReply.Validate if the designated constraints aren't met.
+type AdminConfigRollbackReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigRollbackReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigRollbackReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigRollbackReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigRollbackReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigRollbackReplyValidationError) ErrorName() string {
+	return "AdminConfigRollbackReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigRollbackReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigRollbackReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigRollbackReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigRollbackReplyValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateListenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateListenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateListenRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateListenRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateListenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateListenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateListenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateListenRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminConfigUpdateListenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminConfigUpdateListenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateListenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateListenRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateListenRequestValidationError is the validation error
+// returned by AdminConfigUpdateListenRequest.Validate if the designated
+// constraints aren't met.
+type AdminConfigUpdateListenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateListenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateListenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateListenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateListenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateListenRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateListenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateListenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateListenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateListenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateListenRequestValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateListenReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateListenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateListenReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateListenReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateListenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateListenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PriceChangeId
+
+	// no validation rules for Origin
+
+	// no validation rules for Price
+
+	// no validation rules for DryRun
+
+	// no validation rules for Users
+
+	// no validation rules for Up
+
+	// no validation rules for Down
+
+	// no validation rules for Stopped
+
+	// no validation rules for Amount
+
+	// no validation rules for RunId
+
+	// no validation rules for Status
+
+	// no validation rules for Resumed
+
+	// no validation rules for Retried
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateListenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateListenReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminConfigUpdateListenReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminConfigUpdateListenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateListenReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateListenReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateListenReplyValidationError is the validation error returned
+// by AdminConfigUpdateListenReply.Validate if the designated constraints
+// aren't met.
+type AdminConfigUpdateListenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateListenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateListenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateListenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateListenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateListenReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateListenReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateListenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateListenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateListenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateListenReplyValidationError{}
+
+// Validate checks the field values on AdminPriceRevalueRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPriceRevalueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPriceRevalueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminPriceRevalueRequestMultiError, or nil if none found.
+func (m *AdminPriceRevalueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPriceRevalueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPriceChangeId() <= 0 {
+		err := AdminPriceRevalueRequestValidationError{
+			field:  "PriceChangeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminPriceRevalueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPriceRevalueRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminPriceRevalueRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPriceRevalueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPriceRevalueRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPriceRevalueRequestMultiError) AllErrors() []error { return m }
+
+// AdminPriceRevalueRequestValidationError is the validation error returned by
+// AdminPriceRevalueRequest.Validate if the designated constraints aren't met.
+type AdminPriceRevalueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPriceRevalueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPriceRevalueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPriceRevalueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPriceRevalueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPriceRevalueRequestValidationError) ErrorName() string {
+	return "AdminPriceRevalueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPriceRevalueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPriceRevalueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPriceRevalueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPriceRevalueRequestValidationError{}
+
+// Validate checks the field values on AdminPriceRevalueReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPriceRevalueReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPriceRevalueReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminPriceRevalueReplyMultiError, or nil if none found.
+func (m *AdminPriceRevalueReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPriceRevalueReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RunId
+
+	// no validation rules for PriceChangeId
+
+	// no validation rules for Status
+
+	// no validation rules for LastUserId
+
+	// no validation rules for Users
+
+	// no validation rules for Up
+
+	// no validation rules for Down
+
+	// no validation rules for Stopped
+
+	// no validation rules for Amount
+
+	// no validation rules for Failed
+
+	// no validation rules for Error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminPriceRevalueReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminPriceRevalueReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminPriceRevalueReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminPriceRevalueReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPriceRevalueReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminPriceRevalueReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPriceRevalueReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPriceRevalueReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPriceRevalueReplyMultiError) AllErrors() []error { return m }
+
+// AdminPriceRevalueReplyValidationError is the validation error returned by
+// AdminPriceRevalueReply.Validate if the designated constraints aren't met.
+type AdminPriceRevalueReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPriceRevalueReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPriceRevalueReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPriceRevalueReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPriceRevalueReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPriceRevalueReplyValidationError) ErrorName() string {
+	return "AdminPriceRevalueReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPriceRevalueReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPriceRevalueReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPriceRevalueReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPriceRevalueReplyValidationError{}
+
+// Validate checks the field values on AdminPriceTickRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPriceTickRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPriceTickRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminPriceTickRequestMultiError, or nil if none found.
+func (m *AdminPriceTickRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPriceTickRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return AdminPriceTickRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPriceTickRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminPriceTickRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPriceTickRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPriceTickRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPriceTickRequestMultiError) AllErrors() []error { return m }
+
+// AdminPriceTickRequestValidationError is the validation error returned by
+// AdminPriceTickRequest.Validate if the designated constraints aren't met.
+type AdminPriceTickRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPriceTickRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPriceTickRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPriceTickRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPriceTickRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPriceTickRequestValidationError) ErrorName() string {
+	return "AdminPriceTickRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPriceTickRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPriceTickRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPriceTickRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPriceTickRequestValidationError{}
+
+// Validate checks the field values on AdminPriceTickReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPriceTickReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPriceTickReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminPriceTickReplyMultiError, or nil if none found.
+func (m *AdminPriceTickReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPriceTickReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	// no validation rules for Quote
+
+	// no validation rules for Current
+
+	// no validation rules for Target
+
+	// no validation rules for Next
+
+	// no validation rules for Scheduled
+
+	// no validation rules for PriceChangeId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AdminPriceTickReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPriceTickReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminPriceTickReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPriceTickReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPriceTickReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPriceTickReplyMultiError) AllErrors() []error { return m }
+
+// AdminPriceTickReplyValidationError is the validation error returned by
+// AdminPriceTickReply.Validate if the designated constraints aren't met.
+type AdminPriceTickReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPriceTickReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPriceTickReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPriceTickReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPriceTickReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPriceTickReplyValidationError) ErrorName() string {
+	return "AdminPriceTickReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPriceTickReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPriceTickReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPriceTickReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPriceTickReplyValidationError{}
+
+// Validate checks the field values on AdminPasswordUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPasswordUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPasswordUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminPasswordUpdateRequestMultiError, or nil if none found.
+func (m *AdminPasswordUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPasswordUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminPasswordUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminPasswordUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminPasswordUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminPasswordUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminPasswordUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPasswordUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminPasswordUpdateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminPasswordUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPasswordUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPasswordUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminPasswordUpdateRequestValidationError is the validation error returned
+// by AdminPasswordUpdateRequest.Validate if the designated constraints aren't
+// met.
+type AdminPasswordUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPasswordUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPasswordUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPasswordUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPasswordUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPasswordUpdateRequestValidationError) ErrorName() string {
+	return "AdminPasswordUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPasswordUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPasswordUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPasswordUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPasswordUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminPasswordUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPasswordUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPasswordUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminPasswordUpdateReplyMultiError, or nil if none found.
+func (m *AdminPasswordUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPasswordUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminPasswordUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPasswordUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminPasswordUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPasswordUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPasswordUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPasswordUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminPasswordUpdateReplyValidationError is the validation error returned by
+// AdminPasswordUpdateReply.Validate if the designated constraints aren't met.
+type AdminPasswordUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPasswordUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPasswordUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPasswordUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPasswordUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPasswordUpdateReplyValidationError) ErrorName() string {
+	return "AdminPasswordUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPasswordUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPasswordUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPasswordUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPasswordUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminUpdateLocationNewMaxRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUpdateLocationNewMaxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUpdateLocationNewMaxRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUpdateLocationNewMaxRequestMultiError, or nil if none found.
+func (m *AdminUpdateLocationNewMaxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUpdateLocationNewMaxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminUpdateLocationNewMaxRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUpdateLocationNewMaxRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUpdateLocationNewMaxRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUpdateLocationNewMaxRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminUpdateLocationNewMaxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUpdateLocationNewMaxRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateLocationNewMaxRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUpdateLocationNewMaxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUpdateLocationNewMaxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUpdateLocationNewMaxRequestMultiError) AllErrors() []error { return m }
+
+// AdminUpdateLocationNewMaxRequestValidationError is the validation error
+// returned by AdminUpdateLocationNewMaxRequest.Validate if the designated
+// constraints aren't met.
+type AdminUpdateLocationNewMaxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUpdateLocationNewMaxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUpdateLocationNewMaxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUpdateLocationNewMaxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUpdateLocationNewMaxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUpdateLocationNewMaxRequestValidationError) ErrorName() string {
+	return "AdminUpdateLocationNewMaxRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUpdateLocationNewMaxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUpdateLocationNewMaxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUpdateLocationNewMaxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUpdateLocationNewMaxRequestValidationError{}
+
+// Validate checks the field values on AdminUpdateLocationNewMaxReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUpdateLocationNewMaxReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUpdateLocationNewMaxReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUpdateLocationNewMaxReplyMultiError, or nil if none found.
+func (m *AdminUpdateLocationNewMaxReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUpdateLocationNewMaxReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminUpdateLocationNewMaxReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUpdateLocationNewMaxReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateLocationNewMaxReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUpdateLocationNewMaxReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUpdateLocationNewMaxReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUpdateLocationNewMaxReplyMultiError) AllErrors() []error { return m }
+
+// AdminUpdateLocationNewMaxReplyValidationError is the validation error
+// returned by AdminUpdateLocationNewMaxReply.Validate if the designated
+// constraints aren't met.
+type AdminUpdateLocationNewMaxReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUpdateLocationNewMaxReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUpdateLocationNewMaxReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUpdateLocationNewMaxReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUpdateLocationNewMaxReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUpdateLocationNewMaxReplyValidationError) ErrorName() string {
+	return "AdminUpdateLocationNewMaxReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUpdateLocationNewMaxReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUpdateLocationNewMaxReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUpdateLocationNewMaxReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUpdateLocationNewMaxReplyValidationError{}
+
+// Validate checks the field values on AdminVipDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminVipDeleteRequestMultiError, or nil if none found.
+func (m *AdminVipDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminVipDeleteRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminVipDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminVipDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminVipDeleteRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminVipDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipDeleteRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipDeleteRequestValidationError is the validation error returned by
+// AdminVipDeleteRequest.Validate if the designated constraints aren't met.
+type AdminVipDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipDeleteRequestValidationError) ErrorName() string {
+	return "AdminVipDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipDeleteRequestValidationError{}
+
+// Validate checks the field values on AdminVipDeleteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipDeleteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipDeleteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipDeleteReplyMultiError, or nil if none found.
+func (m *AdminVipDeleteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipDeleteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminVipDeleteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipDeleteReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipDeleteReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipDeleteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipDeleteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipDeleteReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipDeleteReplyValidationError is the validation error returned by
+// AdminVipDeleteReply.Validate if the designated constraints aren't met.
+type AdminVipDeleteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipDeleteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipDeleteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipDeleteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipDeleteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipDeleteReplyValidationError) ErrorName() string {
+	return "AdminVipDeleteReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipDeleteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipDeleteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipDeleteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipDeleteReplyValidationError{}
+
+// Validate checks the field values on AdminVipUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminVipUpdateRequestMultiError, or nil if none found.
+func (m *AdminVipUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminVipUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminVipUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminVipUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminVipUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminVipUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminVipUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminVipUpdateRequestValidationError is the validation error returned by
+// AdminVipUpdateRequest.Validate if the designated constraints aren't met.
+type AdminVipUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipUpdateRequestValidationError) ErrorName() string {
+	return "AdminVipUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminVipUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminVipUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminVipUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminVipUpdateReplyMultiError, or nil if none found.
+func (m *AdminVipUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminVipUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminVipUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminVipUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminVipUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminVipUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminVipUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminVipUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminVipUpdateReplyValidationError is the validation error returned by
+// AdminVipUpdateReply.Validate if the designated constraints aren't met.
+type AdminVipUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminVipUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminVipUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminVipUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminVipUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminVipUpdateReplyValidationError) ErrorName() string {
+	return "AdminVipUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminVipUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminVipUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminVipUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminVipUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminKkdtUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminKkdtUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminKkdtUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminKkdtUpdateRequestMultiError, or nil if none found.
+func (m *AdminKkdtUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminKkdtUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminKkdtUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminKkdtUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminKkdtUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminKkdtUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminKkdtUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminKkdtUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminKkdtUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminKkdtUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminKkdtUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminKkdtUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminKkdtUpdateRequestValidationError is the validation error returned by
+// AdminKkdtUpdateRequest.Validate if the designated constraints aren't met.
+type AdminKkdtUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminKkdtUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminKkdtUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminKkdtUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminKkdtUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminKkdtUpdateRequestValidationError) ErrorName() string {
+	return "AdminKkdtUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminKkdtUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminKkdtUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminKkdtUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminKkdtUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminKkdtUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminKkdtUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminKkdtUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminKkdtUpdateReplyMultiError, or nil if none found.
+func (m *AdminKkdtUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminKkdtUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminKkdtUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminKkdtUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminKkdtUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminKkdtUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminKkdtUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminKkdtUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminKkdtUpdateReplyValidationError is the validation error returned by
+// AdminKkdtUpdateReply.Validate if the designated constraints aren't met.
+type AdminKkdtUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminKkdtUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminKkdtUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminKkdtUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminKkdtUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminKkdtUpdateReplyValidationError) ErrorName() string {
+	return "AdminKkdtUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminKkdtUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminKkdtUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminKkdtUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminKkdtUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminUndoUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUndoUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUndoUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminUndoUpdateRequestMultiError, or nil if none found.
+func (m *AdminUndoUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUndoUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminUndoUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUndoUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUndoUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUndoUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminUndoUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUndoUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUndoUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUndoUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUndoUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUndoUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminUndoUpdateRequestValidationError is the validation error returned by
+// AdminUndoUpdateRequest.Validate if the designated constraints aren't met.
+type AdminUndoUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUndoUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUndoUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUndoUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUndoUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUndoUpdateRequestValidationError) ErrorName() string {
+	return "AdminUndoUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUndoUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUndoUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUndoUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUndoUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminUndoUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUndoUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUndoUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUndoUpdateReplyMultiError, or nil if none found.
+func (m *AdminUndoUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUndoUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminUndoUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUndoUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUndoUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUndoUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUndoUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUndoUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminUndoUpdateReplyValidationError is the validation error returned by
+// AdminUndoUpdateReply.Validate if the designated constraints aren't met.
+type AdminUndoUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUndoUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUndoUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUndoUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUndoUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUndoUpdateReplyValidationError) ErrorName() string {
+	return "AdminUndoUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUndoUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUndoUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUndoUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUndoUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminAreaLevelUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminAreaLevelUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAreaLevelUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAreaLevelUpdateRequestMultiError, or nil if none found.
+func (m *AdminAreaLevelUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminAreaLevelUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAreaLevelUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminAreaLevelUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAreaLevelUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminAreaLevelUpdateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminAreaLevelUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAreaLevelUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAreaLevelUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminAreaLevelUpdateRequestValidationError is the validation error returned
+// by AdminAreaLevelUpdateRequest.Validate if the designated constraints aren't
+// met.
+type AdminAreaLevelUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAreaLevelUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAreaLevelUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAreaLevelUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAreaLevelUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAreaLevelUpdateRequestValidationError) ErrorName() string {
+	return "AdminAreaLevelUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminAreaLevelUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAreaLevelUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAreaLevelUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAreaLevelUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminAreaLevelUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminAreaLevelUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAreaLevelUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAreaLevelUpdateReplyMultiError, or nil if none found.
+func (m *AdminAreaLevelUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAreaLevelUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminAreaLevelUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAreaLevelUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminAreaLevelUpdateReply.ValidateAll() if the
+// designated constraints aren't met.
+type AdminAreaLevelUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAreaLevelUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAreaLevelUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminAreaLevelUpdateReplyValidationError is the validation error returned by
+// AdminAreaLevelUpdateReply.Validate if the designated constraints aren't met.
+type AdminAreaLevelUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAreaLevelUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAreaLevelUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAreaLevelUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAreaLevelUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAreaLevelUpdateReplyValidationError) ErrorName() string {
+	return "AdminAreaLevelUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminAreaLevelUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAreaLevelUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAreaLevelUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAreaLevelUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminLocationInsertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationInsertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationInsertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationInsertRequestMultiError, or nil if none found.
+func (m *AdminLocationInsertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationInsertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminLocationInsertRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLocationInsertRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminLocationInsertRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLocationInsertRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminLocationInsertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationInsertRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationInsertRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationInsertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationInsertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationInsertRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationInsertRequestValidationError is the validation error returned
+// by AdminLocationInsertRequest.Validate if the designated constraints aren't
+// met.
+type AdminLocationInsertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationInsertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationInsertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationInsertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationInsertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationInsertRequestValidationError) ErrorName() string {
+	return "AdminLocationInsertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationInsertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationInsertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationInsertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationInsertRequestValidationError{}
+
+// Validate checks the field values on AdminLocationInsertReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationInsertReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationInsertReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationInsertReplyMultiError, or nil if none found.
+func (m *AdminLocationInsertReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationInsertReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecordId
+
+	// no validation rules for LocationId
+
+	// no validation rules for Existed
+
+	if len(errors) > 0 {
+		return AdminLocationInsertReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationInsertReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationInsertReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationInsertReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationInsertReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationInsertReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationInsertReplyValidationError is the validation error returned by
+// AdminLocationInsertReply.Validate if the designated constraints aren't met.
+type AdminLocationInsertReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationInsertReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationInsertReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationInsertReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationInsertReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationInsertReplyValidationError) ErrorName() string {
+	return "AdminLocationInsertReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationInsertReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationInsertReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationInsertReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationInsertReplyValidationError{}
+
+// Validate checks the field values on AdminBalanceUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceUpdateRequestMultiError, or nil if none found.
+func (m *AdminBalanceUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AdminBalanceUpdateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminBalanceUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminBalanceUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminBalanceUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminBalanceUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminBalanceUpdateRequestValidationError is the validation error returned by
+// AdminBalanceUpdateRequest.Validate if the designated constraints aren't met.
+type AdminBalanceUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceUpdateRequestValidationError) ErrorName() string {
+	return "AdminBalanceUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminBalanceUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBalanceUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminBalanceUpdateReplyMultiError, or nil if none found.
+func (m *AdminBalanceUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBalanceUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminBalanceUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBalanceUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminBalanceUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminBalanceUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBalanceUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBalanceUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminBalanceUpdateReplyValidationError is the validation error returned by
+// AdminBalanceUpdateReply.Validate if the designated constraints aren't met.
+type AdminBalanceUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBalanceUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBalanceUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBalanceUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBalanceUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBalanceUpdateReplyValidationError) ErrorName() string {
+	return "AdminBalanceUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminBalanceUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBalanceUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBalanceUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBalanceUpdateReplyValidationError{}
+
+// Validate checks the field values on AuthAdminCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAdminCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AuthAdminCreateRequestMultiError, or nil if none found.
+func (m *AuthAdminCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAdminCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AuthAdminCreateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthAdminCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthAdminCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthAdminCreateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthAdminCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAdminCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAdminCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAdminCreateRequestMultiError) AllErrors() []error { return m }
+
+// AuthAdminCreateRequestValidationError is the validation error returned by
+// AuthAdminCreateRequest.Validate if the designated constraints aren't met.
+type AuthAdminCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAdminCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAdminCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAdminCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAdminCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAdminCreateRequestValidationError) ErrorName() string {
+	return "AuthAdminCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAdminCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAdminCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAdminCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAdminCreateRequestValidationError{}
+
+// Validate checks the field values on AuthAdminCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminCreateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAdminCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAdminCreateReplyMultiError, or nil if none found.
+func (m *AuthAdminCreateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAdminCreateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuthAdminCreateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAdminCreateReplyMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminCreateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminCreateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAdminCreateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAdminCreateReplyMultiError) AllErrors() []error { return m }
+
+// AuthAdminCreateReplyValidationError is the validation error returned by
+// AuthAdminCreateReply.Validate if the designated constraints aren't met.
+type AuthAdminCreateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAdminCreateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAdminCreateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAdminCreateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAdminCreateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAdminCreateReplyValidationError) ErrorName() string {
+	return "AuthAdminCreateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAdminCreateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAdminCreateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAdminCreateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAdminCreateReplyValidationError{}
+
+// Validate checks the field values on AuthAdminDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAdminDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AuthAdminDeleteRequestMultiError, or nil if none found.
+func (m *AuthAdminDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAdminDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSendBody() == nil {
+		err := AuthAdminDeleteRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthAdminDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthAdminDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthAdminDeleteRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthAdminDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAdminDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAdminDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAdminDeleteRequestMultiError) AllErrors() []error { return m }
+
+// AuthAdminDeleteRequestValidationError is the validation error returned by
+// AuthAdminDeleteRequest.Validate if the designated constraints aren't met.
+type AuthAdminDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAdminDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAdminDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAdminDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAdminDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAdminDeleteRequestValidationError) ErrorName() string {
+	return "AuthAdminDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAdminDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAdminDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAdminDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAdminDeleteRequestValidationError{}
+
+// Validate checks the field values on AuthAdminDeleteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAdminDeleteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAdminDeleteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAdminDeleteReplyMultiError, or nil if none found.
+func (m *AuthAdminDeleteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAdminDeleteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AuthAdminDeleteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAdminDeleteReplyMultiError is an error wrapping multiple validation
+// errors returned by AuthAdminDeleteReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuthAdminDeleteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAdminDeleteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAdminDeleteReplyMultiError) AllErrors() []error { return m }
+
+// AuthAdminDeleteReplyValidationError is the validation error returned by
+// AuthAdminDeleteReply.Validate if the designated constraints aren't met.
+type AuthAdminDeleteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAdminDeleteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAdminDeleteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAdminDeleteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAdminDeleteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAdminDeleteReplyValidationError) ErrorName() string {
+	return "AuthAdminDeleteReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAdminDeleteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAdminDeleteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAdminDeleteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAdminDeleteReplyValidationError{}
+
+// Validate checks the field values on CheckAndInsertRecommendAreaRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckAndInsertRecommendAreaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAndInsertRecommendAreaRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CheckAndInsertRecommendAreaRequestMultiError, or nil if none found.
+func (m *CheckAndInsertRecommendAreaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAndInsertRecommendAreaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CheckAndInsertRecommendAreaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAndInsertRecommendAreaRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckAndInsertRecommendAreaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAndInsertRecommendAreaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAndInsertRecommendAreaRequestMultiError) AllErrors() []error { return m }
+
+// CheckAndInsertRecommendAreaRequestValidationError is the validation error
+// returned by CheckAndInsertRecommendAreaRequest.Validate if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAndInsertRecommendAreaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAndInsertRecommendAreaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAndInsertRecommendAreaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAndInsertRecommendAreaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAndInsertRecommendAreaRequestValidationError) ErrorName() string {
+	return "CheckAndInsertRecommendAreaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAndInsertRecommendAreaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAndInsertRecommendAreaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAndInsertRecommendAreaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAndInsertRecommendAreaRequestValidationError{}
+
+// Validate checks the field values on CheckAndInsertRecommendAreaReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckAndInsertRecommendAreaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAndInsertRecommendAreaReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckAndInsertRecommendAreaReplyMultiError, or nil if none found.
+func (m *CheckAndInsertRecommendAreaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAndInsertRecommendAreaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CheckAndInsertRecommendAreaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAndInsertRecommendAreaReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckAndInsertRecommendAreaReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAndInsertRecommendAreaReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAndInsertRecommendAreaReplyMultiError) AllErrors() []error { return m }
+
+// CheckAndInsertRecommendAreaReplyValidationError is the validation error
+// returned by CheckAndInsertRecommendAreaReply.Validate if the designated
+// constraints aren't met.
+type CheckAndInsertRecommendAreaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAndInsertRecommendAreaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAndInsertRecommendAreaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAndInsertRecommendAreaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAndInsertRecommendAreaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAndInsertRecommendAreaReplyValidationError) ErrorName() string {
+	return "CheckAndInsertRecommendAreaReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAndInsertRecommendAreaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAndInsertRecommendAreaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAndInsertRecommendAreaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAndInsertRecommendAreaReplyValidationError{}
+
+// Validate checks the field values on AdminDailyRecommendRewardRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyRecommendRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyRecommendRewardRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyRecommendRewardRequestMultiError, or nil if none found.
+func (m *AdminDailyRecommendRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyRecommendRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return AdminDailyRecommendRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyRecommendRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyRecommendRewardRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyRecommendRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyRecommendRewardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyRecommendRewardRequestMultiError) AllErrors() []error { return m }
+
+// AdminDailyRecommendRewardRequestValidationError is the validation error
+// returned by AdminDailyRecommendRewardRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyRecommendRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyRecommendRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyRecommendRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyRecommendRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyRecommendRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyRecommendRewardRequestValidationError) ErrorName() string {
+	return "AdminDailyRecommendRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyRecommendRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyRecommendRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyRecommendRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyRecommendRewardRequestValidationError{}
+
+// Validate checks the field values on AdminDailyRecommendRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyRecommendRewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyRecommendRewardReply with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyRecommendRewardReplyMultiError, or nil if none found.
+func (m *AdminDailyRecommendRewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyRecommendRewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDailyRecommendRewardReplyValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDailyRecommendRewardReplyValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDailyRecommendRewardReplyValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminDailyRecommendRewardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyRecommendRewardReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyRecommendRewardReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyRecommendRewardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyRecommendRewardReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyRecommendRewardReplyMultiError) AllErrors() []error { return m }
+
+// AdminDailyRecommendRewardReplyValidationError is the validation error
+// returned by AdminDailyRecommendRewardReply.Validate if the designated
+// constraints aren't met.
+type AdminDailyRecommendRewardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyRecommendRewardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyRecommendRewardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyRecommendRewardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyRecommendRewardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyRecommendRewardReplyValidationError) ErrorName() string {
+	return "AdminDailyRecommendRewardReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyRecommendRewardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyRecommendRewardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyRecommendRewardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyRecommendRewardReplyValidationError{}
+
+// Validate checks the field values on AdminDailyBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyBalanceRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyBalanceRewardRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyBalanceRewardRequestMultiError, or nil if none found.
+func (m *AdminDailyBalanceRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyBalanceRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return AdminDailyBalanceRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDailyBalanceRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyBalanceRewardRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyBalanceRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDailyBalanceRewardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDailyBalanceRewardRequestMultiError) AllErrors() []error { return m }
+
+// AdminDailyBalanceRewardRequestValidationError is the validation error
+// returned by AdminDailyBalanceRewardRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyBalanceRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDailyBalanceRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDailyBalanceRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDailyBalanceRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDailyBalanceRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDailyBalanceRewardRequestValidationError) ErrorName() string {
+	return "AdminDailyBalanceRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDailyBalanceRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDailyBalanceRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDailyBalanceRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDailyBalanceRewardRequestValidationError{}
+
+// Validate checks the field values on AdminDailyBalanceRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyBalanceRewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDailyBalanceRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyBalanceRewardReplyMultiError, or nil if none found.
+func (m *AdminDailyBalanceRewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDailyBalanceRewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminDailyBalanceRewardReplyValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminDailyBalanceRewardReplyValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminDailyBalanceRewardReplyValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return UserAuthListRequestMultiError(errors)
+		return AdminDailyBalanceRewardReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAuthListRequestMultiError is an error wrapping multiple validation
-// errors returned by UserAuthListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UserAuthListRequestMultiError []error
+// AdminDailyBalanceRewardReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyBalanceRewardReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDailyBalanceRewardReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAuthListRequestMultiError) Error() string {
+func (m AdminDailyBalanceRewardReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7402,11 +14839,12 @@ func (m UserAuthListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAuthListRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyBalanceRewardReplyMultiError) AllErrors() []error { return m }
 
-// UserAuthListRequestValidationError is the validation error returned by
-// UserAuthListRequest.Validate if the designated constraints aren't met.
-type UserAuthListRequestValidationError struct {
+// AdminDailyBalanceRewardReplyValidationError is the validation error returned
+// by AdminDailyBalanceRewardReply.Validate if the designated constraints
+// aren't met.
+type AdminDailyBalanceRewardReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7414,24 +14852,24 @@ type UserAuthListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAuthListRequestValidationError) Field() string { return e.field }
+func (e AdminDailyBalanceRewardReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAuthListRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyBalanceRewardReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAuthListRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyBalanceRewardReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAuthListRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyBalanceRewardReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAuthListRequestValidationError) ErrorName() string {
-	return "UserAuthListRequestValidationError"
+func (e AdminDailyBalanceRewardReplyValidationError) ErrorName() string {
+	return "AdminDailyBalanceRewardReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAuthListRequestValidationError) Error() string {
+func (e AdminDailyBalanceRewardReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7443,14 +14881,14 @@ func (e UserAuthListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAuthListRequest.%s: %s%s",
+		"invalid %sAdminDailyBalanceRewardReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAuthListRequestValidationError{}
+var _ error = AdminDailyBalanceRewardReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7458,78 +14896,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAuthListRequestValidationError{}
+} = AdminDailyBalanceRewardReplyValidationError{}
 
-// Validate checks the field values on UserAuthListReply with the rules defined
+// Validate checks the field values on AdminLoginRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *UserAuthListReply) Validate() error {
+func (m *AdminLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAuthListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserAuthListReplyMultiError, or nil if none found.
-func (m *UserAuthListReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminLoginRequestMultiError, or nil if none found.
+func (m *AdminLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAuthListReply) validate(all bool) error {
+func (m *AdminLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAuth() {
-		_, _ = idx, item
+	if m.GetSendBody() == nil {
+		err := AdminLoginRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminLoginRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return UserAuthListReplyValidationError{
-					field:  fmt.Sprintf("Auth[%v]", idx),
+				errors = append(errors, AdminLoginRequestValidationError{
+					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminLoginRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return UserAuthListReplyMultiError(errors)
+		return AdminLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAuthListReplyMultiError is an error wrapping multiple validation errors
-// returned by UserAuthListReply.ValidateAll() if the designated constraints
+// AdminLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UserAuthListReplyMultiError []error
+type AdminLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAuthListReplyMultiError) Error() string {
+func (m AdminLoginRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7538,11 +14982,11 @@ func (m UserAuthListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAuthListReplyMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
 
-// UserAuthListReplyValidationError is the validation error returned by
-// UserAuthListReply.Validate if the designated constraints aren't met.
-type UserAuthListReplyValidationError struct {
+// AdminLoginRequestValidationError is the validation error returned by
+// AdminLoginRequest.Validate if the designated constraints aren't met.
+type AdminLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7550,24 +14994,24 @@ type UserAuthListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAuthListReplyValidationError) Field() string { return e.field }
+func (e AdminLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAuthListReplyValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAuthListReplyValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAuthListReplyValidationError) Key() bool { return e.key }
+func (e AdminLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAuthListReplyValidationError) ErrorName() string {
-	return "UserAuthListReplyValidationError"
+func (e AdminLoginRequestValidationError) ErrorName() string {
+	return "AdminLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAuthListReplyValidationError) Error() string {
+func (e AdminLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7579,14 +15023,14 @@ func (e UserAuthListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAuthListReply.%s: %s%s",
+		"invalid %sAdminLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAuthListReplyValidationError{}
+var _ error = AdminLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7594,44 +15038,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAuthListReplyValidationError{}
+} = AdminLoginRequestValidationError{}
 
-// Validate checks the field values on MyAuthListRequest with the rules defined
+// Validate checks the field values on AdminLoginReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *MyAuthListRequest) Validate() error {
+func (m *AdminLoginReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MyAuthListRequest with the rules
+// ValidateAll checks the field values on AdminLoginReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MyAuthListRequestMultiError, or nil if none found.
-func (m *MyAuthListRequest) ValidateAll() error {
+// AdminLoginReplyMultiError, or nil if none found.
+func (m *AdminLoginReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MyAuthListRequest) validate(all bool) error {
+func (m *AdminLoginReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return MyAuthListRequestMultiError(errors)
+		return AdminLoginReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// MyAuthListRequestMultiError is an error wrapping multiple validation errors
-// returned by MyAuthListRequest.ValidateAll() if the designated constraints
+// AdminLoginReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginReply.ValidateAll() if the designated constraints
 // aren't met.
-type MyAuthListRequestMultiError []error
+type AdminLoginReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MyAuthListRequestMultiError) Error() string {
+func (m AdminLoginReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7640,11 +15086,11 @@ func (m MyAuthListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MyAuthListRequestMultiError) AllErrors() []error { return m }
+func (m AdminLoginReplyMultiError) AllErrors() []error { return m }
 
-// MyAuthListRequestValidationError is the validation error returned by
-// MyAuthListRequest.Validate if the designated constraints aren't met.
-type MyAuthListRequestValidationError struct {
+// AdminLoginReplyValidationError is the validation error returned by
+// AdminLoginReply.Validate if the designated constraints aren't met.
+type AdminLoginReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7652,24 +15098,22 @@ type MyAuthListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MyAuthListRequestValidationError) Field() string { return e.field }
+func (e AdminLoginReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MyAuthListRequestValidationError) Reason() string { return e.reason }
+func (e AdminLoginReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MyAuthListRequestValidationError) Cause() error { return e.cause }
+func (e AdminLoginReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MyAuthListRequestValidationError) Key() bool { return e.key }
+func (e AdminLoginReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MyAuthListRequestValidationError) ErrorName() string {
-	return "MyAuthListRequestValidationError"
-}
+func (e AdminLoginReplyValidationError) ErrorName() string { return "AdminLoginReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MyAuthListRequestValidationError) Error() string {
+func (e AdminLoginReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7681,14 +15125,14 @@ func (e MyAuthListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMyAuthListRequest.%s: %s%s",
+		"invalid %sAdminLoginReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MyAuthListRequestValidationError{}
+var _ error = AdminLoginReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7696,80 +15140,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MyAuthListRequestValidationError{}
+} = AdminLoginReplyValidationError{}
 
-// Validate checks the field values on MyAuthListReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MyAuthListReply) Validate() error {
+// Validate checks the field values on AdminChangePasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminChangePasswordRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MyAuthListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminChangePasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MyAuthListReplyMultiError, or nil if none found.
-func (m *MyAuthListReply) ValidateAll() error {
+// AdminChangePasswordRequestMultiError, or nil if none found.
+func (m *AdminChangePasswordRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MyAuthListReply) validate(all bool) error {
+func (m *AdminChangePasswordRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAuth() {
-		_, _ = idx, item
+	if m.GetSendBody() == nil {
+		err := AdminChangePasswordRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MyAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MyAuthListReplyValidationError{
-						field:  fmt.Sprintf("Auth[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminChangePasswordRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MyAuthListReplyValidationError{
-					field:  fmt.Sprintf("Auth[%v]", idx),
+				errors = append(errors, AdminChangePasswordRequestValidationError{
+					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminChangePasswordRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Super
-
 	if len(errors) > 0 {
-		return MyAuthListReplyMultiError(errors)
+		return AdminChangePasswordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MyAuthListReplyMultiError is an error wrapping multiple validation errors
-// returned by MyAuthListReply.ValidateAll() if the designated constraints
-// aren't met.
-type MyAuthListReplyMultiError []error
+// AdminChangePasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminChangePasswordRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminChangePasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MyAuthListReplyMultiError) Error() string {
+func (m AdminChangePasswordRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7778,11 +15226,12 @@ func (m MyAuthListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MyAuthListReplyMultiError) AllErrors() []error { return m }
+func (m AdminChangePasswordRequestMultiError) AllErrors() []error { return m }
 
-// MyAuthListReplyValidationError is the validation error returned by
-// MyAuthListReply.Validate if the designated constraints aren't met.
-type MyAuthListReplyValidationError struct {
+// AdminChangePasswordRequestValidationError is the validation error returned
+// by AdminChangePasswordRequest.Validate if the designated constraints aren't
+// met.
+type AdminChangePasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7790,22 +15239,24 @@ type MyAuthListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e MyAuthListReplyValidationError) Field() string { return e.field }
+func (e AdminChangePasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MyAuthListReplyValidationError) Reason() string { return e.reason }
+func (e AdminChangePasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MyAuthListReplyValidationError) Cause() error { return e.cause }
+func (e AdminChangePasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MyAuthListReplyValidationError) Key() bool { return e.key }
+func (e AdminChangePasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MyAuthListReplyValidationError) ErrorName() string { return "MyAuthListReplyValidationError" }
+func (e AdminChangePasswordRequestValidationError) ErrorName() string {
+	return "AdminChangePasswordRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MyAuthListReplyValidationError) Error() string {
+func (e AdminChangePasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7817,14 +15268,14 @@ func (e MyAuthListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMyAuthListReply.%s: %s%s",
+		"invalid %sAdminChangePasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MyAuthListReplyValidationError{}
+var _ error = AdminChangePasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7832,73 +15283,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MyAuthListReplyValidationError{}
+} = AdminChangePasswordRequestValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// Validate checks the field values on AdminChangePasswordReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateRequest) Validate() error {
+func (m *AdminChangePasswordReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// ValidateAll checks the field values on AdminChangePasswordReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest) ValidateAll() error {
+// AdminChangePasswordReplyMultiError, or nil if none found.
+func (m *AdminChangePasswordReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest) validate(all bool) error {
+func (m *AdminChangePasswordReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminConfigUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequestMultiError(errors)
+		return AdminChangePasswordReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// AdminChangePasswordReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminChangePasswordReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateRequestMultiError []error
+type AdminChangePasswordReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequestMultiError) Error() string {
+func (m AdminChangePasswordReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7907,11 +15329,11 @@ func (m AdminConfigUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminChangePasswordReplyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequestValidationError is the validation error returned by
-// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
-type AdminConfigUpdateRequestValidationError struct {
+// AdminChangePasswordReplyValidationError is the validation error returned by
+// AdminChangePasswordReply.Validate if the designated constraints aren't met.
+type AdminChangePasswordReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7919,24 +15341,24 @@ type AdminConfigUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminChangePasswordReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminChangePasswordReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminChangePasswordReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminChangePasswordReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequestValidationError"
+func (e AdminChangePasswordReplyValidationError) ErrorName() string {
+	return "AdminChangePasswordReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequestValidationError) Error() string {
+func (e AdminChangePasswordReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7948,14 +15370,14 @@ func (e AdminConfigUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		"invalid %sAdminChangePasswordReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequestValidationError{}
+var _ error = AdminChangePasswordReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7963,44 +15385,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequestValidationError{}
+} = AdminChangePasswordReplyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateReply) Validate() error {
+// Validate checks the field values on AdminCreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCreateAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminCreateAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateReply) ValidateAll() error {
+// AdminCreateAccountRequestMultiError, or nil if none found.
+func (m *AdminCreateAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateReply) validate(all bool) error {
+func (m *AdminCreateAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := AdminCreateAccountRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminCreateAccountRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminCreateAccountRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminCreateAccountRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateReplyMultiError(errors)
+		return AdminCreateAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// AdminCreateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminCreateAccountRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateReplyMultiError []error
+type AdminCreateAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateReplyMultiError) Error() string {
+func (m AdminCreateAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8009,11 +15471,11 @@ func (m AdminConfigUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminCreateAccountRequestMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateReplyValidationError is the validation error returned by
-// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
-type AdminConfigUpdateReplyValidationError struct {
+// AdminCreateAccountRequestValidationError is the validation error returned by
+// AdminCreateAccountRequest.Validate if the designated constraints aren't met.
+type AdminCreateAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8021,24 +15483,24 @@ type AdminConfigUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminCreateAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminCreateAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminCreateAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminCreateAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateReplyValidationError"
+func (e AdminCreateAccountRequestValidationError) ErrorName() string {
+	return "AdminCreateAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateReplyValidationError) Error() string {
+func (e AdminCreateAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8050,14 +15512,14 @@ func (e AdminConfigUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		"invalid %sAdminCreateAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateReplyValidationError{}
+var _ error = AdminCreateAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8065,24 +15527,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateReplyValidationError{}
+} = AdminCreateAccountRequestValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateListenRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminCreateAccountReply with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateListenRequest) Validate() error {
+func (m *AdminCreateAccountReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateListenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateListenRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateListenRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminCreateAccountReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCreateAccountReplyMultiError, or nil if none found.
+func (m *AdminCreateAccountReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateListenRequest) validate(all bool) error {
+func (m *AdminCreateAccountReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8090,19 +15552,19 @@ func (m *AdminConfigUpdateListenRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateListenRequestMultiError(errors)
+		return AdminCreateAccountReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateListenRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminConfigUpdateListenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AdminConfigUpdateListenRequestMultiError []error
+// AdminCreateAccountReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminCreateAccountReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCreateAccountReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateListenRequestMultiError) Error() string {
+func (m AdminCreateAccountReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8111,12 +15573,11 @@ func (m AdminConfigUpdateListenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateListenRequestMultiError) AllErrors() []error { return m }
+func (m AdminCreateAccountReplyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateListenRequestValidationError is the validation error
-// returned by AdminConfigUpdateListenRequest.Validate if the designated
-// constraints aren't met.
-type AdminConfigUpdateListenRequestValidationError struct {
+// AdminCreateAccountReplyValidationError is the validation error returned by
+// AdminCreateAccountReply.Validate if the designated constraints aren't met.
+type AdminCreateAccountReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8124,24 +15585,24 @@ type AdminConfigUpdateListenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateListenRequestValidationError) Field() string { return e.field }
+func (e AdminCreateAccountReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateListenRequestValidationError) Reason() string { return e.reason }
+func (e AdminCreateAccountReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateListenRequestValidationError) Cause() error { return e.cause }
+func (e AdminCreateAccountReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateListenRequestValidationError) Key() bool { return e.key }
+func (e AdminCreateAccountReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateListenRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateListenRequestValidationError"
+func (e AdminCreateAccountReplyValidationError) ErrorName() string {
+	return "AdminCreateAccountReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateListenRequestValidationError) Error() string {
+func (e AdminCreateAccountReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8153,14 +15614,14 @@ func (e AdminConfigUpdateListenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateListenRequest.%s: %s%s",
+		"invalid %sAdminCreateAccountReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateListenRequestValidationError{}
+var _ error = AdminCreateAccountReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8168,44 +15629,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateListenRequestValidationError{}
+} = AdminCreateAccountReplyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateListenReply with the
+// Validate checks the field values on AdminDailyLocationRewardRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateListenReply) Validate() error {
+func (m *AdminDailyLocationRewardRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateListenReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDailyLocationRewardRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateListenReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateListenReply) ValidateAll() error {
+// AdminDailyLocationRewardRequestMultiError, or nil if none found.
+func (m *AdminDailyLocationRewardRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateListenReply) validate(all bool) error {
+func (m *AdminDailyLocationRewardRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Date
+
+	// no validation rules for Day
+
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateListenReplyMultiError(errors)
+		return AdminDailyLocationRewardRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateListenReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminConfigUpdateListenReply.ValidateAll() if
-// the designated constraints aren't met.
-type AdminConfigUpdateListenReplyMultiError []error
+// AdminDailyLocationRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyLocationRewardRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyLocationRewardRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateListenReplyMultiError) Error() string {
+func (m AdminDailyLocationRewardRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8214,12 +15681,12 @@ func (m AdminConfigUpdateListenReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateListenReplyMultiError) AllErrors() []error { return m }
+func (m AdminDailyLocationRewardRequestMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateListenReplyValidationError is the validation error returned
-// by AdminConfigUpdateListenReply.Validate if the designated constraints
-// aren't met.
-type AdminConfigUpdateListenReplyValidationError struct {
+// AdminDailyLocationRewardRequestValidationError is the validation error
+// returned by AdminDailyLocationRewardRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyLocationRewardRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8227,24 +15694,24 @@ type AdminConfigUpdateListenReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateListenReplyValidationError) Field() string { return e.field }
+func (e AdminDailyLocationRewardRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateListenReplyValidationError) Reason() string { return e.reason }
+func (e AdminDailyLocationRewardRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateListenReplyValidationError) Cause() error { return e.cause }
+func (e AdminDailyLocationRewardRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateListenReplyValidationError) Key() bool { return e.key }
+func (e AdminDailyLocationRewardRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateListenReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateListenReplyValidationError"
+func (e AdminDailyLocationRewardRequestValidationError) ErrorName() string {
+	return "AdminDailyLocationRewardRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateListenReplyValidationError) Error() string {
+func (e AdminDailyLocationRewardRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8256,14 +15723,14 @@ func (e AdminConfigUpdateListenReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateListenReply.%s: %s%s",
+		"invalid %sAdminDailyLocationRewardRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateListenReplyValidationError{}
+var _ error = AdminDailyLocationRewardRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8271,24 +15738,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateListenReplyValidationError{}
+} = AdminDailyLocationRewardRequestValidationError{}
 
-// Validate checks the field values on AdminPasswordUpdateRequest with the
+// Validate checks the field values on AdminDailyLocationRewardReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminPasswordUpdateRequest) Validate() error {
+func (m *AdminDailyLocationRewardReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminPasswordUpdateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDailyLocationRewardReply with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminPasswordUpdateRequestMultiError, or nil if none found.
-func (m *AdminPasswordUpdateRequest) ValidateAll() error {
+// AdminDailyLocationRewardReplyMultiError, or nil if none found.
+func (m *AdminDailyLocationRewardReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminPasswordUpdateRequest) validate(all bool) error {
+func (m *AdminDailyLocationRewardReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8296,28 +15763,28 @@ func (m *AdminPasswordUpdateRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
+		switch v := interface{}(m.GetReport()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminPasswordUpdateRequestValidationError{
-					field:  "SendBody",
+				errors = append(errors, AdminDailyLocationRewardReplyValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminPasswordUpdateRequestValidationError{
-					field:  "SendBody",
+				errors = append(errors, AdminDailyLocationRewardReplyValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminPasswordUpdateRequestValidationError{
-				field:  "SendBody",
+			return AdminDailyLocationRewardReplyValidationError{
+				field:  "Report",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8325,19 +15792,19 @@ func (m *AdminPasswordUpdateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminPasswordUpdateRequestMultiError(errors)
+		return AdminDailyLocationRewardReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminPasswordUpdateRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminPasswordUpdateRequest.ValidateAll() if
+// AdminDailyLocationRewardReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyLocationRewardReply.ValidateAll() if
 // the designated constraints aren't met.
-type AdminPasswordUpdateRequestMultiError []error
+type AdminDailyLocationRewardReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminPasswordUpdateRequestMultiError) Error() string {
+func (m AdminDailyLocationRewardReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8346,11 +15813,12 @@ func (m AdminPasswordUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminPasswordUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyLocationRewardReplyMultiError) AllErrors() []error { return m }
 
-// AdminPasswordUpdateRequestValidationError is the validation error returned
-// by AdminPasswordUpdateRequest.Validate if the designated constraints aren't met.
-type AdminPasswordUpdateRequestValidationError struct {
+// AdminDailyLocationRewardReplyValidationError is the validation error
+// returned by AdminDailyLocationRewardReply.Validate if the designated
+// constraints aren't met.
+type AdminDailyLocationRewardReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8358,24 +15826,24 @@ type AdminPasswordUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminPasswordUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminDailyLocationRewardReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminPasswordUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyLocationRewardReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminPasswordUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyLocationRewardReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminPasswordUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyLocationRewardReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminPasswordUpdateRequestValidationError) ErrorName() string {
-	return "AdminPasswordUpdateRequestValidationError"
+func (e AdminDailyLocationRewardReplyValidationError) ErrorName() string {
+	return "AdminDailyLocationRewardReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminPasswordUpdateRequestValidationError) Error() string {
+func (e AdminDailyLocationRewardReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8387,14 +15855,14 @@ func (e AdminPasswordUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminPasswordUpdateRequest.%s: %s%s",
+		"invalid %sAdminDailyLocationRewardReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminPasswordUpdateRequestValidationError{}
+var _ error = AdminDailyLocationRewardReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8402,44 +15870,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminPasswordUpdateRequestValidationError{}
+} = AdminDailyLocationRewardReplyValidationError{}
 
-// Validate checks the field values on AdminPasswordUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDailyLocationRewardNewRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminPasswordUpdateReply) Validate() error {
+func (m *AdminDailyLocationRewardNewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminPasswordUpdateReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminPasswordUpdateReplyMultiError, or nil if none found.
-func (m *AdminPasswordUpdateReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyLocationRewardNewRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminDailyLocationRewardNewRequestMultiError, or nil if none found.
+func (m *AdminDailyLocationRewardNewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminPasswordUpdateReply) validate(all bool) error {
+func (m *AdminDailyLocationRewardNewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return AdminPasswordUpdateReplyMultiError(errors)
+		return AdminDailyLocationRewardNewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminPasswordUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminPasswordUpdateReply.ValidateAll() if the designated
+// AdminDailyLocationRewardNewRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminDailyLocationRewardNewRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminPasswordUpdateReplyMultiError []error
+type AdminDailyLocationRewardNewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminPasswordUpdateReplyMultiError) Error() string {
+func (m AdminDailyLocationRewardNewRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8448,11 +15919,12 @@ func (m AdminPasswordUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminPasswordUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminDailyLocationRewardNewRequestMultiError) AllErrors() []error { return m }
 
-// AdminPasswordUpdateReplyValidationError is the validation error returned by
-// AdminPasswordUpdateReply.Validate if the designated constraints aren't met.
-type AdminPasswordUpdateReplyValidationError struct {
+// AdminDailyLocationRewardNewRequestValidationError is the validation error
+// returned by AdminDailyLocationRewardNewRequest.Validate if the designated
+// constraints aren't met.
+type AdminDailyLocationRewardNewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8460,24 +15932,24 @@ type AdminPasswordUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminPasswordUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminDailyLocationRewardNewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminPasswordUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminDailyLocationRewardNewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminPasswordUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminDailyLocationRewardNewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminPasswordUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminDailyLocationRewardNewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminPasswordUpdateReplyValidationError) ErrorName() string {
-	return "AdminPasswordUpdateReplyValidationError"
+func (e AdminDailyLocationRewardNewRequestValidationError) ErrorName() string {
+	return "AdminDailyLocationRewardNewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminPasswordUpdateReplyValidationError) Error() string {
+func (e AdminDailyLocationRewardNewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8489,14 +15961,14 @@ func (e AdminPasswordUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminPasswordUpdateReply.%s: %s%s",
+		"invalid %sAdminDailyLocationRewardNewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminPasswordUpdateReplyValidationError{}
+var _ error = AdminDailyLocationRewardNewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8504,25 +15976,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminPasswordUpdateReplyValidationError{}
+} = AdminDailyLocationRewardNewRequestValidationError{}
 
-// Validate checks the field values on AdminUpdateLocationNewMaxRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminUpdateLocationNewMaxRequest) Validate() error {
+// Validate checks the field values on AdminDailyLocationRewardNewReply with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDailyLocationRewardNewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUpdateLocationNewMaxRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminUpdateLocationNewMaxRequestMultiError, or nil if none found.
-func (m *AdminUpdateLocationNewMaxRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminDailyLocationRewardNewReply with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDailyLocationRewardNewReplyMultiError, or nil if none found.
+func (m *AdminDailyLocationRewardNewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUpdateLocationNewMaxRequest) validate(all bool) error {
+func (m *AdminDailyLocationRewardNewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8530,28 +16001,28 @@ func (m *AdminUpdateLocationNewMaxRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
+		switch v := interface{}(m.GetReport()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminUpdateLocationNewMaxRequestValidationError{
-					field:  "SendBody",
+				errors = append(errors, AdminDailyLocationRewardNewReplyValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminUpdateLocationNewMaxRequestValidationError{
-					field:  "SendBody",
+				errors = append(errors, AdminDailyLocationRewardNewReplyValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminUpdateLocationNewMaxRequestValidationError{
-				field:  "SendBody",
+			return AdminDailyLocationRewardNewReplyValidationError{
+				field:  "Report",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8559,20 +16030,19 @@ func (m *AdminUpdateLocationNewMaxRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminUpdateLocationNewMaxRequestMultiError(errors)
+		return AdminDailyLocationRewardNewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUpdateLocationNewMaxRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminUpdateLocationNewMaxRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUpdateLocationNewMaxRequestMultiError []error
+// AdminDailyLocationRewardNewReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminDailyLocationRewardNewReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminDailyLocationRewardNewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUpdateLocationNewMaxRequestMultiError) Error() string {
+func (m AdminDailyLocationRewardNewReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8581,12 +16051,12 @@ func (m AdminUpdateLocationNewMaxRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUpdateLocationNewMaxRequestMultiError) AllErrors() []error { return m }
+func (m AdminDailyLocationRewardNewReplyMultiError) AllErrors() []error { return m }
 
-// AdminUpdateLocationNewMaxRequestValidationError is the validation error
-// returned by AdminUpdateLocationNewMaxRequest.Validate if the designated
+// AdminDailyLocationRewardNewReplyValidationError is the validation error
+// returned by AdminDailyLocationRewardNewReply.Validate if the designated
 // constraints aren't met.
-type AdminUpdateLocationNewMaxRequestValidationError struct {
+type AdminDailyLocationRewardNewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8594,24 +16064,24 @@ type AdminUpdateLocationNewMaxRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUpdateLocationNewMaxRequestValidationError) Field() string { return e.field }
+func (e AdminDailyLocationRewardNewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUpdateLocationNewMaxRequestValidationError) Reason() string { return e.reason }
+func (e AdminDailyLocationRewardNewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUpdateLocationNewMaxRequestValidationError) Cause() error { return e.cause }
+func (e AdminDailyLocationRewardNewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUpdateLocationNewMaxRequestValidationError) Key() bool { return e.key }
+func (e AdminDailyLocationRewardNewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUpdateLocationNewMaxRequestValidationError) ErrorName() string {
-	return "AdminUpdateLocationNewMaxRequestValidationError"
+func (e AdminDailyLocationRewardNewReplyValidationError) ErrorName() string {
+	return "AdminDailyLocationRewardNewReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUpdateLocationNewMaxRequestValidationError) Error() string {
+func (e AdminDailyLocationRewardNewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8623,14 +16093,14 @@ func (e AdminUpdateLocationNewMaxRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUpdateLocationNewMaxRequest.%s: %s%s",
+		"invalid %sAdminDailyLocationRewardNewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUpdateLocationNewMaxRequestValidationError{}
+var _ error = AdminDailyLocationRewardNewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8638,44 +16108,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUpdateLocationNewMaxRequestValidationError{}
+} = AdminDailyLocationRewardNewReplyValidationError{}
 
-// Validate checks the field values on AdminUpdateLocationNewMaxReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUpdateLocationNewMaxReply) Validate() error {
+// Validate checks the field values on AdminRewardRunRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUpdateLocationNewMaxReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminUpdateLocationNewMaxReplyMultiError, or nil if none found.
-func (m *AdminUpdateLocationNewMaxReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminRewardRunRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminRewardRunRequestMultiError, or nil if none found.
+func (m *AdminRewardRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUpdateLocationNewMaxReply) validate(all bool) error {
+func (m *AdminRewardRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Job
+
+	// no validation rules for Date
+
+	// no validation rules for Day
+
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return AdminUpdateLocationNewMaxReplyMultiError(errors)
+		return AdminRewardRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUpdateLocationNewMaxReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminUpdateLocationNewMaxReply.ValidateAll()
-// if the designated constraints aren't met.
-type AdminUpdateLocationNewMaxReplyMultiError []error
+// AdminRewardRunRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUpdateLocationNewMaxReplyMultiError) Error() string {
+func (m AdminRewardRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8684,12 +16162,11 @@ func (m AdminUpdateLocationNewMaxReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUpdateLocationNewMaxReplyMultiError) AllErrors() []error { return m }
+func (m AdminRewardRunRequestMultiError) AllErrors() []error { return m }
 
-// AdminUpdateLocationNewMaxReplyValidationError is the validation error
-// returned by AdminUpdateLocationNewMaxReply.Validate if the designated
-// constraints aren't met.
-type AdminUpdateLocationNewMaxReplyValidationError struct {
+// AdminRewardRunRequestValidationError is the validation error returned by
+// AdminRewardRunRequest.Validate if the designated constraints aren't met.
+type AdminRewardRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8697,24 +16174,24 @@ type AdminUpdateLocationNewMaxReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUpdateLocationNewMaxReplyValidationError) Field() string { return e.field }
+func (e AdminRewardRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUpdateLocationNewMaxReplyValidationError) Reason() string { return e.reason }
+func (e AdminRewardRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUpdateLocationNewMaxReplyValidationError) Cause() error { return e.cause }
+func (e AdminRewardRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUpdateLocationNewMaxReplyValidationError) Key() bool { return e.key }
+func (e AdminRewardRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUpdateLocationNewMaxReplyValidationError) ErrorName() string {
-	return "AdminUpdateLocationNewMaxReplyValidationError"
+func (e AdminRewardRunRequestValidationError) ErrorName() string {
+	return "AdminRewardRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUpdateLocationNewMaxReplyValidationError) Error() string {
+func (e AdminRewardRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8726,14 +16203,14 @@ func (e AdminUpdateLocationNewMaxReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUpdateLocationNewMaxReply.%s: %s%s",
+		"invalid %sAdminRewardRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUpdateLocationNewMaxReplyValidationError{}
+var _ error = AdminRewardRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8741,24 +16218,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUpdateLocationNewMaxReplyValidationError{}
+} = AdminRewardRunRequestValidationError{}
 
-// Validate checks the field values on AdminVipDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipDeleteRequest) Validate() error {
+// Validate checks the field values on AdminRewardRunReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardRunReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminVipDeleteRequestMultiError, or nil if none found.
-func (m *AdminVipDeleteRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminRewardRunReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminRewardRunReplyMultiError, or nil if none found.
+func (m *AdminRewardRunReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipDeleteRequest) validate(all bool) error {
+func (m *AdminRewardRunReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8766,28 +16243,28 @@ func (m *AdminVipDeleteRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
+		switch v := interface{}(m.GetReport()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminVipDeleteRequestValidationError{
-					field:  "SendBody",
+				errors = append(errors, AdminRewardRunReplyValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminVipDeleteRequestValidationError{
-					field:  "SendBody",
+				errors = append(errors, AdminRewardRunReplyValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminVipDeleteRequestValidationError{
-				field:  "SendBody",
+			return AdminRewardRunReplyValidationError{
+				field:  "Report",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8795,19 +16272,19 @@ func (m *AdminVipDeleteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminVipDeleteRequestMultiError(errors)
+		return AdminRewardRunReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminVipDeleteRequest.ValidateAll() if the designated
+// AdminRewardRunReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardRunReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminVipDeleteRequestMultiError []error
+type AdminRewardRunReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipDeleteRequestMultiError) Error() string {
+func (m AdminRewardRunReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8816,11 +16293,11 @@ func (m AdminVipDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminRewardRunReplyMultiError) AllErrors() []error { return m }
 
-// AdminVipDeleteRequestValidationError is the validation error returned by
-// AdminVipDeleteRequest.Validate if the designated constraints aren't met.
-type AdminVipDeleteRequestValidationError struct {
+// AdminRewardRunReplyValidationError is the validation error returned by
+// AdminRewardRunReply.Validate if the designated constraints aren't met.
+type AdminRewardRunReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8828,24 +16305,24 @@ type AdminVipDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminRewardRunReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminRewardRunReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminRewardRunReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminRewardRunReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipDeleteRequestValidationError) ErrorName() string {
-	return "AdminVipDeleteRequestValidationError"
+func (e AdminRewardRunReplyValidationError) ErrorName() string {
+	return "AdminRewardRunReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipDeleteRequestValidationError) Error() string {
+func (e AdminRewardRunReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8857,14 +16334,14 @@ func (e AdminVipDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipDeleteRequest.%s: %s%s",
+		"invalid %sAdminRewardRunReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipDeleteRequestValidationError{}
+var _ error = AdminRewardRunReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8872,44 +16349,140 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipDeleteRequestValidationError{}
+} = AdminRewardRunReplyValidationError{}
 
-// Validate checks the field values on AdminVipDeleteReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipDeleteReply) Validate() error {
+// Validate checks the field values on RewardReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RewardReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipDeleteReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminVipDeleteReplyMultiError, or nil if none found.
-func (m *AdminVipDeleteReply) ValidateAll() error {
+// ValidateAll checks the field values on RewardReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RewardReportMultiError, or
+// nil if none found.
+func (m *RewardReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipDeleteReply) validate(all bool) error {
+func (m *RewardReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Job
+
+	// no validation rules for DryRun
+
+	// no validation rules for Count
+
+	// no validation rules for Budget
+
+	// no validation rules for Amount
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardReportValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardReportValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RewardReportValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetVips() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardReportValidationError{
+						field:  fmt.Sprintf("Vips[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardReportValidationError{
+						field:  fmt.Sprintf("Vips[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RewardReportValidationError{
+					field:  fmt.Sprintf("Vips[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RunId
+
+	// no validation rules for Status
+
+	// no validation rules for Resumed
+
+	// no validation rules for Chunks
+
+	// no validation rules for Statements
+
+	// no validation rules for ElapsedMs
+
+	// no validation rules for ItemsPerSecond
+
+	// no validation rules for Excluded
+
+	// no validation rules for Error
+
 	if len(errors) > 0 {
-		return AdminVipDeleteReplyMultiError(errors)
+		return RewardReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipDeleteReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminVipDeleteReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminVipDeleteReplyMultiError []error
+// RewardReportMultiError is an error wrapping multiple validation errors
+// returned by RewardReport.ValidateAll() if the designated constraints aren't
+// met.
+type RewardReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipDeleteReplyMultiError) Error() string {
+func (m RewardReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8918,11 +16491,11 @@ func (m AdminVipDeleteReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipDeleteReplyMultiError) AllErrors() []error { return m }
+func (m RewardReportMultiError) AllErrors() []error { return m }
 
-// AdminVipDeleteReplyValidationError is the validation error returned by
-// AdminVipDeleteReply.Validate if the designated constraints aren't met.
-type AdminVipDeleteReplyValidationError struct {
+// RewardReportValidationError is the validation error returned by
+// RewardReport.Validate if the designated constraints aren't met.
+type RewardReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8930,24 +16503,22 @@ type AdminVipDeleteReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipDeleteReplyValidationError) Field() string { return e.field }
+func (e RewardReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipDeleteReplyValidationError) Reason() string { return e.reason }
+func (e RewardReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipDeleteReplyValidationError) Cause() error { return e.cause }
+func (e RewardReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipDeleteReplyValidationError) Key() bool { return e.key }
+func (e RewardReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipDeleteReplyValidationError) ErrorName() string {
-	return "AdminVipDeleteReplyValidationError"
-}
+func (e RewardReportValidationError) ErrorName() string { return "RewardReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminVipDeleteReplyValidationError) Error() string {
+func (e RewardReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8959,14 +16530,14 @@ func (e AdminVipDeleteReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipDeleteReply.%s: %s%s",
+		"invalid %sRewardReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipDeleteReplyValidationError{}
+var _ error = RewardReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -8974,35 +16545,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipDeleteReplyValidationError{}
+} = RewardReportValidationError{}
 
-// Validate checks the field values on AdminVipUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminRewardRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipUpdateRequest) Validate() error {
+func (m *AdminRewardRollbackRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRewardRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminVipUpdateRequestMultiError, or nil if none found.
-func (m *AdminVipUpdateRequest) ValidateAll() error {
+// AdminRewardRollbackRequestMultiError, or nil if none found.
+func (m *AdminRewardRollbackRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipUpdateRequest) validate(all bool) error {
+func (m *AdminRewardRollbackRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := AdminRewardRollbackRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminVipUpdateRequestValidationError{
+				errors = append(errors, AdminRewardRollbackRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9010,7 +16592,7 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminVipUpdateRequestValidationError{
+				errors = append(errors, AdminRewardRollbackRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9019,7 +16601,7 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminVipUpdateRequestValidationError{
+			return AdminRewardRollbackRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9028,19 +16610,19 @@ func (m *AdminVipUpdateRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminVipUpdateRequestMultiError(errors)
+		return AdminRewardRollbackRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminVipUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminVipUpdateRequestMultiError []error
+// AdminRewardRollbackRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminRewardRollbackRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminRewardRollbackRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipUpdateRequestMultiError) Error() string {
+func (m AdminRewardRollbackRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9049,11 +16631,12 @@ func (m AdminVipUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminRewardRollbackRequestMultiError) AllErrors() []error { return m }
 
-// AdminVipUpdateRequestValidationError is the validation error returned by
-// AdminVipUpdateRequest.Validate if the designated constraints aren't met.
-type AdminVipUpdateRequestValidationError struct {
+// AdminRewardRollbackRequestValidationError is the validation error returned
+// by AdminRewardRollbackRequest.Validate if the designated constraints aren't
+// met.
+type AdminRewardRollbackRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9061,24 +16644,24 @@ type AdminVipUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminRewardRollbackRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminRewardRollbackRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminRewardRollbackRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminRewardRollbackRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipUpdateRequestValidationError) ErrorName() string {
-	return "AdminVipUpdateRequestValidationError"
+func (e AdminRewardRollbackRequestValidationError) ErrorName() string {
+	return "AdminRewardRollbackRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipUpdateRequestValidationError) Error() string {
+func (e AdminRewardRollbackRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9090,14 +16673,14 @@ func (e AdminVipUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipUpdateRequest.%s: %s%s",
+		"invalid %sAdminRewardRollbackRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipUpdateRequestValidationError{}
+var _ error = AdminRewardRollbackRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9105,44 +16688,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipUpdateRequestValidationError{}
+} = AdminRewardRollbackRequestValidationError{}
 
-// Validate checks the field values on AdminVipUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipUpdateReply) Validate() error {
+// Validate checks the field values on AdminRewardRollbackReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardRollbackReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRewardRollbackReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminVipUpdateReplyMultiError, or nil if none found.
-func (m *AdminVipUpdateReply) ValidateAll() error {
+// AdminRewardRollbackReplyMultiError, or nil if none found.
+func (m *AdminRewardRollbackReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipUpdateReply) validate(all bool) error {
+func (m *AdminRewardRollbackReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminRewardRollbackReplyValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminRewardRollbackReplyValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminRewardRollbackReplyValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AdminVipUpdateReplyMultiError(errors)
+		return AdminRewardRollbackReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminVipUpdateReply.ValidateAll() if the designated
+// AdminRewardRollbackReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardRollbackReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminVipUpdateReplyMultiError []error
+type AdminRewardRollbackReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipUpdateReplyMultiError) Error() string {
+func (m AdminRewardRollbackReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9151,11 +16763,11 @@ func (m AdminVipUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminRewardRollbackReplyMultiError) AllErrors() []error { return m }
 
-// AdminVipUpdateReplyValidationError is the validation error returned by
-// AdminVipUpdateReply.Validate if the designated constraints aren't met.
-type AdminVipUpdateReplyValidationError struct {
+// AdminRewardRollbackReplyValidationError is the validation error returned by
+// AdminRewardRollbackReply.Validate if the designated constraints aren't met.
+type AdminRewardRollbackReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9163,24 +16775,24 @@ type AdminVipUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminRewardRollbackReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminRewardRollbackReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminRewardRollbackReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminRewardRollbackReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipUpdateReplyValidationError) ErrorName() string {
-	return "AdminVipUpdateReplyValidationError"
+func (e AdminRewardRollbackReplyValidationError) ErrorName() string {
+	return "AdminRewardRollbackReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipUpdateReplyValidationError) Error() string {
+func (e AdminRewardRollbackReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9192,14 +16804,14 @@ func (e AdminVipUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipUpdateReply.%s: %s%s",
+		"invalid %sAdminRewardRollbackReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipUpdateReplyValidationError{}
+var _ error = AdminRewardRollbackReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9207,73 +16819,114 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipUpdateReplyValidationError{}
+} = AdminRewardRollbackReplyValidationError{}
 
-// Validate checks the field values on AdminKkdtUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminKkdtUpdateRequest) Validate() error {
+// Validate checks the field values on RewardRollbackReport with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RewardRollbackReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminKkdtUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminKkdtUpdateRequestMultiError, or nil if none found.
-func (m *AdminKkdtUpdateRequest) ValidateAll() error {
+// ValidateAll checks the field values on RewardRollbackReport with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RewardRollbackReportMultiError, or nil if none found.
+func (m *RewardRollbackReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminKkdtUpdateRequest) validate(all bool) error {
+func (m *RewardRollbackReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminKkdtUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Reason
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardRollbackReportValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardRollbackReportValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminKkdtUpdateRequestValidationError{
-					field:  "SendBody",
+				return RewardRollbackReportValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminKkdtUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RewardRollbackReportValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RewardRollbackReportValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RewardRollbackReportValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return AdminKkdtUpdateRequestMultiError(errors)
+		return RewardRollbackReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminKkdtUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminKkdtUpdateRequest.ValidateAll() if the designated
+// RewardRollbackReportMultiError is an error wrapping multiple validation
+// errors returned by RewardRollbackReport.ValidateAll() if the designated
 // constraints aren't met.
-type AdminKkdtUpdateRequestMultiError []error
+type RewardRollbackReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminKkdtUpdateRequestMultiError) Error() string {
+func (m RewardRollbackReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9282,11 +16935,11 @@ func (m AdminKkdtUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminKkdtUpdateRequestMultiError) AllErrors() []error { return m }
+func (m RewardRollbackReportMultiError) AllErrors() []error { return m }
 
-// AdminKkdtUpdateRequestValidationError is the validation error returned by
-// AdminKkdtUpdateRequest.Validate if the designated constraints aren't met.
-type AdminKkdtUpdateRequestValidationError struct {
+// RewardRollbackReportValidationError is the validation error returned by
+// RewardRollbackReport.Validate if the designated constraints aren't met.
+type RewardRollbackReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9294,24 +16947,24 @@ type AdminKkdtUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminKkdtUpdateRequestValidationError) Field() string { return e.field }
+func (e RewardRollbackReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminKkdtUpdateRequestValidationError) Reason() string { return e.reason }
+func (e RewardRollbackReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminKkdtUpdateRequestValidationError) Cause() error { return e.cause }
+func (e RewardRollbackReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminKkdtUpdateRequestValidationError) Key() bool { return e.key }
+func (e RewardRollbackReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminKkdtUpdateRequestValidationError) ErrorName() string {
-	return "AdminKkdtUpdateRequestValidationError"
+func (e RewardRollbackReportValidationError) ErrorName() string {
+	return "RewardRollbackReportValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminKkdtUpdateRequestValidationError) Error() string {
+func (e RewardRollbackReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9323,14 +16976,14 @@ func (e AdminKkdtUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminKkdtUpdateRequest.%s: %s%s",
+		"invalid %sRewardRollbackReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminKkdtUpdateRequestValidationError{}
+var _ error = RewardRollbackReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -9338,44 +16991,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminKkdtUpdateRequestValidationError{}
+} = RewardRollbackReportValidationError{}
 
-// Validate checks the field values on AdminKkdtUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationTreeCheckRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminKkdtUpdateReply) Validate() error {
+func (m *AdminLocationTreeCheckRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminKkdtUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationTreeCheckRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminKkdtUpdateReplyMultiError, or nil if none found.
-func (m *AdminKkdtUpdateReply) ValidateAll() error {
+// AdminLocationTreeCheckRequestMultiError, or nil if none found.
+func (m *AdminLocationTreeCheckRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminKkdtUpdateReply) validate(all bool) error {
+func (m *AdminLocationTreeCheckRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Fix
+
 	if len(errors) > 0 {
-		return AdminKkdtUpdateReplyMultiError(errors)
+		return AdminLocationTreeCheckRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminKkdtUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminKkdtUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminKkdtUpdateReplyMultiError []error
+// AdminLocationTreeCheckRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationTreeCheckRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationTreeCheckRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminKkdtUpdateReplyMultiError) Error() string {
+func (m AdminLocationTreeCheckRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9384,11 +17039,12 @@ func (m AdminKkdtUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminKkdtUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationTreeCheckRequestMultiError) AllErrors() []error { return m }
 
-// AdminKkdtUpdateReplyValidationError is the validation error returned by
-// AdminKkdtUpdateReply.Validate if the designated constraints aren't met.
-type AdminKkdtUpdateReplyValidationError struct {
+// AdminLocationTreeCheckRequestValidationError is the validation error
+// returned by AdminLocationTreeCheckRequest.Validate if the designated
+// constraints aren't met.
+type AdminLocationTreeCheckRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9396,24 +17052,24 @@ type AdminKkdtUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminKkdtUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminLocationTreeCheckRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminKkdtUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationTreeCheckRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminKkdtUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationTreeCheckRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminKkdtUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationTreeCheckRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminKkdtUpdateReplyValidationError) ErrorName() string {
-	return "AdminKkdtUpdateReplyValidationError"
+func (e AdminLocationTreeCheckRequestValidationError) ErrorName() string {
+	return "AdminLocationTreeCheckRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminKkdtUpdateReplyValidationError) Error() string {
+func (e AdminLocationTreeCheckRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9425,14 +17081,14 @@ func (e AdminKkdtUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminKkdtUpdateReply.%s: %s%s",
+		"invalid %sAdminLocationTreeCheckRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminKkdtUpdateReplyValidationError{}
+var _ error = AdminLocationTreeCheckRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9440,73 +17096,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminKkdtUpdateReplyValidationError{}
+} = AdminLocationTreeCheckRequestValidationError{}
 
-// Validate checks the field values on AdminUndoUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationTreeCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUndoUpdateRequest) Validate() error {
+func (m *AdminLocationTreeCheckReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUndoUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationTreeCheckReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUndoUpdateRequestMultiError, or nil if none found.
-func (m *AdminUndoUpdateRequest) ValidateAll() error {
+// AdminLocationTreeCheckReplyMultiError, or nil if none found.
+func (m *AdminLocationTreeCheckReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUndoUpdateRequest) validate(all bool) error {
+func (m *AdminLocationTreeCheckReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminUndoUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Count
+
+	// no validation rules for Paths
+
+	// no validation rules for Fixed
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationTreeCheckReplyValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationTreeCheckReplyValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminUndoUpdateRequestValidationError{
-					field:  "SendBody",
+				return AdminLocationTreeCheckReplyValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminUndoUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return AdminUndoUpdateRequestMultiError(errors)
+		return AdminLocationTreeCheckReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUndoUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUndoUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUndoUpdateRequestMultiError []error
+// AdminLocationTreeCheckReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationTreeCheckReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationTreeCheckReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUndoUpdateRequestMultiError) Error() string {
+func (m AdminLocationTreeCheckReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9515,11 +17182,12 @@ func (m AdminUndoUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUndoUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationTreeCheckReplyMultiError) AllErrors() []error { return m }
 
-// AdminUndoUpdateRequestValidationError is the validation error returned by
-// AdminUndoUpdateRequest.Validate if the designated constraints aren't met.
-type AdminUndoUpdateRequestValidationError struct {
+// AdminLocationTreeCheckReplyValidationError is the validation error returned
+// by AdminLocationTreeCheckReply.Validate if the designated constraints aren't
+// met.
+type AdminLocationTreeCheckReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9527,24 +17195,24 @@ type AdminUndoUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUndoUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminLocationTreeCheckReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUndoUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationTreeCheckReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUndoUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationTreeCheckReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUndoUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationTreeCheckReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUndoUpdateRequestValidationError) ErrorName() string {
-	return "AdminUndoUpdateRequestValidationError"
+func (e AdminLocationTreeCheckReplyValidationError) ErrorName() string {
+	return "AdminLocationTreeCheckReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUndoUpdateRequestValidationError) Error() string {
+func (e AdminLocationTreeCheckReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9556,14 +17224,14 @@ func (e AdminUndoUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUndoUpdateRequest.%s: %s%s",
+		"invalid %sAdminLocationTreeCheckReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUndoUpdateRequestValidationError{}
+var _ error = AdminLocationTreeCheckReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9571,44 +17239,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUndoUpdateRequestValidationError{}
+} = AdminLocationTreeCheckReplyValidationError{}
 
-// Validate checks the field values on AdminUndoUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUndoUpdateReply) Validate() error {
+// Validate checks the field values on LocationProduct with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LocationProduct) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUndoUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUndoUpdateReplyMultiError, or nil if none found.
-func (m *AdminUndoUpdateReply) ValidateAll() error {
+// ValidateAll checks the field values on LocationProduct with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// LocationProductMultiError, or nil if none found.
+func (m *LocationProduct) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUndoUpdateReply) validate(all bool) error {
+func (m *LocationProduct) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for MinAmount
+
+	// no validation rules for MaxAmount
+
+	// no validation rules for OutRate
+
+	// no validation rules for TimeAgain
+
+	// no validation rules for Sort
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminUndoUpdateReplyMultiError(errors)
+		return LocationProductMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUndoUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUndoUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUndoUpdateReplyMultiError []error
+// LocationProductMultiError is an error wrapping multiple validation errors
+// returned by LocationProduct.ValidateAll() if the designated constraints
+// aren't met.
+type LocationProductMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUndoUpdateReplyMultiError) Error() string {
+func (m LocationProductMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9617,11 +17303,11 @@ func (m AdminUndoUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUndoUpdateReplyMultiError) AllErrors() []error { return m }
+func (m LocationProductMultiError) AllErrors() []error { return m }
 
-// AdminUndoUpdateReplyValidationError is the validation error returned by
-// AdminUndoUpdateReply.Validate if the designated constraints aren't met.
-type AdminUndoUpdateReplyValidationError struct {
+// LocationProductValidationError is the validation error returned by
+// LocationProduct.Validate if the designated constraints aren't met.
+type LocationProductValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9629,24 +17315,22 @@ type AdminUndoUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUndoUpdateReplyValidationError) Field() string { return e.field }
+func (e LocationProductValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUndoUpdateReplyValidationError) Reason() string { return e.reason }
+func (e LocationProductValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUndoUpdateReplyValidationError) Cause() error { return e.cause }
+func (e LocationProductValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUndoUpdateReplyValidationError) Key() bool { return e.key }
+func (e LocationProductValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUndoUpdateReplyValidationError) ErrorName() string {
-	return "AdminUndoUpdateReplyValidationError"
-}
+func (e LocationProductValidationError) ErrorName() string { return "LocationProductValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminUndoUpdateReplyValidationError) Error() string {
+func (e LocationProductValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9658,14 +17342,14 @@ func (e AdminUndoUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUndoUpdateReply.%s: %s%s",
+		"invalid %sLocationProduct.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUndoUpdateReplyValidationError{}
+var _ error = LocationProductValidationError{}
 
 var _ interface {
 	Field() string
@@ -9673,73 +17357,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUndoUpdateReplyValidationError{}
+} = LocationProductValidationError{}
 
-// Validate checks the field values on AdminAreaLevelUpdateRequest with the
+// Validate checks the field values on AdminLocationProductListRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminAreaLevelUpdateRequest) Validate() error {
+func (m *AdminLocationProductListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAreaLevelUpdateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationProductListRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAreaLevelUpdateRequestMultiError, or nil if none found.
-func (m *AdminAreaLevelUpdateRequest) ValidateAll() error {
+// AdminLocationProductListRequestMultiError, or nil if none found.
+func (m *AdminLocationProductListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAreaLevelUpdateRequest) validate(all bool) error {
+func (m *AdminLocationProductListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminAreaLevelUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminAreaLevelUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AdminAreaLevelUpdateRequestMultiError(errors)
+		return AdminLocationProductListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAreaLevelUpdateRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminAreaLevelUpdateRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminAreaLevelUpdateRequestMultiError []error
+// AdminLocationProductListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationProductListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationProductListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAreaLevelUpdateRequestMultiError) Error() string {
+func (m AdminLocationProductListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9748,12 +17403,12 @@ func (m AdminAreaLevelUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAreaLevelUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationProductListRequestMultiError) AllErrors() []error { return m }
 
-// AdminAreaLevelUpdateRequestValidationError is the validation error returned
-// by AdminAreaLevelUpdateRequest.Validate if the designated constraints
-// aren't met.
-type AdminAreaLevelUpdateRequestValidationError struct {
+// AdminLocationProductListRequestValidationError is the validation error
+// returned by AdminLocationProductListRequest.Validate if the designated
+// constraints aren't met.
+type AdminLocationProductListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9761,24 +17416,24 @@ type AdminAreaLevelUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAreaLevelUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminLocationProductListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAreaLevelUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationProductListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAreaLevelUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationProductListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAreaLevelUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationProductListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAreaLevelUpdateRequestValidationError) ErrorName() string {
-	return "AdminAreaLevelUpdateRequestValidationError"
+func (e AdminLocationProductListRequestValidationError) ErrorName() string {
+	return "AdminLocationProductListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminAreaLevelUpdateRequestValidationError) Error() string {
+func (e AdminLocationProductListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9790,14 +17445,14 @@ func (e AdminAreaLevelUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAreaLevelUpdateRequest.%s: %s%s",
+		"invalid %sAdminLocationProductListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAreaLevelUpdateRequestValidationError{}
+var _ error = AdminLocationProductListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9805,44 +17460,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAreaLevelUpdateRequestValidationError{}
+} = AdminLocationProductListRequestValidationError{}
 
-// Validate checks the field values on AdminAreaLevelUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationProductListReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminAreaLevelUpdateReply) Validate() error {
+func (m *AdminLocationProductListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAreaLevelUpdateReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationProductListReply with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAreaLevelUpdateReplyMultiError, or nil if none found.
-func (m *AdminAreaLevelUpdateReply) ValidateAll() error {
+// AdminLocationProductListReplyMultiError, or nil if none found.
+func (m *AdminLocationProductListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAreaLevelUpdateReply) validate(all bool) error {
+func (m *AdminLocationProductListReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationProductListReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationProductListReplyValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationProductListReplyValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AdminAreaLevelUpdateReplyMultiError(errors)
+		return AdminLocationProductListReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAreaLevelUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminAreaLevelUpdateReply.ValidateAll() if the
-// designated constraints aren't met.
-type AdminAreaLevelUpdateReplyMultiError []error
+// AdminLocationProductListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationProductListReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationProductListReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAreaLevelUpdateReplyMultiError) Error() string {
+func (m AdminLocationProductListReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9851,11 +17540,12 @@ func (m AdminAreaLevelUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAreaLevelUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationProductListReplyMultiError) AllErrors() []error { return m }
 
-// AdminAreaLevelUpdateReplyValidationError is the validation error returned by
-// AdminAreaLevelUpdateReply.Validate if the designated constraints aren't met.
-type AdminAreaLevelUpdateReplyValidationError struct {
+// AdminLocationProductListReplyValidationError is the validation error
+// returned by AdminLocationProductListReply.Validate if the designated
+// constraints aren't met.
+type AdminLocationProductListReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9863,24 +17553,24 @@ type AdminAreaLevelUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAreaLevelUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminLocationProductListReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAreaLevelUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationProductListReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAreaLevelUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationProductListReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAreaLevelUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationProductListReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAreaLevelUpdateReplyValidationError) ErrorName() string {
-	return "AdminAreaLevelUpdateReplyValidationError"
+func (e AdminLocationProductListReplyValidationError) ErrorName() string {
+	return "AdminLocationProductListReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminAreaLevelUpdateReplyValidationError) Error() string {
+func (e AdminLocationProductListReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9892,14 +17582,14 @@ func (e AdminAreaLevelUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAreaLevelUpdateReply.%s: %s%s",
+		"invalid %sAdminLocationProductListReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAreaLevelUpdateReplyValidationError{}
+var _ error = AdminLocationProductListReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9907,24 +17597,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAreaLevelUpdateReplyValidationError{}
+} = AdminLocationProductListReplyValidationError{}
 
-// Validate checks the field values on AdminLocationInsertRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationProductUpdateRequest with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationInsertRequest) Validate() error {
+func (m *AdminLocationProductUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationInsertRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLocationInsertRequestMultiError, or nil if none found.
-func (m *AdminLocationInsertRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminLocationProductUpdateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationProductUpdateRequestMultiError, or nil if none found.
+func (m *AdminLocationProductUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationInsertRequest) validate(all bool) error {
+func (m *AdminLocationProductUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9935,7 +17625,7 @@ func (m *AdminLocationInsertRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminLocationInsertRequestValidationError{
+				errors = append(errors, AdminLocationProductUpdateRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9943,7 +17633,7 @@ func (m *AdminLocationInsertRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminLocationInsertRequestValidationError{
+				errors = append(errors, AdminLocationProductUpdateRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9952,7 +17642,7 @@ func (m *AdminLocationInsertRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminLocationInsertRequestValidationError{
+			return AdminLocationProductUpdateRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9961,19 +17651,20 @@ func (m *AdminLocationInsertRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminLocationInsertRequestMultiError(errors)
+		return AdminLocationProductUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationInsertRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminLocationInsertRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminLocationInsertRequestMultiError []error
+// AdminLocationProductUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationProductUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationProductUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationInsertRequestMultiError) Error() string {
+func (m AdminLocationProductUpdateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9982,11 +17673,12 @@ func (m AdminLocationInsertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationInsertRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationProductUpdateRequestMultiError) AllErrors() []error { return m }
 
-// AdminLocationInsertRequestValidationError is the validation error returned
-// by AdminLocationInsertRequest.Validate if the designated constraints aren't met.
-type AdminLocationInsertRequestValidationError struct {
+// AdminLocationProductUpdateRequestValidationError is the validation error
+// returned by AdminLocationProductUpdateRequest.Validate if the designated
+// constraints aren't met.
+type AdminLocationProductUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9994,24 +17686,24 @@ type AdminLocationInsertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationInsertRequestValidationError) Field() string { return e.field }
+func (e AdminLocationProductUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationInsertRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationProductUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationInsertRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationProductUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationInsertRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationProductUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationInsertRequestValidationError) ErrorName() string {
-	return "AdminLocationInsertRequestValidationError"
+func (e AdminLocationProductUpdateRequestValidationError) ErrorName() string {
+	return "AdminLocationProductUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationInsertRequestValidationError) Error() string {
+func (e AdminLocationProductUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10023,14 +17715,14 @@ func (e AdminLocationInsertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationInsertRequest.%s: %s%s",
+		"invalid %sAdminLocationProductUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationInsertRequestValidationError{}
+var _ error = AdminLocationProductUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10038,44 +17730,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationInsertRequestValidationError{}
+} = AdminLocationProductUpdateRequestValidationError{}
 
-// Validate checks the field values on AdminLocationInsertReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationProductUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationInsertReply) Validate() error {
+func (m *AdminLocationProductUpdateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationInsertReply with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationProductUpdateReply with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationInsertReplyMultiError, or nil if none found.
-func (m *AdminLocationInsertReply) ValidateAll() error {
+// AdminLocationProductUpdateReplyMultiError, or nil if none found.
+func (m *AdminLocationProductUpdateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationInsertReply) validate(all bool) error {
+func (m *AdminLocationProductUpdateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return AdminLocationInsertReplyMultiError(errors)
+		return AdminLocationProductUpdateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationInsertReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationInsertReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationInsertReplyMultiError []error
+// AdminLocationProductUpdateReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationProductUpdateReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationProductUpdateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationInsertReplyMultiError) Error() string {
+func (m AdminLocationProductUpdateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10084,11 +17778,12 @@ func (m AdminLocationInsertReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationInsertReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationProductUpdateReplyMultiError) AllErrors() []error { return m }
 
-// AdminLocationInsertReplyValidationError is the validation error returned by
-// AdminLocationInsertReply.Validate if the designated constraints aren't met.
-type AdminLocationInsertReplyValidationError struct {
+// AdminLocationProductUpdateReplyValidationError is the validation error
+// returned by AdminLocationProductUpdateReply.Validate if the designated
+// constraints aren't met.
+type AdminLocationProductUpdateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10096,24 +17791,24 @@ type AdminLocationInsertReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationInsertReplyValidationError) Field() string { return e.field }
+func (e AdminLocationProductUpdateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationInsertReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationProductUpdateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationInsertReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationProductUpdateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationInsertReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationProductUpdateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationInsertReplyValidationError) ErrorName() string {
-	return "AdminLocationInsertReplyValidationError"
+func (e AdminLocationProductUpdateReplyValidationError) ErrorName() string {
+	return "AdminLocationProductUpdateReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationInsertReplyValidationError) Error() string {
+func (e AdminLocationProductUpdateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10125,14 +17820,14 @@ func (e AdminLocationInsertReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationInsertReply.%s: %s%s",
+		"invalid %sAdminLocationProductUpdateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationInsertReplyValidationError{}
+var _ error = AdminLocationProductUpdateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10140,73 +17835,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationInsertReplyValidationError{}
+} = AdminLocationProductUpdateReplyValidationError{}
 
-// Validate checks the field values on AdminBalanceUpdateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminBalanceUpdateRequest) Validate() error {
+func (m *AdminLocationHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminBalanceUpdateRequest with the
+// ValidateAll checks the field values on AdminLocationHistoryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminBalanceUpdateRequestMultiError, or nil if none found.
-func (m *AdminBalanceUpdateRequest) ValidateAll() error {
+// AdminLocationHistoryRequestMultiError, or nil if none found.
+func (m *AdminLocationHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminBalanceUpdateRequest) validate(all bool) error {
+func (m *AdminLocationHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminBalanceUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminBalanceUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetLocationId() <= 0 {
+		err := AdminLocationHistoryRequestValidationError{
+			field:  "LocationId",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminBalanceUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Table
+
 	if len(errors) > 0 {
-		return AdminBalanceUpdateRequestMultiError(errors)
+		return AdminLocationHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminBalanceUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminBalanceUpdateRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminBalanceUpdateRequestMultiError []error
+// AdminLocationHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLocationHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminBalanceUpdateRequestMultiError) Error() string {
+func (m AdminLocationHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10215,11 +17894,12 @@ func (m AdminBalanceUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminBalanceUpdateRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationHistoryRequestMultiError) AllErrors() []error { return m }
 
-// AdminBalanceUpdateRequestValidationError is the validation error returned by
-// AdminBalanceUpdateRequest.Validate if the designated constraints aren't met.
-type AdminBalanceUpdateRequestValidationError struct {
+// AdminLocationHistoryRequestValidationError is the validation error returned
+// by AdminLocationHistoryRequest.Validate if the designated constraints aren't
+// met.
+type AdminLocationHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10227,24 +17907,24 @@ type AdminBalanceUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminBalanceUpdateRequestValidationError) Field() string { return e.field }
+func (e AdminLocationHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminBalanceUpdateRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminBalanceUpdateRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminBalanceUpdateRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminBalanceUpdateRequestValidationError) ErrorName() string {
-	return "AdminBalanceUpdateRequestValidationError"
+func (e AdminLocationHistoryRequestValidationError) ErrorName() string {
+	return "AdminLocationHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminBalanceUpdateRequestValidationError) Error() string {
+func (e AdminLocationHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10256,14 +17936,14 @@ func (e AdminBalanceUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminBalanceUpdateRequest.%s: %s%s",
+		"invalid %sAdminLocationHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminBalanceUpdateRequestValidationError{}
+var _ error = AdminLocationHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10271,44 +17951,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminBalanceUpdateRequestValidationError{}
+} = AdminLocationHistoryRequestValidationError{}
 
-// Validate checks the field values on AdminBalanceUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminBalanceUpdateReply) Validate() error {
+// Validate checks the field values on AdminLocationHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationHistoryReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminBalanceUpdateReply with the
+// ValidateAll checks the field values on AdminLocationHistoryReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminBalanceUpdateReplyMultiError, or nil if none found.
-func (m *AdminBalanceUpdateReply) ValidateAll() error {
+// AdminLocationHistoryReplyMultiError, or nil if none found.
+func (m *AdminLocationHistoryReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminBalanceUpdateReply) validate(all bool) error {
+func (m *AdminLocationHistoryReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for LocationId
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationHistoryReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationHistoryReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationHistoryReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AdminBalanceUpdateReplyMultiError(errors)
+		return AdminLocationHistoryReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminBalanceUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminBalanceUpdateReply.ValidateAll() if the designated
+// AdminLocationHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationHistoryReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminBalanceUpdateReplyMultiError []error
+type AdminLocationHistoryReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminBalanceUpdateReplyMultiError) Error() string {
+func (m AdminLocationHistoryReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10317,11 +18041,11 @@ func (m AdminBalanceUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminBalanceUpdateReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationHistoryReplyMultiError) AllErrors() []error { return m }
 
-// AdminBalanceUpdateReplyValidationError is the validation error returned by
-// AdminBalanceUpdateReply.Validate if the designated constraints aren't met.
-type AdminBalanceUpdateReplyValidationError struct {
+// AdminLocationHistoryReplyValidationError is the validation error returned by
+// AdminLocationHistoryReply.Validate if the designated constraints aren't met.
+type AdminLocationHistoryReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10329,24 +18053,24 @@ type AdminBalanceUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminBalanceUpdateReplyValidationError) Field() string { return e.field }
+func (e AdminLocationHistoryReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminBalanceUpdateReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationHistoryReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminBalanceUpdateReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationHistoryReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminBalanceUpdateReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationHistoryReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminBalanceUpdateReplyValidationError) ErrorName() string {
-	return "AdminBalanceUpdateReplyValidationError"
+func (e AdminLocationHistoryReplyValidationError) ErrorName() string {
+	return "AdminLocationHistoryReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminBalanceUpdateReplyValidationError) Error() string {
+func (e AdminLocationHistoryReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10358,14 +18082,14 @@ func (e AdminBalanceUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminBalanceUpdateReply.%s: %s%s",
+		"invalid %sAdminLocationHistoryReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminBalanceUpdateReplyValidationError{}
+var _ error = AdminLocationHistoryReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10373,73 +18097,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminBalanceUpdateReplyValidationError{}
+} = AdminLocationHistoryReplyValidationError{}
 
-// Validate checks the field values on AuthAdminCreateRequest with the rules
+// Validate checks the field values on TestCreateAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminCreateRequest) Validate() error {
+func (m *TestCreateAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminCreateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TestCreateAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminCreateRequestMultiError, or nil if none found.
-func (m *AuthAdminCreateRequest) ValidateAll() error {
+// TestCreateAccountRequestMultiError, or nil if none found.
+func (m *TestCreateAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminCreateRequest) validate(all bool) error {
+func (m *TestCreateAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthAdminCreateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthAdminCreateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthAdminCreateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AuthAdminCreateRequestMultiError(errors)
+		return TestCreateAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminCreateRequest.ValidateAll() if the designated
+// TestCreateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by TestCreateAccountRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AuthAdminCreateRequestMultiError []error
+type TestCreateAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminCreateRequestMultiError) Error() string {
+func (m TestCreateAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10448,11 +18143,11 @@ func (m AuthAdminCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminCreateRequestMultiError) AllErrors() []error { return m }
+func (m TestCreateAccountRequestMultiError) AllErrors() []error { return m }
 
-// AuthAdminCreateRequestValidationError is the validation error returned by
-// AuthAdminCreateRequest.Validate if the designated constraints aren't met.
-type AuthAdminCreateRequestValidationError struct {
+// TestCreateAccountRequestValidationError is the validation error returned by
+// TestCreateAccountRequest.Validate if the designated constraints aren't met.
+type TestCreateAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10460,24 +18155,24 @@ type AuthAdminCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminCreateRequestValidationError) Field() string { return e.field }
+func (e TestCreateAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminCreateRequestValidationError) Reason() string { return e.reason }
+func (e TestCreateAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminCreateRequestValidationError) Cause() error { return e.cause }
+func (e TestCreateAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminCreateRequestValidationError) Key() bool { return e.key }
+func (e TestCreateAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminCreateRequestValidationError) ErrorName() string {
-	return "AuthAdminCreateRequestValidationError"
+func (e TestCreateAccountRequestValidationError) ErrorName() string {
+	return "TestCreateAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminCreateRequestValidationError) Error() string {
+func (e TestCreateAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10489,14 +18184,14 @@ func (e AuthAdminCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminCreateRequest.%s: %s%s",
+		"invalid %sTestCreateAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminCreateRequestValidationError{}
+var _ error = TestCreateAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10504,24 +18199,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminCreateRequestValidationError{}
+} = TestCreateAccountRequestValidationError{}
 
-// Validate checks the field values on AuthAdminCreateReply with the rules
+// Validate checks the field values on TestCreateAccountReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminCreateReply) Validate() error {
+func (m *TestCreateAccountReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminCreateReply with the rules
+// ValidateAll checks the field values on TestCreateAccountReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminCreateReplyMultiError, or nil if none found.
-func (m *AuthAdminCreateReply) ValidateAll() error {
+// TestCreateAccountReplyMultiError, or nil if none found.
+func (m *TestCreateAccountReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminCreateReply) validate(all bool) error {
+func (m *TestCreateAccountReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10529,19 +18224,19 @@ func (m *AuthAdminCreateReply) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AuthAdminCreateReplyMultiError(errors)
+		return TestCreateAccountReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminCreateReplyMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminCreateReply.ValidateAll() if the designated
+// TestCreateAccountReplyMultiError is an error wrapping multiple validation
+// errors returned by TestCreateAccountReply.ValidateAll() if the designated
 // constraints aren't met.
-type AuthAdminCreateReplyMultiError []error
+type TestCreateAccountReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminCreateReplyMultiError) Error() string {
+func (m TestCreateAccountReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10550,11 +18245,11 @@ func (m AuthAdminCreateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminCreateReplyMultiError) AllErrors() []error { return m }
+func (m TestCreateAccountReplyMultiError) AllErrors() []error { return m }
 
-// AuthAdminCreateReplyValidationError is the validation error returned by
-// AuthAdminCreateReply.Validate if the designated constraints aren't met.
-type AuthAdminCreateReplyValidationError struct {
+// TestCreateAccountReplyValidationError is the validation error returned by
+// TestCreateAccountReply.Validate if the designated constraints aren't met.
+type TestCreateAccountReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10562,24 +18257,24 @@ type AuthAdminCreateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminCreateReplyValidationError) Field() string { return e.field }
+func (e TestCreateAccountReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminCreateReplyValidationError) Reason() string { return e.reason }
+func (e TestCreateAccountReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminCreateReplyValidationError) Cause() error { return e.cause }
+func (e TestCreateAccountReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminCreateReplyValidationError) Key() bool { return e.key }
+func (e TestCreateAccountReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminCreateReplyValidationError) ErrorName() string {
-	return "AuthAdminCreateReplyValidationError"
+func (e TestCreateAccountReplyValidationError) ErrorName() string {
+	return "TestCreateAccountReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminCreateReplyValidationError) Error() string {
+func (e TestCreateAccountReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10591,14 +18286,14 @@ func (e AuthAdminCreateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminCreateReply.%s: %s%s",
+		"invalid %sTestCreateAccountReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminCreateReplyValidationError{}
+var _ error = TestCreateAccountReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10606,35 +18301,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminCreateReplyValidationError{}
+} = TestCreateAccountReplyValidationError{}
 
-// Validate checks the field values on AuthAdminDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminExportJobCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminDeleteRequest) Validate() error {
+func (m *AdminExportJobCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminExportJobCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminDeleteRequestMultiError, or nil if none found.
-func (m *AuthAdminDeleteRequest) ValidateAll() error {
+// AdminExportJobCreateRequestMultiError, or nil if none found.
+func (m *AdminExportJobCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminDeleteRequest) validate(all bool) error {
+func (m *AdminExportJobCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetSendBody() == nil {
+		err := AdminExportJobCreateRequestValidationError{
+			field:  "SendBody",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthAdminDeleteRequestValidationError{
+				errors = append(errors, AdminExportJobCreateRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10642,7 +18348,7 @@ func (m *AuthAdminDeleteRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthAdminDeleteRequestValidationError{
+				errors = append(errors, AdminExportJobCreateRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10651,7 +18357,7 @@ func (m *AuthAdminDeleteRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AuthAdminDeleteRequestValidationError{
+			return AdminExportJobCreateRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -10660,19 +18366,19 @@ func (m *AuthAdminDeleteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AuthAdminDeleteRequestMultiError(errors)
+		return AdminExportJobCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminDeleteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AuthAdminDeleteRequestMultiError []error
+// AdminExportJobCreateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminExportJobCreateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminExportJobCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminDeleteRequestMultiError) Error() string {
+func (m AdminExportJobCreateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10681,11 +18387,12 @@ func (m AuthAdminDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminExportJobCreateRequestMultiError) AllErrors() []error { return m }
 
-// AuthAdminDeleteRequestValidationError is the validation error returned by
-// AuthAdminDeleteRequest.Validate if the designated constraints aren't met.
-type AuthAdminDeleteRequestValidationError struct {
+// AdminExportJobCreateRequestValidationError is the validation error returned
+// by AdminExportJobCreateRequest.Validate if the designated constraints aren't
+// met.
+type AdminExportJobCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10693,24 +18400,24 @@ type AuthAdminDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminExportJobCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminExportJobCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminExportJobCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminExportJobCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminDeleteRequestValidationError) ErrorName() string {
-	return "AuthAdminDeleteRequestValidationError"
+func (e AdminExportJobCreateRequestValidationError) ErrorName() string {
+	return "AdminExportJobCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminDeleteRequestValidationError) Error() string {
+func (e AdminExportJobCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10722,14 +18429,14 @@ func (e AuthAdminDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminDeleteRequest.%s: %s%s",
+		"invalid %sAdminExportJobCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminDeleteRequestValidationError{}
+var _ error = AdminExportJobCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10737,44 +18444,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminDeleteRequestValidationError{}
+} = AdminExportJobCreateRequestValidationError{}
 
-// Validate checks the field values on AuthAdminDeleteReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminDeleteReply) Validate() error {
+// Validate checks the field values on AdminExportJobCreateReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminExportJobCreateReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminDeleteReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminExportJobCreateReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthAdminDeleteReplyMultiError, or nil if none found.
-func (m *AuthAdminDeleteReply) ValidateAll() error {
+// AdminExportJobCreateReplyMultiError, or nil if none found.
+func (m *AdminExportJobCreateReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminDeleteReply) validate(all bool) error {
+func (m *AdminExportJobCreateReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return AuthAdminDeleteReplyMultiError(errors)
+		return AdminExportJobCreateReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminDeleteReplyMultiError is an error wrapping multiple validation
-// errors returned by AuthAdminDeleteReply.ValidateAll() if the designated
+// AdminExportJobCreateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminExportJobCreateReply.ValidateAll() if the designated
 // constraints aren't met.
-type AuthAdminDeleteReplyMultiError []error
+type AdminExportJobCreateReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminDeleteReplyMultiError) Error() string {
+func (m AdminExportJobCreateReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10783,11 +18494,11 @@ func (m AuthAdminDeleteReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminDeleteReplyMultiError) AllErrors() []error { return m }
+func (m AdminExportJobCreateReplyMultiError) AllErrors() []error { return m }
 
-// AuthAdminDeleteReplyValidationError is the validation error returned by
-// AuthAdminDeleteReply.Validate if the designated constraints aren't met.
-type AuthAdminDeleteReplyValidationError struct {
+// AdminExportJobCreateReplyValidationError is the validation error returned by
+// AdminExportJobCreateReply.Validate if the designated constraints aren't met.
+type AdminExportJobCreateReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10795,24 +18506,24 @@ type AuthAdminDeleteReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminDeleteReplyValidationError) Field() string { return e.field }
+func (e AdminExportJobCreateReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminDeleteReplyValidationError) Reason() string { return e.reason }
+func (e AdminExportJobCreateReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminDeleteReplyValidationError) Cause() error { return e.cause }
+func (e AdminExportJobCreateReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminDeleteReplyValidationError) Key() bool { return e.key }
+func (e AdminExportJobCreateReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminDeleteReplyValidationError) ErrorName() string {
-	return "AuthAdminDeleteReplyValidationError"
+func (e AdminExportJobCreateReplyValidationError) ErrorName() string {
+	return "AdminExportJobCreateReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminDeleteReplyValidationError) Error() string {
+func (e AdminExportJobCreateReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10824,14 +18535,14 @@ func (e AuthAdminDeleteReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminDeleteReply.%s: %s%s",
+		"invalid %sAdminExportJobCreateReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminDeleteReplyValidationError{}
+var _ error = AdminExportJobCreateReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10839,46 +18550,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminDeleteReplyValidationError{}
+} = AdminExportJobCreateReplyValidationError{}
 
-// Validate checks the field values on CheckAndInsertRecommendAreaRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckAndInsertRecommendAreaRequest) Validate() error {
+// Validate checks the field values on AdminExportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminExportJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckAndInsertRecommendAreaRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CheckAndInsertRecommendAreaRequestMultiError, or nil if none found.
-func (m *CheckAndInsertRecommendAreaRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminExportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminExportJobRequestMultiError, or nil if none found.
+func (m *AdminExportJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAndInsertRecommendAreaRequest) validate(all bool) error {
+func (m *AdminExportJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetId() <= 0 {
+		err := AdminExportJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return CheckAndInsertRecommendAreaRequestMultiError(errors)
+		return AdminExportJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAndInsertRecommendAreaRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckAndInsertRecommendAreaRequest.ValidateAll() if the designated
+// AdminExportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminExportJobRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CheckAndInsertRecommendAreaRequestMultiError []error
+type AdminExportJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAndInsertRecommendAreaRequestMultiError) Error() string {
+func (m AdminExportJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10887,12 +18607,11 @@ func (m CheckAndInsertRecommendAreaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAndInsertRecommendAreaRequestMultiError) AllErrors() []error { return m }
+func (m AdminExportJobRequestMultiError) AllErrors() []error { return m }
 
-// CheckAndInsertRecommendAreaRequestValidationError is the validation error
-// returned by CheckAndInsertRecommendAreaRequest.Validate if the designated
-// constraints aren't met.
-type CheckAndInsertRecommendAreaRequestValidationError struct {
+// AdminExportJobRequestValidationError is the validation error returned by
+// AdminExportJobRequest.Validate if the designated constraints aren't met.
+type AdminExportJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10900,24 +18619,24 @@ type CheckAndInsertRecommendAreaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Field() string { return e.field }
+func (e AdminExportJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Reason() string { return e.reason }
+func (e AdminExportJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Cause() error { return e.cause }
+func (e AdminExportJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAndInsertRecommendAreaRequestValidationError) Key() bool { return e.key }
+func (e AdminExportJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAndInsertRecommendAreaRequestValidationError) ErrorName() string {
-	return "CheckAndInsertRecommendAreaRequestValidationError"
+func (e AdminExportJobRequestValidationError) ErrorName() string {
+	return "AdminExportJobRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAndInsertRecommendAreaRequestValidationError) Error() string {
+func (e AdminExportJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10929,14 +18648,14 @@ func (e CheckAndInsertRecommendAreaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAndInsertRecommendAreaRequest.%s: %s%s",
+		"invalid %sAdminExportJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAndInsertRecommendAreaRequestValidationError{}
+var _ error = AdminExportJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10944,46 +18663,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAndInsertRecommendAreaRequestValidationError{}
+} = AdminExportJobRequestValidationError{}
 
-// Validate checks the field values on CheckAndInsertRecommendAreaReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *CheckAndInsertRecommendAreaReply) Validate() error {
+// Validate checks the field values on AdminExportJobReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminExportJobReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckAndInsertRecommendAreaReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckAndInsertRecommendAreaReplyMultiError, or nil if none found.
-func (m *CheckAndInsertRecommendAreaReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminExportJobReply with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminExportJobReplyMultiError, or nil if none found.
+func (m *AdminExportJobReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckAndInsertRecommendAreaReply) validate(all bool) error {
+func (m *AdminExportJobReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Format
+
+	// no validation rules for Status
+
+	// no validation rules for Rows
+
+	// no validation rules for Error
+
+	// no validation rules for Download
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
 	if len(errors) > 0 {
-		return CheckAndInsertRecommendAreaReplyMultiError(errors)
+		return AdminExportJobReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckAndInsertRecommendAreaReplyMultiError is an error wrapping multiple
-// validation errors returned by
-// CheckAndInsertRecommendAreaReply.ValidateAll() if the designated
+// AdminExportJobReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminExportJobReply.ValidateAll() if the designated
 // constraints aren't met.
-type CheckAndInsertRecommendAreaReplyMultiError []error
+type AdminExportJobReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckAndInsertRecommendAreaReplyMultiError) Error() string {
+func (m AdminExportJobReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10992,12 +18727,11 @@ func (m CheckAndInsertRecommendAreaReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckAndInsertRecommendAreaReplyMultiError) AllErrors() []error { return m }
+func (m AdminExportJobReplyMultiError) AllErrors() []error { return m }
 
-// CheckAndInsertRecommendAreaReplyValidationError is the validation error
-// returned by CheckAndInsertRecommendAreaReply.Validate if the designated
-// constraints aren't met.
-type CheckAndInsertRecommendAreaReplyValidationError struct {
+// AdminExportJobReplyValidationError is the validation error returned by
+// AdminExportJobReply.Validate if the designated constraints aren't met.
+type AdminExportJobReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11005,24 +18739,24 @@ type CheckAndInsertRecommendAreaReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Field() string { return e.field }
+func (e AdminExportJobReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Reason() string { return e.reason }
+func (e AdminExportJobReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Cause() error { return e.cause }
+func (e AdminExportJobReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckAndInsertRecommendAreaReplyValidationError) Key() bool { return e.key }
+func (e AdminExportJobReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckAndInsertRecommendAreaReplyValidationError) ErrorName() string {
-	return "CheckAndInsertRecommendAreaReplyValidationError"
+func (e AdminExportJobReplyValidationError) ErrorName() string {
+	return "AdminExportJobReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckAndInsertRecommendAreaReplyValidationError) Error() string {
+func (e AdminExportJobReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11034,14 +18768,14 @@ func (e CheckAndInsertRecommendAreaReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckAndInsertRecommendAreaReply.%s: %s%s",
+		"invalid %sAdminExportJobReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckAndInsertRecommendAreaReplyValidationError{}
+var _ error = AdminExportJobReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11049,48 +18783,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckAndInsertRecommendAreaReplyValidationError{}
+} = AdminExportJobReplyValidationError{}
 
-// Validate checks the field values on AdminDailyRecommendRewardRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminDailyRecommendRewardRequest) Validate() error {
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyRecommendRewardRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyRecommendRewardRequestMultiError, or nil if none found.
-func (m *AdminDailyRecommendRewardRequest) ValidateAll() error {
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyRecommendRewardRequest) validate(all bool) error {
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Day
+	if !_EthAuthorizeRequest_SendBody_Address_Pattern.MatchString(m.GetAddress()) {
+		err := EthAuthorizeRequest_SendBodyValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^(0x[0-9a-fA-F]{40}|b[1-9A-HJ-NP-Za-km-z]{25,45})$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return AdminDailyRecommendRewardRequestMultiError(errors)
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyRecommendRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminDailyRecommendRewardRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminDailyRecommendRewardRequestMultiError []error
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyRecommendRewardRequestMultiError) Error() string {
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11099,12 +18842,12 @@ func (m AdminDailyRecommendRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyRecommendRewardRequestMultiError) AllErrors() []error { return m }
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminDailyRecommendRewardRequestValidationError is the validation error
-// returned by AdminDailyRecommendRewardRequest.Validate if the designated
-// constraints aren't met.
-type AdminDailyRecommendRewardRequestValidationError struct {
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11112,24 +18855,24 @@ type AdminDailyRecommendRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyRecommendRewardRequestValidationError) Field() string { return e.field }
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyRecommendRewardRequestValidationError) Reason() string { return e.reason }
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyRecommendRewardRequestValidationError) Cause() error { return e.cause }
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyRecommendRewardRequestValidationError) Key() bool { return e.key }
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyRecommendRewardRequestValidationError) ErrorName() string {
-	return "AdminDailyRecommendRewardRequestValidationError"
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyRecommendRewardRequestValidationError) Error() string {
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11141,14 +18884,14 @@ func (e AdminDailyRecommendRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyRecommendRewardRequest.%s: %s%s",
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyRecommendRewardRequestValidationError{}
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11156,44 +18899,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyRecommendRewardRequestValidationError{}
+} = EthAuthorizeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminDailyRecommendRewardReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyRecommendRewardReply) Validate() error {
+var _EthAuthorizeRequest_SendBody_Address_Pattern = regexp.MustCompile("^(0x[0-9a-fA-F]{40}|b[1-9A-HJ-NP-Za-km-z]{25,45})$")
+
+// Validate checks the field values on DepositBuyReply_Item with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *DepositBuyReply_Item) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyRecommendRewardReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyRecommendRewardReplyMultiError, or nil if none found.
-func (m *AdminDailyRecommendRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on DepositBuyReply_Item with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// DepositBuyReply_ItemMultiError, or nil if none found.
+func (m *DepositBuyReply_Item) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyRecommendRewardReply) validate(all bool) error {
+func (m *DepositBuyReply_Item) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Hash
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	// no validation rules for Stage
+
+	// no validation rules for Reason
+
+	// no validation rules for RecordId
+
+	// no validation rules for LocationId
+
 	if len(errors) > 0 {
-		return AdminDailyRecommendRewardReplyMultiError(errors)
+		return DepositBuyReply_ItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyRecommendRewardReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyRecommendRewardReply.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyRecommendRewardReplyMultiError []error
+// DepositBuyReply_ItemMultiError is an error wrapping multiple validation
+// errors returned by DepositBuyReply_Item.ValidateAll() if the designated
+// constraints aren't met.
+type DepositBuyReply_ItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyRecommendRewardReplyMultiError) Error() string {
+func (m DepositBuyReply_ItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11202,12 +18961,11 @@ func (m AdminDailyRecommendRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyRecommendRewardReplyMultiError) AllErrors() []error { return m }
+func (m DepositBuyReply_ItemMultiError) AllErrors() []error { return m }
 
-// AdminDailyRecommendRewardReplyValidationError is the validation error
-// returned by AdminDailyRecommendRewardReply.Validate if the designated
-// constraints aren't met.
-type AdminDailyRecommendRewardReplyValidationError struct {
+// DepositBuyReply_ItemValidationError is the validation error returned by
+// DepositBuyReply_Item.Validate if the designated constraints aren't met.
+type DepositBuyReply_ItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11215,24 +18973,24 @@ type AdminDailyRecommendRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyRecommendRewardReplyValidationError) Field() string { return e.field }
+func (e DepositBuyReply_ItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyRecommendRewardReplyValidationError) Reason() string { return e.reason }
+func (e DepositBuyReply_ItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyRecommendRewardReplyValidationError) Cause() error { return e.cause }
+func (e DepositBuyReply_ItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyRecommendRewardReplyValidationError) Key() bool { return e.key }
+func (e DepositBuyReply_ItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyRecommendRewardReplyValidationError) ErrorName() string {
-	return "AdminDailyRecommendRewardReplyValidationError"
+func (e DepositBuyReply_ItemValidationError) ErrorName() string {
+	return "DepositBuyReply_ItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyRecommendRewardReplyValidationError) Error() string {
+func (e DepositBuyReply_ItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11244,14 +19002,14 @@ func (e AdminDailyRecommendRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyRecommendRewardReply.%s: %s%s",
+		"invalid %sDepositBuyReply_Item.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyRecommendRewardReplyValidationError{}
+var _ error = DepositBuyReply_ItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -11259,46 +19017,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyRecommendRewardReplyValidationError{}
+} = DepositBuyReply_ItemValidationError{}
 
-// Validate checks the field values on AdminDailyBalanceRewardRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyBalanceRewardRequest) Validate() error {
+// Validate checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyBalanceRewardRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyBalanceRewardRequestMultiError, or nil if none found.
-func (m *AdminDailyBalanceRewardRequest) ValidateAll() error {
+// ValidateAll checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyBalanceRewardRequest) validate(all bool) error {
+func (m *RewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Date
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for AmountB
 
 	if len(errors) > 0 {
-		return AdminDailyBalanceRewardRequestMultiError(errors)
+		return RewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyBalanceRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyBalanceRewardRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyBalanceRewardRequestMultiError []error
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyBalanceRewardRequestMultiError) Error() string {
+func (m RewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11307,12 +19075,11 @@ func (m AdminDailyBalanceRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyBalanceRewardRequestMultiError) AllErrors() []error { return m }
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminDailyBalanceRewardRequestValidationError is the validation error
-// returned by AdminDailyBalanceRewardRequest.Validate if the designated
-// constraints aren't met.
-type AdminDailyBalanceRewardRequestValidationError struct {
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11320,24 +19087,24 @@ type AdminDailyBalanceRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyBalanceRewardRequestValidationError) Field() string { return e.field }
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyBalanceRewardRequestValidationError) Reason() string { return e.reason }
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyBalanceRewardRequestValidationError) Cause() error { return e.cause }
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyBalanceRewardRequestValidationError) Key() bool { return e.key }
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyBalanceRewardRequestValidationError) ErrorName() string {
-	return "AdminDailyBalanceRewardRequestValidationError"
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyBalanceRewardRequestValidationError) Error() string {
+func (e RewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11349,14 +19116,14 @@ func (e AdminDailyBalanceRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyBalanceRewardRequest.%s: %s%s",
+		"invalid %sRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyBalanceRewardRequestValidationError{}
+var _ error = RewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11364,44 +19131,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyBalanceRewardRequestValidationError{}
+} = RewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminDailyBalanceRewardReply with the
+// Validate checks the field values on RecommendRewardListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyBalanceRewardReply) Validate() error {
+func (m *RecommendRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyBalanceRewardReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminDailyBalanceRewardReplyMultiError, or nil if none found.
-func (m *AdminDailyBalanceRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyBalanceRewardReply) validate(all bool) error {
+func (m *RecommendRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
 	if len(errors) > 0 {
-		return AdminDailyBalanceRewardReplyMultiError(errors)
+		return RecommendRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyBalanceRewardReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyBalanceRewardReply.ValidateAll() if
-// the designated constraints aren't met.
-type AdminDailyBalanceRewardReplyMultiError []error
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyBalanceRewardReplyMultiError) Error() string {
+func (m RecommendRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11410,12 +19185,12 @@ func (m AdminDailyBalanceRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyBalanceRewardReplyMultiError) AllErrors() []error { return m }
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminDailyBalanceRewardReplyValidationError is the validation error returned
-// by AdminDailyBalanceRewardReply.Validate if the designated constraints
-// aren't met.
-type AdminDailyBalanceRewardReplyValidationError struct {
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11423,24 +19198,24 @@ type AdminDailyBalanceRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyBalanceRewardReplyValidationError) Field() string { return e.field }
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyBalanceRewardReplyValidationError) Reason() string { return e.reason }
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyBalanceRewardReplyValidationError) Cause() error { return e.cause }
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyBalanceRewardReplyValidationError) Key() bool { return e.key }
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyBalanceRewardReplyValidationError) ErrorName() string {
-	return "AdminDailyBalanceRewardReplyValidationError"
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyBalanceRewardReplyValidationError) Error() string {
+func (e RecommendRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11452,14 +19227,14 @@ func (e AdminDailyBalanceRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyBalanceRewardReply.%s: %s%s",
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyBalanceRewardReplyValidationError{}
+var _ error = RecommendRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11467,73 +19242,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyBalanceRewardReplyValidationError{}
+} = RecommendRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLoginRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginRequest) Validate() error {
+// Validate checks the field values on FeeRewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *FeeRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on FeeRewardListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLoginRequestMultiError, or nil if none found.
-func (m *AdminLoginRequest) ValidateAll() error {
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginRequest) validate(all bool) error {
+func (m *FeeRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminLoginRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminLoginRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminLoginRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminLoginRequestMultiError(errors)
+		return FeeRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminLoginRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLoginRequestMultiError []error
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type FeeRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginRequestMultiError) Error() string {
+func (m FeeRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11542,11 +19294,11 @@ func (m AdminLoginRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLoginRequestValidationError is the validation error returned by
-// AdminLoginRequest.Validate if the designated constraints aren't met.
-type AdminLoginRequestValidationError struct {
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11554,24 +19306,24 @@ type AdminLoginRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginRequestValidationError) Field() string { return e.field }
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginRequestValidationError) Key() bool { return e.key }
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginRequestValidationError) ErrorName() string {
-	return "AdminLoginRequestValidationError"
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLoginRequestValidationError) Error() string {
+func (e FeeRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11583,14 +19335,14 @@ func (e AdminLoginRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginRequest.%s: %s%s",
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginRequestValidationError{}
+var _ error = FeeRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11598,46 +19350,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginRequestValidationError{}
+} = FeeRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLoginReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginReply) Validate() error {
+// Validate checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLoginReplyMultiError, or nil if none found.
-func (m *AdminLoginReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginReply) validate(all bool) error {
+func (m *WithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Id
+
+	// no validation rules for RelAmount
 
 	if len(errors) > 0 {
-		return AdminLoginReplyMultiError(errors)
+		return WithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminLoginReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLoginReplyMultiError []error
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginReplyMultiError) Error() string {
+func (m WithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11646,11 +19408,11 @@ func (m AdminLoginReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLoginReplyValidationError is the validation error returned by
-// AdminLoginReply.Validate if the designated constraints aren't met.
-type AdminLoginReplyValidationError struct {
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11658,22 +19420,24 @@ type AdminLoginReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginReplyValidationError) Field() string { return e.field }
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginReplyValidationError) Key() bool { return e.key }
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginReplyValidationError) ErrorName() string { return "AdminLoginReplyValidationError" }
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminLoginReplyValidationError) Error() string {
+func (e WithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11685,14 +19449,14 @@ func (e AdminLoginReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginReply.%s: %s%s",
+		"invalid %sWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginReplyValidationError{}
+var _ error = WithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11700,73 +19464,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginReplyValidationError{}
+} = WithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on AdminChangePasswordRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminChangePasswordRequest) Validate() error {
+func (m *RecommendListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminChangePasswordRequest with the
+// ValidateAll checks the field values on RecommendListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminChangePasswordRequestMultiError, or nil if none found.
-func (m *AdminChangePasswordRequest) ValidateAll() error {
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminChangePasswordRequest) validate(all bool) error {
+func (m *RecommendListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminChangePasswordRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminChangePasswordRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminChangePasswordRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Amount
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminChangePasswordRequestMultiError(errors)
+		return RecommendListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminChangePasswordRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminChangePasswordRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminChangePasswordRequestMultiError []error
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminChangePasswordRequestMultiError) Error() string {
+func (m RecommendListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11775,11 +19514,11 @@ func (m AdminChangePasswordRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminChangePasswordRequestMultiError) AllErrors() []error { return m }
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminChangePasswordRequestValidationError is the validation error returned
-// by AdminChangePasswordRequest.Validate if the designated constraints aren't met.
-type AdminChangePasswordRequestValidationError struct {
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11787,24 +19526,24 @@ type AdminChangePasswordRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminChangePasswordRequestValidationError) Field() string { return e.field }
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminChangePasswordRequestValidationError) Reason() string { return e.reason }
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminChangePasswordRequestValidationError) Cause() error { return e.cause }
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminChangePasswordRequestValidationError) Key() bool { return e.key }
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminChangePasswordRequestValidationError) ErrorName() string {
-	return "AdminChangePasswordRequestValidationError"
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminChangePasswordRequestValidationError) Error() string {
+func (e RecommendListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11816,14 +19555,14 @@ func (e AdminChangePasswordRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminChangePasswordRequest.%s: %s%s",
+		"invalid %sRecommendListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminChangePasswordRequestValidationError{}
+var _ error = RecommendListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11831,44 +19570,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminChangePasswordRequestValidationError{}
+} = RecommendListReply_ListValidationError{}
 
-// Validate checks the field values on AdminChangePasswordReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminChangePasswordReply) Validate() error {
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminChangePasswordReply with the
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminChangePasswordReplyMultiError, or nil if none found.
-func (m *AdminChangePasswordReply) ValidateAll() error {
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminChangePasswordReply) validate(all bool) error {
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Type
+
+	if !_WithdrawRequest_SendBody_Amount_Pattern.MatchString(m.GetAmount()) {
+		err := WithdrawRequest_SendBodyValidationError{
+			field:  "Amount",
+			reason: "value does not match regex pattern \"^[0-9]{1,12}(\\\\.[0-9]{1,5})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return AdminChangePasswordReplyMultiError(errors)
+		return WithdrawRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminChangePasswordReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminChangePasswordReply.ValidateAll() if the designated
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminChangePasswordReplyMultiError []error
+type WithdrawRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminChangePasswordReplyMultiError) Error() string {
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11877,11 +19629,11 @@ func (m AdminChangePasswordReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminChangePasswordReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminChangePasswordReplyValidationError is the validation error returned by
-// AdminChangePasswordReply.Validate if the designated constraints aren't met.
-type AdminChangePasswordReplyValidationError struct {
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11889,24 +19641,24 @@ type AdminChangePasswordReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminChangePasswordReplyValidationError) Field() string { return e.field }
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminChangePasswordReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminChangePasswordReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminChangePasswordReplyValidationError) Key() bool { return e.key }
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminChangePasswordReplyValidationError) ErrorName() string {
-	return "AdminChangePasswordReplyValidationError"
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminChangePasswordReplyValidationError) Error() string {
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11918,14 +19670,14 @@ func (e AdminChangePasswordReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminChangePasswordReply.%s: %s%s",
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminChangePasswordReplyValidationError{}
+var _ error = WithdrawRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11933,73 +19685,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminChangePasswordReplyValidationError{}
+} = WithdrawRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminCreateAccountRequest with the rules
+var _WithdrawRequest_SendBody_Amount_Pattern = regexp.MustCompile("^[0-9]{1,12}(\\.[0-9]{1,5})?$")
+
+// Validate checks the field values on AdminRewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminCreateAccountRequest) Validate() error {
+func (m *AdminRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminCreateAccountRequest with the
+// ValidateAll checks the field values on AdminRewardListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminCreateAccountRequestMultiError, or nil if none found.
-func (m *AdminCreateAccountRequest) ValidateAll() error {
+// AdminRewardListReply_ListMultiError, or nil if none found.
+func (m *AdminRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminCreateAccountRequest) validate(all bool) error {
+func (m *AdminRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminCreateAccountRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminCreateAccountRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminCreateAccountRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for AmountB
+
+	// no validation rules for Address
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminCreateAccountRequestMultiError(errors)
+		return AdminRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminCreateAccountRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminCreateAccountRequest.ValidateAll() if the
+// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply_List.ValidateAll() if the
 // designated constraints aren't met.
-type AdminCreateAccountRequestMultiError []error
+type AdminRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminCreateAccountRequestMultiError) Error() string {
+func (m AdminRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12008,11 +19745,11 @@ func (m AdminCreateAccountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminCreateAccountRequestMultiError) AllErrors() []error { return m }
+func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminCreateAccountRequestValidationError is the validation error returned by
-// AdminCreateAccountRequest.Validate if the designated constraints aren't met.
-type AdminCreateAccountRequestValidationError struct {
+// AdminRewardListReply_ListValidationError is the validation error returned by
+// AdminRewardListReply_List.Validate if the designated constraints aren't met.
+type AdminRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12020,24 +19757,24 @@ type AdminCreateAccountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminCreateAccountRequestValidationError) Field() string { return e.field }
+func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminCreateAccountRequestValidationError) Reason() string { return e.reason }
+func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminCreateAccountRequestValidationError) Cause() error { return e.cause }
+func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminCreateAccountRequestValidationError) Key() bool { return e.key }
+func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminCreateAccountRequestValidationError) ErrorName() string {
-	return "AdminCreateAccountRequestValidationError"
+func (e AdminRewardListReply_ListValidationError) ErrorName() string {
+	return "AdminRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminCreateAccountRequestValidationError) Error() string {
+func (e AdminRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12049,14 +19786,14 @@ func (e AdminCreateAccountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminCreateAccountRequest.%s: %s%s",
+		"invalid %sAdminRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminCreateAccountRequestValidationError{}
+var _ error = AdminRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12064,44 +19801,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminCreateAccountRequestValidationError{}
+} = AdminRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminCreateAccountReply with the rules
+// Validate checks the field values on AdminTradeListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminCreateAccountReply) Validate() error {
+func (m *AdminTradeListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminCreateAccountReply with the
+// ValidateAll checks the field values on AdminTradeListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminCreateAccountReplyMultiError, or nil if none found.
-func (m *AdminCreateAccountReply) ValidateAll() error {
+// AdminTradeListReply_ListMultiError, or nil if none found.
+func (m *AdminTradeListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminCreateAccountReply) validate(all bool) error {
+func (m *AdminTradeListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for AmountCsd
+
+	// no validation rules for AmountHbs
+
+	// no validation rules for Address
+
 	if len(errors) > 0 {
-		return AdminCreateAccountReplyMultiError(errors)
+		return AdminTradeListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminCreateAccountReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminCreateAccountReply.ValidateAll() if the designated
+// AdminTradeListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminTradeListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminCreateAccountReplyMultiError []error
+type AdminTradeListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminCreateAccountReplyMultiError) Error() string {
+func (m AdminTradeListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12110,11 +19855,11 @@ func (m AdminCreateAccountReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminCreateAccountReplyMultiError) AllErrors() []error { return m }
-
-// AdminCreateAccountReplyValidationError is the validation error returned by
-// AdminCreateAccountReply.Validate if the designated constraints aren't met.
-type AdminCreateAccountReplyValidationError struct {
+func (m AdminTradeListReply_ListMultiError) AllErrors() []error { return m }
+
+// AdminTradeListReply_ListValidationError is the validation error returned by
+// AdminTradeListReply_List.Validate if the designated constraints aren't met.
+type AdminTradeListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12122,24 +19867,24 @@ type AdminCreateAccountReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminCreateAccountReplyValidationError) Field() string { return e.field }
+func (e AdminTradeListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminCreateAccountReplyValidationError) Reason() string { return e.reason }
+func (e AdminTradeListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminCreateAccountReplyValidationError) Cause() error { return e.cause }
+func (e AdminTradeListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminCreateAccountReplyValidationError) Key() bool { return e.key }
+func (e AdminTradeListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminCreateAccountReplyValidationError) ErrorName() string {
-	return "AdminCreateAccountReplyValidationError"
+func (e AdminTradeListReply_ListValidationError) ErrorName() string {
+	return "AdminTradeListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminCreateAccountReplyValidationError) Error() string {
+func (e AdminTradeListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12151,14 +19896,14 @@ func (e AdminCreateAccountReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminCreateAccountReply.%s: %s%s",
+		"invalid %sAdminTradeListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminCreateAccountReplyValidationError{}
+var _ error = AdminTradeListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12166,48 +19911,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminCreateAccountReplyValidationError{}
+} = AdminTradeListReply_ListValidationError{}
 
-// Validate checks the field values on AdminDailyLocationRewardRequest with the
+// Validate checks the field values on AdminUserListReply_UserList with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyLocationRewardRequest) Validate() error {
+func (m *AdminUserListReply_UserList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyLocationRewardRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyLocationRewardRequestMultiError, or nil if none found.
-func (m *AdminDailyLocationRewardRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminUserListReply_UserList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReply_UserListMultiError, or nil if none found.
+func (m *AdminUserListReply_UserList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyLocationRewardRequest) validate(all bool) error {
+func (m *AdminUserListReply_UserList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Date
+	// no validation rules for UserId
 
-	// no validation rules for Day
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for BalanceUsdt
+
+	// no validation rules for BalanceDhb
+
+	// no validation rules for Out
+
+	// no validation rules for AreaTotal
+
+	// no validation rules for AreaMin
+
+	// no validation rules for AreaMax
+
+	// no validation rules for Vip
+
+	// no validation rules for HistoryRecommend
+
+	// no validation rules for Amount
+
+	// no validation rules for Kkdt
 
 	if len(errors) > 0 {
-		return AdminDailyLocationRewardRequestMultiError(errors)
+		return AdminUserListReply_UserListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyLocationRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyLocationRewardRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyLocationRewardRequestMultiError []error
+// AdminUserListReply_UserListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserListReply_UserListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyLocationRewardRequestMultiError) Error() string {
+func (m AdminUserListReply_UserListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12216,12 +19983,12 @@ func (m AdminDailyLocationRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyLocationRewardRequestMultiError) AllErrors() []error { return m }
+func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
 
-// AdminDailyLocationRewardRequestValidationError is the validation error
-// returned by AdminDailyLocationRewardRequest.Validate if the designated
-// constraints aren't met.
-type AdminDailyLocationRewardRequestValidationError struct {
+// AdminUserListReply_UserListValidationError is the validation error returned
+// by AdminUserListReply_UserList.Validate if the designated constraints
+// aren't met.
+type AdminUserListReply_UserListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12229,24 +19996,24 @@ type AdminDailyLocationRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyLocationRewardRequestValidationError) Field() string { return e.field }
+func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyLocationRewardRequestValidationError) Reason() string { return e.reason }
+func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyLocationRewardRequestValidationError) Cause() error { return e.cause }
+func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyLocationRewardRequestValidationError) Key() bool { return e.key }
+func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyLocationRewardRequestValidationError) ErrorName() string {
-	return "AdminDailyLocationRewardRequestValidationError"
+func (e AdminUserListReply_UserListValidationError) ErrorName() string {
+	return "AdminUserListReply_UserListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyLocationRewardRequestValidationError) Error() string {
+func (e AdminUserListReply_UserListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12258,14 +20025,14 @@ func (e AdminDailyLocationRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyLocationRewardRequest.%s: %s%s",
+		"invalid %sAdminUserListReply_UserList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyLocationRewardRequestValidationError{}
+var _ error = AdminUserListReply_UserListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12273,44 +20040,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyLocationRewardRequestValidationError{}
+} = AdminUserListReply_UserListValidationError{}
 
-// Validate checks the field values on AdminDailyLocationRewardReply with the
+// Validate checks the field values on RecordListReply_LocationList with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminDailyLocationRewardReply) Validate() error {
+func (m *RecordListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyLocationRewardReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyLocationRewardReplyMultiError, or nil if none found.
-func (m *AdminDailyLocationRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on RecordListReply_LocationList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordListReply_LocationListMultiError, or nil if none found.
+func (m *RecordListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyLocationRewardReply) validate(all bool) error {
+func (m *RecordListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminDailyLocationRewardReplyMultiError(errors)
+		return RecordListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyLocationRewardReplyMultiError is an error wrapping multiple
-// validation errors returned by AdminDailyLocationRewardReply.ValidateAll()
-// if the designated constraints aren't met.
-type AdminDailyLocationRewardReplyMultiError []error
+// RecordListReply_LocationListMultiError is an error wrapping multiple
+// validation errors returned by RecordListReply_LocationList.ValidateAll() if
+// the designated constraints aren't met.
+type RecordListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyLocationRewardReplyMultiError) Error() string {
+func (m RecordListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12319,12 +20092,12 @@ func (m AdminDailyLocationRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyLocationRewardReplyMultiError) AllErrors() []error { return m }
+func (m RecordListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// AdminDailyLocationRewardReplyValidationError is the validation error
-// returned by AdminDailyLocationRewardReply.Validate if the designated
-// constraints aren't met.
-type AdminDailyLocationRewardReplyValidationError struct {
+// RecordListReply_LocationListValidationError is the validation error returned
+// by RecordListReply_LocationList.Validate if the designated constraints
+// aren't met.
+type RecordListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12332,24 +20105,24 @@ type AdminDailyLocationRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyLocationRewardReplyValidationError) Field() string { return e.field }
+func (e RecordListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyLocationRewardReplyValidationError) Reason() string { return e.reason }
+func (e RecordListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyLocationRewardReplyValidationError) Cause() error { return e.cause }
+func (e RecordListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyLocationRewardReplyValidationError) Key() bool { return e.key }
+func (e RecordListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyLocationRewardReplyValidationError) ErrorName() string {
-	return "AdminDailyLocationRewardReplyValidationError"
+func (e RecordListReply_LocationListValidationError) ErrorName() string {
+	return "RecordListReply_LocationListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyLocationRewardReplyValidationError) Error() string {
+func (e RecordListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12361,14 +20134,14 @@ func (e AdminDailyLocationRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyLocationRewardReply.%s: %s%s",
+		"invalid %sRecordListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyLocationRewardReplyValidationError{}
+var _ error = RecordListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12376,46 +20149,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyLocationRewardReplyValidationError{}
+} = RecordListReply_LocationListValidationError{}
 
-// Validate checks the field values on AdminDailyLocationRewardNewRequest with
+// Validate checks the field values on AdminLocationListReply_LocationList with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *AdminDailyLocationRewardNewRequest) Validate() error {
+func (m *AdminLocationListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyLocationRewardNewRequest
+// ValidateAll checks the field values on AdminLocationListReply_LocationList
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminDailyLocationRewardNewRequestMultiError, or nil if none found.
-func (m *AdminDailyLocationRewardNewRequest) ValidateAll() error {
+// AdminLocationListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyLocationRewardNewRequest) validate(all bool) error {
+func (m *AdminLocationListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
+	// no validation rules for Usdt
+
+	// no validation rules for CurrentMaxSub
+
+	// no validation rules for Out
+
 	if len(errors) > 0 {
-		return AdminDailyLocationRewardNewRequestMultiError(errors)
+		return AdminLocationListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyLocationRewardNewRequestMultiError is an error wrapping multiple
+// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminDailyLocationRewardNewRequest.ValidateAll() if the designated
+// AdminLocationListReply_LocationList.ValidateAll() if the designated
 // constraints aren't met.
-type AdminDailyLocationRewardNewRequestMultiError []error
+type AdminLocationListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyLocationRewardNewRequestMultiError) Error() string {
+func (m AdminLocationListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12424,12 +20209,12 @@ func (m AdminDailyLocationRewardNewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyLocationRewardNewRequestMultiError) AllErrors() []error { return m }
+func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// AdminDailyLocationRewardNewRequestValidationError is the validation error
-// returned by AdminDailyLocationRewardNewRequest.Validate if the designated
+// AdminLocationListReply_LocationListValidationError is the validation error
+// returned by AdminLocationListReply_LocationList.Validate if the designated
 // constraints aren't met.
-type AdminDailyLocationRewardNewRequestValidationError struct {
+type AdminLocationListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12437,24 +20222,24 @@ type AdminDailyLocationRewardNewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyLocationRewardNewRequestValidationError) Field() string { return e.field }
+func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyLocationRewardNewRequestValidationError) Reason() string { return e.reason }
+func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyLocationRewardNewRequestValidationError) Cause() error { return e.cause }
+func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyLocationRewardNewRequestValidationError) Key() bool { return e.key }
+func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyLocationRewardNewRequestValidationError) ErrorName() string {
-	return "AdminDailyLocationRewardNewRequestValidationError"
+func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationListReply_LocationListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyLocationRewardNewRequestValidationError) Error() string {
+func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12466,14 +20251,14 @@ func (e AdminDailyLocationRewardNewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyLocationRewardNewRequest.%s: %s%s",
+		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyLocationRewardNewRequestValidationError{}
+var _ error = AdminLocationListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12481,46 +20266,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyLocationRewardNewRequestValidationError{}
+} = AdminLocationListReply_LocationListValidationError{}
 
-// Validate checks the field values on AdminDailyLocationRewardNewReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminDailyLocationRewardNewReply) Validate() error {
+// Validate checks the field values on AdminLocationAllListReply_LocationList
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminLocationAllListReply_LocationList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminDailyLocationRewardNewReply with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminDailyLocationRewardNewReplyMultiError, or nil if none found.
-func (m *AdminDailyLocationRewardNewReply) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminLocationAllListReply_LocationList with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AdminLocationAllListReply_LocationListMultiError, or nil if none found.
+func (m *AdminLocationAllListReply_LocationList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminDailyLocationRewardNewReply) validate(all bool) error {
+func (m *AdminLocationAllListReply_LocationList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Status
+
+	// no validation rules for CurrentLevel
+
+	// no validation rules for Current
+
+	// no validation rules for CurrentMax
+
 	if len(errors) > 0 {
-		return AdminDailyLocationRewardNewReplyMultiError(errors)
+		return AdminLocationAllListReply_LocationListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminDailyLocationRewardNewReplyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminDailyLocationRewardNewReply.ValidateAll() if the designated
+// AdminLocationAllListReply_LocationListMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminLocationAllListReply_LocationList.ValidateAll() if the designated
 // constraints aren't met.
-type AdminDailyLocationRewardNewReplyMultiError []error
+type AdminLocationAllListReply_LocationListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminDailyLocationRewardNewReplyMultiError) Error() string {
+func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12529,12 +20327,12 @@ func (m AdminDailyLocationRewardNewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminDailyLocationRewardNewReplyMultiError) AllErrors() []error { return m }
+func (m AdminLocationAllListReply_LocationListMultiError) AllErrors() []error { return m }
 
-// AdminDailyLocationRewardNewReplyValidationError is the validation error
-// returned by AdminDailyLocationRewardNewReply.Validate if the designated
-// constraints aren't met.
-type AdminDailyLocationRewardNewReplyValidationError struct {
+// AdminLocationAllListReply_LocationListValidationError is the validation
+// error returned by AdminLocationAllListReply_LocationList.Validate if the
+// designated constraints aren't met.
+type AdminLocationAllListReply_LocationListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12542,24 +20340,24 @@ type AdminDailyLocationRewardNewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminDailyLocationRewardNewReplyValidationError) Field() string { return e.field }
+func (e AdminLocationAllListReply_LocationListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminDailyLocationRewardNewReplyValidationError) Reason() string { return e.reason }
+func (e AdminLocationAllListReply_LocationListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminDailyLocationRewardNewReplyValidationError) Cause() error { return e.cause }
+func (e AdminLocationAllListReply_LocationListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminDailyLocationRewardNewReplyValidationError) Key() bool { return e.key }
+func (e AdminLocationAllListReply_LocationListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminDailyLocationRewardNewReplyValidationError) ErrorName() string {
-	return "AdminDailyLocationRewardNewReplyValidationError"
+func (e AdminLocationAllListReply_LocationListValidationError) ErrorName() string {
+	return "AdminLocationAllListReply_LocationListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminDailyLocationRewardNewReplyValidationError) Error() string {
+func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12571,14 +20369,14 @@ func (e AdminDailyLocationRewardNewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminDailyLocationRewardNewReply.%s: %s%s",
+		"invalid %sAdminLocationAllListReply_LocationList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminDailyLocationRewardNewReplyValidationError{}
+var _ error = AdminLocationAllListReply_LocationListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12586,44 +20384,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminDailyLocationRewardNewReplyValidationError{}
+} = AdminLocationAllListReply_LocationListValidationError{}
 
-// Validate checks the field values on TestCreateAccountRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TestCreateAccountRequest) Validate() error {
+func (m *AdminWithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TestCreateAccountRequest with the
+// ValidateAll checks the field values on AdminWithdrawListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TestCreateAccountRequestMultiError, or nil if none found.
-func (m *TestCreateAccountRequest) ValidateAll() error {
+// AdminWithdrawListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TestCreateAccountRequest) validate(all bool) error {
+func (m *AdminWithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for RelAmount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return TestCreateAccountRequestMultiError(errors)
+		return AdminWithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// TestCreateAccountRequestMultiError is an error wrapping multiple validation
-// errors returned by TestCreateAccountRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TestCreateAccountRequestMultiError []error
+// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TestCreateAccountRequestMultiError) Error() string {
+func (m AdminWithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12632,11 +20444,12 @@ func (m TestCreateAccountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TestCreateAccountRequestMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// TestCreateAccountRequestValidationError is the validation error returned by
-// TestCreateAccountRequest.Validate if the designated constraints aren't met.
-type TestCreateAccountRequestValidationError struct {
+// AdminWithdrawListReply_ListValidationError is the validation error returned
+// by AdminWithdrawListReply_List.Validate if the designated constraints
+// aren't met.
+type AdminWithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12644,24 +20457,24 @@ type TestCreateAccountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TestCreateAccountRequestValidationError) Field() string { return e.field }
+func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TestCreateAccountRequestValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TestCreateAccountRequestValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TestCreateAccountRequestValidationError) Key() bool { return e.key }
+func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TestCreateAccountRequestValidationError) ErrorName() string {
-	return "TestCreateAccountRequestValidationError"
+func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TestCreateAccountRequestValidationError) Error() string {
+func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12673,14 +20486,14 @@ func (e TestCreateAccountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTestCreateAccountRequest.%s: %s%s",
+		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TestCreateAccountRequestValidationError{}
+var _ error = AdminWithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -12688,44 +20501,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TestCreateAccountRequestValidationError{}
+} = AdminWithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on TestCreateAccountReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawPassRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TestCreateAccountReply) Validate() error {
+func (m *AdminWithdrawPassRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TestCreateAccountReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TestCreateAccountReplyMultiError, or nil if none found.
-func (m *TestCreateAccountReply) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawPassRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawPassRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawPassRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TestCreateAccountReply) validate(all bool) error {
+func (m *AdminWithdrawPassRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetId() <= 0 {
+		err := AdminWithdrawPassRequest_SendBodyValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return TestCreateAccountReplyMultiError(errors)
+		return AdminWithdrawPassRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// TestCreateAccountReplyMultiError is an error wrapping multiple validation
-// errors returned by TestCreateAccountReply.ValidateAll() if the designated
+// AdminWithdrawPassRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawPassRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type TestCreateAccountReplyMultiError []error
+type AdminWithdrawPassRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TestCreateAccountReplyMultiError) Error() string {
+func (m AdminWithdrawPassRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12734,11 +20559,12 @@ func (m TestCreateAccountReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TestCreateAccountReplyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawPassRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// TestCreateAccountReplyValidationError is the validation error returned by
-// TestCreateAccountReply.Validate if the designated constraints aren't met.
-type TestCreateAccountReplyValidationError struct {
+// AdminWithdrawPassRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawPassRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawPassRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12746,24 +20572,24 @@ type TestCreateAccountReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e TestCreateAccountReplyValidationError) Field() string { return e.field }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TestCreateAccountReplyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TestCreateAccountReplyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TestCreateAccountReplyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TestCreateAccountReplyValidationError) ErrorName() string {
-	return "TestCreateAccountReplyValidationError"
+func (e AdminWithdrawPassRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawPassRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TestCreateAccountReplyValidationError) Error() string {
+func (e AdminWithdrawPassRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12775,14 +20601,14 @@ func (e TestCreateAccountReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTestCreateAccountReply.%s: %s%s",
+		"invalid %sAdminWithdrawPassRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TestCreateAccountReplyValidationError{}
+var _ error = AdminWithdrawPassRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12790,48 +20616,67 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TestCreateAccountReplyValidationError{}
+} = AdminWithdrawPassRequest_SendBodyValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawRejectRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *AdminWithdrawRejectRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawRejectRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRejectRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRejectRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *AdminWithdrawRejectRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	if m.GetId() <= 0 {
+		err := AdminWithdrawRejectRequest_SendBodyValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Code
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 200 {
+		err := AdminWithdrawRejectRequest_SendBodyValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return AdminWithdrawRejectRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+// AdminWithdrawRejectRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawRejectRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRejectRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12840,12 +20685,12 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawRejectRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
-// aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+// AdminWithdrawRejectRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRejectRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRejectRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12853,24 +20698,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRejectRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e AdminWithdrawRejectRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12882,14 +20727,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWithdrawRejectRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12897,52 +20742,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = AdminWithdrawRejectRequest_SendBodyValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+// Validate checks the field values on AdminWithdrawBatchPassRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if there
+// are no violations.
+func (m *AdminWithdrawBatchPassRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminWithdrawBatchPassRequest_SendBody with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a list
+// of violation errors wrapped in
+// AdminWithdrawBatchPassRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawBatchPassRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *AdminWithdrawBatchPassRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for LocationStatus
-
-	// no validation rules for Type
+	if l := len(m.GetIds()); l < 1 || l > 100 {
+		err := AdminWithdrawBatchPassRequest_SendBodyValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return AdminWithdrawBatchPassRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
+// AdminWithdrawBatchPassRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminWithdrawBatchPassRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type RewardListReply_ListMultiError []error
+type AdminWithdrawBatchPassRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m AdminWithdrawBatchPassRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12951,11 +20802,12 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawBatchPassRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// AdminWithdrawBatchPassRequest_SendBodyValidationError is the validation
+// error returned by AdminWithdrawBatchPassRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminWithdrawBatchPassRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12963,24 +20815,24 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawBatchPassRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawBatchPassRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawBatchPassRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawBatchPassRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e AdminWithdrawBatchPassRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawBatchPassRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e AdminWithdrawBatchPassRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12992,14 +20844,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawBatchPassRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = AdminWithdrawBatchPassRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -13007,52 +20859,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
+} = AdminWithdrawBatchPassRequest_SendBodyValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawBatchPassReply_Item with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
+func (m *AdminWithdrawBatchPassReply_Item) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawBatchPassReply_Item with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawBatchPassReply_ItemMultiError, or nil if none found.
+func (m *AdminWithdrawBatchPassReply_Item) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminWithdrawBatchPassReply_Item) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for Id
 
-	// no validation rules for Type
+	// no validation rules for Status
 
-	// no validation rules for Reason
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminWithdrawBatchPassReply_ItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// AdminWithdrawBatchPassReply_ItemMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawBatchPassReply_Item.ValidateAll()
 // if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+type AdminWithdrawBatchPassReply_ItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminWithdrawBatchPassReply_ItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13061,12 +20911,12 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawBatchPassReply_ItemMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
+// AdminWithdrawBatchPassReply_ItemValidationError is the validation error
+// returned by AdminWithdrawBatchPassReply_Item.Validate if the designated
 // constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+type AdminWithdrawBatchPassReply_ItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13074,24 +20924,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawBatchPassReply_ItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawBatchPassReply_ItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawBatchPassReply_ItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawBatchPassReply_ItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminWithdrawBatchPassReply_ItemValidationError) ErrorName() string {
+	return "AdminWithdrawBatchPassReply_ItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminWithdrawBatchPassReply_ItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13103,14 +20953,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawBatchPassReply_Item.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminWithdrawBatchPassReply_ItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -13118,48 +20968,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminWithdrawBatchPassReply_ItemValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawReviewListReply_List with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+func (m *AdminWithdrawReviewListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminWithdrawReviewListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReviewListReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawReviewListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminWithdrawReviewListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Address
+
 	// no validation rules for CreatedAt
 
 	// no validation rules for Amount
 
+	// no validation rules for RelAmount
+
+	// no validation rules for AccountDays
+
+	// no validation rules for DepositTotal
+
+	// no validation rules for WithdrawTotal
+
+	// no validation rules for WithdrawCount
+
+	// no validation rules for WithdrawAvg
+
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminWithdrawReviewListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// AdminWithdrawReviewListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWithdrawReviewListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+type AdminWithdrawReviewListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminWithdrawReviewListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13168,11 +21035,12 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewListReply_ListMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminWithdrawReviewListReply_ListValidationError is the validation error
+// returned by AdminWithdrawReviewListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13180,24 +21048,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminWithdrawReviewListReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawReviewListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminWithdrawReviewListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13209,14 +21077,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawReviewListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminWithdrawReviewListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13224,52 +21092,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminWithdrawReviewListReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWithdrawReviewsReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+func (m *AdminWithdrawReviewsReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminWithdrawReviewsReply_List with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// AdminWithdrawReviewsReply_ListMultiError, or nil if none found.
+func (m *AdminWithdrawReviewsReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminWithdrawReviewsReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
+
+	// no validation rules for WithdrawId
+
+	// no validation rules for UserId
+
+	// no validation rules for AdminId
+
+	// no validation rules for Action
+
+	// no validation rules for StatusBefore
+
+	// no validation rules for StatusAfter
 
 	// no validation rules for Amount
 
-	// no validation rules for Type
+	// no validation rules for Reason
 
-	// no validation rules for Status
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminWithdrawReviewsReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+// AdminWithdrawReviewsReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawReviewsReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawReviewsReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminWithdrawReviewsReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13278,11 +21158,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminWithdrawReviewsReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminWithdrawReviewsReply_ListValidationError is the validation error
+// returned by AdminWithdrawReviewsReply_List.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawReviewsReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13290,24 +21171,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminWithdrawReviewsReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminWithdrawReviewsReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminWithdrawReviewsReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminWithdrawReviewsReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminWithdrawReviewsReply_ListValidationError) ErrorName() string {
+	return "AdminWithdrawReviewsReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminWithdrawReviewsReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13319,14 +21200,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminWithdrawReviewsReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminWithdrawReviewsReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13334,48 +21215,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminWithdrawReviewsReply_ListValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUserRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+func (m *AdminUserRecommendReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
+// ValidateAll checks the field values on AdminUserRecommendReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// AdminUserRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminUserRecommendReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminUserRecommendReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
 	// no validation rules for Amount
 
-	// no validation rules for Status
+	// no validation rules for RelAmount
+
+	// no validation rules for RecommendAllAmount
 
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminUserRecommendReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendListReply_ListMultiError []error
+// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUserRecommendReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminUserRecommendReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13384,11 +21275,12 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminUserRecommendReply_ListValidationError is the validation error returned
+// by AdminUserRecommendReply_List.Validate if the designated constraints
+// aren't met.
+type AdminUserRecommendReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13396,24 +21288,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminUserRecommendReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminUserRecommendReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13425,14 +21317,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminUserRecommendReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13440,48 +21332,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminUserRecommendReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminMonthRecommendReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+func (m *AdminMonthRecommendReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminMonthRecommendReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendReply_ListMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminMonthRecommendReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Address
 
-	// no validation rules for Amount
+	// no validation rules for RecommendAddress
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminMonthRecommendReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+// AdminMonthRecommendReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type AdminMonthRecommendReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminMonthRecommendReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13490,11 +21386,12 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminMonthRecommendReply_ListMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminMonthRecommendReply_ListValidationError is the validation error
+// returned by AdminMonthRecommendReply_List.Validate if the designated
+// constraints aren't met.
+type AdminMonthRecommendReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13502,24 +21399,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminMonthRecommendReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminMonthRecommendReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminMonthRecommendReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminMonthRecommendReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminMonthRecommendReply_ListValidationError) ErrorName() string {
+	return "AdminMonthRecommendReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminMonthRecommendReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13531,14 +21428,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminMonthRecommendReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminMonthRecommendReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13546,24 +21443,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminMonthRecommendReply_ListValidationError{}
 
-// Validate checks the field values on AdminRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply_List) Validate() error {
+// Validate checks the field values on AdminConfigReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminRewardListReply_ListMultiError, or nil if none found.
-func (m *AdminRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminConfigReply_List with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// AdminConfigReply_ListMultiError, or nil if none found.
+func (m *AdminConfigReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply_List) validate(all bool) error {
+func (m *AdminConfigReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13572,30 +21469,36 @@ func (m *AdminRewardListReply_List) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
+	// no validation rules for Name
 
-	// no validation rules for Amount
+	// no validation rules for Value
 
-	// no validation rules for AmountB
+	// no validation rules for KeyName
 
-	// no validation rules for Address
+	// no validation rules for Type
 
-	// no validation rules for Reason
+	// no validation rules for Unit
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Default
 
 	if len(errors) > 0 {
-		return AdminRewardListReply_ListMultiError(errors)
+		return AdminConfigReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply_List.ValidateAll() if the
-// designated constraints aren't met.
-type AdminRewardListReply_ListMultiError []error
+// AdminConfigReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReply_ListMultiError) Error() string {
+func (m AdminConfigReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13604,11 +21507,11 @@ func (m AdminRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminConfigReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReply_ListValidationError is the validation error returned by
-// AdminRewardListReply_List.Validate if the designated constraints aren't met.
-type AdminRewardListReply_ListValidationError struct {
+// AdminConfigReply_ListValidationError is the validation error returned by
+// AdminConfigReply_List.Validate if the designated constraints aren't met.
+type AdminConfigReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13616,24 +21519,24 @@ type AdminRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminConfigReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminConfigReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminConfigReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminConfigReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReply_ListValidationError) ErrorName() string {
-	return "AdminRewardListReply_ListValidationError"
+func (e AdminConfigReply_ListValidationError) ErrorName() string {
+	return "AdminConfigReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReply_ListValidationError) Error() string {
+func (e AdminConfigReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13645,14 +21548,14 @@ func (e AdminRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply_List.%s: %s%s",
+		"invalid %sAdminConfigReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReply_ListValidationError{}
+var _ error = AdminConfigReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13660,52 +21563,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReply_ListValidationError{}
+} = AdminConfigReply_ListValidationError{}
 
-// Validate checks the field values on AdminTradeListReply_List with the rules
+// Validate checks the field values on AdminListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminTradeListReply_List) Validate() error {
+func (m *AdminListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminTradeListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminTradeListReply_ListMultiError, or nil if none found.
-func (m *AdminTradeListReply_List) ValidateAll() error {
+// AdminListReply_ListMultiError, or nil if none found.
+func (m *AdminListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminTradeListReply_List) validate(all bool) error {
+func (m *AdminListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for AmountCsd
-
-	// no validation rules for AmountHbs
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Account
 
 	if len(errors) > 0 {
-		return AdminTradeListReply_ListMultiError(errors)
+		return AdminListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminTradeListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminTradeListReply_List.ValidateAll() if the designated
+// AdminListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminTradeListReply_ListMultiError []error
+type AdminListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminTradeListReply_ListMultiError) Error() string {
+func (m AdminListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13714,11 +21613,11 @@ func (m AdminTradeListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminTradeListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminTradeListReply_ListValidationError is the validation error returned by
-// AdminTradeListReply_List.Validate if the designated constraints aren't met.
-type AdminTradeListReply_ListValidationError struct {
+// AdminListReply_ListValidationError is the validation error returned by
+// AdminListReply_List.Validate if the designated constraints aren't met.
+type AdminListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13726,24 +21625,24 @@ type AdminTradeListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminTradeListReply_ListValidationError) Field() string { return e.field }
+func (e AdminListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminTradeListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminTradeListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminTradeListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminTradeListReply_ListValidationError) ErrorName() string {
-	return "AdminTradeListReply_ListValidationError"
+func (e AdminListReply_ListValidationError) ErrorName() string {
+	return "AdminListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminTradeListReply_ListValidationError) Error() string {
+func (e AdminListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13755,14 +21654,14 @@ func (e AdminTradeListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminTradeListReply_List.%s: %s%s",
+		"invalid %sAdminListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminTradeListReply_ListValidationError{}
+var _ error = AdminListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13770,70 +21669,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminTradeListReply_ListValidationError{}
+} = AdminListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply_UserList) Validate() error {
+func (m *AuthListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply_UserList with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserListReply_UserListMultiError, or nil if none found.
-func (m *AdminUserListReply_UserList) ValidateAll() error {
+// AuthListReply_ListMultiError, or nil if none found.
+func (m *AuthListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply_UserList) validate(all bool) error {
+func (m *AuthListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for BalanceUsdt
-
-	// no validation rules for BalanceDhb
-
-	// no validation rules for Out
-
-	// no validation rules for AreaTotal
-
-	// no validation rules for AreaMin
-
-	// no validation rules for AreaMax
-
-	// no validation rules for Vip
-
-	// no validation rules for HistoryRecommend
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Name
 
-	// no validation rules for Kkdt
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return AdminUserListReply_UserListMultiError(errors)
+		return AuthListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReply_UserListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserListReply_UserList.ValidateAll() if
-// the designated constraints aren't met.
-type AdminUserListReply_UserListMultiError []error
+// AuthListReply_ListMultiError is an error wrapping multiple validation errors
+// returned by AuthListReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type AuthListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReply_UserListMultiError) Error() string {
+func (m AuthListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13842,12 +21721,11 @@ func (m AdminUserListReply_UserListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReply_UserListMultiError) AllErrors() []error { return m }
+func (m AuthListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserListReply_UserListValidationError is the validation error returned
-// by AdminUserListReply_UserList.Validate if the designated constraints
-// aren't met.
-type AdminUserListReply_UserListValidationError struct {
+// AuthListReply_ListValidationError is the validation error returned by
+// AuthListReply_List.Validate if the designated constraints aren't met.
+type AuthListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13855,24 +21733,24 @@ type AdminUserListReply_UserListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReply_UserListValidationError) Field() string { return e.field }
+func (e AuthListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReply_UserListValidationError) Reason() string { return e.reason }
+func (e AuthListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReply_UserListValidationError) Cause() error { return e.cause }
+func (e AuthListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReply_UserListValidationError) Key() bool { return e.key }
+func (e AuthListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReply_UserListValidationError) ErrorName() string {
-	return "AdminUserListReply_UserListValidationError"
+func (e AuthListReply_ListValidationError) ErrorName() string {
+	return "AuthListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReply_UserListValidationError) Error() string {
+func (e AuthListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13884,14 +21762,14 @@ func (e AdminUserListReply_UserListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply_UserList.%s: %s%s",
+		"invalid %sAuthListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReply_UserListValidationError{}
+var _ error = AuthListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -13899,50 +21777,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReply_UserListValidationError{}
+} = AuthListReply_ListValidationError{}
 
-// Validate checks the field values on RecordListReply_LocationList with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UserAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecordListReply_LocationList) Validate() error {
+func (m *UserAuthListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecordListReply_LocationList with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecordListReply_LocationListMultiError, or nil if none found.
-func (m *RecordListReply_LocationList) ValidateAll() error {
+// UserAuthListReply_ListMultiError, or nil if none found.
+func (m *UserAuthListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecordListReply_LocationList) validate(all bool) error {
+func (m *UserAuthListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Address
+	// no validation rules for Name
 
-	// no validation rules for Amount
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return RecordListReply_LocationListMultiError(errors)
+		return UserAuthListReply_ListMultiError(errors)
 	}
 
 	return nil
-}
-
-// RecordListReply_LocationListMultiError is an error wrapping multiple
-// validation errors returned by RecordListReply_LocationList.ValidateAll() if
-// the designated constraints aren't met.
-type RecordListReply_LocationListMultiError []error
+}
+
+// UserAuthListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by UserAuthListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type UserAuthListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecordListReply_LocationListMultiError) Error() string {
+func (m UserAuthListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13951,12 +21829,11 @@ func (m RecordListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecordListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m UserAuthListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecordListReply_LocationListValidationError is the validation error returned
-// by RecordListReply_LocationList.Validate if the designated constraints
-// aren't met.
-type RecordListReply_LocationListValidationError struct {
+// UserAuthListReply_ListValidationError is the validation error returned by
+// UserAuthListReply_List.Validate if the designated constraints aren't met.
+type UserAuthListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13964,24 +21841,24 @@ type RecordListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecordListReply_LocationListValidationError) Field() string { return e.field }
+func (e UserAuthListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecordListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e UserAuthListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecordListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e UserAuthListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecordListReply_LocationListValidationError) Key() bool { return e.key }
+func (e UserAuthListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecordListReply_LocationListValidationError) ErrorName() string {
-	return "RecordListReply_LocationListValidationError"
+func (e UserAuthListReply_ListValidationError) ErrorName() string {
+	return "UserAuthListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecordListReply_LocationListValidationError) Error() string {
+func (e UserAuthListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13993,14 +21870,14 @@ func (e RecordListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecordListReply_LocationList.%s: %s%s",
+		"invalid %sUserAuthListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecordListReply_LocationListValidationError{}
+var _ error = UserAuthListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -14008,58 +21885,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecordListReply_LocationListValidationError{}
+} = UserAuthListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationListReply_LocationList with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationListReply_LocationList) Validate() error {
+// Validate checks the field values on MyAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MyAuthListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on MyAuthListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MyAuthListReply_ListMultiError, or nil if none found.
+func (m *MyAuthListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply_LocationList) validate(all bool) error {
+func (m *MyAuthListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Current
-
-	// no validation rules for CurrentMax
-
-	// no validation rules for Usdt
+	// no validation rules for Id
 
-	// no validation rules for CurrentMaxSub
+	// no validation rules for Name
 
-	// no validation rules for Out
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return AdminLocationListReply_LocationListMultiError(errors)
+		return MyAuthListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReply_LocationListMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationListReply_LocationList.ValidateAll() if the designated
+// MyAuthListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by MyAuthListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationListReply_LocationListMultiError []error
+type MyAuthListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReply_LocationListMultiError) Error() string {
+func (m MyAuthListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14068,12 +21937,11 @@ func (m AdminLocationListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m MyAuthListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReply_LocationListValidationError is the validation error
-// returned by AdminLocationListReply_LocationList.Validate if the designated
-// constraints aren't met.
-type AdminLocationListReply_LocationListValidationError struct {
+// MyAuthListReply_ListValidationError is the validation error returned by
+// MyAuthListReply_List.Validate if the designated constraints aren't met.
+type MyAuthListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14081,24 +21949,24 @@ type AdminLocationListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReply_LocationListValidationError) Field() string { return e.field }
+func (e MyAuthListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e MyAuthListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e MyAuthListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReply_LocationListValidationError) Key() bool { return e.key }
+func (e MyAuthListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationListReply_LocationListValidationError"
+func (e MyAuthListReply_ListValidationError) ErrorName() string {
+	return "MyAuthListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReply_LocationListValidationError) Error() string {
+func (e MyAuthListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14110,14 +21978,14 @@ func (e AdminLocationListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply_LocationList.%s: %s%s",
+		"invalid %sMyAuthListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReply_LocationListValidationError{}
+var _ error = MyAuthListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -14125,59 +21993,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReply_LocationListValidationError{}
+} = MyAuthListReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationAllListReply_LocationList
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AdminLocationAllListReply_LocationList) Validate() error {
+// Validate checks the field values on AdminConfigUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AdminLocationAllListReply_LocationList with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// AdminLocationAllListReply_LocationListMultiError, or nil if none found.
-func (m *AdminLocationAllListReply_LocationList) ValidateAll() error {
+// ValidateAll checks the field values on AdminConfigUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationAllListReply_LocationList) validate(all bool) error {
+func (m *AdminConfigUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Address
-
-	// no validation rules for Status
+	if m.GetId() <= 0 {
+		err := AdminConfigUpdateRequest_SendBodyValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CurrentLevel
+	// no validation rules for Value
 
-	// no validation rules for Current
+	// no validation rules for EffectiveFrom
 
-	// no validation rules for CurrentMax
+	// no validation rules for Note
 
 	if len(errors) > 0 {
-		return AdminLocationAllListReply_LocationListMultiError(errors)
+		return AdminConfigUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationAllListReply_LocationListMultiError is an error wrapping
-// multiple validation errors returned by
-// AdminLocationAllListReply_LocationList.ValidateAll() if the designated
+// AdminConfigUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminConfigUpdateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLocationAllListReply_LocationListMultiError []error
+type AdminConfigUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
+func (m AdminConfigUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14186,12 +22057,12 @@ func (m AdminLocationAllListReply_LocationListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationAllListReply_LocationListMultiError) AllErrors() []error { return m }
+func (m AdminConfigUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminLocationAllListReply_LocationListValidationError is the validation
-// error returned by AdminLocationAllListReply_LocationList.Validate if the
-// designated constraints aren't met.
-type AdminLocationAllListReply_LocationListValidationError struct {
+// AdminConfigUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminConfigUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14199,24 +22070,24 @@ type AdminLocationAllListReply_LocationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationAllListReply_LocationListValidationError) Field() string { return e.field }
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationAllListReply_LocationListValidationError) Reason() string { return e.reason }
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationAllListReply_LocationListValidationError) Cause() error { return e.cause }
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationAllListReply_LocationListValidationError) Key() bool { return e.key }
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationAllListReply_LocationListValidationError) ErrorName() string {
-	return "AdminLocationAllListReply_LocationListValidationError"
+func (e AdminConfigUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
+func (e AdminConfigUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14228,14 +22099,14 @@ func (e AdminLocationAllListReply_LocationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationAllListReply_LocationList.%s: %s%s",
+		"invalid %sAdminConfigUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationAllListReply_LocationListValidationError{}
+var _ error = AdminConfigUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14243,58 +22114,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationAllListReply_LocationListValidationError{}
+} = AdminConfigUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply_List with the
+// Validate checks the field values on AdminConfigHistoryReply_Version with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply_List) Validate() error {
+func (m *AdminConfigHistoryReply_Version) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminConfigHistoryReply_Version with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReply_ListMultiError, or nil if none found.
-func (m *AdminWithdrawListReply_List) ValidateAll() error {
+// AdminConfigHistoryReply_VersionMultiError, or nil if none found.
+func (m *AdminConfigHistoryReply_Version) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply_List) validate(all bool) error {
+func (m *AdminConfigHistoryReply_Version) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
 	// no validation rules for Id
 
-	// no validation rules for CreatedAt
+	// no validation rules for Value
 
-	// no validation rules for Amount
+	// no validation rules for PreviousValue
 
-	// no validation rules for RelAmount
+	// no validation rules for Diff
 
-	// no validation rules for Type
+	// no validation rules for AdminId
 
-	// no validation rules for Status
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for Note
+
+	// no validation rules for RollbackOf
+
+	// no validation rules for Current
+
+	// no validation rules for Pending
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReply_ListMultiError(errors)
+		return AdminConfigHistoryReply_VersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminWithdrawListReply_List.ValidateAll() if
-// the designated constraints aren't met.
-type AdminWithdrawListReply_ListMultiError []error
+// AdminConfigHistoryReply_VersionMultiError is an error wrapping multiple
+// validation errors returned by AdminConfigHistoryReply_Version.ValidateAll()
+// if the designated constraints aren't met.
+type AdminConfigHistoryReply_VersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReply_ListMultiError) Error() string {
+func (m AdminConfigHistoryReply_VersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14303,12 +22182,12 @@ func (m AdminWithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminConfigHistoryReply_VersionMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReply_ListValidationError is the validation error returned
-// by AdminWithdrawListReply_List.Validate if the designated constraints
-// aren't met.
-type AdminWithdrawListReply_ListValidationError struct {
+// AdminConfigHistoryReply_VersionValidationError is the validation error
+// returned by AdminConfigHistoryReply_Version.Validate if the designated
+// constraints aren't met.
+type AdminConfigHistoryReply_VersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14316,24 +22195,24 @@ type AdminWithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminConfigHistoryReply_VersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminConfigHistoryReply_VersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminConfigHistoryReply_VersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminConfigHistoryReply_VersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReply_ListValidationError) ErrorName() string {
-	return "AdminWithdrawListReply_ListValidationError"
+func (e AdminConfigHistoryReply_VersionValidationError) ErrorName() string {
+	return "AdminConfigHistoryReply_VersionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReply_ListValidationError) Error() string {
+func (e AdminConfigHistoryReply_VersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14345,14 +22224,14 @@ func (e AdminWithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminConfigHistoryReply_Version.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReply_ListValidationError{}
+var _ error = AdminConfigHistoryReply_VersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -14360,48 +22239,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReply_ListValidationError{}
+} = AdminConfigHistoryReply_VersionValidationError{}
 
-// Validate checks the field values on AdminWithdrawPassRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminWithdrawPassRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminConfigRollbackRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRollbackRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawPassRequest_SendBody
+// ValidateAll checks the field values on AdminConfigRollbackRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminWithdrawPassRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminWithdrawPassRequest_SendBody) ValidateAll() error {
+// AdminConfigRollbackRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminConfigRollbackRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawPassRequest_SendBody) validate(all bool) error {
+func (m *AdminConfigRollbackRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetVersionId() <= 0 {
+		err := AdminConfigRollbackRequest_SendBodyValidationError{
+			field:  "VersionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Note
 
 	if len(errors) > 0 {
-		return AdminWithdrawPassRequest_SendBodyMultiError(errors)
+		return AdminConfigRollbackRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawPassRequest_SendBodyMultiError is an error wrapping multiple
+// AdminConfigRollbackRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminWithdrawPassRequest_SendBody.ValidateAll() if the designated
+// AdminConfigRollbackRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawPassRequest_SendBodyMultiError []error
+type AdminConfigRollbackRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawPassRequest_SendBodyMultiError) Error() string {
+func (m AdminConfigRollbackRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14410,12 +22299,12 @@ func (m AdminWithdrawPassRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawPassRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminConfigRollbackRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawPassRequest_SendBodyValidationError is the validation error
-// returned by AdminWithdrawPassRequest_SendBody.Validate if the designated
+// AdminConfigRollbackRequest_SendBodyValidationError is the validation error
+// returned by AdminConfigRollbackRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminWithdrawPassRequest_SendBodyValidationError struct {
+type AdminConfigRollbackRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14423,24 +22312,24 @@ type AdminWithdrawPassRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminConfigRollbackRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminConfigRollbackRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminConfigRollbackRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminConfigRollbackRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawPassRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminWithdrawPassRequest_SendBodyValidationError"
+func (e AdminConfigRollbackRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminConfigRollbackRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawPassRequest_SendBodyValidationError) Error() string {
+func (e AdminConfigRollbackRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14452,14 +22341,14 @@ func (e AdminWithdrawPassRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawPassRequest_SendBody.%s: %s%s",
+		"invalid %sAdminConfigRollbackRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawPassRequest_SendBodyValidationError{}
+var _ error = AdminConfigRollbackRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14467,24 +22356,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawPassRequest_SendBodyValidationError{}
+} = AdminConfigRollbackRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply_List with the
+// Validate checks the field values on AdminPriceRevalueReply_Item with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply_List) Validate() error {
+func (m *AdminPriceRevalueReply_Item) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply_List with the
+// ValidateAll checks the field values on AdminPriceRevalueReply_Item with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminUserRecommendReply_List) ValidateAll() error {
+// AdminPriceRevalueReply_ItemMultiError, or nil if none found.
+func (m *AdminPriceRevalueReply_Item) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply_List) validate(all bool) error {
+func (m *AdminPriceRevalueReply_Item) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14493,32 +22382,34 @@ func (m *AdminUserRecommendReply_List) validate(all bool) error {
 
 	// no validation rules for UserId
 
-	// no validation rules for Address
-
-	// no validation rules for Id
+	// no validation rules for LocationId
 
-	// no validation rules for CreatedAt
+	// no validation rules for Direction
 
 	// no validation rules for Amount
 
-	// no validation rules for RelAmount
+	// no validation rules for Stopped
 
-	// no validation rules for RecommendAllAmount
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	// no validation rules for UpdatedAt
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReply_ListMultiError(errors)
+		return AdminPriceRevalueReply_ItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminUserRecommendReply_List.ValidateAll() if
+// AdminPriceRevalueReply_ItemMultiError is an error wrapping multiple
+// validation errors returned by AdminPriceRevalueReply_Item.ValidateAll() if
 // the designated constraints aren't met.
-type AdminUserRecommendReply_ListMultiError []error
+type AdminPriceRevalueReply_ItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReply_ListMultiError) Error() string {
+func (m AdminPriceRevalueReply_ItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14527,12 +22418,12 @@ func (m AdminUserRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminPriceRevalueReply_ItemMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReply_ListValidationError is the validation error returned
-// by AdminUserRecommendReply_List.Validate if the designated constraints
-// aren't met.
-type AdminUserRecommendReply_ListValidationError struct {
+// AdminPriceRevalueReply_ItemValidationError is the validation error returned
+// by AdminPriceRevalueReply_Item.Validate if the designated constraints aren't
+// met.
+type AdminPriceRevalueReply_ItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14540,24 +22431,24 @@ type AdminUserRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminPriceRevalueReply_ItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminPriceRevalueReply_ItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminPriceRevalueReply_ItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminPriceRevalueReply_ItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminUserRecommendReply_ListValidationError"
+func (e AdminPriceRevalueReply_ItemValidationError) ErrorName() string {
+	return "AdminPriceRevalueReply_ItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReply_ListValidationError) Error() string {
+func (e AdminPriceRevalueReply_ItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14569,14 +22460,14 @@ func (e AdminUserRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply_List.%s: %s%s",
+		"invalid %sAdminPriceRevalueReply_Item.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReply_ListValidationError{}
+var _ error = AdminPriceRevalueReply_ItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -14584,52 +22475,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReply_ListValidationError{}
+} = AdminPriceRevalueReply_ItemValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply_List with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminPasswordUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply_List) Validate() error {
+func (m *AdminPasswordUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReply_ListMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminPasswordUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminPasswordUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminPasswordUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply_List) validate(all bool) error {
+func (m *AdminPasswordUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for RecommendAddress
-
-	// no validation rules for Id
+	if m.GetUserId() <= 0 {
+		err := AdminPasswordUpdateRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CreatedAt
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendReply_ListMultiError(errors)
+		return AdminPasswordUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReply_ListMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type AdminMonthRecommendReply_ListMultiError []error
+// AdminPasswordUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminPasswordUpdateRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPasswordUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReply_ListMultiError) Error() string {
+func (m AdminPasswordUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14638,12 +22535,12 @@ func (m AdminMonthRecommendReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminPasswordUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReply_ListValidationError is the validation error
-// returned by AdminMonthRecommendReply_List.Validate if the designated
+// AdminPasswordUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminPasswordUpdateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminMonthRecommendReply_ListValidationError struct {
+type AdminPasswordUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14651,24 +22548,24 @@ type AdminMonthRecommendReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReply_ListValidationError) Field() string { return e.field }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReply_ListValidationError) Key() bool { return e.key }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReply_ListValidationError) ErrorName() string {
-	return "AdminMonthRecommendReply_ListValidationError"
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminPasswordUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReply_ListValidationError) Error() string {
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14680,14 +22577,14 @@ func (e AdminMonthRecommendReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply_List.%s: %s%s",
+		"invalid %sAdminPasswordUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReply_ListValidationError{}
+var _ error = AdminPasswordUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14695,50 +22592,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReply_ListValidationError{}
+} = AdminPasswordUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply_List) Validate() error {
+// Validate checks the field values on
+// AdminUpdateLocationNewMaxRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminUpdateLocationNewMaxRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminConfigReply_ListMultiError, or nil if none found.
-func (m *AdminConfigReply_List) ValidateAll() error {
+// ValidateAll checks the field values on
+// AdminUpdateLocationNewMaxRequest_SendBody with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AdminUpdateLocationNewMaxRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminUpdateLocationNewMaxRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply_List) validate(all bool) error {
+func (m *AdminUpdateLocationNewMaxRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
+	if m.GetUserId() <= 0 {
+		err := AdminUpdateLocationNewMaxRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Value
+	if !_AdminUpdateLocationNewMaxRequest_SendBody_Amount_Pattern.MatchString(m.GetAmount()) {
+		err := AdminUpdateLocationNewMaxRequest_SendBodyValidationError{
+			field:  "Amount",
+			reason: "value does not match regex pattern \"^[0-9]{1,12}(\\\\.[0-9]{1,5})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AdminConfigReply_ListMultiError(errors)
+		return AdminUpdateLocationNewMaxRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigReply_List.ValidateAll() if the designated
+// AdminUpdateLocationNewMaxRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminUpdateLocationNewMaxRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigReply_ListMultiError []error
+type AdminUpdateLocationNewMaxRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReply_ListMultiError) Error() string {
+func (m AdminUpdateLocationNewMaxRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14747,11 +22663,12 @@ func (m AdminConfigReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUpdateLocationNewMaxRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigReply_ListValidationError is the validation error returned by
-// AdminConfigReply_List.Validate if the designated constraints aren't met.
-type AdminConfigReply_ListValidationError struct {
+// AdminUpdateLocationNewMaxRequest_SendBodyValidationError is the validation
+// error returned by AdminUpdateLocationNewMaxRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminUpdateLocationNewMaxRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14759,24 +22676,24 @@ type AdminConfigReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReply_ListValidationError) Field() string { return e.field }
+func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReply_ListValidationError) ErrorName() string {
-	return "AdminConfigReply_ListValidationError"
+func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminUpdateLocationNewMaxRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReply_ListValidationError) Error() string {
+func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14788,14 +22705,14 @@ func (e AdminConfigReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply_List.%s: %s%s",
+		"invalid %sAdminUpdateLocationNewMaxRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReply_ListValidationError{}
+var _ error = AdminUpdateLocationNewMaxRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14803,48 +22720,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReply_ListValidationError{}
+} = AdminUpdateLocationNewMaxRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+var _AdminUpdateLocationNewMaxRequest_SendBody_Amount_Pattern = regexp.MustCompile("^[0-9]{1,12}(\\.[0-9]{1,5})?$")
+
+// Validate checks the field values on AdminVipDeleteRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminListReply_List) Validate() error {
+func (m *AdminVipDeleteRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminVipDeleteRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminListReply_ListMultiError, or nil if none found.
-func (m *AdminListReply_List) ValidateAll() error {
+// AdminVipDeleteRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminVipDeleteRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminListReply_List) validate(all bool) error {
+func (m *AdminVipDeleteRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetId() <= 0 {
+		err := AdminVipDeleteRequest_SendBodyValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Account
+	if m.GetAmount() < 0 {
+		err := AdminVipDeleteRequest_SendBodyValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Kkdt
 
 	if len(errors) > 0 {
-		return AdminListReply_ListMultiError(errors)
+		return AdminVipDeleteRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by AdminListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type AdminListReply_ListMultiError []error
+// AdminVipDeleteRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminVipDeleteRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminVipDeleteRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminListReply_ListMultiError) Error() string {
+func (m AdminVipDeleteRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14853,11 +22792,12 @@ func (m AdminListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminVipDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminListReply_ListValidationError is the validation error returned by
-// AdminListReply_List.Validate if the designated constraints aren't met.
-type AdminListReply_ListValidationError struct {
+// AdminVipDeleteRequest_SendBodyValidationError is the validation error
+// returned by AdminVipDeleteRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminVipDeleteRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14865,24 +22805,24 @@ type AdminListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminListReply_ListValidationError) Field() string { return e.field }
+func (e AdminVipDeleteRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminVipDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminVipDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminVipDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminListReply_ListValidationError) ErrorName() string {
-	return "AdminListReply_ListValidationError"
+func (e AdminVipDeleteRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminVipDeleteRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminListReply_ListValidationError) Error() string {
+func (e AdminVipDeleteRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14894,14 +22834,14 @@ func (e AdminListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminListReply_List.%s: %s%s",
+		"invalid %sAdminVipDeleteRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminListReply_ListValidationError{}
+var _ error = AdminVipDeleteRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -14909,50 +22849,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminListReply_ListValidationError{}
+} = AdminVipDeleteRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminVipUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthListReply_List) Validate() error {
+func (m *AdminVipUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminVipUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthListReply_ListMultiError, or nil if none found.
-func (m *AuthListReply_List) ValidateAll() error {
+// AdminVipUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminVipUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthListReply_List) validate(all bool) error {
+func (m *AdminVipUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
+	if m.GetUserId() <= 0 {
+		err := AdminVipUpdateRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Path
+	// no validation rules for Vip
 
 	if len(errors) > 0 {
-		return AuthListReply_ListMultiError(errors)
+		return AdminVipUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthListReply_ListMultiError is an error wrapping multiple validation errors
-// returned by AuthListReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type AuthListReply_ListMultiError []error
+// AdminVipUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminVipUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminVipUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthListReply_ListMultiError) Error() string {
+func (m AdminVipUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14961,11 +22908,12 @@ func (m AuthListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminVipUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AuthListReply_ListValidationError is the validation error returned by
-// AuthListReply_List.Validate if the designated constraints aren't met.
-type AuthListReply_ListValidationError struct {
+// AdminVipUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminVipUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminVipUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14973,24 +22921,24 @@ type AuthListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthListReply_ListValidationError) Field() string { return e.field }
+func (e AdminVipUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminVipUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminVipUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminVipUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthListReply_ListValidationError) ErrorName() string {
-	return "AuthListReply_ListValidationError"
+func (e AdminVipUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminVipUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthListReply_ListValidationError) Error() string {
+func (e AdminVipUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15002,14 +22950,14 @@ func (e AuthListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthListReply_List.%s: %s%s",
+		"invalid %sAdminVipUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthListReply_ListValidationError{}
+var _ error = AdminVipUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15017,50 +22965,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthListReply_ListValidationError{}
+} = AdminVipUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminKkdtUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserAuthListReply_List) Validate() error {
+func (m *AdminKkdtUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminKkdtUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserAuthListReply_ListMultiError, or nil if none found.
-func (m *UserAuthListReply_List) ValidateAll() error {
+// AdminKkdtUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminKkdtUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAuthListReply_List) validate(all bool) error {
+func (m *AdminKkdtUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
+	if m.GetUserId() <= 0 {
+		err := AdminKkdtUpdateRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Path
+	if m.GetAmount() < 0 {
+		err := AdminKkdtUpdateRequest_SendBodyValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UserAuthListReply_ListMultiError(errors)
+		return AdminKkdtUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAuthListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by UserAuthListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type UserAuthListReply_ListMultiError []error
+// AdminKkdtUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminKkdtUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminKkdtUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAuthListReply_ListMultiError) Error() string {
+func (m AdminKkdtUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15069,11 +23033,12 @@ func (m UserAuthListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAuthListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminKkdtUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserAuthListReply_ListValidationError is the validation error returned by
-// UserAuthListReply_List.Validate if the designated constraints aren't met.
-type UserAuthListReply_ListValidationError struct {
+// AdminKkdtUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminKkdtUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminKkdtUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15081,24 +23046,24 @@ type UserAuthListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAuthListReply_ListValidationError) Field() string { return e.field }
+func (e AdminKkdtUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAuthListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminKkdtUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAuthListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminKkdtUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAuthListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminKkdtUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAuthListReply_ListValidationError) ErrorName() string {
-	return "UserAuthListReply_ListValidationError"
+func (e AdminKkdtUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminKkdtUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAuthListReply_ListValidationError) Error() string {
+func (e AdminKkdtUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15110,14 +23075,14 @@ func (e UserAuthListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAuthListReply_List.%s: %s%s",
+		"invalid %sAdminKkdtUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAuthListReply_ListValidationError{}
+var _ error = AdminKkdtUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15125,50 +23090,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAuthListReply_ListValidationError{}
+} = AdminKkdtUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on MyAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUndoUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MyAuthListReply_List) Validate() error {
+func (m *AdminUndoUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MyAuthListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUndoUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MyAuthListReply_ListMultiError, or nil if none found.
-func (m *MyAuthListReply_List) ValidateAll() error {
+// AdminUndoUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminUndoUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MyAuthListReply_List) validate(all bool) error {
+func (m *AdminUndoUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
+	if m.GetUserId() <= 0 {
+		err := AdminUndoUpdateRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Path
+	// no validation rules for Undo
 
 	if len(errors) > 0 {
-		return MyAuthListReply_ListMultiError(errors)
+		return AdminUndoUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// MyAuthListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by MyAuthListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type MyAuthListReply_ListMultiError []error
+// AdminUndoUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminUndoUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUndoUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MyAuthListReply_ListMultiError) Error() string {
+func (m AdminUndoUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15177,11 +23149,12 @@ func (m MyAuthListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MyAuthListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminUndoUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// MyAuthListReply_ListValidationError is the validation error returned by
-// MyAuthListReply_List.Validate if the designated constraints aren't met.
-type MyAuthListReply_ListValidationError struct {
+// AdminUndoUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminUndoUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminUndoUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15189,24 +23162,24 @@ type MyAuthListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e MyAuthListReply_ListValidationError) Field() string { return e.field }
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MyAuthListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MyAuthListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MyAuthListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MyAuthListReply_ListValidationError) ErrorName() string {
-	return "MyAuthListReply_ListValidationError"
+func (e AdminUndoUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminUndoUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MyAuthListReply_ListValidationError) Error() string {
+func (e AdminUndoUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15218,14 +23191,14 @@ func (e MyAuthListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMyAuthListReply_List.%s: %s%s",
+		"invalid %sAdminUndoUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MyAuthListReply_ListValidationError{}
+var _ error = AdminUndoUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15233,50 +23206,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MyAuthListReply_ListValidationError{}
+} = AdminUndoUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminConfigUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminAreaLevelUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if there
+// are no violations.
+func (m *AdminAreaLevelUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest_SendBody
+// ValidateAll checks the field values on AdminAreaLevelUpdateRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest_SendBody) ValidateAll() error {
+// AdminAreaLevelUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminAreaLevelUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminAreaLevelUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetUserId() <= 0 {
+		err := AdminAreaLevelUpdateRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Value
+	// no validation rules for Level
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequest_SendBodyMultiError(errors)
+		return AdminAreaLevelUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// AdminAreaLevelUpdateRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminConfigUpdateRequest_SendBody.ValidateAll() if the designated
+// AdminAreaLevelUpdateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminConfigUpdateRequest_SendBodyMultiError []error
+type AdminAreaLevelUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15285,12 +23267,12 @@ func (m AdminConfigUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminConfigUpdateRequest_SendBody.Validate if the designated
+// AdminAreaLevelUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminAreaLevelUpdateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminConfigUpdateRequest_SendBodyValidationError struct {
+type AdminAreaLevelUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15298,24 +23280,24 @@ type AdminConfigUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequest_SendBodyValidationError"
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminAreaLevelUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15327,14 +23309,14 @@ func (e AdminConfigUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminAreaLevelUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequest_SendBodyValidationError{}
+var _ error = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15342,50 +23324,71 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequest_SendBodyValidationError{}
+} = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminPasswordUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminPasswordUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminLocationInsertRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationInsertRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminPasswordUpdateRequest_SendBody
+// ValidateAll checks the field values on AdminLocationInsertRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminPasswordUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminPasswordUpdateRequest_SendBody) ValidateAll() error {
+// AdminLocationInsertRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLocationInsertRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminPasswordUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminLocationInsertRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	if m.GetUserId() <= 0 {
+		err := AdminLocationInsertRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Password
+	if m.GetAmount() <= 0 {
+		err := AdminLocationInsertRequest_SendBodyValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Ref
+
+	// no validation rules for Note
 
 	if len(errors) > 0 {
-		return AdminPasswordUpdateRequest_SendBodyMultiError(errors)
+		return AdminLocationInsertRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminPasswordUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// AdminLocationInsertRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminPasswordUpdateRequest_SendBody.ValidateAll() if the designated
+// AdminLocationInsertRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminPasswordUpdateRequest_SendBodyMultiError []error
+type AdminLocationInsertRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminPasswordUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminLocationInsertRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15394,12 +23397,12 @@ func (m AdminPasswordUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminPasswordUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLocationInsertRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminPasswordUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminPasswordUpdateRequest_SendBody.Validate if the designated
+// AdminLocationInsertRequest_SendBodyValidationError is the validation error
+// returned by AdminLocationInsertRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminPasswordUpdateRequest_SendBodyValidationError struct {
+type AdminLocationInsertRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15407,24 +23410,24 @@ type AdminPasswordUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminPasswordUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLocationInsertRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminPasswordUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLocationInsertRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminPasswordUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLocationInsertRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminPasswordUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLocationInsertRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminPasswordUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminPasswordUpdateRequest_SendBodyValidationError"
+func (e AdminLocationInsertRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLocationInsertRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminPasswordUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminLocationInsertRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15436,14 +23439,14 @@ func (e AdminPasswordUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminPasswordUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLocationInsertRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminPasswordUpdateRequest_SendBodyValidationError{}
+var _ error = AdminLocationInsertRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15451,51 +23454,67 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminPasswordUpdateRequest_SendBodyValidationError{}
+} = AdminLocationInsertRequest_SendBodyValidationError{}
 
-// Validate checks the field values on
-// AdminUpdateLocationNewMaxRequest_SendBody with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminUpdateLocationNewMaxRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminBalanceUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminBalanceUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AdminUpdateLocationNewMaxRequest_SendBody with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AdminUpdateLocationNewMaxRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminUpdateLocationNewMaxRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminBalanceUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminBalanceUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminBalanceUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUpdateLocationNewMaxRequest_SendBody) validate(all bool) error {
+func (m *AdminBalanceUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	if m.GetUserId() <= 0 {
+		err := AdminBalanceUpdateRequest_SendBodyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Amount
+	if !_AdminBalanceUpdateRequest_SendBody_Amount_Pattern.MatchString(m.GetAmount()) {
+		err := AdminBalanceUpdateRequest_SendBodyValidationError{
+			field:  "Amount",
+			reason: "value does not match regex pattern \"^[0-9]{1,12}(\\\\.[0-9]{1,5})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AdminUpdateLocationNewMaxRequest_SendBodyMultiError(errors)
+		return AdminBalanceUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUpdateLocationNewMaxRequest_SendBodyMultiError is an error wrapping
-// multiple validation errors returned by
-// AdminUpdateLocationNewMaxRequest_SendBody.ValidateAll() if the designated
+// AdminBalanceUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminBalanceUpdateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUpdateLocationNewMaxRequest_SendBodyMultiError []error
+type AdminBalanceUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUpdateLocationNewMaxRequest_SendBodyMultiError) Error() string {
+func (m AdminBalanceUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15504,12 +23523,12 @@ func (m AdminUpdateLocationNewMaxRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUpdateLocationNewMaxRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminBalanceUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUpdateLocationNewMaxRequest_SendBodyValidationError is the validation
-// error returned by AdminUpdateLocationNewMaxRequest_SendBody.Validate if the
-// designated constraints aren't met.
-type AdminUpdateLocationNewMaxRequest_SendBodyValidationError struct {
+// AdminBalanceUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminBalanceUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminBalanceUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15517,24 +23536,24 @@ type AdminUpdateLocationNewMaxRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminUpdateLocationNewMaxRequest_SendBodyValidationError"
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminBalanceUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Error() string {
+func (e AdminBalanceUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15546,14 +23565,14 @@ func (e AdminUpdateLocationNewMaxRequest_SendBodyValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUpdateLocationNewMaxRequest_SendBody.%s: %s%s",
+		"invalid %sAdminBalanceUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUpdateLocationNewMaxRequest_SendBodyValidationError{}
+var _ error = AdminBalanceUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15561,50 +23580,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUpdateLocationNewMaxRequest_SendBodyValidationError{}
+} = AdminBalanceUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminVipDeleteRequest_SendBody with the
+var _AdminBalanceUpdateRequest_SendBody_Amount_Pattern = regexp.MustCompile("^[0-9]{1,12}(\\.[0-9]{1,5})?$")
+
+// Validate checks the field values on AuthAdminCreateRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipDeleteRequest_SendBody) Validate() error {
+func (m *AuthAdminCreateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipDeleteRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminVipDeleteRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminVipDeleteRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AuthAdminCreateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAdminCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *AuthAdminCreateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipDeleteRequest_SendBody) validate(all bool) error {
+func (m *AuthAdminCreateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Amount
+	if m.GetAdminId() <= 0 {
+		err := AuthAdminCreateRequest_SendBodyValidationError{
+			field:  "AdminId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Kkdt
+	if m.GetAuthId() <= 0 {
+		err := AuthAdminCreateRequest_SendBodyValidationError{
+			field:  "AuthId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AdminVipDeleteRequest_SendBodyMultiError(errors)
+		return AuthAdminCreateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipDeleteRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminVipDeleteRequest_SendBody.ValidateAll()
+// AuthAdminCreateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AuthAdminCreateRequest_SendBody.ValidateAll()
 // if the designated constraints aren't met.
-type AdminVipDeleteRequest_SendBodyMultiError []error
+type AuthAdminCreateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipDeleteRequest_SendBodyMultiError) Error() string {
+func (m AuthAdminCreateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15613,12 +23650,12 @@ func (m AdminVipDeleteRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AuthAdminCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminVipDeleteRequest_SendBodyValidationError is the validation error
-// returned by AdminVipDeleteRequest_SendBody.Validate if the designated
+// AuthAdminCreateRequest_SendBodyValidationError is the validation error
+// returned by AuthAdminCreateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminVipDeleteRequest_SendBodyValidationError struct {
+type AuthAdminCreateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15626,24 +23663,24 @@ type AdminVipDeleteRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipDeleteRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AuthAdminCreateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AuthAdminCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AuthAdminCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AuthAdminCreateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipDeleteRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminVipDeleteRequest_SendBodyValidationError"
+func (e AuthAdminCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "AuthAdminCreateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipDeleteRequest_SendBodyValidationError) Error() string {
+func (e AuthAdminCreateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15655,14 +23692,14 @@ func (e AdminVipDeleteRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipDeleteRequest_SendBody.%s: %s%s",
+		"invalid %sAuthAdminCreateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipDeleteRequest_SendBodyValidationError{}
+var _ error = AuthAdminCreateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15670,48 +23707,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipDeleteRequest_SendBodyValidationError{}
+} = AuthAdminCreateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminVipUpdateRequest_SendBody with the
+// Validate checks the field values on AuthAdminDeleteRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminVipUpdateRequest_SendBody) Validate() error {
+func (m *AuthAdminDeleteRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminVipUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminVipUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminVipUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AuthAdminDeleteRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAdminDeleteRequest_SendBodyMultiError, or nil if none found.
+func (m *AuthAdminDeleteRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminVipUpdateRequest_SendBody) validate(all bool) error {
+func (m *AuthAdminDeleteRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	if m.GetAdminId() <= 0 {
+		err := AuthAdminDeleteRequest_SendBodyValidationError{
+			field:  "AdminId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Vip
+	if m.GetAuthId() <= 0 {
+		err := AuthAdminDeleteRequest_SendBodyValidationError{
+			field:  "AuthId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AdminVipUpdateRequest_SendBodyMultiError(errors)
+		return AuthAdminDeleteRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminVipUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminVipUpdateRequest_SendBody.ValidateAll()
+// AuthAdminDeleteRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AuthAdminDeleteRequest_SendBody.ValidateAll()
 // if the designated constraints aren't met.
-type AdminVipUpdateRequest_SendBodyMultiError []error
+type AuthAdminDeleteRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminVipUpdateRequest_SendBodyMultiError) Error() string {
+func (m AuthAdminDeleteRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15720,12 +23775,12 @@ func (m AdminVipUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminVipUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AuthAdminDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminVipUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminVipUpdateRequest_SendBody.Validate if the designated
+// AuthAdminDeleteRequest_SendBodyValidationError is the validation error
+// returned by AuthAdminDeleteRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminVipUpdateRequest_SendBodyValidationError struct {
+type AuthAdminDeleteRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15733,24 +23788,24 @@ type AdminVipUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminVipUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminVipUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminVipUpdateRequest_SendBodyValidationError"
+func (e AuthAdminDeleteRequest_SendBodyValidationError) ErrorName() string {
+	return "AuthAdminDeleteRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminVipUpdateRequest_SendBodyValidationError) Error() string {
+func (e AuthAdminDeleteRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15762,14 +23817,14 @@ func (e AdminVipUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminVipUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAuthAdminDeleteRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminVipUpdateRequest_SendBodyValidationError{}
+var _ error = AuthAdminDeleteRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15777,48 +23832,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminVipUpdateRequest_SendBodyValidationError{}
+} = AuthAdminDeleteRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminKkdtUpdateRequest_SendBody with the
+// Validate checks the field values on AdminLoginRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminKkdtUpdateRequest_SendBody) Validate() error {
+func (m *AdminLoginRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminKkdtUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminKkdtUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminKkdtUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminLoginRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLoginRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminLoginRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminKkdtUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminLoginRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Account
 
-	// no validation rules for Amount
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return AdminKkdtUpdateRequest_SendBodyMultiError(errors)
+		return AdminLoginRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminKkdtUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminKkdtUpdateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type AdminKkdtUpdateRequest_SendBodyMultiError []error
+// AdminLoginRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminLoginRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type AdminLoginRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminKkdtUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminLoginRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15827,12 +23882,11 @@ func (m AdminKkdtUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminKkdtUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminKkdtUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminKkdtUpdateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminKkdtUpdateRequest_SendBodyValidationError struct {
+// AdminLoginRequest_SendBodyValidationError is the validation error returned
+// by AdminLoginRequest_SendBody.Validate if the designated constraints aren't met.
+type AdminLoginRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15840,24 +23894,24 @@ type AdminKkdtUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminKkdtUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLoginRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminKkdtUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminKkdtUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminKkdtUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLoginRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminKkdtUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminKkdtUpdateRequest_SendBodyValidationError"
+func (e AdminLoginRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminLoginRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminKkdtUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminLoginRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15869,14 +23923,14 @@ func (e AdminKkdtUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminKkdtUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLoginRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminKkdtUpdateRequest_SendBodyValidationError{}
+var _ error = AdminLoginRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15884,48 +23938,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminKkdtUpdateRequest_SendBodyValidationError{}
+} = AdminLoginRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminUndoUpdateRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUndoUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminChangePasswordRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminChangePasswordRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUndoUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AdminUndoUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminUndoUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminChangePasswordRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminChangePasswordRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminChangePasswordRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUndoUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminChangePasswordRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Account
 
-	// no validation rules for Undo
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return AdminUndoUpdateRequest_SendBodyMultiError(errors)
+		return AdminChangePasswordRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUndoUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminUndoUpdateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type AdminUndoUpdateRequest_SendBodyMultiError []error
+// AdminChangePasswordRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminChangePasswordRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminChangePasswordRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUndoUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminChangePasswordRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15934,12 +23990,12 @@ func (m AdminUndoUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUndoUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminChangePasswordRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUndoUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminUndoUpdateRequest_SendBody.Validate if the designated
+// AdminChangePasswordRequest_SendBodyValidationError is the validation error
+// returned by AdminChangePasswordRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminUndoUpdateRequest_SendBodyValidationError struct {
+type AdminChangePasswordRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15947,24 +24003,24 @@ type AdminUndoUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminChangePasswordRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminChangePasswordRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminChangePasswordRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminChangePasswordRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUndoUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminUndoUpdateRequest_SendBodyValidationError"
+func (e AdminChangePasswordRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminChangePasswordRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUndoUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminChangePasswordRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15976,14 +24032,14 @@ func (e AdminUndoUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUndoUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminChangePasswordRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUndoUpdateRequest_SendBodyValidationError{}
+var _ error = AdminChangePasswordRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -15991,50 +24047,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUndoUpdateRequest_SendBodyValidationError{}
+} = AdminChangePasswordRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminAreaLevelUpdateRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AdminAreaLevelUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminCreateAccountRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCreateAccountRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAreaLevelUpdateRequest_SendBody
+// ValidateAll checks the field values on AdminCreateAccountRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminAreaLevelUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminAreaLevelUpdateRequest_SendBody) ValidateAll() error {
+// AdminCreateAccountRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminCreateAccountRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAreaLevelUpdateRequest_SendBody) validate(all bool) error {
+func (m *AdminCreateAccountRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Account
 
-	// no validation rules for Level
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return AdminAreaLevelUpdateRequest_SendBodyMultiError(errors)
+		return AdminCreateAccountRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAreaLevelUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// AdminCreateAccountRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminAreaLevelUpdateRequest_SendBody.ValidateAll() if the designated
+// AdminCreateAccountRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminAreaLevelUpdateRequest_SendBodyMultiError []error
+type AdminCreateAccountRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) Error() string {
+func (m AdminCreateAccountRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16043,12 +24098,12 @@ func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAreaLevelUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminCreateAccountRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminAreaLevelUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminAreaLevelUpdateRequest_SendBody.Validate if the designated
+// AdminCreateAccountRequest_SendBodyValidationError is the validation error
+// returned by AdminCreateAccountRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminAreaLevelUpdateRequest_SendBodyValidationError struct {
+type AdminCreateAccountRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16056,24 +24111,24 @@ type AdminAreaLevelUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminCreateAccountRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminAreaLevelUpdateRequest_SendBodyValidationError"
+func (e AdminCreateAccountRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminCreateAccountRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Error() string {
+func (e AdminCreateAccountRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16085,14 +24140,14 @@ func (e AdminAreaLevelUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAreaLevelUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminCreateAccountRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
+var _ error = AdminCreateAccountRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -16100,25 +24155,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAreaLevelUpdateRequest_SendBodyValidationError{}
+} = AdminCreateAccountRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminLocationInsertRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminLocationInsertRequest_SendBody) Validate() error {
+// Validate checks the field values on RewardReport_User with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardReport_User) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationInsertRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminLocationInsertRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminLocationInsertRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on RewardReport_User with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RewardReport_UserMultiError, or nil if none found.
+func (m *RewardReport_User) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationInsertRequest_SendBody) validate(all bool) error {
+func (m *RewardReport_User) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -16127,23 +24181,26 @@ func (m *AdminLocationInsertRequest_SendBody) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	// no validation rules for Address
+
+	// no validation rules for Vip
+
 	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminLocationInsertRequest_SendBodyMultiError(errors)
+		return RewardReport_UserMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationInsertRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminLocationInsertRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationInsertRequest_SendBodyMultiError []error
+// RewardReport_UserMultiError is an error wrapping multiple validation errors
+// returned by RewardReport_User.ValidateAll() if the designated constraints
+// aren't met.
+type RewardReport_UserMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationInsertRequest_SendBodyMultiError) Error() string {
+func (m RewardReport_UserMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16152,12 +24209,11 @@ func (m AdminLocationInsertRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationInsertRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m RewardReport_UserMultiError) AllErrors() []error { return m }
 
-// AdminLocationInsertRequest_SendBodyValidationError is the validation error
-// returned by AdminLocationInsertRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminLocationInsertRequest_SendBodyValidationError struct {
+// RewardReport_UserValidationError is the validation error returned by
+// RewardReport_User.Validate if the designated constraints aren't met.
+type RewardReport_UserValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16165,24 +24221,24 @@ type AdminLocationInsertRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Field() string { return e.field }
+func (e RewardReport_UserValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e RewardReport_UserValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e RewardReport_UserValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationInsertRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e RewardReport_UserValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationInsertRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminLocationInsertRequest_SendBodyValidationError"
+func (e RewardReport_UserValidationError) ErrorName() string {
+	return "RewardReport_UserValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationInsertRequest_SendBodyValidationError) Error() string {
+func (e RewardReport_UserValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16194,14 +24250,14 @@ func (e AdminLocationInsertRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationInsertRequest_SendBody.%s: %s%s",
+		"invalid %sRewardReport_User.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationInsertRequest_SendBodyValidationError{}
+var _ error = RewardReport_UserValidationError{}
 
 var _ interface {
 	Field() string
@@ -16209,50 +24265,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationInsertRequest_SendBodyValidationError{}
+} = RewardReport_UserValidationError{}
 
-// Validate checks the field values on AdminBalanceUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminBalanceUpdateRequest_SendBody) Validate() error {
+// Validate checks the field values on RewardReport_Vip with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RewardReport_Vip) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminBalanceUpdateRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminBalanceUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminBalanceUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on RewardReport_Vip with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RewardReport_VipMultiError, or nil if none found.
+func (m *RewardReport_Vip) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminBalanceUpdateRequest_SendBody) validate(all bool) error {
+func (m *RewardReport_Vip) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Vip
+
+	// no validation rules for Count
 
 	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminBalanceUpdateRequest_SendBodyMultiError(errors)
+		return RewardReport_VipMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminBalanceUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminBalanceUpdateRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminBalanceUpdateRequest_SendBodyMultiError []error
+// RewardReport_VipMultiError is an error wrapping multiple validation errors
+// returned by RewardReport_Vip.ValidateAll() if the designated constraints
+// aren't met.
+type RewardReport_VipMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminBalanceUpdateRequest_SendBodyMultiError) Error() string {
+func (m RewardReport_VipMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16261,12 +24317,11 @@ func (m AdminBalanceUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminBalanceUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m RewardReport_VipMultiError) AllErrors() []error { return m }
 
-// AdminBalanceUpdateRequest_SendBodyValidationError is the validation error
-// returned by AdminBalanceUpdateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AdminBalanceUpdateRequest_SendBodyValidationError struct {
+// RewardReport_VipValidationError is the validation error returned by
+// RewardReport_Vip.Validate if the designated constraints aren't met.
+type RewardReport_VipValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16274,24 +24329,22 @@ type AdminBalanceUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e RewardReport_VipValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e RewardReport_VipValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e RewardReport_VipValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e RewardReport_VipValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminBalanceUpdateRequest_SendBodyValidationError"
-}
+func (e RewardReport_VipValidationError) ErrorName() string { return "RewardReport_VipValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminBalanceUpdateRequest_SendBodyValidationError) Error() string {
+func (e RewardReport_VipValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16303,14 +24356,14 @@ func (e AdminBalanceUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminBalanceUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sRewardReport_Vip.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminBalanceUpdateRequest_SendBodyValidationError{}
+var _ error = RewardReport_VipValidationError{}
 
 var _ interface {
 	Field() string
@@ -16318,48 +24371,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminBalanceUpdateRequest_SendBodyValidationError{}
+} = RewardReport_VipValidationError{}
 
-// Validate checks the field values on AuthAdminCreateRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminRewardRollbackRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminCreateRequest_SendBody) Validate() error {
+func (m *AdminRewardRollbackRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminCreateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AuthAdminCreateRequest_SendBodyMultiError, or nil if none found.
-func (m *AuthAdminCreateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminRewardRollbackRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminRewardRollbackRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminRewardRollbackRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminCreateRequest_SendBody) validate(all bool) error {
+func (m *AdminRewardRollbackRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AdminId
+	if m.GetRunId() < 0 {
+		err := AdminRewardRollbackRequest_SendBodyValidationError{
+			field:  "RunId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Job
+
+	// no validation rules for StartDate
 
-	// no validation rules for AuthId
+	// no validation rules for EndDate
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AuthAdminCreateRequest_SendBodyMultiError(errors)
+		return AdminRewardRollbackRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminCreateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AuthAdminCreateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type AuthAdminCreateRequest_SendBodyMultiError []error
+// AdminRewardRollbackRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminRewardRollbackRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardRollbackRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminCreateRequest_SendBodyMultiError) Error() string {
+func (m AdminRewardRollbackRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16368,12 +24437,12 @@ func (m AuthAdminCreateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminRewardRollbackRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AuthAdminCreateRequest_SendBodyValidationError is the validation error
-// returned by AuthAdminCreateRequest_SendBody.Validate if the designated
+// AdminRewardRollbackRequest_SendBodyValidationError is the validation error
+// returned by AdminRewardRollbackRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AuthAdminCreateRequest_SendBodyValidationError struct {
+type AdminRewardRollbackRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16381,24 +24450,24 @@ type AuthAdminCreateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminRewardRollbackRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminRewardRollbackRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminRewardRollbackRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminCreateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminRewardRollbackRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminCreateRequest_SendBodyValidationError) ErrorName() string {
-	return "AuthAdminCreateRequest_SendBodyValidationError"
+func (e AdminRewardRollbackRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminRewardRollbackRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminCreateRequest_SendBodyValidationError) Error() string {
+func (e AdminRewardRollbackRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16410,14 +24479,14 @@ func (e AuthAdminCreateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminCreateRequest_SendBody.%s: %s%s",
+		"invalid %sAdminRewardRollbackRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminCreateRequest_SendBodyValidationError{}
+var _ error = AdminRewardRollbackRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -16425,48 +24494,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminCreateRequest_SendBodyValidationError{}
+} = AdminRewardRollbackRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AuthAdminDeleteRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthAdminDeleteRequest_SendBody) Validate() error {
+// Validate checks the field values on RewardRollbackReport_Run with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RewardRollbackReport_Run) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthAdminDeleteRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AuthAdminDeleteRequest_SendBodyMultiError, or nil if none found.
-func (m *AuthAdminDeleteRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on RewardRollbackReport_Run with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RewardRollbackReport_RunMultiError, or nil if none found.
+func (m *RewardRollbackReport_Run) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthAdminDeleteRequest_SendBody) validate(all bool) error {
+func (m *RewardRollbackReport_Run) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AdminId
+	// no validation rules for RunId
+
+	// no validation rules for Job
+
+	// no validation rules for BusinessDate
+
+	// no validation rules for Items
 
-	// no validation rules for AuthId
+	// no validation rules for Rewards
+
+	// no validation rules for Records
+
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AuthAdminDeleteRequest_SendBodyMultiError(errors)
+		return RewardRollbackReport_RunMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthAdminDeleteRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AuthAdminDeleteRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type AuthAdminDeleteRequest_SendBodyMultiError []error
+// RewardRollbackReport_RunMultiError is an error wrapping multiple validation
+// errors returned by RewardRollbackReport_Run.ValidateAll() if the designated
+// constraints aren't met.
+type RewardRollbackReport_RunMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthAdminDeleteRequest_SendBodyMultiError) Error() string {
+func (m RewardRollbackReport_RunMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16475,12 +24554,11 @@ func (m AuthAdminDeleteRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthAdminDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m RewardRollbackReport_RunMultiError) AllErrors() []error { return m }
 
-// AuthAdminDeleteRequest_SendBodyValidationError is the validation error
-// returned by AuthAdminDeleteRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type AuthAdminDeleteRequest_SendBodyValidationError struct {
+// RewardRollbackReport_RunValidationError is the validation error returned by
+// RewardRollbackReport_Run.Validate if the designated constraints aren't met.
+type RewardRollbackReport_RunValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16488,24 +24566,24 @@ type AuthAdminDeleteRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Field() string { return e.field }
+func (e RewardRollbackReport_RunValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e RewardRollbackReport_RunValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e RewardRollbackReport_RunValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e RewardRollbackReport_RunValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthAdminDeleteRequest_SendBodyValidationError) ErrorName() string {
-	return "AuthAdminDeleteRequest_SendBodyValidationError"
+func (e RewardRollbackReport_RunValidationError) ErrorName() string {
+	return "RewardRollbackReport_RunValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuthAdminDeleteRequest_SendBodyValidationError) Error() string {
+func (e RewardRollbackReport_RunValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16517,14 +24595,14 @@ func (e AuthAdminDeleteRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthAdminDeleteRequest_SendBody.%s: %s%s",
+		"invalid %sRewardRollbackReport_Run.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthAdminDeleteRequest_SendBodyValidationError{}
+var _ error = RewardRollbackReport_RunValidationError{}
 
 var _ interface {
 	Field() string
@@ -16532,48 +24610,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthAdminDeleteRequest_SendBodyValidationError{}
+} = RewardRollbackReport_RunValidationError{}
 
-// Validate checks the field values on AdminLoginRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLocationTreeCheckReply_Diff with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginRequest_SendBody) Validate() error {
+func (m *AdminLocationTreeCheckReply_Diff) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLocationTreeCheckReply_Diff with
+// the rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLoginRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminLoginRequest_SendBody) ValidateAll() error {
+// AdminLocationTreeCheckReply_DiffMultiError, or nil if none found.
+func (m *AdminLocationTreeCheckReply_Diff) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginRequest_SendBody) validate(all bool) error {
+func (m *AdminLocationTreeCheckReply_Diff) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	// no validation rules for LocationId
 
-	// no validation rules for Password
+	// no validation rules for Total
+
+	// no validation rules for TotalTwo
+
+	// no validation rules for TotalThree
+
+	// no validation rules for ExpectTotal
+
+	// no validation rules for ExpectTotalTwo
+
+	// no validation rules for ExpectTotalThree
 
 	if len(errors) > 0 {
-		return AdminLoginRequest_SendBodyMultiError(errors)
+		return AdminLocationTreeCheckReply_DiffMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by AdminLoginRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type AdminLoginRequest_SendBodyMultiError []error
+// AdminLocationTreeCheckReply_DiffMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationTreeCheckReply_Diff.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationTreeCheckReply_DiffMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginRequest_SendBodyMultiError) Error() string {
+func (m AdminLocationTreeCheckReply_DiffMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16582,11 +24670,12 @@ func (m AdminLoginRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLocationTreeCheckReply_DiffMultiError) AllErrors() []error { return m }
 
-// AdminLoginRequest_SendBodyValidationError is the validation error returned
-// by AdminLoginRequest_SendBody.Validate if the designated constraints aren't met.
-type AdminLoginRequest_SendBodyValidationError struct {
+// AdminLocationTreeCheckReply_DiffValidationError is the validation error
+// returned by AdminLocationTreeCheckReply_Diff.Validate if the designated
+// constraints aren't met.
+type AdminLocationTreeCheckReply_DiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16594,24 +24683,24 @@ type AdminLoginRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLocationTreeCheckReply_DiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLocationTreeCheckReply_DiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLocationTreeCheckReply_DiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLocationTreeCheckReply_DiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminLoginRequest_SendBodyValidationError"
+func (e AdminLocationTreeCheckReply_DiffValidationError) ErrorName() string {
+	return "AdminLocationTreeCheckReply_DiffValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLoginRequest_SendBodyValidationError) Error() string {
+func (e AdminLocationTreeCheckReply_DiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16623,14 +24712,14 @@ func (e AdminLoginRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLocationTreeCheckReply_Diff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginRequest_SendBodyValidationError{}
+var _ error = AdminLocationTreeCheckReply_DiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -16638,50 +24727,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginRequest_SendBodyValidationError{}
+} = AdminLocationTreeCheckReply_DiffValidationError{}
 
-// Validate checks the field values on AdminChangePasswordRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminChangePasswordRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminLocationHistoryReply_Event with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationHistoryReply_Event) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminChangePasswordRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AdminChangePasswordRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminChangePasswordRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminLocationHistoryReply_Event with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationHistoryReply_EventMultiError, or nil if none found.
+func (m *AdminLocationHistoryReply_Event) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminChangePasswordRequest_SendBody) validate(all bool) error {
+func (m *AdminLocationHistoryReply_Event) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	// no validation rules for Id
 
-	// no validation rules for Password
+	// no validation rules for Event
+
+	// no validation rules for Source
+
+	// no validation rules for RunId
+
+	// no validation rules for AdminId
+
+	// no validation rules for StatusBefore
+
+	// no validation rules for StatusAfter
+
+	// no validation rules for CurrentBefore
+
+	// no validation rules for CurrentAfter
+
+	// no validation rules for CurrentMaxBefore
+
+	// no validation rules for CurrentMaxAfter
+
+	// no validation rules for Amount
+
+	// no validation rules for Note
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return AdminChangePasswordRequest_SendBodyMultiError(errors)
+		return AdminLocationHistoryReply_EventMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminChangePasswordRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// AdminChangePasswordRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type AdminChangePasswordRequest_SendBodyMultiError []error
+// AdminLocationHistoryReply_EventMultiError is an error wrapping multiple
+// validation errors returned by AdminLocationHistoryReply_Event.ValidateAll()
+// if the designated constraints aren't met.
+type AdminLocationHistoryReply_EventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminChangePasswordRequest_SendBodyMultiError) Error() string {
+func (m AdminLocationHistoryReply_EventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16690,12 +24801,12 @@ func (m AdminChangePasswordRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminChangePasswordRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminLocationHistoryReply_EventMultiError) AllErrors() []error { return m }
 
-// AdminChangePasswordRequest_SendBodyValidationError is the validation error
-// returned by AdminChangePasswordRequest_SendBody.Validate if the designated
+// AdminLocationHistoryReply_EventValidationError is the validation error
+// returned by AdminLocationHistoryReply_Event.Validate if the designated
 // constraints aren't met.
-type AdminChangePasswordRequest_SendBodyValidationError struct {
+type AdminLocationHistoryReply_EventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16703,24 +24814,24 @@ type AdminChangePasswordRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminLocationHistoryReply_EventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminLocationHistoryReply_EventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminLocationHistoryReply_EventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminChangePasswordRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminLocationHistoryReply_EventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminChangePasswordRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminChangePasswordRequest_SendBodyValidationError"
+func (e AdminLocationHistoryReply_EventValidationError) ErrorName() string {
+	return "AdminLocationHistoryReply_EventValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminChangePasswordRequest_SendBodyValidationError) Error() string {
+func (e AdminLocationHistoryReply_EventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16732,14 +24843,14 @@ func (e AdminChangePasswordRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminChangePasswordRequest_SendBody.%s: %s%s",
+		"invalid %sAdminLocationHistoryReply_Event.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminChangePasswordRequest_SendBodyValidationError{}
+var _ error = AdminLocationHistoryReply_EventValidationError{}
 
 var _ interface {
 	Field() string
@@ -16747,50 +24858,156 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminChangePasswordRequest_SendBodyValidationError{}
+} = AdminLocationHistoryReply_EventValidationError{}
 
-// Validate checks the field values on AdminCreateAccountRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AdminCreateAccountRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminExportJobCreateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if there
+// are no violations.
+func (m *AdminExportJobCreateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminCreateAccountRequest_SendBody
+// ValidateAll checks the field values on AdminExportJobCreateRequest_SendBody
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AdminCreateAccountRequest_SendBodyMultiError, or nil if none found.
-func (m *AdminCreateAccountRequest_SendBody) ValidateAll() error {
+// AdminExportJobCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminExportJobCreateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminCreateAccountRequest_SendBody) validate(all bool) error {
+func (m *AdminExportJobCreateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Account
+	if utf8.RuneCountInString(m.GetAddress()) > 100 {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Address",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Password
+	if !_AdminExportJobCreateRequest_SendBody_Address_Pattern.MatchString(m.GetAddress()) {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^[0-9a-zA-Z]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 45 {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminExportJobCreateRequest_SendBody_Reason_Pattern.MatchString(m.GetReason()) {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Reason",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetType()) > 45 {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Type",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminExportJobCreateRequest_SendBody_Type_Pattern.MatchString(m.GetType()) {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Type",
+			reason: "value does not match regex pattern \"^[a-z_0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStatus()) > 45 {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Status",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminExportJobCreateRequest_SendBody_Status_Pattern.MatchString(m.GetStatus()) {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Status",
+			reason: "value does not match regex pattern \"^[a-z_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminExportJobCreateRequest_SendBody_Start_Pattern.MatchString(m.GetStart()) {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{2}-[0-9]{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminExportJobCreateRequest_SendBody_End_Pattern.MatchString(m.GetEnd()) {
+		err := AdminExportJobCreateRequest_SendBodyValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{2}-[0-9]{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AdminCreateAccountRequest_SendBodyMultiError(errors)
+		return AdminExportJobCreateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminCreateAccountRequest_SendBodyMultiError is an error wrapping multiple
+// AdminExportJobCreateRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// AdminCreateAccountRequest_SendBody.ValidateAll() if the designated
+// AdminExportJobCreateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminCreateAccountRequest_SendBodyMultiError []error
+type AdminExportJobCreateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminCreateAccountRequest_SendBodyMultiError) Error() string {
+func (m AdminExportJobCreateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16799,12 +25016,12 @@ func (m AdminCreateAccountRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminCreateAccountRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminExportJobCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminCreateAccountRequest_SendBodyValidationError is the validation error
-// returned by AdminCreateAccountRequest_SendBody.Validate if the designated
+// AdminExportJobCreateRequest_SendBodyValidationError is the validation error
+// returned by AdminExportJobCreateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type AdminCreateAccountRequest_SendBodyValidationError struct {
+type AdminExportJobCreateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16812,24 +25029,24 @@ type AdminCreateAccountRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminExportJobCreateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminExportJobCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminExportJobCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminCreateAccountRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminExportJobCreateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminCreateAccountRequest_SendBodyValidationError) ErrorName() string {
-	return "AdminCreateAccountRequest_SendBodyValidationError"
+func (e AdminExportJobCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminExportJobCreateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminCreateAccountRequest_SendBodyValidationError) Error() string {
+func (e AdminExportJobCreateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16841,14 +25058,14 @@ func (e AdminCreateAccountRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminCreateAccountRequest_SendBody.%s: %s%s",
+		"invalid %sAdminExportJobCreateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminCreateAccountRequest_SendBodyValidationError{}
+var _ error = AdminExportJobCreateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -16856,4 +25073,16 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminCreateAccountRequest_SendBodyValidationError{}
+} = AdminExportJobCreateRequest_SendBodyValidationError{}
+
+var _AdminExportJobCreateRequest_SendBody_Address_Pattern = regexp.MustCompile("^[0-9a-zA-Z]*$")
+
+var _AdminExportJobCreateRequest_SendBody_Reason_Pattern = regexp.MustCompile("^[a-z_]*$")
+
+var _AdminExportJobCreateRequest_SendBody_Type_Pattern = regexp.MustCompile("^[a-z_0-9]*$")
+
+var _AdminExportJobCreateRequest_SendBody_Status_Pattern = regexp.MustCompile("^[a-z_]*$")
+
+var _AdminExportJobCreateRequest_SendBody_Start_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2})?$")
+
+var _AdminExportJobCreateRequest_SendBody_End_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2})?$")
@@ -27,6 +27,7 @@ const (
 	App_RecommendList_FullMethodName                        = "/api.App/RecommendList"
 	App_Withdraw_FullMethodName                             = "/api.App/Withdraw"
 	App_Deposit_FullMethodName                              = "/api.App/Deposit"
+	App_DepositBuy_FullMethodName                           = "/api.App/DepositBuy"
 	App_DepositWithdraw_FullMethodName                      = "/api.App/DepositWithdraw"
 	App_Deposit5_FullMethodName                             = "/api.App/Deposit5"
 	App_Deposit3_FullMethodName                             = "/api.App/Deposit3"
@@ -47,6 +48,12 @@ const (
 	App_AdminWithdraw_FullMethodName                        = "/api.App/AdminWithdraw"
 	App_AdminTrade_FullMethodName                           = "/api.App/AdminTrade"
 	App_AdminWithdrawPass_FullMethodName                    = "/api.App/AdminWithdrawPass"
+	App_AdminWithdrawReject_FullMethodName                  = "/api.App/AdminWithdrawReject"
+	App_AdminWithdrawBatchPass_FullMethodName               = "/api.App/AdminWithdrawBatchPass"
+	App_AdminWithdrawReviewList_FullMethodName              = "/api.App/AdminWithdrawReviewList"
+	App_AdminWithdrawReviews_FullMethodName                 = "/api.App/AdminWithdrawReviews"
+	App_AdminExportJobCreate_FullMethodName                 = "/api.App/AdminExportJobCreate"
+	App_AdminExportJob_FullMethodName                       = "/api.App/AdminExportJob"
 	App_AdminWithdrawEth_FullMethodName                     = "/api.App/AdminWithdrawEth"
 	App_AdminFee_FullMethodName                             = "/api.App/AdminFee"
 	App_AdminDailyFee_FullMethodName                        = "/api.App/AdminDailyFee"
@@ -55,7 +62,11 @@ const (
 	App_AdminMonthRecommend_FullMethodName                  = "/api.App/AdminMonthRecommend"
 	App_AdminConfig_FullMethodName                          = "/api.App/AdminConfig"
 	App_AdminConfigUpdate_FullMethodName                    = "/api.App/AdminConfigUpdate"
+	App_AdminConfigHistory_FullMethodName                   = "/api.App/AdminConfigHistory"
+	App_AdminConfigRollback_FullMethodName                  = "/api.App/AdminConfigRollback"
 	App_AdminConfigUpdateListen_FullMethodName              = "/api.App/AdminConfigUpdateListen"
+	App_AdminPriceTick_FullMethodName                       = "/api.App/AdminPriceTick"
+	App_AdminPriceRevalue_FullMethodName                    = "/api.App/AdminPriceRevalue"
 	App_AdminUserPasswordUpdate_FullMethodName              = "/api.App/AdminUserPasswordUpdate"
 	App_AdminUpdateLocationNewMax_FullMethodName            = "/api.App/AdminUpdateLocationNewMax"
 	App_AdminVipUpdate_FullMethodName                       = "/api.App/AdminVipUpdate"
@@ -81,6 +92,12 @@ const (
 	App_AdminDailyBuyReward_FullMethodName                  = "/api.App/AdminDailyBuyReward"
 	App_AdminDailyAreaReward_FullMethodName                 = "/api.App/AdminDailyAreaReward"
 	App_AdminDailyLocationRewardNew_FullMethodName          = "/api.App/AdminDailyLocationRewardNew"
+	App_AdminRewardRun_FullMethodName                       = "/api.App/AdminRewardRun"
+	App_AdminRewardRollback_FullMethodName                  = "/api.App/AdminRewardRollback"
+	App_AdminLocationTreeCheck_FullMethodName               = "/api.App/AdminLocationTreeCheck"
+	App_AdminLocationProductList_FullMethodName             = "/api.App/AdminLocationProductList"
+	App_AdminLocationProductUpdate_FullMethodName           = "/api.App/AdminLocationProductUpdate"
+	App_AdminLocationHistory_FullMethodName                 = "/api.App/AdminLocationHistory"
 	App_TestCreateAccount_FullMethodName                    = "/api.App/TestCreateAccount"
 )
 
@@ -96,6 +113,7 @@ type AppClient interface {
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
+	DepositBuy(ctx context.Context, in *DepositBuyRequest, opts ...grpc.CallOption) (*DepositBuyReply, error)
 	DepositWithdraw(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	Deposit5(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	Deposit3(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
@@ -116,6 +134,12 @@ type AppClient interface {
 	AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...grpc.CallOption) (*AdminWithdrawReply, error)
 	AdminTrade(ctx context.Context, in *AdminTradeRequest, opts ...grpc.CallOption) (*AdminTradeReply, error)
 	AdminWithdrawPass(ctx context.Context, in *AdminWithdrawPassRequest, opts ...grpc.CallOption) (*AdminWithdrawPassReply, error)
+	AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawBatchPass(ctx context.Context, in *AdminWithdrawBatchPassRequest, opts ...grpc.CallOption) (*AdminWithdrawBatchPassReply, error)
+	AdminWithdrawReviewList(ctx context.Context, in *AdminWithdrawReviewListRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewListReply, error)
+	AdminWithdrawReviews(ctx context.Context, in *AdminWithdrawReviewsRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewsReply, error)
+	AdminExportJobCreate(ctx context.Context, in *AdminExportJobCreateRequest, opts ...grpc.CallOption) (*AdminExportJobCreateReply, error)
+	AdminExportJob(ctx context.Context, in *AdminExportJobRequest, opts ...grpc.CallOption) (*AdminExportJobReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
 	AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...grpc.CallOption) (*AdminFeeReply, error)
 	AdminDailyFee(ctx context.Context, in *AdminDailyFeeRequest, opts ...grpc.CallOption) (*AdminDailyFeeReply, error)
@@ -124,7 +148,11 @@ type AppClient interface {
 	AdminMonthRecommend(ctx context.Context, in *AdminMonthRecommendRequest, opts ...grpc.CallOption) (*AdminMonthRecommendReply, error)
 	AdminConfig(ctx context.Context, in *AdminConfigRequest, opts ...grpc.CallOption) (*AdminConfigReply, error)
 	AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdateRequest, opts ...grpc.CallOption) (*AdminConfigUpdateReply, error)
+	AdminConfigHistory(ctx context.Context, in *AdminConfigHistoryRequest, opts ...grpc.CallOption) (*AdminConfigHistoryReply, error)
+	AdminConfigRollback(ctx context.Context, in *AdminConfigRollbackRequest, opts ...grpc.CallOption) (*AdminConfigRollbackReply, error)
 	AdminConfigUpdateListen(ctx context.Context, in *AdminConfigUpdateListenRequest, opts ...grpc.CallOption) (*AdminConfigUpdateListenReply, error)
+	AdminPriceTick(ctx context.Context, in *AdminPriceTickRequest, opts ...grpc.CallOption) (*AdminPriceTickReply, error)
+	AdminPriceRevalue(ctx context.Context, in *AdminPriceRevalueRequest, opts ...grpc.CallOption) (*AdminPriceRevalueReply, error)
 	AdminUserPasswordUpdate(ctx context.Context, in *AdminPasswordUpdateRequest, opts ...grpc.CallOption) (*AdminPasswordUpdateReply, error)
 	AdminUpdateLocationNewMax(ctx context.Context, in *AdminUpdateLocationNewMaxRequest, opts ...grpc.CallOption) (*AdminUpdateLocationNewMaxReply, error)
 	AdminVipUpdate(ctx context.Context, in *AdminVipUpdateRequest, opts ...grpc.CallOption) (*AdminVipUpdateReply, error)
@@ -150,6 +178,12 @@ type AppClient interface {
 	AdminDailyBuyReward(ctx context.Context, in *AdminDailyLocationRewardRequest, opts ...grpc.CallOption) (*AdminDailyLocationRewardReply, error)
 	AdminDailyAreaReward(ctx context.Context, in *AdminDailyLocationRewardRequest, opts ...grpc.CallOption) (*AdminDailyLocationRewardReply, error)
 	AdminDailyLocationRewardNew(ctx context.Context, in *AdminDailyLocationRewardNewRequest, opts ...grpc.CallOption) (*AdminDailyLocationRewardNewReply, error)
+	AdminRewardRun(ctx context.Context, in *AdminRewardRunRequest, opts ...grpc.CallOption) (*AdminRewardRunReply, error)
+	AdminRewardRollback(ctx context.Context, in *AdminRewardRollbackRequest, opts ...grpc.CallOption) (*AdminRewardRollbackReply, error)
+	AdminLocationTreeCheck(ctx context.Context, in *AdminLocationTreeCheckRequest, opts ...grpc.CallOption) (*AdminLocationTreeCheckReply, error)
+	AdminLocationProductList(ctx context.Context, in *AdminLocationProductListRequest, opts ...grpc.CallOption) (*AdminLocationProductListReply, error)
+	AdminLocationProductUpdate(ctx context.Context, in *AdminLocationProductUpdateRequest, opts ...grpc.CallOption) (*AdminLocationProductUpdateReply, error)
+	AdminLocationHistory(ctx context.Context, in *AdminLocationHistoryRequest, opts ...grpc.CallOption) (*AdminLocationHistoryReply, error)
 	TestCreateAccount(ctx context.Context, in *TestCreateAccountRequest, opts ...grpc.CallOption) (*TestCreateAccountReply, error)
 }
 
@@ -233,6 +267,15 @@ func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grp
 	return out, nil
 }
 
+func (c *appClient) DepositBuy(ctx context.Context, in *DepositBuyRequest, opts ...grpc.CallOption) (*DepositBuyReply, error) {
+	out := new(DepositBuyReply)
+	err := c.cc.Invoke(ctx, App_DepositBuy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) DepositWithdraw(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error) {
 	out := new(DepositReply)
 	err := c.cc.Invoke(ctx, App_DepositWithdraw_FullMethodName, in, out, opts...)
@@ -413,6 +456,60 @@ func (c *appClient) AdminWithdrawPass(ctx context.Context, in *AdminWithdrawPass
 	return out, nil
 }
 
+func (c *appClient) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...grpc.CallOption) (*AdminWithdrawRejectReply, error) {
+	out := new(AdminWithdrawRejectReply)
+	err := c.cc.Invoke(ctx, App_AdminWithdrawReject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawBatchPass(ctx context.Context, in *AdminWithdrawBatchPassRequest, opts ...grpc.CallOption) (*AdminWithdrawBatchPassReply, error) {
+	out := new(AdminWithdrawBatchPassReply)
+	err := c.cc.Invoke(ctx, App_AdminWithdrawBatchPass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReviewList(ctx context.Context, in *AdminWithdrawReviewListRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewListReply, error) {
+	out := new(AdminWithdrawReviewListReply)
+	err := c.cc.Invoke(ctx, App_AdminWithdrawReviewList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWithdrawReviews(ctx context.Context, in *AdminWithdrawReviewsRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewsReply, error) {
+	out := new(AdminWithdrawReviewsReply)
+	err := c.cc.Invoke(ctx, App_AdminWithdrawReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminExportJobCreate(ctx context.Context, in *AdminExportJobCreateRequest, opts ...grpc.CallOption) (*AdminExportJobCreateReply, error) {
+	out := new(AdminExportJobCreateReply)
+	err := c.cc.Invoke(ctx, App_AdminExportJobCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminExportJob(ctx context.Context, in *AdminExportJobRequest, opts ...grpc.CallOption) (*AdminExportJobReply, error) {
+	out := new(AdminExportJobReply)
+	err := c.cc.Invoke(ctx, App_AdminExportJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error) {
 	out := new(AdminWithdrawEthReply)
 	err := c.cc.Invoke(ctx, App_AdminWithdrawEth_FullMethodName, in, out, opts...)
@@ -485,6 +582,24 @@ func (c *appClient) AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdate
 	return out, nil
 }
 
+func (c *appClient) AdminConfigHistory(ctx context.Context, in *AdminConfigHistoryRequest, opts ...grpc.CallOption) (*AdminConfigHistoryReply, error) {
+	out := new(AdminConfigHistoryReply)
+	err := c.cc.Invoke(ctx, App_AdminConfigHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminConfigRollback(ctx context.Context, in *AdminConfigRollbackRequest, opts ...grpc.CallOption) (*AdminConfigRollbackReply, error) {
+	out := new(AdminConfigRollbackReply)
+	err := c.cc.Invoke(ctx, App_AdminConfigRollback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) AdminConfigUpdateListen(ctx context.Context, in *AdminConfigUpdateListenRequest, opts ...grpc.CallOption) (*AdminConfigUpdateListenReply, error) {
 	out := new(AdminConfigUpdateListenReply)
 	err := c.cc.Invoke(ctx, App_AdminConfigUpdateListen_FullMethodName, in, out, opts...)
@@ -494,6 +609,24 @@ func (c *appClient) AdminConfigUpdateListen(ctx context.Context, in *AdminConfig
 	return out, nil
 }
 
+func (c *appClient) AdminPriceTick(ctx context.Context, in *AdminPriceTickRequest, opts ...grpc.CallOption) (*AdminPriceTickReply, error) {
+	out := new(AdminPriceTickReply)
+	err := c.cc.Invoke(ctx, App_AdminPriceTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminPriceRevalue(ctx context.Context, in *AdminPriceRevalueRequest, opts ...grpc.CallOption) (*AdminPriceRevalueReply, error) {
+	out := new(AdminPriceRevalueReply)
+	err := c.cc.Invoke(ctx, App_AdminPriceRevalue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) AdminUserPasswordUpdate(ctx context.Context, in *AdminPasswordUpdateRequest, opts ...grpc.CallOption) (*AdminPasswordUpdateReply, error) {
 	out := new(AdminPasswordUpdateReply)
 	err := c.cc.Invoke(ctx, App_AdminUserPasswordUpdate_FullMethodName, in, out, opts...)
@@ -719,6 +852,60 @@ func (c *appClient) AdminDailyLocationRewardNew(ctx context.Context, in *AdminDa
 	return out, nil
 }
 
+func (c *appClient) AdminRewardRun(ctx context.Context, in *AdminRewardRunRequest, opts ...grpc.CallOption) (*AdminRewardRunReply, error) {
+	out := new(AdminRewardRunReply)
+	err := c.cc.Invoke(ctx, App_AdminRewardRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminRewardRollback(ctx context.Context, in *AdminRewardRollbackRequest, opts ...grpc.CallOption) (*AdminRewardRollbackReply, error) {
+	out := new(AdminRewardRollbackReply)
+	err := c.cc.Invoke(ctx, App_AdminRewardRollback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLocationTreeCheck(ctx context.Context, in *AdminLocationTreeCheckRequest, opts ...grpc.CallOption) (*AdminLocationTreeCheckReply, error) {
+	out := new(AdminLocationTreeCheckReply)
+	err := c.cc.Invoke(ctx, App_AdminLocationTreeCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLocationProductList(ctx context.Context, in *AdminLocationProductListRequest, opts ...grpc.CallOption) (*AdminLocationProductListReply, error) {
+	out := new(AdminLocationProductListReply)
+	err := c.cc.Invoke(ctx, App_AdminLocationProductList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLocationProductUpdate(ctx context.Context, in *AdminLocationProductUpdateRequest, opts ...grpc.CallOption) (*AdminLocationProductUpdateReply, error) {
+	out := new(AdminLocationProductUpdateReply)
+	err := c.cc.Invoke(ctx, App_AdminLocationProductUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLocationHistory(ctx context.Context, in *AdminLocationHistoryRequest, opts ...grpc.CallOption) (*AdminLocationHistoryReply, error) {
+	out := new(AdminLocationHistoryReply)
+	err := c.cc.Invoke(ctx, App_AdminLocationHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) TestCreateAccount(ctx context.Context, in *TestCreateAccountRequest, opts ...grpc.CallOption) (*TestCreateAccountReply, error) {
 	out := new(TestCreateAccountReply)
 	err := c.cc.Invoke(ctx, App_TestCreateAccount_FullMethodName, in, out, opts...)
@@ -740,6 +927,7 @@ type AppServer interface {
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
+	DepositBuy(context.Context, *DepositBuyRequest) (*DepositBuyReply, error)
 	DepositWithdraw(context.Context, *DepositRequest) (*DepositReply, error)
 	Deposit5(context.Context, *DepositRequest) (*DepositReply, error)
 	Deposit3(context.Context, *DepositRequest) (*DepositReply, error)
@@ -760,6 +948,12 @@ type AppServer interface {
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminTrade(context.Context, *AdminTradeRequest) (*AdminTradeReply, error)
 	AdminWithdrawPass(context.Context, *AdminWithdrawPassRequest) (*AdminWithdrawPassReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawBatchPass(context.Context, *AdminWithdrawBatchPassRequest) (*AdminWithdrawBatchPassReply, error)
+	AdminWithdrawReviewList(context.Context, *AdminWithdrawReviewListRequest) (*AdminWithdrawReviewListReply, error)
+	AdminWithdrawReviews(context.Context, *AdminWithdrawReviewsRequest) (*AdminWithdrawReviewsReply, error)
+	AdminExportJobCreate(context.Context, *AdminExportJobCreateRequest) (*AdminExportJobCreateReply, error)
+	AdminExportJob(context.Context, *AdminExportJobRequest) (*AdminExportJobReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
 	AdminDailyFee(context.Context, *AdminDailyFeeRequest) (*AdminDailyFeeReply, error)
@@ -768,7 +962,11 @@ type AppServer interface {
 	AdminMonthRecommend(context.Context, *AdminMonthRecommendRequest) (*AdminMonthRecommendReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
+	AdminConfigHistory(context.Context, *AdminConfigHistoryRequest) (*AdminConfigHistoryReply, error)
+	AdminConfigRollback(context.Context, *AdminConfigRollbackRequest) (*AdminConfigRollbackReply, error)
 	AdminConfigUpdateListen(context.Context, *AdminConfigUpdateListenRequest) (*AdminConfigUpdateListenReply, error)
+	AdminPriceTick(context.Context, *AdminPriceTickRequest) (*AdminPriceTickReply, error)
+	AdminPriceRevalue(context.Context, *AdminPriceRevalueRequest) (*AdminPriceRevalueReply, error)
 	AdminUserPasswordUpdate(context.Context, *AdminPasswordUpdateRequest) (*AdminPasswordUpdateReply, error)
 	AdminUpdateLocationNewMax(context.Context, *AdminUpdateLocationNewMaxRequest) (*AdminUpdateLocationNewMaxReply, error)
 	AdminVipUpdate(context.Context, *AdminVipUpdateRequest) (*AdminVipUpdateReply, error)
@@ -794,6 +992,12 @@ type AppServer interface {
 	AdminDailyBuyReward(context.Context, *AdminDailyLocationRewardRequest) (*AdminDailyLocationRewardReply, error)
 	AdminDailyAreaReward(context.Context, *AdminDailyLocationRewardRequest) (*AdminDailyLocationRewardReply, error)
 	AdminDailyLocationRewardNew(context.Context, *AdminDailyLocationRewardNewRequest) (*AdminDailyLocationRewardNewReply, error)
+	AdminRewardRun(context.Context, *AdminRewardRunRequest) (*AdminRewardRunReply, error)
+	AdminRewardRollback(context.Context, *AdminRewardRollbackRequest) (*AdminRewardRollbackReply, error)
+	AdminLocationTreeCheck(context.Context, *AdminLocationTreeCheckRequest) (*AdminLocationTreeCheckReply, error)
+	AdminLocationProductList(context.Context, *AdminLocationProductListRequest) (*AdminLocationProductListReply, error)
+	AdminLocationProductUpdate(context.Context, *AdminLocationProductUpdateRequest) (*AdminLocationProductUpdateReply, error)
+	AdminLocationHistory(context.Context, *AdminLocationHistoryRequest) (*AdminLocationHistoryReply, error)
 	TestCreateAccount(context.Context, *TestCreateAccountRequest) (*TestCreateAccountReply, error)
 	mustEmbedUnimplementedAppServer()
 }
@@ -826,6 +1030,9 @@ func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*With
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (UnimplementedAppServer) DepositBuy(context.Context, *DepositBuyRequest) (*DepositBuyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositBuy not implemented")
+}
 func (UnimplementedAppServer) DepositWithdraw(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositWithdraw not implemented")
 }
@@ -886,6 +1093,24 @@ func (UnimplementedAppServer) AdminTrade(context.Context, *AdminTradeRequest) (*
 func (UnimplementedAppServer) AdminWithdrawPass(context.Context, *AdminWithdrawPassRequest) (*AdminWithdrawPassReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawPass not implemented")
 }
+func (UnimplementedAppServer) AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReject not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawBatchPass(context.Context, *AdminWithdrawBatchPassRequest) (*AdminWithdrawBatchPassReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawBatchPass not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReviewList(context.Context, *AdminWithdrawReviewListRequest) (*AdminWithdrawReviewListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReviewList not implemented")
+}
+func (UnimplementedAppServer) AdminWithdrawReviews(context.Context, *AdminWithdrawReviewsRequest) (*AdminWithdrawReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReviews not implemented")
+}
+func (UnimplementedAppServer) AdminExportJobCreate(context.Context, *AdminExportJobCreateRequest) (*AdminExportJobCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminExportJobCreate not implemented")
+}
+func (UnimplementedAppServer) AdminExportJob(context.Context, *AdminExportJobRequest) (*AdminExportJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminExportJob not implemented")
+}
 func (UnimplementedAppServer) AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEth not implemented")
 }
@@ -910,9 +1135,21 @@ func (UnimplementedAppServer) AdminConfig(context.Context, *AdminConfigRequest)
 func (UnimplementedAppServer) AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminConfigUpdate not implemented")
 }
+func (UnimplementedAppServer) AdminConfigHistory(context.Context, *AdminConfigHistoryRequest) (*AdminConfigHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminConfigHistory not implemented")
+}
+func (UnimplementedAppServer) AdminConfigRollback(context.Context, *AdminConfigRollbackRequest) (*AdminConfigRollbackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminConfigRollback not implemented")
+}
 func (UnimplementedAppServer) AdminConfigUpdateListen(context.Context, *AdminConfigUpdateListenRequest) (*AdminConfigUpdateListenReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminConfigUpdateListen not implemented")
 }
+func (UnimplementedAppServer) AdminPriceTick(context.Context, *AdminPriceTickRequest) (*AdminPriceTickReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPriceTick not implemented")
+}
+func (UnimplementedAppServer) AdminPriceRevalue(context.Context, *AdminPriceRevalueRequest) (*AdminPriceRevalueReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPriceRevalue not implemented")
+}
 func (UnimplementedAppServer) AdminUserPasswordUpdate(context.Context, *AdminPasswordUpdateRequest) (*AdminPasswordUpdateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminUserPasswordUpdate not implemented")
 }
@@ -988,6 +1225,24 @@ func (UnimplementedAppServer) AdminDailyAreaReward(context.Context, *AdminDailyL
 func (UnimplementedAppServer) AdminDailyLocationRewardNew(context.Context, *AdminDailyLocationRewardNewRequest) (*AdminDailyLocationRewardNewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminDailyLocationRewardNew not implemented")
 }
+func (UnimplementedAppServer) AdminRewardRun(context.Context, *AdminRewardRunRequest) (*AdminRewardRunReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRewardRun not implemented")
+}
+func (UnimplementedAppServer) AdminRewardRollback(context.Context, *AdminRewardRollbackRequest) (*AdminRewardRollbackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRewardRollback not implemented")
+}
+func (UnimplementedAppServer) AdminLocationTreeCheck(context.Context, *AdminLocationTreeCheckRequest) (*AdminLocationTreeCheckReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationTreeCheck not implemented")
+}
+func (UnimplementedAppServer) AdminLocationProductList(context.Context, *AdminLocationProductListRequest) (*AdminLocationProductListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationProductList not implemented")
+}
+func (UnimplementedAppServer) AdminLocationProductUpdate(context.Context, *AdminLocationProductUpdateRequest) (*AdminLocationProductUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationProductUpdate not implemented")
+}
+func (UnimplementedAppServer) AdminLocationHistory(context.Context, *AdminLocationHistoryRequest) (*AdminLocationHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationHistory not implemented")
+}
 func (UnimplementedAppServer) TestCreateAccount(context.Context, *TestCreateAccountRequest) (*TestCreateAccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestCreateAccount not implemented")
 }
@@ -1148,6 +1403,24 @@ func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_DepositBuy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositBuyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).DepositBuy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_DepositBuy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).DepositBuy(ctx, req.(*DepositBuyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_DepositWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositRequest)
 	if err := dec(in); err != nil {
@@ -1508,6 +1781,114 @@ func _App_AdminWithdrawPass_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminWithdrawReject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWithdrawReject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawBatchPass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawBatchPassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawBatchPass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWithdrawBatchPass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawBatchPass(ctx, req.(*AdminWithdrawBatchPassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReviewListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWithdrawReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReviewList(ctx, req.(*AdminWithdrawReviewListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWithdrawReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWithdrawReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWithdrawReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWithdrawReviews(ctx, req.(*AdminWithdrawReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminExportJobCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminExportJobCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminExportJobCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminExportJobCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminExportJobCreate(ctx, req.(*AdminExportJobCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminExportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminExportJob(ctx, req.(*AdminExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminWithdrawEthRequest)
 	if err := dec(in); err != nil {
@@ -1652,6 +2033,42 @@ func _App_AdminConfigUpdate_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminConfigHistory(ctx, req.(*AdminConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminConfigRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminConfigRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminConfigRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminConfigRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminConfigRollback(ctx, req.(*AdminConfigRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_AdminConfigUpdateListen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminConfigUpdateListenRequest)
 	if err := dec(in); err != nil {
@@ -1670,6 +2087,42 @@ func _App_AdminConfigUpdateListen_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminPriceTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPriceTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminPriceTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminPriceTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminPriceTick(ctx, req.(*AdminPriceTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminPriceRevalue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPriceRevalueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminPriceRevalue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminPriceRevalue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminPriceRevalue(ctx, req.(*AdminPriceRevalueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_AdminUserPasswordUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminPasswordUpdateRequest)
 	if err := dec(in); err != nil {
@@ -2120,6 +2573,114 @@ func _App_AdminDailyLocationRewardNew_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_AdminRewardRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRewardRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRewardRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminRewardRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRewardRun(ctx, req.(*AdminRewardRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminRewardRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRewardRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminRewardRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminRewardRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminRewardRollback(ctx, req.(*AdminRewardRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationTreeCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationTreeCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLocationTreeCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminLocationTreeCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLocationTreeCheck(ctx, req.(*AdminLocationTreeCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationProductListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLocationProductList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminLocationProductList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLocationProductList(ctx, req.(*AdminLocationProductListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationProductUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationProductUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLocationProductUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminLocationProductUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLocationProductUpdate(ctx, req.(*AdminLocationProductUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLocationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminLocationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLocationHistory(ctx, req.(*AdminLocationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_TestCreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestCreateAccountRequest)
 	if err := dec(in); err != nil {
@@ -2177,6 +2738,10 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _App_Deposit_Handler,
 		},
+		{
+			MethodName: "DepositBuy",
+			Handler:    _App_DepositBuy_Handler,
+		},
 		{
 			MethodName: "DepositWithdraw",
 			Handler:    _App_DepositWithdraw_Handler,
@@ -2257,6 +2822,30 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminWithdrawPass",
 			Handler:    _App_AdminWithdrawPass_Handler,
 		},
+		{
+			MethodName: "AdminWithdrawReject",
+			Handler:    _App_AdminWithdrawReject_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawBatchPass",
+			Handler:    _App_AdminWithdrawBatchPass_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReviewList",
+			Handler:    _App_AdminWithdrawReviewList_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReviews",
+			Handler:    _App_AdminWithdrawReviews_Handler,
+		},
+		{
+			MethodName: "AdminExportJobCreate",
+			Handler:    _App_AdminExportJobCreate_Handler,
+		},
+		{
+			MethodName: "AdminExportJob",
+			Handler:    _App_AdminExportJob_Handler,
+		},
 		{
 			MethodName: "AdminWithdrawEth",
 			Handler:    _App_AdminWithdrawEth_Handler,
@@ -2289,10 +2878,26 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminConfigUpdate",
 			Handler:    _App_AdminConfigUpdate_Handler,
 		},
+		{
+			MethodName: "AdminConfigHistory",
+			Handler:    _App_AdminConfigHistory_Handler,
+		},
+		{
+			MethodName: "AdminConfigRollback",
+			Handler:    _App_AdminConfigRollback_Handler,
+		},
 		{
 			MethodName: "AdminConfigUpdateListen",
 			Handler:    _App_AdminConfigUpdateListen_Handler,
 		},
+		{
+			MethodName: "AdminPriceTick",
+			Handler:    _App_AdminPriceTick_Handler,
+		},
+		{
+			MethodName: "AdminPriceRevalue",
+			Handler:    _App_AdminPriceRevalue_Handler,
+		},
 		{
 			MethodName: "AdminUserPasswordUpdate",
 			Handler:    _App_AdminUserPasswordUpdate_Handler,
@@ -2393,6 +2998,30 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminDailyLocationRewardNew",
 			Handler:    _App_AdminDailyLocationRewardNew_Handler,
 		},
+		{
+			MethodName: "AdminRewardRun",
+			Handler:    _App_AdminRewardRun_Handler,
+		},
+		{
+			MethodName: "AdminRewardRollback",
+			Handler:    _App_AdminRewardRollback_Handler,
+		},
+		{
+			MethodName: "AdminLocationTreeCheck",
+			Handler:    _App_AdminLocationTreeCheck_Handler,
+		},
+		{
+			MethodName: "AdminLocationProductList",
+			Handler:    _App_AdminLocationProductList_Handler,
+		},
+		{
+			MethodName: "AdminLocationProductUpdate",
+			Handler:    _App_AdminLocationProductUpdate_Handler,
+		},
+		{
+			MethodName: "AdminLocationHistory",
+			Handler:    _App_AdminLocationHistory_Handler,
+		},
 		{
 			MethodName: "TestCreateAccount",
 			Handler:    _App_TestCreateAccount_Handler,
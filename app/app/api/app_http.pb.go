@@ -24,6 +24,8 @@ const OperationAppAdminAreaLevelUpdate = "/api.App/AdminAreaLevelUpdate"
 const OperationAppAdminBalanceUpdate = "/api.App/AdminBalanceUpdate"
 const OperationAppAdminChangePassword = "/api.App/AdminChangePassword"
 const OperationAppAdminConfig = "/api.App/AdminConfig"
+const OperationAppAdminConfigHistory = "/api.App/AdminConfigHistory"
+const OperationAppAdminConfigRollback = "/api.App/AdminConfigRollback"
 const OperationAppAdminConfigUpdate = "/api.App/AdminConfigUpdate"
 const OperationAppAdminConfigUpdateListen = "/api.App/AdminConfigUpdateListen"
 const OperationAppAdminCreateAccount = "/api.App/AdminCreateAccount"
@@ -34,17 +36,27 @@ const OperationAppAdminDailyFee = "/api.App/AdminDailyFee"
 const OperationAppAdminDailyLocationReward = "/api.App/AdminDailyLocationReward"
 const OperationAppAdminDailyLocationRewardNew = "/api.App/AdminDailyLocationRewardNew"
 const OperationAppAdminDailyRecommendReward = "/api.App/AdminDailyRecommendReward"
+const OperationAppAdminExportJob = "/api.App/AdminExportJob"
+const OperationAppAdminExportJobCreate = "/api.App/AdminExportJobCreate"
 const OperationAppAdminFee = "/api.App/AdminFee"
 const OperationAppAdminKkdtUpdate = "/api.App/AdminKkdtUpdate"
 const OperationAppAdminList = "/api.App/AdminList"
 const OperationAppAdminLocationAllList = "/api.App/AdminLocationAllList"
+const OperationAppAdminLocationHistory = "/api.App/AdminLocationHistory"
 const OperationAppAdminLocationInsert = "/api.App/AdminLocationInsert"
 const OperationAppAdminLocationList = "/api.App/AdminLocationList"
 const OperationAppAdminLocationListNew = "/api.App/AdminLocationListNew"
+const OperationAppAdminLocationProductList = "/api.App/AdminLocationProductList"
+const OperationAppAdminLocationProductUpdate = "/api.App/AdminLocationProductUpdate"
+const OperationAppAdminLocationTreeCheck = "/api.App/AdminLocationTreeCheck"
 const OperationAppAdminLogin = "/api.App/AdminLogin"
 const OperationAppAdminMonthRecommend = "/api.App/AdminMonthRecommend"
+const OperationAppAdminPriceRevalue = "/api.App/AdminPriceRevalue"
+const OperationAppAdminPriceTick = "/api.App/AdminPriceTick"
 const OperationAppAdminRecordList = "/api.App/AdminRecordList"
 const OperationAppAdminRewardList = "/api.App/AdminRewardList"
+const OperationAppAdminRewardRollback = "/api.App/AdminRewardRollback"
+const OperationAppAdminRewardRun = "/api.App/AdminRewardRun"
 const OperationAppAdminTrade = "/api.App/AdminTrade"
 const OperationAppAdminTradeList = "/api.App/AdminTradeList"
 const OperationAppAdminUndoUpdate = "/api.App/AdminUndoUpdate"
@@ -55,9 +67,13 @@ const OperationAppAdminUserRecommend = "/api.App/AdminUserRecommend"
 const OperationAppAdminVipDelete = "/api.App/AdminVipDelete"
 const OperationAppAdminVipUpdate = "/api.App/AdminVipUpdate"
 const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
+const OperationAppAdminWithdrawBatchPass = "/api.App/AdminWithdrawBatchPass"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
 const OperationAppAdminWithdrawList = "/api.App/AdminWithdrawList"
 const OperationAppAdminWithdrawPass = "/api.App/AdminWithdrawPass"
+const OperationAppAdminWithdrawReject = "/api.App/AdminWithdrawReject"
+const OperationAppAdminWithdrawReviewList = "/api.App/AdminWithdrawReviewList"
+const OperationAppAdminWithdrawReviews = "/api.App/AdminWithdrawReviews"
 const OperationAppAuthAdminCreate = "/api.App/AuthAdminCreate"
 const OperationAppAuthAdminDelete = "/api.App/AuthAdminDelete"
 const OperationAppAuthList = "/api.App/AuthList"
@@ -69,6 +85,7 @@ const OperationAppDeposit2 = "/api.App/Deposit2"
 const OperationAppDeposit3 = "/api.App/Deposit3"
 const OperationAppDeposit4 = "/api.App/Deposit4"
 const OperationAppDeposit5 = "/api.App/Deposit5"
+const OperationAppDepositBuy = "/api.App/DepositBuy"
 const OperationAppDepositWithdraw = "/api.App/DepositWithdraw"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
 const OperationAppLockSystem = "/api.App/LockSystem"
@@ -89,6 +106,8 @@ type AppHTTPServer interface {
 	AdminBalanceUpdate(context.Context, *AdminBalanceUpdateRequest) (*AdminBalanceUpdateReply, error)
 	AdminChangePassword(context.Context, *AdminChangePasswordRequest) (*AdminChangePasswordReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
+	AdminConfigHistory(context.Context, *AdminConfigHistoryRequest) (*AdminConfigHistoryReply, error)
+	AdminConfigRollback(context.Context, *AdminConfigRollbackRequest) (*AdminConfigRollbackReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
 	AdminConfigUpdateListen(context.Context, *AdminConfigUpdateListenRequest) (*AdminConfigUpdateListenReply, error)
 	AdminCreateAccount(context.Context, *AdminCreateAccountRequest) (*AdminCreateAccountReply, error)
@@ -99,17 +118,27 @@ type AppHTTPServer interface {
 	AdminDailyLocationReward(context.Context, *AdminDailyLocationRewardRequest) (*AdminDailyLocationRewardReply, error)
 	AdminDailyLocationRewardNew(context.Context, *AdminDailyLocationRewardNewRequest) (*AdminDailyLocationRewardNewReply, error)
 	AdminDailyRecommendReward(context.Context, *AdminDailyRecommendRewardRequest) (*AdminDailyRecommendRewardReply, error)
+	AdminExportJob(context.Context, *AdminExportJobRequest) (*AdminExportJobReply, error)
+	AdminExportJobCreate(context.Context, *AdminExportJobCreateRequest) (*AdminExportJobCreateReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
 	AdminKkdtUpdate(context.Context, *AdminKkdtUpdateRequest) (*AdminKkdtUpdateReply, error)
 	AdminList(context.Context, *AdminListRequest) (*AdminListReply, error)
 	AdminLocationAllList(context.Context, *AdminLocationAllListRequest) (*AdminLocationAllListReply, error)
+	AdminLocationHistory(context.Context, *AdminLocationHistoryRequest) (*AdminLocationHistoryReply, error)
 	AdminLocationInsert(context.Context, *AdminLocationInsertRequest) (*AdminLocationInsertReply, error)
 	AdminLocationList(context.Context, *AdminLocationListRequest) (*AdminLocationListReply, error)
 	AdminLocationListNew(context.Context, *AdminLocationListRequest) (*AdminLocationListReply, error)
+	AdminLocationProductList(context.Context, *AdminLocationProductListRequest) (*AdminLocationProductListReply, error)
+	AdminLocationProductUpdate(context.Context, *AdminLocationProductUpdateRequest) (*AdminLocationProductUpdateReply, error)
+	AdminLocationTreeCheck(context.Context, *AdminLocationTreeCheckRequest) (*AdminLocationTreeCheckReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	AdminMonthRecommend(context.Context, *AdminMonthRecommendRequest) (*AdminMonthRecommendReply, error)
+	AdminPriceRevalue(context.Context, *AdminPriceRevalueRequest) (*AdminPriceRevalueReply, error)
+	AdminPriceTick(context.Context, *AdminPriceTickRequest) (*AdminPriceTickReply, error)
 	AdminRecordList(context.Context, *RecordListRequest) (*RecordListReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
+	AdminRewardRollback(context.Context, *AdminRewardRollbackRequest) (*AdminRewardRollbackReply, error)
+	AdminRewardRun(context.Context, *AdminRewardRunRequest) (*AdminRewardRunReply, error)
 	AdminTrade(context.Context, *AdminTradeRequest) (*AdminTradeReply, error)
 	AdminTradeList(context.Context, *AdminTradeListRequest) (*AdminTradeListReply, error)
 	AdminUndoUpdate(context.Context, *AdminUndoUpdateRequest) (*AdminUndoUpdateReply, error)
@@ -120,9 +149,13 @@ type AppHTTPServer interface {
 	AdminVipDelete(context.Context, *AdminVipDeleteRequest) (*AdminVipDeleteReply, error)
 	AdminVipUpdate(context.Context, *AdminVipUpdateRequest) (*AdminVipUpdateReply, error)
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
+	AdminWithdrawBatchPass(context.Context, *AdminWithdrawBatchPassRequest) (*AdminWithdrawBatchPassReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
 	AdminWithdrawPass(context.Context, *AdminWithdrawPassRequest) (*AdminWithdrawPassReply, error)
+	AdminWithdrawReject(context.Context, *AdminWithdrawRejectRequest) (*AdminWithdrawRejectReply, error)
+	AdminWithdrawReviewList(context.Context, *AdminWithdrawReviewListRequest) (*AdminWithdrawReviewListReply, error)
+	AdminWithdrawReviews(context.Context, *AdminWithdrawReviewsRequest) (*AdminWithdrawReviewsReply, error)
 	AuthAdminCreate(context.Context, *AuthAdminCreateRequest) (*AuthAdminCreateReply, error)
 	AuthAdminDelete(context.Context, *AuthAdminDeleteRequest) (*AuthAdminDeleteReply, error)
 	AuthList(context.Context, *AuthListRequest) (*AuthListReply, error)
@@ -134,6 +167,7 @@ type AppHTTPServer interface {
 	Deposit3(context.Context, *DepositRequest) (*DepositReply, error)
 	Deposit4(context.Context, *DepositRequest) (*DepositReply, error)
 	Deposit5(context.Context, *DepositRequest) (*DepositReply, error)
+	DepositBuy(context.Context, *DepositBuyRequest) (*DepositBuyReply, error)
 	DepositWithdraw(context.Context, *DepositRequest) (*DepositReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
 	LockSystem(context.Context, *LockSystemRequest) (*LockSystemReply, error)
@@ -159,6 +193,7 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_buy", _App_DepositBuy0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit_withdraw", _App_DepositWithdraw0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit_5", _App_Deposit50_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit_3", _App_Deposit30_HTTP_Handler(srv))
@@ -179,6 +214,12 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/trade", _App_AdminTrade0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/withdraw_pass", _App_AdminWithdrawPass0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_reject", _App_AdminWithdrawReject0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_batch_pass", _App_AdminWithdrawBatchPass0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_review_list", _App_AdminWithdrawReviewList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_reviews", _App_AdminWithdrawReviews0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/export_job", _App_AdminExportJobCreate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/export_job", _App_AdminExportJob0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _App_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fee", _App_AdminFee0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/daily_fee", _App_AdminDailyFee0_HTTP_Handler(srv))
@@ -187,7 +228,11 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/admin_dhb/month_recommend", _App_AdminMonthRecommend0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/config", _App_AdminConfig0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/config_update", _App_AdminConfigUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/config_history", _App_AdminConfigHistory0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/config_rollback", _App_AdminConfigRollback0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/config_update_listen", _App_AdminConfigUpdateListen0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/price_tick", _App_AdminPriceTick0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/price_revalue", _App_AdminPriceRevalue0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/password_update", _App_AdminUserPasswordUpdate0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/admin_update_location_new_max", _App_AdminUpdateLocationNewMax0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/vip_update", _App_AdminVipUpdate0_HTTP_Handler(srv))
@@ -213,6 +258,12 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/admin_dhb/daily_buy_reward", _App_AdminDailyBuyReward0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/daily_area_reward", _App_AdminDailyAreaReward0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/daily_location_reward_new", _App_AdminDailyLocationRewardNew0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reward_run", _App_AdminRewardRun0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/reward_rollback", _App_AdminRewardRollback0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/location_tree_check", _App_AdminLocationTreeCheck0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/location_product_list", _App_AdminLocationProductList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/location_product_update", _App_AdminLocationProductUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/location_history", _App_AdminLocationHistory0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/create_account", _App_TestCreateAccount0_HTTP_Handler(srv))
 }
 
@@ -371,6 +422,25 @@ func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _App_DepositBuy0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DepositBuyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppDepositBuy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DepositBuy(ctx, req.(*DepositBuyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DepositBuyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_DepositWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DepositRequest
@@ -754,6 +824,129 @@ func _App_AdminWithdrawPass0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _App_AdminWithdrawReject0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawRejectRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReject(ctx, req.(*AdminWithdrawRejectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawRejectReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawBatchPass0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawBatchPassRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawBatchPass)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawBatchPass(ctx, req.(*AdminWithdrawBatchPassRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawBatchPassReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawReviewList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReviewListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReviewList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReviewList(ctx, req.(*AdminWithdrawReviewListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReviewListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWithdrawReviews0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWithdrawReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReviews(ctx, req.(*AdminWithdrawReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminExportJobCreate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminExportJobCreateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminExportJobCreate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminExportJobCreate(ctx, req.(*AdminExportJobCreateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminExportJobCreateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminExportJob0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminExportJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminExportJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminExportJob(ctx, req.(*AdminExportJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminExportJobReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_AdminWithdrawEth0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminWithdrawEthRequest
@@ -909,6 +1102,47 @@ func _App_AdminConfigUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _App_AdminConfigHistory0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminConfigHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminConfigHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminConfigHistory(ctx, req.(*AdminConfigHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminConfigHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminConfigRollback0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminConfigRollbackRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminConfigRollback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminConfigRollback(ctx, req.(*AdminConfigRollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminConfigRollbackReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_AdminConfigUpdateListen0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminConfigUpdateListenRequest
@@ -928,6 +1162,44 @@ func _App_AdminConfigUpdateListen0_HTTP_Handler(srv AppHTTPServer) func(ctx http
 	}
 }
 
+func _App_AdminPriceTick0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminPriceTickRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminPriceTick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminPriceTick(ctx, req.(*AdminPriceTickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminPriceTickReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminPriceRevalue0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminPriceRevalueRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminPriceRevalue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminPriceRevalue(ctx, req.(*AdminPriceRevalueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminPriceRevalueReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_AdminUserPasswordUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminPasswordUpdateRequest
@@ -1445,6 +1717,126 @@ func _App_AdminDailyLocationRewardNew0_HTTP_Handler(srv AppHTTPServer) func(ctx
 	}
 }
 
+func _App_AdminRewardRun0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRewardRunRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRewardRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRewardRun(ctx, req.(*AdminRewardRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRewardRunReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminRewardRollback0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRewardRollbackRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminRewardRollback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRewardRollback(ctx, req.(*AdminRewardRollbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRewardRollbackReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationTreeCheck0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationTreeCheckRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationTreeCheck)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationTreeCheck(ctx, req.(*AdminLocationTreeCheckRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationTreeCheckReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationProductList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationProductListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationProductList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationProductList(ctx, req.(*AdminLocationProductListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationProductListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationProductUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationProductUpdateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationProductUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationProductUpdate(ctx, req.(*AdminLocationProductUpdateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationProductUpdateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationHistory0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationHistory(ctx, req.(*AdminLocationHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_TestCreateAccount0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in TestCreateAccountRequest
@@ -1470,6 +1862,8 @@ type AppHTTPClient interface {
 	AdminBalanceUpdate(ctx context.Context, req *AdminBalanceUpdateRequest, opts ...http.CallOption) (rsp *AdminBalanceUpdateReply, err error)
 	AdminChangePassword(ctx context.Context, req *AdminChangePasswordRequest, opts ...http.CallOption) (rsp *AdminChangePasswordReply, err error)
 	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
+	AdminConfigHistory(ctx context.Context, req *AdminConfigHistoryRequest, opts ...http.CallOption) (rsp *AdminConfigHistoryReply, err error)
+	AdminConfigRollback(ctx context.Context, req *AdminConfigRollbackRequest, opts ...http.CallOption) (rsp *AdminConfigRollbackReply, err error)
 	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
 	AdminConfigUpdateListen(ctx context.Context, req *AdminConfigUpdateListenRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateListenReply, err error)
 	AdminCreateAccount(ctx context.Context, req *AdminCreateAccountRequest, opts ...http.CallOption) (rsp *AdminCreateAccountReply, err error)
@@ -1480,17 +1874,27 @@ type AppHTTPClient interface {
 	AdminDailyLocationReward(ctx context.Context, req *AdminDailyLocationRewardRequest, opts ...http.CallOption) (rsp *AdminDailyLocationRewardReply, err error)
 	AdminDailyLocationRewardNew(ctx context.Context, req *AdminDailyLocationRewardNewRequest, opts ...http.CallOption) (rsp *AdminDailyLocationRewardNewReply, err error)
 	AdminDailyRecommendReward(ctx context.Context, req *AdminDailyRecommendRewardRequest, opts ...http.CallOption) (rsp *AdminDailyRecommendRewardReply, err error)
+	AdminExportJob(ctx context.Context, req *AdminExportJobRequest, opts ...http.CallOption) (rsp *AdminExportJobReply, err error)
+	AdminExportJobCreate(ctx context.Context, req *AdminExportJobCreateRequest, opts ...http.CallOption) (rsp *AdminExportJobCreateReply, err error)
 	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
 	AdminKkdtUpdate(ctx context.Context, req *AdminKkdtUpdateRequest, opts ...http.CallOption) (rsp *AdminKkdtUpdateReply, err error)
 	AdminList(ctx context.Context, req *AdminListRequest, opts ...http.CallOption) (rsp *AdminListReply, err error)
 	AdminLocationAllList(ctx context.Context, req *AdminLocationAllListRequest, opts ...http.CallOption) (rsp *AdminLocationAllListReply, err error)
+	AdminLocationHistory(ctx context.Context, req *AdminLocationHistoryRequest, opts ...http.CallOption) (rsp *AdminLocationHistoryReply, err error)
 	AdminLocationInsert(ctx context.Context, req *AdminLocationInsertRequest, opts ...http.CallOption) (rsp *AdminLocationInsertReply, err error)
 	AdminLocationList(ctx context.Context, req *AdminLocationListRequest, opts ...http.CallOption) (rsp *AdminLocationListReply, err error)
 	AdminLocationListNew(ctx context.Context, req *AdminLocationListRequest, opts ...http.CallOption) (rsp *AdminLocationListReply, err error)
+	AdminLocationProductList(ctx context.Context, req *AdminLocationProductListRequest, opts ...http.CallOption) (rsp *AdminLocationProductListReply, err error)
+	AdminLocationProductUpdate(ctx context.Context, req *AdminLocationProductUpdateRequest, opts ...http.CallOption) (rsp *AdminLocationProductUpdateReply, err error)
+	AdminLocationTreeCheck(ctx context.Context, req *AdminLocationTreeCheckRequest, opts ...http.CallOption) (rsp *AdminLocationTreeCheckReply, err error)
 	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
 	AdminMonthRecommend(ctx context.Context, req *AdminMonthRecommendRequest, opts ...http.CallOption) (rsp *AdminMonthRecommendReply, err error)
+	AdminPriceRevalue(ctx context.Context, req *AdminPriceRevalueRequest, opts ...http.CallOption) (rsp *AdminPriceRevalueReply, err error)
+	AdminPriceTick(ctx context.Context, req *AdminPriceTickRequest, opts ...http.CallOption) (rsp *AdminPriceTickReply, err error)
 	AdminRecordList(ctx context.Context, req *RecordListRequest, opts ...http.CallOption) (rsp *RecordListReply, err error)
 	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
+	AdminRewardRollback(ctx context.Context, req *AdminRewardRollbackRequest, opts ...http.CallOption) (rsp *AdminRewardRollbackReply, err error)
+	AdminRewardRun(ctx context.Context, req *AdminRewardRunRequest, opts ...http.CallOption) (rsp *AdminRewardRunReply, err error)
 	AdminTrade(ctx context.Context, req *AdminTradeRequest, opts ...http.CallOption) (rsp *AdminTradeReply, err error)
 	AdminTradeList(ctx context.Context, req *AdminTradeListRequest, opts ...http.CallOption) (rsp *AdminTradeListReply, err error)
 	AdminUndoUpdate(ctx context.Context, req *AdminUndoUpdateRequest, opts ...http.CallOption) (rsp *AdminUndoUpdateReply, err error)
@@ -1501,9 +1905,13 @@ type AppHTTPClient interface {
 	AdminVipDelete(ctx context.Context, req *AdminVipDeleteRequest, opts ...http.CallOption) (rsp *AdminVipDeleteReply, err error)
 	AdminVipUpdate(ctx context.Context, req *AdminVipUpdateRequest, opts ...http.CallOption) (rsp *AdminVipUpdateReply, err error)
 	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
+	AdminWithdrawBatchPass(ctx context.Context, req *AdminWithdrawBatchPassRequest, opts ...http.CallOption) (rsp *AdminWithdrawBatchPassReply, err error)
 	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
 	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
 	AdminWithdrawPass(ctx context.Context, req *AdminWithdrawPassRequest, opts ...http.CallOption) (rsp *AdminWithdrawPassReply, err error)
+	AdminWithdrawReject(ctx context.Context, req *AdminWithdrawRejectRequest, opts ...http.CallOption) (rsp *AdminWithdrawRejectReply, err error)
+	AdminWithdrawReviewList(ctx context.Context, req *AdminWithdrawReviewListRequest, opts ...http.CallOption) (rsp *AdminWithdrawReviewListReply, err error)
+	AdminWithdrawReviews(ctx context.Context, req *AdminWithdrawReviewsRequest, opts ...http.CallOption) (rsp *AdminWithdrawReviewsReply, err error)
 	AuthAdminCreate(ctx context.Context, req *AuthAdminCreateRequest, opts ...http.CallOption) (rsp *AuthAdminCreateReply, err error)
 	AuthAdminDelete(ctx context.Context, req *AuthAdminDeleteRequest, opts ...http.CallOption) (rsp *AuthAdminDeleteReply, err error)
 	AuthList(ctx context.Context, req *AuthListRequest, opts ...http.CallOption) (rsp *AuthListReply, err error)
@@ -1515,6 +1923,7 @@ type AppHTTPClient interface {
 	Deposit3(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
 	Deposit4(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
 	Deposit5(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	DepositBuy(ctx context.Context, req *DepositBuyRequest, opts ...http.CallOption) (rsp *DepositBuyReply, err error)
 	DepositWithdraw(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
 	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
 	LockSystem(ctx context.Context, req *LockSystemRequest, opts ...http.CallOption) (rsp *LockSystemReply, err error)
@@ -1603,6 +2012,32 @@ func (c *AppHTTPClientImpl) AdminConfig(ctx context.Context, in *AdminConfigRequ
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminConfigHistory(ctx context.Context, in *AdminConfigHistoryRequest, opts ...http.CallOption) (*AdminConfigHistoryReply, error) {
+	var out AdminConfigHistoryReply
+	pattern := "/api/admin_dhb/config_history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminConfigHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminConfigRollback(ctx context.Context, in *AdminConfigRollbackRequest, opts ...http.CallOption) (*AdminConfigRollbackReply, error) {
+	var out AdminConfigRollbackReply
+	pattern := "/api/admin_dhb/config_rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminConfigRollback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdateRequest, opts ...http.CallOption) (*AdminConfigUpdateReply, error) {
 	var out AdminConfigUpdateReply
 	pattern := "/api/admin_dhb/config_update"
@@ -1733,6 +2168,32 @@ func (c *AppHTTPClientImpl) AdminDailyRecommendReward(ctx context.Context, in *A
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminExportJob(ctx context.Context, in *AdminExportJobRequest, opts ...http.CallOption) (*AdminExportJobReply, error) {
+	var out AdminExportJobReply
+	pattern := "/api/admin_dhb/export_job"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminExportJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminExportJobCreate(ctx context.Context, in *AdminExportJobCreateRequest, opts ...http.CallOption) (*AdminExportJobCreateReply, error) {
+	var out AdminExportJobCreateReply
+	pattern := "/api/admin_dhb/export_job"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminExportJobCreate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
 	var out AdminFeeReply
 	pattern := "/api/admin_dhb/fee"
@@ -1785,6 +2246,19 @@ func (c *AppHTTPClientImpl) AdminLocationAllList(ctx context.Context, in *AdminL
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminLocationHistory(ctx context.Context, in *AdminLocationHistoryRequest, opts ...http.CallOption) (*AdminLocationHistoryReply, error) {
+	var out AdminLocationHistoryReply
+	pattern := "/api/admin_dhb/location_history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLocationHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminLocationInsert(ctx context.Context, in *AdminLocationInsertRequest, opts ...http.CallOption) (*AdminLocationInsertReply, error) {
 	var out AdminLocationInsertReply
 	pattern := "/api/admin_dhb/location_insert"
@@ -1824,6 +2298,45 @@ func (c *AppHTTPClientImpl) AdminLocationListNew(ctx context.Context, in *AdminL
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminLocationProductList(ctx context.Context, in *AdminLocationProductListRequest, opts ...http.CallOption) (*AdminLocationProductListReply, error) {
+	var out AdminLocationProductListReply
+	pattern := "/api/admin_dhb/location_product_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLocationProductList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLocationProductUpdate(ctx context.Context, in *AdminLocationProductUpdateRequest, opts ...http.CallOption) (*AdminLocationProductUpdateReply, error) {
+	var out AdminLocationProductUpdateReply
+	pattern := "/api/admin_dhb/location_product_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminLocationProductUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLocationTreeCheck(ctx context.Context, in *AdminLocationTreeCheckRequest, opts ...http.CallOption) (*AdminLocationTreeCheckReply, error) {
+	var out AdminLocationTreeCheckReply
+	pattern := "/api/admin_dhb/location_tree_check"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLocationTreeCheck))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
 	var out AdminLoginReply
 	pattern := "/api/admin_dhb/login"
@@ -1850,6 +2363,32 @@ func (c *AppHTTPClientImpl) AdminMonthRecommend(ctx context.Context, in *AdminMo
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminPriceRevalue(ctx context.Context, in *AdminPriceRevalueRequest, opts ...http.CallOption) (*AdminPriceRevalueReply, error) {
+	var out AdminPriceRevalueReply
+	pattern := "/api/admin_dhb/price_revalue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminPriceRevalue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminPriceTick(ctx context.Context, in *AdminPriceTickRequest, opts ...http.CallOption) (*AdminPriceTickReply, error) {
+	var out AdminPriceTickReply
+	pattern := "/api/admin_dhb/price_tick"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminPriceTick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminRecordList(ctx context.Context, in *RecordListRequest, opts ...http.CallOption) (*RecordListReply, error) {
 	var out RecordListReply
 	pattern := "/api/admin_dhb/record_list"
@@ -1876,6 +2415,32 @@ func (c *AppHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminReward
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminRewardRollback(ctx context.Context, in *AdminRewardRollbackRequest, opts ...http.CallOption) (*AdminRewardRollbackReply, error) {
+	var out AdminRewardRollbackReply
+	pattern := "/api/admin_dhb/reward_rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminRewardRollback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminRewardRun(ctx context.Context, in *AdminRewardRunRequest, opts ...http.CallOption) (*AdminRewardRunReply, error) {
+	var out AdminRewardRunReply
+	pattern := "/api/admin_dhb/reward_run"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminRewardRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminTrade(ctx context.Context, in *AdminTradeRequest, opts ...http.CallOption) (*AdminTradeReply, error) {
 	var out AdminTradeReply
 	pattern := "/api/admin_dhb/trade"
@@ -2006,6 +2571,19 @@ func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdraw
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawBatchPass(ctx context.Context, in *AdminWithdrawBatchPassRequest, opts ...http.CallOption) (*AdminWithdrawBatchPassReply, error) {
+	var out AdminWithdrawBatchPassReply
+	pattern := "/api/admin_dhb/withdraw_batch_pass"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawBatchPass))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...http.CallOption) (*AdminWithdrawEthReply, error) {
 	var out AdminWithdrawEthReply
 	pattern := "/api/admin_dhb/withdraw_eth"
@@ -2045,6 +2623,45 @@ func (c *AppHTTPClientImpl) AdminWithdrawPass(ctx context.Context, in *AdminWith
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminWithdrawReject(ctx context.Context, in *AdminWithdrawRejectRequest, opts ...http.CallOption) (*AdminWithdrawRejectReply, error) {
+	var out AdminWithdrawRejectReply
+	pattern := "/api/admin_dhb/withdraw_reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReviewList(ctx context.Context, in *AdminWithdrawReviewListRequest, opts ...http.CallOption) (*AdminWithdrawReviewListReply, error) {
+	var out AdminWithdrawReviewListReply
+	pattern := "/api/admin_dhb/withdraw_review_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReviewList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWithdrawReviews(ctx context.Context, in *AdminWithdrawReviewsRequest, opts ...http.CallOption) (*AdminWithdrawReviewsReply, error) {
+	var out AdminWithdrawReviewsReply
+	pattern := "/api/admin_dhb/withdraw_reviews"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWithdrawReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AuthAdminCreate(ctx context.Context, in *AuthAdminCreateRequest, opts ...http.CallOption) (*AuthAdminCreateReply, error) {
 	var out AuthAdminCreateReply
 	pattern := "/api/admin_dhb/auth_create"
@@ -2188,6 +2805,19 @@ func (c *AppHTTPClientImpl) Deposit5(ctx context.Context, in *DepositRequest, op
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) DepositBuy(ctx context.Context, in *DepositBuyRequest, opts ...http.CallOption) (*DepositBuyReply, error) {
+	var out DepositBuyReply
+	pattern := "/api/admin_dhb/deposit_buy"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppDepositBuy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) DepositWithdraw(ctx context.Context, in *DepositRequest, opts ...http.CallOption) (*DepositReply, error) {
 	var out DepositReply
 	pattern := "/api/admin_dhb/deposit_withdraw"
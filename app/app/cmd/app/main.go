@@ -5,12 +5,15 @@ import (
 	"os"
 
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/requestid"
+	"dhb/app/app/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
@@ -26,13 +29,16 @@ var (
 	flagconf string
 
 	id, _ = os.Hostname()
+
+	// redactKeys 日志里这些字段的值替换为***
+	redactKeys = []string{"password", "private_key", "privateKey", "secret", "token", "apikey"}
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server, is *server.InternalServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,13 +47,16 @@ func newApp(logger log.Logger, hs *http.Server) *kratos.App {
 		kratos.Logger(logger),
 		kratos.Server(
 			hs,
+			gs,
+			is,
 		),
 	)
 }
 
 func main() {
 	flag.Parse()
-	logger := log.With(log.NewStdLogger(os.Stdout),
+	// 私钥、密码等字段的值不输出
+	logger := log.With(log.NewFilter(log.NewStdLogger(os.Stdout), log.FilterKey(redactKeys...)),
 		"ts", log.DefaultTimestamp,
 		"caller", log.DefaultCaller,
 		"service.id", id,
@@ -55,7 +64,10 @@ func main() {
 		"service.version", Version,
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
+		"request.id", requestid.Valuer(),
 	)
+	// 没有注入日志的包级函数使用全局日志
+	log.SetLogger(logger)
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
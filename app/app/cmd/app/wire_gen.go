@@ -38,12 +38,27 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	locationRepo := data.NewLocationRepo(dataData, logger)
 	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataData, logger)
 	userBalanceRepo := data.NewUserBalanceRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, logger)
+	rewardRunRepo := data.NewRewardRunRepo(dataData, logger)
+	locationTreeRepo := data.NewLocationTreeRepo(dataData, logger)
+	locationProductRepo := data.NewLocationProductRepo(dataData, logger)
+	locationEventRepo := data.NewLocationEventRepo(dataData, logger)
+	priceFeedRepo := data.NewPriceFeedRepo(dataData, logger)
+	priceRevalueRepo := data.NewPriceRevalueRepo(dataData, logger)
+	withdrawReviewRepo := data.NewWithdrawReviewRepo(dataData, logger)
+	exportRepo := data.NewExportRepo(dataData, logger)
+	configVersionRepo := data.NewConfigVersionRepo(dataData, logger)
+	configNotifier := data.NewConfigNotifier(dataData, logger)
+	configRegistry := biz.NewConfigRegistry(configRepo, configVersionRepo, configNotifier)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, rewardRunRepo, locationTreeRepo, locationProductRepo, locationEventRepo, priceFeedRepo, priceRevalueRepo, withdrawReviewRepo, exportRepo, configRegistry, logger)
 	ethUserRecordRepo := data.NewEthUserRecordRepo(dataData, logger)
-	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, transaction, logger)
+	buyContractRepo := data.NewBuyContractRepo(logger)
+	chainCursorRepo := data.NewChainCursorRepo(dataData, logger)
+	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, locationTreeRepo, locationProductRepo, locationEventRepo, userRepo, buyContractRepo, chainCursorRepo, configRegistry, transaction, logger)
 	appService := service.NewAppService(userUseCase, recordUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, appService, logger)
-	app := newApp(logger, httpServer)
+	httpServer := server.NewHTTPServer(confServer, appService)
+	grpcServer := server.NewGRPCServer(confServer, appService)
+	internalServer := server.NewInternalServer(confServer, appService)
+	app := newApp(logger, httpServer, grpcServer, internalServer)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -6,9 +6,11 @@ import (
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewUserUseCase, NewRecordUseCase)
+var ProviderSet = wire.NewSet(NewUserUseCase, NewRecordUseCase, NewConfigRegistry)
 
 // Transaction 新增事务接口方法
 type Transaction interface {
 	ExecTx(context.Context, func(ctx context.Context) error) error
+	// ExecTxRetry 死锁或锁等待超时时重新执行，只用于可以重复执行的fn
+	ExecTxRetry(context.Context, func(ctx context.Context) error) error
 }
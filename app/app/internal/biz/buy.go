@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+)
+
+// BuyPurchase BuySomething合约中的一笔购买，Index为合约中的序号
+type BuyPurchase struct {
+	Index   int64
+	Account string // 平台用户地址
+	Address string // 付款地址
+	Amount  int64  // usdt
+}
+
+// BuyContractRepo 读取合约的购买列表
+type BuyContractRepo interface {
+	// GetBuyPurchases 在同一区块读取购买总数和[start, start+limit)的购买，三个数组长度不一致时返回错误
+	GetBuyPurchases(ctx context.Context, rpcUrl string, contract string, start int64, limit int64) (int64, []*BuyPurchase, error)
+}
+
+// ChainCursorRepo 链上同步的进度
+type ChainCursorRepo interface {
+	GetChainCursor(ctx context.Context, name string) (int64, error)
+	// AdvanceChainCursor 只从from前进到to，进度已被修改时返回false
+	AdvanceChainCursor(ctx context.Context, name string, from int64, to int64) (bool, error)
+}
+
+// BuyIngestReport 一次同步的结果
+type BuyIngestReport struct {
+	Contract string
+	Length   int64
+	From     int64
+	To       int64
+	Done     []*DepositResult
+	Skipped  []*DepositResult // 不满足入金条件，进度已越过，需要时后台补单
+	Failed   *DepositResult   // 写入失败，进度停在这一笔，下次同步重试
+}
+
+// EthUserRecordBuyHash 合约购买没有交易hash，用合约地址和序号生成，同时作为外部单号保证只入金一次
+func EthUserRecordBuyHash(contract string, index int64) string {
+	return "buy:" + strings.ToLower(contract) + ":" + strconv.FormatInt(index, 10)
+}
+
+// buyCursorName 合约购买的同步进度
+func buyCursorName(contract string) string {
+	return "buy:" + strings.ToLower(contract)
+}
+
+// parseBuyHash 从合约购买的hash取出同步进度名称和序号
+func parseBuyHash(hash string) (string, int64, error) {
+	i := strings.LastIndex(hash, ":")
+	if !strings.HasPrefix(hash, "buy:") || 0 > i {
+		return "", 0, errors.New(500, "ERROR", "合约购买hash错误 "+hash)
+	}
+
+	index, err := strconv.ParseInt(hash[i+1:], 10, 64)
+	if nil != err {
+		return "", 0, errors.New(500, "ERROR", "合约购买hash错误 "+hash)
+	}
+
+	return hash[:i], index, nil
+}
+
+// depositCursorStage 入金与同步进度在同一事务中提交，进度已被其他进程推进时回滚
+func (ruc *RecordUseCase) depositCursorStage() *DepositStage {
+	return &DepositStage{
+		Name: "cursor",
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			name, index, err := parseBuyHash(d.Record.Hash)
+			if nil != err {
+				return err
+			}
+
+			ok, err := ruc.cursorRepo.AdvanceChainCursor(ctx, name, index, index+1)
+			if nil != err {
+				return err
+			}
+			if !ok {
+				return errors.New(500, "ERROR", "同步进度已变化")
+			}
+
+			return nil
+		},
+	}
+}
+
+// IngestBuyPurchases 从进度处按序号同步BuySomething合约的购买，每笔购买生成入金记录和占位。
+// 入金与进度在同一事务中提交，外部单号唯一，重复同步或并发同步不会重复入金
+func (ruc *RecordUseCase) IngestBuyPurchases(ctx context.Context) (*BuyIngestReport, error) {
+	var (
+		configs   map[string]string
+		purchases []*BuyPurchase
+		users     map[string]*User
+		err       error
+	)
+
+	configs, err = ruc.registry.Values(ctx, "buy_contract", "buy_rpc_url", "buy_batch")
+	if nil != err {
+		return nil, err
+	}
+	contract := configs["buy_contract"]
+	if "" == contract {
+		return nil, errors.New(500, "ERROR", "未配置合约地址")
+	}
+	batch, _ := strconv.ParseInt(configs["buy_batch"], 10, 64)
+	name := buyCursorName(contract)
+
+	res := &BuyIngestReport{
+		Contract: contract,
+		Done:     make([]*DepositResult, 0),
+		Skipped:  make([]*DepositResult, 0),
+	}
+	res.From, err = ruc.cursorRepo.GetChainCursor(ctx, name)
+	if nil != err {
+		return nil, err
+	}
+	res.To = res.From
+
+	res.Length, purchases, err = ruc.buyRepo.GetBuyPurchases(ctx, configs["buy_rpc_url"], contract, res.From, batch)
+	if nil != err {
+		ruc.log.WithContext(ctx).Errorw("msg", "buy purchases", "contract", contract, "from", res.From, "err", err)
+		return nil, err
+	}
+	if 0 >= len(purchases) {
+		return res, nil
+	}
+
+	accounts := make([]string, 0, len(purchases))
+	for _, v := range purchases {
+		accounts = append(accounts, v.Account)
+	}
+	users, err = ruc.userRepo.GetUserByAddresses(ctx, accounts...)
+	if nil != err {
+		return nil, err
+	}
+
+	// skip 不入金的购买只推进进度
+	skip := func(v *BuyPurchase, result *DepositResult) bool {
+		ok, err := ruc.cursorRepo.AdvanceChainCursor(ctx, name, v.Index, v.Index+1)
+		if nil != err || !ok {
+			result.Reason = fmt.Sprintf("同步进度修改失败 %v", err)
+			res.Failed = result
+			return false
+		}
+
+		res.Skipped = append(res.Skipped, result)
+		res.To = v.Index + 1
+		return true
+	}
+
+	for _, v := range purchases {
+		var (
+			exist  map[string]*EthUserRecord
+			report *DepositReport
+		)
+
+		hash := EthUserRecordBuyHash(contract, v.Index)
+		result := &DepositResult{Hash: hash}
+
+		user, ok := users[v.Account]
+		if !ok {
+			result.Reason = "用户不存在 " + v.Account
+			if !skip(v, result) {
+				break
+			}
+			continue
+		}
+		result.UserId = user.ID
+
+		// 已入金，上次提交后进度未推进
+		exist, err = ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, hash)
+		if nil != err {
+			result.Reason = err.Error()
+			res.Failed = result
+			break
+		}
+		if record, ok := exist[hash]; ok {
+			result.RecordId = record.ID
+			result.Reason = "入金记录已存在"
+			if !skip(v, result) {
+				break
+			}
+			continue
+		}
+
+		report, err = ruc.RunDeposit(ctx, DepositSourceBuy, &EthUserRecord{
+			UserId:      user.ID,
+			Hash:        hash,
+			Status:      "success",
+			Type:        "deposit",
+			Amount:      strconv.FormatInt(v.Amount, 10) + "000000000000000000",
+			RelAmount:   v.Amount * 100000,
+			CoinType:    "USDT",
+			Last:        v.Index + 1,
+			Source:      EthUserRecordSourceBuy,
+			Note:        v.Address,
+			ExternalRef: hash,
+		})
+		if nil != err {
+			result.Reason = err.Error()
+			res.Failed = result
+			break
+		}
+
+		if 0 < len(report.Done) {
+			res.Done = append(res.Done, report.Done[0])
+			res.To = v.Index + 1
+			continue
+		}
+		if 0 < len(report.Skipped) {
+			if !skip(v, report.Skipped[0]) {
+				break
+			}
+			continue
+		}
+		if 0 < len(report.Failed) {
+			res.Failed = report.Failed[0]
+		}
+		break
+	}
+
+	return res, nil
+}
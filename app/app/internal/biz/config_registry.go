@@ -0,0 +1,433 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 配置值类型
+const (
+	ConfigTypeInt    = "int"
+	ConfigTypeFloat  = "float"
+	ConfigTypeString = "string"
+)
+
+// 缓存最长有效期，订阅断开时其他实例也能在这个时间内读到新值
+const configRegistryTTL = time.Minute
+
+// ConfigDef 配置项声明，Min、Max都为0时不限制范围，Required为true时必须在config表中配置
+type ConfigDef struct {
+	Key      string
+	Type     string
+	Unit     string
+	Min      float64
+	Max      float64
+	Default  string
+	Required bool
+}
+
+// ConfigNotifier 配置修改通知，多实例部署时通知其他实例清除缓存
+type ConfigNotifier interface {
+	PublishConfigChange(ctx context.Context, key string) error
+	SubscribeConfigChange(ctx context.Context, fn func(key string))
+}
+
+func configRate(key string, unit string, max float64) *ConfigDef {
+	return &ConfigDef{Key: key, Type: ConfigTypeInt, Unit: unit, Min: 0, Max: max, Default: "0"}
+}
+
+func configAmount(key string, unit string) *ConfigDef {
+	return &ConfigDef{Key: key, Type: ConfigTypeInt, Unit: unit, Min: 0, Max: 1e12, Default: "0"}
+}
+
+func configFloat(key string, unit string) *ConfigDef {
+	return &ConfigDef{Key: key, Type: ConfigTypeFloat, Unit: unit, Min: 0, Max: 1e12, Default: "0"}
+}
+
+// configDefs 已声明的配置项，未声明的按字符串读取
+var configDefs = []*ConfigDef{
+	// 价格
+	{Key: "b_price", Type: ConfigTypeInt, Unit: "price", Min: 1, Max: 1e12, Required: true},
+	{Key: "b_price_base", Type: ConfigTypeInt, Unit: "price", Min: 1, Max: 1e12, Required: true},
+	configRate("exchange_rate", "‰", 1000), // 兑换手续费，使用时除以1000
+	configAmount("coin_price", "price"),
+
+	// 价格来源
+	{Key: "price_oracle", Type: ConfigTypeString, Default: PriceOracleManual},
+	configFloat("price_manual", "usdt"),
+	{Key: "price_rpc_url", Type: ConfigTypeString, Default: "https://bsc-dataseed.binance.org/"},
+	{Key: "price_pair", Type: ConfigTypeString},
+	{Key: "price_token", Type: ConfigTypeString},
+	{Key: "price_twap_source", Type: ConfigTypeString, Default: PriceOraclePair},
+	{Key: "price_twap_window", Type: ConfigTypeInt, Unit: "minute", Min: 1, Max: 10080, Default: "60"},
+	configRate("price_step_max", "%", 100),
+
+	// 入金
+	configAmount("area_one", "usdt"),
+	configAmount("area_two", "usdt"),
+	configAmount("area_three", "usdt"),
+	configAmount("area_four", "usdt"),
+	configAmount("area_five", "usdt"),
+	configRate("recommend_new_one", "‰", 1000),
+	configRate("recommend_new_two", "‰", 1000),
+	configAmount("buy_one", "count"),
+	configAmount("buy_two", "count"),
+	configAmount("buy_three", "count"),
+	configAmount("buy_four", "count"),
+	configAmount("buy_five", "count"),
+	configAmount("buy_six", "count"),
+	configAmount("time_again", "minute"),
+	configRate("recommend_rate_2", "%", 100),
+	{Key: "buy_contract", Type: ConfigTypeString, Default: "0x008EC6D29A4Eb429eDD90dC394CC185E33F4e534"},
+	{Key: "buy_rpc_url", Type: ConfigTypeString, Default: "https://bsc-dataseed4.binance.org/"},
+	{Key: "buy_batch", Type: ConfigTypeInt, Unit: "count", Min: 1, Max: 1000, Default: "100"},
+
+	// 分红
+	configRate("reward_rate", "%", 100),
+	configRate("coin_reward_rate", "%", 100),
+	configFloat("one", "usdt"),
+	configFloat("two", "usdt"),
+	configFloat("three", "usdt"),
+	configFloat("four", "usdt"),
+	configFloat("five", "usdt"),
+	configFloat("six", "usdt"),
+	configFloat("seven", "usdt"),
+	configFloat("eight", "usdt"),
+	configFloat("nine", "usdt"),
+	configFloat("one_two", "usdt"),
+	configFloat("two_two", "usdt"),
+	configFloat("three_two", "usdt"),
+	configFloat("four_two", "usdt"),
+	configFloat("five_two", "usdt"),
+	configFloat("four_three", "%"),
+	configFloat("five_three", "%"),
+	configFloat("seven_two", "usdt"),
+	configFloat("eight_two", "usdt"),
+	configFloat("nine_two", "usdt"),
+
+	// 提现
+	configRate("withdraw_rate", "%", 100),
+	configRate("withdraw_destroy_rate", "%", 100),
+	configRate("withdraw_recommend_rate", "%", 100),
+	configRate("withdraw_recommend_second_rate", "%", 100),
+	configRate("withdraw_team_vip_rate", "%", 100),
+	configRate("withdraw_team_vip_second_rate", "%", 100),
+	configRate("withdraw_team_vip_third_rate", "%", 100),
+	configRate("withdraw_team_vip_fourth_rate", "%", 100),
+	configRate("withdraw_team_vip_fifth_rate", "%", 100),
+	configRate("withdraw_team_vip_level_rate", "%", 100),
+	configAmount("withdraw_min", "usdt"),            // 0不限制
+	configAmount("withdraw_max", "usdt"),            // 0不限制
+	configAmount("withdraw_user_daily_max", "usdt"), // 0不限制
+	configAmount("withdraw_daily_max", "usdt"),      // 全平台，0不限制
+	configAmount("withdraw_cooldown", "minute"),
+	configAmount("withdraw_auto_max", "usdt"), // 不超过时直接进入打款，0时全部人工审核
+	{Key: "withdraw_risk_account_days", Type: ConfigTypeInt, Unit: "day", Min: 0, Max: 3650, Default: "7"},
+	{Key: "withdraw_risk_deposit_rate", Type: ConfigTypeInt, Unit: "%", Min: 0, Max: 100000, Default: "100"}, // 0不检查
+	{Key: "withdraw_risk_large_times", Type: ConfigTypeInt, Unit: "times", Min: 0, Max: 1000, Default: "3"},
+	{Key: "withdraw_risk_bind_days", Type: ConfigTypeInt, Unit: "day", Min: 0, Max: 3650, Default: "3"}, // 0不检查
+
+	// 导出
+	{Key: "export_dir", Type: ConfigTypeString, Default: "/tmp/dhb_export"},
+
+	// vip
+	configAmount("vip_0_balance", "csd"),
+	configAmount("vip_1_balance", "csd"),
+	configAmount("vip_2_balance", "csd"),
+	configAmount("vip_3_balance", "csd"),
+	configAmount("vip_4_balance", "csd"),
+	configAmount("vip_5_balance", "csd"),
+	configAmount("vip_1_balance_team", "csd"),
+	configAmount("vip_2_balance_team", "csd"),
+	configAmount("vip_3_balance_team", "csd"),
+	configAmount("vip_4_balance_team", "csd"),
+	configAmount("vip_5_balance_team", "csd"),
+}
+
+// ConfigRegistry config表和配置版本的缓存，按声明校验类型和范围，按生效时间取值
+type ConfigRegistry struct {
+	repo        ConfigRepo
+	versionRepo ConfigVersionRepo
+	notifier    ConfigNotifier
+	defs        map[string]*ConfigDef
+
+	mu       sync.RWMutex
+	configs  map[string]*Config
+	versions map[string][]*ConfigVersion // 按生效时间升序
+	loadedAt time.Time
+}
+
+func NewConfigRegistry(repo ConfigRepo, versionRepo ConfigVersionRepo, notifier ConfigNotifier) *ConfigRegistry {
+	r := &ConfigRegistry{
+		repo:        repo,
+		versionRepo: versionRepo,
+		notifier:    notifier,
+		defs:        make(map[string]*ConfigDef, 0),
+	}
+	for _, v := range configDefs {
+		r.defs[v.Key] = v
+	}
+
+	notifier.SubscribeConfigChange(context.Background(), func(key string) {
+		r.Invalidate()
+	})
+
+	return r
+}
+
+// Def 配置项声明，未声明时返回nil
+func (r *ConfigRegistry) Def(key string) *ConfigDef {
+	return r.defs[key]
+}
+
+// Invalidate 清除缓存，下次读取时重新加载
+func (r *ConfigRegistry) Invalidate() {
+	r.mu.Lock()
+	r.configs = nil
+	r.mu.Unlock()
+}
+
+func (r *ConfigRegistry) load(ctx context.Context) (map[string]*Config, map[string][]*ConfigVersion, error) {
+	r.mu.RLock()
+	configs := r.configs
+	versions := r.versions
+	loadedAt := r.loadedAt
+	r.mu.RUnlock()
+	if nil != configs && time.Since(loadedAt) < configRegistryTTL {
+		return configs, versions, nil
+	}
+
+	tmpConfigs, err := r.repo.GetConfigs(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	var tmpVersions []*ConfigVersion
+	tmpVersions, err = r.versionRepo.GetConfigVersions(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	configs = make(map[string]*Config, len(tmpConfigs))
+	for _, v := range tmpConfigs {
+		configs[v.KeyName] = v
+	}
+	versions = make(map[string][]*ConfigVersion, 0)
+	for _, v := range tmpVersions {
+		versions[v.KeyName] = append(versions[v.KeyName], v)
+	}
+	r.promote(ctx, configs, versions)
+
+	r.mu.Lock()
+	r.configs = configs
+	r.versions = versions
+	r.loadedAt = time.Now()
+	r.mu.Unlock()
+
+	return configs, versions, nil
+}
+
+// promote 到了生效时间的版本写入config表，直接读config表的地方也能取到新值。
+// 先读config表再读版本，读到的版本不会比config表旧，不会用旧版本覆盖刚修改的值
+func (r *ConfigRegistry) promote(ctx context.Context, configs map[string]*Config, versions map[string][]*ConfigVersion) {
+	now := time.Now()
+	for key, config := range configs {
+		version := configVersionAt(versions[key], now)
+		if nil == version || strings.TrimSpace(version.Value) == strings.TrimSpace(config.Value) {
+			continue
+		}
+
+		_, err := r.repo.UpdateConfig(ctx, config.ID, version.Value)
+		if nil != err {
+			log.Context(ctx).Warnw("msg", "config promote", "key", key, "version_id", version.ID, "err", err) // 下次加载时重试
+			continue
+		}
+
+		configs[key] = &Config{ID: config.ID, KeyName: config.KeyName, Name: config.Name, Value: version.Value}
+	}
+}
+
+// Configs 全部配置
+func (r *ConfigRegistry) Configs(ctx context.Context) (map[string]*Config, error) {
+	configs, _, err := r.load(ctx)
+	return configs, err
+}
+
+// ConfigsByKeys 同ConfigRepo.GetConfigByKeys，Value为当前生效的版本，不传key时返回全部
+func (r *ConfigRegistry) ConfigsByKeys(ctx context.Context, keys ...string) ([]*Config, error) {
+	configs, versions, err := r.load(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	all := 0 >= len(keys)
+	if all {
+		for key := range configs {
+			keys = append(keys, key)
+		}
+	}
+
+	now := time.Now()
+	res := make([]*Config, 0, len(keys))
+	for _, key := range keys {
+		config, ok := configs[key]
+		if !ok {
+			continue
+		}
+
+		tmp := *config
+		if version := configVersionAt(versions[key], now); nil != version {
+			tmp.Value = version.Value
+		}
+		res = append(res, &tmp)
+	}
+
+	if all { // 与config表的顺序一致
+		sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	}
+
+	return res, nil
+}
+
+// Values 按key读取当前生效的配置，未配置时取默认值，值不合法时返回错误
+func (r *ConfigRegistry) Values(ctx context.Context, keys ...string) (map[string]string, error) {
+	return r.ValuesAt(ctx, time.Now(), keys...)
+}
+
+// ValuesAt 按key读取at时生效的配置，没有版本记录的取config表中的值
+func (r *ConfigRegistry) ValuesAt(ctx context.Context, at time.Time, keys ...string) (map[string]string, error) {
+	configs, versions, err := r.load(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value := ""
+		config, ok := configs[key]
+		if ok {
+			value = strings.TrimSpace(config.Value)
+		}
+		if version := configVersionAt(versions[key], at); nil != version {
+			ok = true
+			value = strings.TrimSpace(version.Value)
+		}
+
+		def := r.defs[key]
+		if nil == def {
+			if ok {
+				res[key] = value
+			}
+			continue
+		}
+
+		if !ok || "" == value {
+			if def.Required {
+				return nil, errors.New(500, "CONFIG_MISSING", "配置未设置 "+key)
+			}
+			value = def.Default
+		}
+
+		err = def.Check(value)
+		if nil != err {
+			return nil, err
+		}
+		res[key] = value
+	}
+
+	return res, nil
+}
+
+func (r *ConfigRegistry) String(ctx context.Context, key string) (string, error) {
+	values, err := r.Values(ctx, key)
+	if nil != err {
+		return "", err
+	}
+
+	return values[key], nil
+}
+
+func (r *ConfigRegistry) Int64(ctx context.Context, key string) (int64, error) {
+	values, err := r.Int64s(ctx, key)
+	if nil != err {
+		return 0, err
+	}
+
+	return values[key], nil
+}
+
+func (r *ConfigRegistry) Float64(ctx context.Context, key string) (float64, error) {
+	value, err := r.String(ctx, key)
+	if nil != err {
+		return 0, err
+	}
+
+	res, err := strconv.ParseFloat(value, 64)
+	if nil != err {
+		return 0, errors.New(500, "CONFIG_INVALID", "配置不是数字 "+key)
+	}
+
+	return res, nil
+}
+
+// Int64s 批量读取整数配置
+func (r *ConfigRegistry) Int64s(ctx context.Context, keys ...string) (map[string]int64, error) {
+	values, err := r.Values(ctx, keys...)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make(map[string]int64, len(keys))
+	for _, key := range keys {
+		value, ok := values[key]
+		if !ok {
+			return nil, errors.New(500, "CONFIG_MISSING", "配置未设置 "+key)
+		}
+
+		res[key], err = strconv.ParseInt(value, 10, 64)
+		if nil != err {
+			return nil, errors.New(500, "CONFIG_INVALID", "配置不是整数 "+key)
+		}
+	}
+
+	return res, nil
+}
+
+// Check 校验值的类型和范围
+func (d *ConfigDef) Check(value string) error {
+	if ConfigTypeString == d.Type {
+		return nil
+	}
+
+	var (
+		tmp float64
+		err error
+	)
+	if ConfigTypeInt == d.Type {
+		var tmpInt int64
+		tmpInt, err = strconv.ParseInt(value, 10, 64)
+		tmp = float64(tmpInt)
+	} else {
+		tmp, err = strconv.ParseFloat(value, 64)
+	}
+	if nil != err {
+		return errors.New(500, "CONFIG_INVALID", "配置格式错误 "+d.Key)
+	}
+
+	if (0 != d.Min || 0 != d.Max) && (tmp < d.Min || tmp > d.Max) {
+		return errors.New(500, "CONFIG_INVALID", fmt.Sprintf("配置超出范围 %s [%s, %s]", d.Key, d.Bound(d.Min), d.Bound(d.Max)))
+	}
+
+	return nil
+}
+
+func (d *ConfigDef) Bound(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
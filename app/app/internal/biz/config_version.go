@@ -0,0 +1,309 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 第一次修改前的值，按这个时间生效，之前的业务日都取这个值
+var configVersionBaseline = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ConfigVersion 配置的一次修改，EffectiveFrom之后生效，取生效时间最晚的一个
+type ConfigVersion struct {
+	ID            int64
+	ConfigId      int64
+	KeyName       string
+	Value         string
+	AdminId       int64
+	EffectiveFrom time.Time
+	Note          string
+	RollbackOf    int64 // 回滚到的版本
+	CreatedAt     time.Time
+}
+
+type ConfigVersionRepo interface {
+	CreateConfigVersion(ctx context.Context, version *ConfigVersion) (*ConfigVersion, error)
+	GetConfigVersions(ctx context.Context, keys ...string) ([]*ConfigVersion, error)
+	GetConfigVersionById(ctx context.Context, id int64) (*ConfigVersion, error)
+}
+
+// ConfigChange 配置修改，EffectiveFrom为空时立即生效，Then在同一事务内执行
+type ConfigChange struct {
+	ConfigId      int64
+	Value         string
+	EffectiveFrom time.Time
+	Note          string
+	RollbackOf    int64
+	Then          func(ctx context.Context) error
+}
+
+// configVersionAt versions按生效时间升序，返回at时生效的版本
+func configVersionAt(versions []*ConfigVersion, at time.Time) *ConfigVersion {
+	var res *ConfigVersion
+	for _, v := range versions {
+		if v.EffectiveFrom.After(at) {
+			break
+		}
+		res = v
+	}
+
+	return res
+}
+
+// rewardConfigTime 业务日（UTC+8）结束时，奖励任务按这个时间生效的配置计算
+func rewardConfigTime(businessDate string) time.Time {
+	date, err := time.Parse("2006-01-02", businessDate)
+	if nil != err {
+		return time.Now()
+	}
+
+	return date.AddDate(0, 0, 1).Add(-8 * time.Hour).Add(-time.Second)
+}
+
+// parseConfigEffectiveFrom 后台传入的UTC+8时间，空为立即生效
+func parseConfigEffectiveFrom(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if "" == s {
+		return time.Time{}, nil
+	}
+
+	res, err := time.Parse("2006-01-02 15:04:05", s)
+	if nil != err {
+		return time.Time{}, errors.New(500, "ERROR", "生效时间格式错误")
+	}
+
+	return res.Add(-8 * time.Hour), nil
+}
+
+// Update 校验后写入新版本，已生效的同时修改config表，提交后清除本实例缓存并通知其他实例。
+// 未到生效时间的修改只写版本，生效后registry重新加载时写入config表
+func (r *ConfigRegistry) Update(ctx context.Context, tx Transaction, change *ConfigChange) (*ConfigVersion, error) {
+	var (
+		configs  []*Config
+		config   *Config
+		versions []*ConfigVersion
+		version  *ConfigVersion
+		err      error
+	)
+
+	configs, err = r.repo.GetConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range configs {
+		if change.ConfigId == v.ID {
+			config = v
+			break
+		}
+	}
+	if nil == config {
+		return nil, errors.New(500, "ERROR", "配置不存在")
+	}
+
+	value := strings.TrimSpace(change.Value)
+	if def := r.defs[config.KeyName]; nil != def {
+		err = def.Check(value)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	effectiveFrom := change.EffectiveFrom
+	if effectiveFrom.IsZero() {
+		effectiveFrom = now
+	} else if effectiveFrom.Before(now) {
+		return nil, errors.New(500, "ERROR", "生效时间不能早于当前时间")
+	}
+
+	versions, err = r.versionRepo.GetConfigVersions(ctx, config.KeyName)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if 0 >= len(versions) { // 第一次修改，记录修改前的值
+			_, err = r.versionRepo.CreateConfigVersion(ctx, &ConfigVersion{
+				ConfigId:      config.ID,
+				KeyName:       config.KeyName,
+				Value:         config.Value,
+				EffectiveFrom: configVersionBaseline,
+				Note:          "修改前的值",
+			})
+			if nil != err {
+				return err
+			}
+		}
+
+		version, err = r.versionRepo.CreateConfigVersion(ctx, &ConfigVersion{
+			ConfigId:      config.ID,
+			KeyName:       config.KeyName,
+			Value:         value,
+			AdminId:       adminIdFromContext(ctx),
+			EffectiveFrom: effectiveFrom,
+			Note:          change.Note,
+			RollbackOf:    change.RollbackOf,
+		})
+		if nil != err {
+			return err
+		}
+
+		if !effectiveFrom.After(now) {
+			_, err = r.repo.UpdateConfig(ctx, config.ID, value)
+			if nil != err {
+				return err
+			}
+		}
+
+		if nil != change.Then {
+			return change.Then(ctx)
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	r.Invalidate()
+	err = r.notifier.PublishConfigChange(ctx, config.KeyName)
+	if nil != err {
+		log.Context(ctx).Warnw("msg", "config publish", "key", config.KeyName, "err", err) // 其他实例在缓存过期后读到新值
+	}
+
+	return version, nil
+}
+
+// Versions key的全部版本，按生效时间升序
+func (r *ConfigRegistry) Versions(ctx context.Context, key string) ([]*ConfigVersion, error) {
+	return r.versionRepo.GetConfigVersions(ctx, key)
+}
+
+// Version 不存在时为nil
+func (r *ConfigRegistry) Version(ctx context.Context, id int64) (*ConfigVersion, error) {
+	return r.versionRepo.GetConfigVersionById(ctx, id)
+}
+
+// configValueDiff 数值配置的变化量，非数值为空
+func configValueDiff(before string, after string) string {
+	tmpBefore, err := strconv.ParseFloat(strings.TrimSpace(before), 64)
+	if nil != err {
+		return ""
+	}
+	tmpAfter, err := strconv.ParseFloat(strings.TrimSpace(after), 64)
+	if nil != err {
+		return ""
+	}
+
+	res := strconv.FormatFloat(tmpAfter-tmpBefore, 'f', -1, 64)
+	if tmpAfter > tmpBefore {
+		res = "+" + res
+	}
+
+	return res
+}
+
+func (uuc *UserUseCase) AdminConfigHistory(ctx context.Context, req *v1.AdminConfigHistoryRequest) (*v1.AdminConfigHistoryReply, error) {
+	var (
+		configs  []*Config
+		config   *Config
+		versions []*ConfigVersion
+		err      error
+	)
+
+	configs, err = uuc.configRepo.GetConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range configs {
+		if (0 < req.Id && req.Id == v.ID) || ("" != req.KeyName && req.KeyName == v.KeyName) {
+			config = v
+			break
+		}
+	}
+	if nil == config {
+		return nil, errors.New(500, "ERROR", "配置不存在")
+	}
+
+	versions, err = uuc.registry.Versions(ctx, config.KeyName)
+	if nil != err {
+		return nil, err
+	}
+
+	now := time.Now()
+	current := configVersionAt(versions, now)
+	res := &v1.AdminConfigHistoryReply{
+		Id:       config.ID,
+		KeyName:  config.KeyName,
+		Name:     config.Name,
+		Value:    config.Value,
+		Versions: make([]*v1.AdminConfigHistoryReply_Version, 0),
+	}
+	if nil != current {
+		res.Value = current.Value
+	}
+
+	// 按生效时间倒序，每个版本与前一个版本比较
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		tmp := &v1.AdminConfigHistoryReply_Version{
+			Id:            v.ID,
+			Value:         v.Value,
+			AdminId:       v.AdminId,
+			EffectiveFrom: v.EffectiveFrom.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Note:          v.Note,
+			RollbackOf:    v.RollbackOf,
+			Current:       nil != current && current.ID == v.ID,
+			Pending:       v.EffectiveFrom.After(now),
+			CreatedAt:     v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		}
+		if 0 < i {
+			tmp.PreviousValue = versions[i-1].Value
+			tmp.Diff = configValueDiff(versions[i-1].Value, v.Value)
+		}
+		res.Versions = append(res.Versions, tmp)
+	}
+
+	return res, nil
+}
+
+// AdminConfigRollback 把配置恢复为某个版本的值，作为新版本立即生效
+func (uuc *UserUseCase) AdminConfigRollback(ctx context.Context, req *v1.AdminConfigRollbackRequest) (*v1.AdminConfigRollbackReply, error) {
+	var (
+		version *ConfigVersion
+		err     error
+	)
+
+	if nil == req.SendBody || 0 >= req.SendBody.VersionId {
+		return nil, errors.New(500, "ERROR", "参数错误")
+	}
+
+	version, err = uuc.registry.Version(ctx, req.SendBody.VersionId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == version {
+		return nil, errors.New(500, "ERROR", "版本不存在")
+	}
+
+	note := strings.TrimSpace(req.SendBody.Note)
+	if "" == note {
+		note = "回滚到版本 " + strconv.FormatInt(version.ID, 10)
+	}
+
+	version, err = uuc.registry.Update(ctx, uuc.tx, &ConfigChange{
+		ConfigId:   version.ConfigId,
+		Value:      version.Value,
+		Note:       note,
+		RollbackOf: version.ID,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminConfigRollbackReply{VersionId: version.ID}, nil
+}
@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 列表总数的计算方式
+const (
+	CountExact  = "exact"
+	CountApprox = "approx" // 无筛选时取表统计的估算值，有筛选时仍精确计数
+	CountNone   = "none"
+)
+
+// Cursor 游标分页的位置，最后一行的排序键和id。
+// List、Sort和Asc用来拒绝拿其他列表或其他排序的游标来翻页
+type Cursor struct {
+	List string `json:"l"`
+	Sort string `json:"s,omitempty"` // 排序的列，空为按id
+	Asc  bool   `json:"a,omitempty"`
+	Key  string `json:"k,omitempty"` // 最后一行排序列的值
+	Id   int64  `json:"i"`
+}
+
+// EncodeCursor 对调用方不透明的游标
+func EncodeCursor(cursor *Cursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor 空游标返回nil
+func DecodeCursor(list string, token string) (*Cursor, error) {
+	if "" == token {
+		return nil, nil
+	}
+
+	var cursor Cursor
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if nil == err {
+		err = json.Unmarshal(b, &cursor)
+	}
+	if nil != err || list != cursor.List || 0 >= cursor.Id {
+		return nil, errors.BadRequest("CURSOR_INVALID", "游标无效")
+	}
+
+	return &cursor, nil
+}
+
+// listPagination 按请求组装分页、筛选和排序，estimate为需要另外估算总数，
+// filtered为请求里筛选条件之外的筛选，例如地址
+func listPagination(list string, req listRequest, filtered bool) (b *Pagination, estimate bool, err error) {
+	b = &Pagination{
+		PageNum:  int(req.GetPage()),
+		PageSize: 10,
+	}
+	if b.Filter, err = parseListFilter(list, req.GetFilter()); nil != err {
+		return nil, false, err
+	}
+	if b.Sort, b.Asc, err = listSort(list, req.GetFilter()); nil != err {
+		return nil, false, err
+	}
+
+	if b.Cursor, err = DecodeCursor(list, req.GetCursor()); nil != err {
+		return nil, false, err
+	}
+	if nil != b.Cursor && (b.Sort != b.Cursor.Sort || b.Asc != b.Cursor.Asc || ("" != b.Sort && "" == b.Cursor.Key)) {
+		return nil, false, errors.BadRequest("CURSOR_INVALID", "游标和排序不一致")
+	}
+
+	filtered = filtered || b.Filter.filtered()
+	switch req.GetCountMode() {
+	case CountNone:
+		b.NoCount = true
+	case CountApprox:
+		b.NoCount = !filtered
+		estimate = !filtered
+	}
+
+	return b, estimate, nil
+}
+
+// nextCursor 本页满页时返回下一页游标，keys为最后一行各排序列的值
+func nextCursor(list string, b *Pagination, count int, lastId int64, keys map[string]string) string {
+	if count < b.PageSize || 0 >= lastId {
+		return ""
+	}
+
+	return EncodeCursor(&Cursor{List: list, Sort: b.Sort, Asc: b.Asc, Key: keys[b.Sort], Id: lastId})
+}
+
+// listCount estimate时用表统计的估算值代替总数
+func (uuc *UserUseCase) listCount(ctx context.Context, table string, estimate bool, count int64) (int64, bool) {
+	if !estimate {
+		return count, false
+	}
+
+	count, err := uuc.repo.EstimateCount(ctx, table)
+	if nil != err {
+		uuc.log.WithContext(ctx).Warnw("msg", "estimate count", "table", table, "err", err)
+	}
+
+	return count, true
+}
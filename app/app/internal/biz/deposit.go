@@ -0,0 +1,941 @@
+package biz
+
+import (
+	"context"
+	"dhb/app/app/internal/pkg/metrics"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 入金来源，每个来源配置自己的处理阶段
+const (
+	DepositSourceLocation = "location" // 链上入金，生成排位占位
+	DepositSourceManual   = "manual"   // 后台补单，与链上入金相同
+	DepositSourceBuy      = "buy"      // BuySomething合约购买，与链上入金相同并推进同步进度
+	DepositSourceVip      = "vip"      // 入金升级vip，生成location_new_2占位
+	DepositSourceCoin     = "coin"     // csd、dhb充值到余额
+	DepositSourceContract = "contract" // 旧入金合约，按金额档位累计
+	DepositSourceBalance  = "balance"  // 充值地址余额增加的部分累计到用户
+)
+
+// 入金用到的配置
+var depositConfigKeys = []string{
+	"area_one", "area_two", "area_three", "area_four", "area_five", "recommend_new_one", "recommend_new_two", "exchange_rate",
+	"buy_one", "buy_two", "buy_three", "buy_four", "buy_five", "buy_six", "b_price", "b_price_base", "time_again", "recommend_rate_2",
+}
+
+// DepositStage 入金处理的一个阶段。Check在事务外按顺序执行，返回depositSkip时本笔入金不处理；
+// 全部Check通过后，Apply在同一个事务内按顺序执行
+type DepositStage struct {
+	Name  string
+	Check func(ctx context.Context, run *depositRun, d *depositState) error
+	Apply func(ctx context.Context, run *depositRun, d *depositState) error
+}
+
+// DepositReport 一次入金处理的结果
+type DepositReport struct {
+	Source  string
+	Count   int64
+	Done    []*DepositResult
+	Skipped []*DepositResult // 不满足条件，下次同步仍会处理
+	Failed  []*DepositResult // 写入失败，事务已回滚
+}
+
+type DepositResult struct {
+	Hash       string
+	UserId     int64
+	Stage      string
+	Reason     string
+	RecordId   int64
+	LocationId int64
+}
+
+// depositRun 一次处理共用的配置
+type depositRun struct {
+	source   string
+	configs  map[string]string
+	products []*LocationProduct
+	seen     map[string]bool
+}
+
+func (run *depositRun) Int64(key string) int64 {
+	v, _ := strconv.ParseInt(run.configs[key], 10, 64)
+	return v
+}
+
+// depositState 一笔入金在各阶段之间传递的数据
+type depositState struct {
+	Record *EthUserRecord
+
+	// 校验
+	Product        *LocationProduct
+	CurrentMax     int64
+	MyLocations    []*LocationNew
+	MyLastLocation *LocationNew
+	LastLevel      int64
+
+	// 推荐人
+	RecommendUserIds  []string
+	RecommendUserId   int64
+	RecommendUserInfo *UserInfo
+
+	// 占位
+	Parent   *LocationNew
+	Location *LocationNew
+	RecordId int64
+
+	// 旧合约入金的档位
+	TierColumn string
+
+	// vip占位
+	LocationNum     int64
+	LocationCurrent int64
+	StopLocations   []*LocationNew
+	Vip             int64
+}
+
+func depositSkip(reason string) error {
+	return errors.New(500, "DEPOSIT_SKIP", reason)
+}
+
+func isDepositSkip(err error) bool {
+	return "DEPOSIT_SKIP" == errors.Reason(err)
+}
+
+// depositPipelines 各来源的处理阶段，新增来源在这里组合阶段即可
+func (ruc *RecordUseCase) depositPipelines() map[string][]*DepositStage {
+	location := []*DepositStage{
+		ruc.depositValidateStage(),
+		ruc.depositDedupeStage(false),
+		ruc.depositReferralStage(),
+		ruc.depositPlaceStage(),
+		ruc.depositRecordStage(false),
+		ruc.depositTeamVolumeStage(),
+	}
+
+	return map[string][]*DepositStage{
+		DepositSourceLocation: location,
+		DepositSourceManual:   location,
+		DepositSourceBuy:      append(location[:len(location):len(location)], ruc.depositCursorStage()),
+		DepositSourceVip: {
+			ruc.depositVipValidateStage(),
+			ruc.depositDedupeStage(true),
+			ruc.depositVipCreditStage(),
+			ruc.depositVipPlaceStage(),
+			ruc.depositVipReferralStage(),
+			ruc.depositRecordStage(true),
+		},
+		DepositSourceCoin: {
+			ruc.depositDedupeStage(false),
+			ruc.depositCoinCreditStage(),
+			ruc.depositRecordStage(false),
+		},
+		// 下面两个来源没有交易hash，由合约序号和充值地址余额保证不重复
+		DepositSourceContract: {
+			ruc.depositTierStage(),
+			ruc.depositRecordStage(false),
+		},
+		DepositSourceBalance: {
+			ruc.depositBalanceStage(),
+			ruc.depositRecordStage(false),
+		},
+	}
+}
+
+// RunDeposit 按来源的阶段逐笔处理入金，每笔一个事务
+func (ruc *RecordUseCase) RunDeposit(ctx context.Context, source string, records ...*EthUserRecord) (*DepositReport, error) {
+	var (
+		err error
+	)
+
+	stages, ok := ruc.depositPipelines()[source]
+	if !ok {
+		return nil, errors.New(500, "ERROR", "入金来源不存在")
+	}
+
+	run := &depositRun{source: source, seen: make(map[string]bool, 0)}
+	run.configs, err = ruc.registry.Values(ctx, depositConfigKeys...)
+	if nil != err {
+		ruc.log.WithContext(ctx).Errorw("msg", "入金,配置", "job", source, "err", err)
+		return nil, err
+	}
+
+	run.products, err = ruc.productRepo.GetLocationProducts(ctx)
+	if nil != err {
+		ruc.log.WithContext(ctx).Errorw("msg", "入金,占位产品", "job", source, "err", err)
+		return nil, err
+	}
+
+	res := &DepositReport{
+		Source:  source,
+		Done:    make([]*DepositResult, 0),
+		Skipped: make([]*DepositResult, 0),
+		Failed:  make([]*DepositResult, 0),
+	}
+	for _, v := range records {
+		res.Count++
+		d := &depositState{Record: v}
+		result := &DepositResult{Hash: v.Hash, UserId: v.UserId}
+
+		result.Stage, err = ruc.runDepositStages(ctx, run, stages, d)
+		if nil != err {
+			result.Reason = err.Error()
+			if isDepositSkip(err) {
+				result.Reason = errors.FromError(err).Message
+				res.Skipped = append(res.Skipped, result)
+				metrics.Deposits.With(source, v.CoinType, "skipped").Inc()
+			} else {
+				res.Failed = append(res.Failed, result)
+				metrics.Deposits.With(source, v.CoinType, "failed").Inc()
+			}
+
+			ruc.log.WithContext(ctx).Warnw("msg", "入金未处理", "job", source, "stage", result.Stage, "reason", result.Reason, "user_id", v.UserId, "tx_hash", v.Hash)
+			continue
+		}
+
+		result.RecordId = d.RecordId
+		if nil != d.Location {
+			result.LocationId = d.Location.ID
+		}
+		res.Done = append(res.Done, result)
+		metrics.Deposits.With(source, v.CoinType, "done").Inc()
+	}
+
+	return res, nil
+}
+
+// runDepositStages 返回出错的阶段
+func (ruc *RecordUseCase) runDepositStages(ctx context.Context, run *depositRun, stages []*DepositStage, d *depositState) (string, error) {
+	var (
+		stage string
+		err   error
+	)
+
+	for _, v := range stages {
+		if nil == v.Check {
+			continue
+		}
+
+		err = v.Check(ctx, run, d)
+		if nil != err {
+			return v.Name, err
+		}
+	}
+
+	if err = ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		for _, v := range stages {
+			if nil == v.Apply {
+				continue
+			}
+
+			stage = v.Name
+			err = v.Apply(ctx, run, d)
+			if nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}); nil != err {
+		return stage, err
+	}
+
+	return "", nil
+}
+
+// depositValidateStage 匹配占位产品、检查档位名额、运行中的占位和复投间隔，计算历史最高等级
+func (ruc *RecordUseCase) depositValidateStage() *DepositStage {
+	return &DepositStage{
+		Name: "validate",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				v            = d.Record
+				allLocations []*LocationNew
+				err          error
+			)
+
+			if 0 >= v.RelAmount {
+				return depositSkip("金额错误")
+			}
+
+			outRate := int64(locationProductDefaultOutRate)
+			d.Product = MatchLocationProduct(run.products, v.RelAmount)
+			if nil != d.Product {
+				outRate = d.Product.OutRate
+			}
+			d.CurrentMax = v.RelAmount * outRate / 10
+
+			allLocations, err = ruc.locationRepo.GetAllLocationsNew(ctx, d.CurrentMax) // 同倍率的
+			if nil == allLocations {                                                   // 查询异常跳过
+				return depositSkip(fmt.Sprintf("查询同档位占位失败 %v", err))
+			}
+
+			// 固定档位有名额限制，其他金额需要匹配到占位产品
+			quota := map[int64]string{
+				10000000:   "buy_six",
+				30000000:   "buy_one",
+				100000000:  "buy_two",
+				300000000:  "buy_three",
+				500000000:  "buy_four",
+				1000000000: "buy_five",
+			}
+			if key, ok := quota[v.RelAmount]; ok {
+				if int64(len(allLocations)) >= run.Int64(key) {
+					return depositSkip("档位名额已满")
+				}
+			} else if nil == d.Product {
+				return depositSkip("金额没有匹配的档位或产品")
+			}
+
+			// 获取当前用户的占位信息，已经有运行中的跳过
+			d.MyLocations, err = ruc.locationRepo.GetLocationsNewByUserId(ctx, v.UserId)
+			if nil == d.MyLocations || nil != err {
+				return depositSkip(fmt.Sprintf("查询占位失败 %v", err))
+			}
+
+			for _, vMyLocations := range d.MyLocations {
+				if "stop" != vMyLocations.Status {
+					return depositSkip("已有运行中的占位")
+				}
+
+				d.MyLastLocation = vMyLocations // 遍历到最后一个
+				if level := depositAreaLevel(run, vMyLocations); level > d.LastLevel {
+					d.LastLevel = level
+				}
+				if vMyLocations.LastLevel > d.LastLevel {
+					d.LastLevel = vMyLocations.LastLevel
+				}
+			}
+
+			// 复投间隔，按最近出局的占位计算
+			if 0 < len(d.MyLocations) && nil != d.Product && 0 < d.Product.TimeAgain {
+				now := time.Now().UTC().Add(8 * time.Hour)
+				if now.Before(d.MyLocations[0].StopDate.Add(time.Duration(d.Product.TimeAgain) * time.Minute)) {
+					return depositSkip("复投间隔未到")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// depositAreaLevel 小区业绩（除最大区外两个区之和）达到的等级
+func depositAreaLevel(run *depositRun, location *LocationNew) int64 {
+	big := location.Total
+	if location.TotalTwo > big {
+		big = location.TotalTwo
+	}
+	if location.TotalThree > big {
+		big = location.TotalThree
+	}
+	small := location.Total + location.TotalTwo + location.TotalThree - big
+
+	var res int64
+	for i, key := range []string{"area_one", "area_two", "area_three", "area_four", "area_five"} {
+		if run.Int64(key) <= small {
+			res = int64(i + 1)
+		}
+	}
+
+	return res
+}
+
+// depositDedupeStage 同一hash只处理一次，second为vip入金的记录表
+func (ruc *RecordUseCase) depositDedupeStage(second bool) *DepositStage {
+	return &DepositStage{
+		Name: "dedupe",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				exist map[string]*EthUserRecord
+				err   error
+			)
+
+			if run.seen[d.Record.Hash] {
+				return depositSkip("本批次hash重复")
+			}
+
+			if second {
+				exist, err = ruc.ethUserRecordRepo.GetEthUserRecordListByHash2(ctx, d.Record.Hash)
+			} else {
+				exist, err = ruc.ethUserRecordRepo.GetEthUserRecordListByHash(ctx, d.Record.Hash)
+			}
+			if nil != err {
+				return err
+			}
+			if _, ok := exist[d.Record.Hash]; ok {
+				return depositSkip("入金记录已存在")
+			}
+
+			run.seen[d.Record.Hash] = true
+			return nil
+		},
+	}
+}
+
+// depositRecommend 推荐关系，RecommendCode为 D上级id...D直推id
+func (ruc *RecordUseCase) depositRecommend(ctx context.Context, d *depositState) error {
+	userRecommend, err := ruc.userRecommendRepo.GetUserRecommendByUserId(ctx, d.Record.UserId)
+	if nil != err {
+		return err
+	}
+
+	if "" != userRecommend.RecommendCode {
+		d.RecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		if 2 <= len(d.RecommendUserIds) {
+			d.RecommendUserId, _ = strconv.ParseInt(d.RecommendUserIds[len(d.RecommendUserIds)-1], 10, 64) // 最后一位是直推人
+		}
+	}
+
+	return nil
+}
+
+// depositReferralStage 两代推荐人运行中的占位按入金额计入奖励，每人独立事务，失败不影响入金
+func (ruc *RecordUseCase) depositReferralStage() *DepositStage {
+	return &DepositStage{
+		Name: "referral",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			if err := ruc.depositRecommend(ctx, d); nil != err {
+				return depositSkip(fmt.Sprintf("查询推荐人失败 %v", err))
+			}
+
+			return nil
+		},
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				v      = d.Record
+				bPrice = run.Int64("b_price")
+			)
+
+			lastKey := len(d.RecommendUserIds) - 1 // 有直推len比>=2 ,key是0则是空格，1是直推，键位最后一个人
+			if 1 > lastKey || 0 >= bPrice {
+				return nil
+			}
+
+			for i := 0; i <= 1; i++ { // 两代
+				if lastKey-i <= 0 {
+					break
+				}
+
+				tmpUserId, _ := strconv.ParseInt(d.RecommendUserIds[lastKey-i], 10, 64)
+				if 0 >= tmpUserId {
+					break
+				}
+
+				locations, _ := ruc.locationRepo.GetLocationsNewByUserId(ctx, tmpUserId)
+				var location *LocationNew
+				for _, vLocations := range locations {
+					if "running" == vLocations.Status {
+						location = vLocations
+						break
+					}
+				}
+				if nil == location { // 无位
+					continue
+				}
+
+				tmpMinUsdt := location.Usdt
+				if v.RelAmount < tmpMinUsdt {
+					tmpMinUsdt = v.RelAmount
+				}
+
+				amount := tmpMinUsdt / 1000 * run.Int64("recommend_new_one")
+				if 1 == i {
+					amount = tmpMinUsdt / 1000 * run.Int64("recommend_new_two")
+				}
+				if 0 >= amount {
+					continue
+				}
+
+				status := location.Status
+				stopDate := time.Now().UTC().Add(8 * time.Hour)
+				if location.Current+amount >= location.CurrentMax { // 占位分红人分满停止
+					status = "stop"
+					amount = location.CurrentMax - location.Current
+				}
+				bAmount := amount * run.Int64("b_price_base") / bPrice
+				if 0 >= amount || 0 >= bAmount {
+					continue
+				}
+
+				var maxNew int64
+				if location.CurrentMaxNew < location.CurrentMax {
+					maxNew = location.CurrentMax - location.CurrentMaxNew
+				}
+
+				if err := ruc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+					err := ruc.locationRepo.UpdateLocationNewNew(ctx, location.ID, status, amount, maxNew, bAmount, stopDate) // 分红占位数据修改
+					if nil != err {
+						return err
+					}
+
+					err = ruc.events.Change(ctx, locationChangeEvent(LocationEventReward, location, status), LocationTableNew, location, status, location.Current+amount, location.CurrentMax, "deposit_recommend")
+					if nil != err {
+						return err
+					}
+
+					_, err = ruc.userBalanceRepo.RecommendLocationRewardBiw(ctx, tmpUserId, bAmount, int64(i+1), status, maxNew, run.Int64("exchange_rate")) // 推荐人奖励
+					if nil != err {
+						return err
+					}
+
+					// 业绩减掉
+					if "stop" == status {
+						return addLocationTreeTotal(ctx, ruc.treeRepo, ruc.locationRepo, location, -location.Usdt/100000)
+					}
+
+					return nil
+				}); nil != err {
+					ruc.log.WithContext(ctx).Errorw("msg", "deposit recommend", "user_id", tmpUserId, "err", err)
+					continue
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// depositPlacement 从root开始按层找第一个不满3个下级的占位，找不到时跳过
+func (ruc *RecordUseCase) depositPlacement(ctx context.Context, root *LocationNew) (*LocationNew, error) {
+	if 3 > root.Count {
+		return root, nil
+	}
+
+	tmpIds := []int64{root.ID}
+	for i := 0; i < len(tmpIds); i++ {
+		topLocations, err := ruc.locationRepo.GetLocationsByTop(ctx, tmpIds[i])
+		if nil != err {
+			return nil, err
+		}
+
+		// 没数据, 正常最少三个
+		if 0 >= len(topLocations) {
+			return nil, depositSkip("排位数据异常")
+		}
+
+		for _, vTopLocations := range topLocations {
+			if 3 > vTopLocations.Count {
+				return vTopLocations, nil
+			}
+			tmpIds = append(tmpIds, vTopLocations.ID)
+		}
+	}
+
+	return nil, depositSkip("没有可用的排位")
+}
+
+// depositPlaceStage 第一次入金挂在直推人运行中的占位下，复投或直推人无位时从第一个占位开始顺位
+func (ruc *RecordUseCase) depositPlaceStage() *DepositStage {
+	return &DepositStage{
+		Name: "place",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				root *LocationNew
+				err  error
+			)
+
+			if 0 < d.RecommendUserId && nil == d.MyLastLocation {
+				root, err = ruc.locationRepo.GetMyLocationLastRunning(ctx, d.RecommendUserId)
+				if nil != err {
+					return depositSkip(fmt.Sprintf("查询直推人占位失败 %v", err))
+				}
+			}
+
+			if nil == root {
+				root, err = ruc.locationRepo.GetLocationFirst(ctx)
+				if nil != err {
+					return depositSkip(fmt.Sprintf("查询第一个占位失败 %v", err))
+				}
+			}
+
+			if nil == root { // 第一个占位
+				return nil
+			}
+
+			d.Parent, err = ruc.depositPlacement(ctx, root)
+			if nil != err && !isDepositSkip(err) {
+				return depositSkip(fmt.Sprintf("查询排位失败 %v", err))
+			}
+
+			return err
+		},
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				v       = d.Record
+				tmpTop  int64
+				tmpNum  int64
+				tmpPath = "/"
+				err     error
+			)
+
+			// 顺位
+			if nil != d.Parent {
+				err = ruc.locationRepo.UpdateLocationNewCount(ctx, d.Parent.ID, d.Parent.Count+1, v.RelAmount/100000)
+				if nil != err {
+					return err
+				}
+				tmpTop = d.Parent.ID
+				tmpNum = d.Parent.Count + 1
+
+				var parentPath string
+				parentPath, err = ruc.treeRepo.GetLocationTreePath(ctx, d.Parent.ID)
+				if nil != err {
+					return err
+				}
+				tmpPath = LocationTreeChildPath(parentPath, tmpTop, tmpNum)
+
+				// 大小区业绩
+				err = addLocationTreeTotalByPath(ctx, ruc.treeRepo, ruc.locationRepo, d.Parent, parentPath, v.RelAmount/100000)
+				if nil != err {
+					return err
+				}
+			}
+
+			var productId int64
+			if nil != d.Product {
+				productId = d.Product.ID
+			}
+
+			d.Location, err = ruc.locationRepo.CreateLocationNew(ctx, &LocationNew{ // 占位
+				UserId:     v.UserId,
+				Status:     "running",
+				Current:    0,
+				CurrentMax: d.CurrentMax,
+				Num:        1,
+				Top:        tmpTop,
+				TopNum:     tmpNum,
+				LastLevel:  d.LastLevel,
+				Path:       tmpPath,
+				ProductId:  productId,
+			}, v.RelAmount)
+			if nil != err {
+				return err
+			}
+
+			return ruc.events.Emit(ctx, &LocationEvent{
+				LocationTable:   LocationTableNew,
+				LocationId:      d.Location.ID,
+				UserId:          v.UserId,
+				Event:           LocationEventCreate,
+				Source:          "deposit_" + ethUserRecordSource(v),
+				AdminId:         v.AdminId,
+				StatusAfter:     "running",
+				CurrentMaxAfter: d.CurrentMax,
+				Amount:          v.RelAmount,
+				Note:            v.Hash,
+			})
+		},
+	}
+}
+
+// depositRecordStage 写入入金记录，second为vip入金的记录表
+func (ruc *RecordUseCase) depositRecordStage(second bool) *DepositStage {
+	return &DepositStage{
+		Name: "record",
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				v      = d.Record
+				record *EthUserRecord
+				err    error
+			)
+
+			tmp := &EthUserRecord{
+				Hash:        v.Hash,
+				UserId:      v.UserId,
+				Status:      v.Status,
+				Type:        v.Type,
+				Amount:      v.Amount,
+				AmountTwo:   v.AmountTwo,
+				CoinType:    v.CoinType,
+				Last:        v.Last,
+				Source:      v.Source,
+				AdminId:     v.AdminId,
+				Note:        v.Note,
+				ExternalRef: v.ExternalRef,
+			}
+			if second {
+				record, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash2(ctx, tmp)
+			} else {
+				record, err = ruc.ethUserRecordRepo.CreateEthUserRecordListByHash(ctx, tmp)
+			}
+			if nil != err {
+				return err
+			}
+
+			d.RecordId = record.ID
+			return nil
+		},
+	}
+}
+
+// depositTeamVolumeStage 直推人团队业绩
+func (ruc *RecordUseCase) depositTeamVolumeStage() *DepositStage {
+	return &DepositStage{
+		Name: "team_volume",
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			if 0 >= d.RecommendUserId {
+				return nil
+			}
+
+			return ruc.userRecommendRepo.UpdateUserRecommendTotal(ctx, d.RecommendUserId, d.Record.RelAmount/100000)
+		},
+	}
+}
+
+// depositVipValidateStage 已有运行中的vip占位跳过，按金额确定vip等级
+func (ruc *RecordUseCase) depositVipValidateStage() *DepositStage {
+	return &DepositStage{
+		Name: "validate",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				v   = d.Record
+				err error
+			)
+
+			d.MyLocations, err = ruc.locationRepo.GetLocationsNew2ByUserId(ctx, v.UserId)
+			if nil == d.MyLocations { // 查询异常跳过
+				return depositSkip(fmt.Sprintf("查询vip占位失败 %v", err))
+			}
+
+			for _, vMyLocations := range d.MyLocations {
+				d.LocationNum = vMyLocations.Num
+				if "running" == vMyLocations.Status {
+					return depositSkip("已有运行中的vip占位")
+				}
+			}
+
+			d.CurrentMax = v.RelAmount * locationProductDefaultOutRate / 10
+			d.Vip = 1
+			if 30000000 == v.RelAmount {
+				d.Vip = 2
+			} else if 50000000 == v.RelAmount {
+				d.Vip = 3
+			}
+
+			return nil
+		},
+	}
+}
+
+// depositVipCreditStage 复投间隔内出局的vip占位，超出的额度补到新占位，清算冻结
+func (ruc *RecordUseCase) depositVipCreditStage() *DepositStage {
+	return &DepositStage{
+		Name: "credit",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			d.StopLocations, _ = ruc.locationRepo.GetMyStopLocations2Last(ctx, d.Record.UserId)
+
+			now := time.Now().UTC().Add(8 * time.Hour)
+			for _, vStopLocations := range d.StopLocations {
+				if now.Before(vStopLocations.StopDate.Add(time.Duration(run.Int64("time_again")) * time.Minute)) {
+					d.LocationCurrent += vStopLocations.Current - vStopLocations.CurrentMax // 补上
+				}
+			}
+
+			return nil
+		},
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			if nil == d.StopLocations {
+				return nil
+			}
+
+			err := ruc.userBalanceRepo.UpdateLocationAgain2(ctx, d.StopLocations)
+			if nil != err {
+				return err
+			}
+
+			if 0 < d.LocationCurrent {
+				tmpCurrentAmount := d.LocationCurrent
+				if tmpCurrentAmount > d.CurrentMax {
+					tmpCurrentAmount = d.CurrentMax
+				}
+
+				_, err = ruc.userBalanceRepo.DepositLastNew2(ctx, d.Record.UserId, tmpCurrentAmount) // 充值
+				if nil != err {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// depositVipPlaceStage 创建vip占位，补上的额度达到出局额度时直接出局
+func (ruc *RecordUseCase) depositVipPlaceStage() *DepositStage {
+	return &DepositStage{
+		Name: "place",
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			var (
+				v        = d.Record
+				status   = "running"
+				stopDate time.Time
+				err      error
+			)
+
+			if d.LocationCurrent >= d.CurrentMax {
+				status = "stop"
+				stopDate = time.Now().UTC().Add(8 * time.Hour)
+			}
+
+			d.Location, err = ruc.locationRepo.CreateLocation2New(ctx, &LocationNew{ // 占位
+				UserId:     v.UserId,
+				Status:     status,
+				Current:    d.LocationCurrent,
+				CurrentMax: d.CurrentMax,
+				StopDate:   stopDate,
+				Num:        d.LocationNum,
+			}, v.RelAmount)
+			if nil != err {
+				return err
+			}
+
+			err = ruc.events.Emit(ctx, &LocationEvent{
+				LocationTable:   LocationTableNew2,
+				LocationId:      d.Location.ID,
+				UserId:          v.UserId,
+				Event:           LocationEventCreate,
+				Source:          "deposit_" + ethUserRecordSource(v),
+				AdminId:         v.AdminId,
+				StatusAfter:     status,
+				CurrentAfter:    d.LocationCurrent,
+				CurrentMaxAfter: d.CurrentMax,
+				Amount:          v.RelAmount,
+				Note:            v.Hash,
+			})
+			if nil != err {
+				return err
+			}
+
+			_, err = ruc.userInfoRepo.UpdateUserInfoVip(ctx, v.UserId, d.Vip)
+			return err
+		},
+	}
+}
+
+// depositVipReferralStage 直推人有运行中的vip占位时按入金额奖励
+func (ruc *RecordUseCase) depositVipReferralStage() *DepositStage {
+	return &DepositStage{
+		Name: "referral",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			if err := ruc.depositRecommend(ctx, d); nil != err {
+				return depositSkip(fmt.Sprintf("查询推荐人失败 %v", err))
+			}
+
+			if 0 < d.RecommendUserId {
+				d.RecommendUserInfo, _ = ruc.userInfoRepo.GetUserInfoByUserId(ctx, d.RecommendUserId)
+			}
+
+			return nil
+		},
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			if nil == d.RecommendUserInfo {
+				return nil
+			}
+
+			locations, _ := ruc.locationRepo.GetLocationsNew2ByUserId(ctx, d.RecommendUserInfo.UserId)
+			var running bool
+			for _, vLocations := range locations {
+				if "running" == vLocations.Status {
+					running = true
+					break
+				}
+			}
+
+			amount := d.Record.RelAmount * run.Int64("recommend_rate_2") / 100
+			if !running || 0 >= amount {
+				return nil
+			}
+
+			_, err := ruc.userBalanceRepo.NormalRecommendReward2(ctx, d.RecommendUserId, amount, d.Location.ID, "recommend_token", "recommend") // 直推人奖励
+			return err
+		},
+	}
+}
+
+// depositCoinCreditStage csd入金同时给推荐链上的人记业绩，其他币直接充值
+func (ruc *RecordUseCase) depositCoinCreditStage() *DepositStage {
+	return &DepositStage{
+		Name: "credit",
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			v := d.Record
+			if "CSD" != v.CoinType {
+				return ruc.userBalanceRepo.DepositLastNewDhb(ctx, v.UserId, v.RelAmount) // 充值
+			}
+
+			var recommendUserIds []int64
+			if err := ruc.depositRecommend(ctx, d); nil == err {
+				lastKey := len(d.RecommendUserIds) - 1
+				for i := 0; i < lastKey; i++ {
+					tmpUserId, _ := strconv.ParseInt(d.RecommendUserIds[lastKey-i], 10, 64) // 从直推人开始
+					recommendUserIds = append(recommendUserIds, tmpUserId)
+				}
+			}
+
+			return ruc.userBalanceRepo.DepositLastNewCsd(ctx, v.UserId, v.RelAmount, recommendUserIds) // 充值
+		},
+	}
+}
+
+// depositTierStage 旧合约入金按金额取档位，超过最高档按最高档计，不足最低档跳过
+func (ruc *RecordUseCase) depositTierStage() *DepositStage {
+	return &DepositStage{
+		Name: "tier",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			v := d.Record
+			if 0 >= v.RelAmount {
+				return depositSkip("金额错误")
+			}
+
+			tiers := []struct {
+				amount uint64
+				column string
+			}{
+				{30000, "total_f"},
+				{15000, "total_d"},
+				{5000, "total_c"},
+				{3000, "total_b"},
+				{1000, "total_a"},
+			}
+			for _, tier := range tiers {
+				if tier.amount <= uint64(v.RelAmount) {
+					v.AmountTwo = tier.amount
+					d.TierColumn = tier.column
+					return nil
+				}
+			}
+
+			return depositSkip("金额不足最低档位")
+		},
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			v := d.Record
+			err := ruc.userInfoRepo.UpdateUserNewTwo(ctx, v.UserId, v.AmountTwo, v.OriginTotal, d.TierColumn, int64(v.AmountTwo), int64(v.AmountTwo)/2)
+			if nil != err {
+				return err
+			}
+
+			return ruc.userBalanceRepo.InRecordNew(ctx, v.UserId, v.Address, int64(v.AmountTwo), int64(v.OriginTotal)) // 充值记录
+		},
+	}
+}
+
+// depositBalanceStage 充值地址余额增加的部分累计到用户入金，同时记下当前余额
+func (ruc *RecordUseCase) depositBalanceStage() *DepositStage {
+	return &DepositStage{
+		Name: "credit",
+		Check: func(ctx context.Context, run *depositRun, d *depositState) error {
+			if 0 >= d.Record.RelAmount {
+				return depositSkip("金额错误")
+			}
+
+			d.Record.AmountTwo = uint64(d.Record.RelAmount)
+			return nil
+		},
+		Apply: func(ctx context.Context, run *depositRun, d *depositState) error {
+			return ruc.userInfoRepo.UpdateUserNewTwoNewTwo(ctx, d.Record.UserId, d.Record.AmountTwo, int64(d.Record.Balance))
+		},
+	}
+}
@@ -0,0 +1,608 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeDepositDB 入金用到的表，事务出错时整体回滚
+type fakeDepositDB struct {
+	fail       string // 调用到这个方法时返回错误
+	records    []EthUserRecord
+	locations  []LocationNew
+	recommends map[int64]string // 推荐关系
+	teamTotals map[int64]int64  // 团队业绩
+	areaTotals map[int64]int64  // 大小区业绩
+	rewards    map[int64]int64  // 推荐人奖励
+	userTotals map[int64]uint64 // 用户入金累计
+	userLast   map[int64]int64  // 充值地址余额
+	inRecords  []int64
+	events     int
+}
+
+func (db *fakeDepositDB) call(method string) error {
+	if method == db.fail {
+		return errors.New(500, "ERROR", "fake "+method)
+	}
+
+	return nil
+}
+
+func (db *fakeDepositDB) clone() *fakeDepositDB {
+	res := *db
+	res.records = append([]EthUserRecord(nil), db.records...)
+	res.locations = append([]LocationNew(nil), db.locations...)
+	res.inRecords = append([]int64(nil), db.inRecords...)
+	res.teamTotals = make(map[int64]int64, 0)
+	for k, v := range db.teamTotals {
+		res.teamTotals[k] = v
+	}
+	res.areaTotals = make(map[int64]int64, 0)
+	for k, v := range db.areaTotals {
+		res.areaTotals[k] = v
+	}
+	res.rewards = make(map[int64]int64, 0)
+	for k, v := range db.rewards {
+		res.rewards[k] = v
+	}
+	res.userTotals = make(map[int64]uint64, 0)
+	for k, v := range db.userTotals {
+		res.userTotals[k] = v
+	}
+	res.userLast = make(map[int64]int64, 0)
+	for k, v := range db.userLast {
+		res.userLast[k] = v
+	}
+
+	return &res
+}
+
+type fakeDepositTx struct {
+	db *fakeDepositDB
+}
+
+func (tx *fakeDepositTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	snapshot := tx.db.clone()
+	if err := fn(ctx); nil != err {
+		*tx.db = *snapshot
+		return err
+	}
+
+	return nil
+}
+
+func (tx *fakeDepositTx) ExecTxRetry(ctx context.Context, fn func(ctx context.Context) error) error {
+	return tx.ExecTx(ctx, fn)
+}
+
+type fakeEthUserRecordRepo struct {
+	EthUserRecordRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeEthUserRecordRepo) GetEthUserRecordListByHash(ctx context.Context, hash ...string) (map[string]*EthUserRecord, error) {
+	res := make(map[string]*EthUserRecord, 0)
+	for _, h := range hash {
+		for i := range r.db.records {
+			if h == r.db.records[i].Hash {
+				v := r.db.records[i]
+				res[h] = &v
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeEthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, rel *EthUserRecord) (*EthUserRecord, error) {
+	if err := r.db.call("CreateEthUserRecordListByHash"); nil != err {
+		return nil, err
+	}
+
+	v := *rel
+	v.ID = int64(len(r.db.records) + 1)
+	r.db.records = append(r.db.records, v)
+	return &v, nil
+}
+
+type fakeLocationRepo struct {
+	LocationRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeLocationRepo) find(match func(v *LocationNew) bool) []*LocationNew {
+	res := make([]*LocationNew, 0)
+	for i := range r.db.locations {
+		if match(&r.db.locations[i]) {
+			v := r.db.locations[i]
+			res = append(res, &v)
+		}
+	}
+
+	return res
+}
+
+func (r *fakeLocationRepo) GetAllLocationsNew(ctx context.Context, currentMax int64) ([]*LocationNew, error) {
+	return r.find(func(v *LocationNew) bool { return currentMax == v.CurrentMax }), nil
+}
+
+func (r *fakeLocationRepo) GetLocationsNewByUserId(ctx context.Context, userId int64) ([]*LocationNew, error) {
+	return r.find(func(v *LocationNew) bool { return userId == v.UserId }), nil
+}
+
+func (r *fakeLocationRepo) GetLocationsByTop(ctx context.Context, top int64) ([]*LocationNew, error) {
+	return r.find(func(v *LocationNew) bool { return top == v.Top }), nil
+}
+
+func (r *fakeLocationRepo) GetMyLocationLastRunning(ctx context.Context, userId int64) (*LocationNew, error) {
+	var res *LocationNew
+	for _, v := range r.find(func(v *LocationNew) bool { return userId == v.UserId && "running" == v.Status }) {
+		res = v
+	}
+
+	return res, nil
+}
+
+func (r *fakeLocationRepo) GetLocationFirst(ctx context.Context) (*LocationNew, error) {
+	if 0 >= len(r.db.locations) {
+		return nil, nil
+	}
+
+	v := r.db.locations[0]
+	return &v, nil
+}
+
+func (r *fakeLocationRepo) UpdateLocationNewCount(ctx context.Context, id int64, count int64, total int64) error {
+	if err := r.db.call("UpdateLocationNewCount"); nil != err {
+		return err
+	}
+
+	r.db.locations[id-1].Count = count
+	return nil
+}
+
+func (r *fakeLocationRepo) UpdateLocationNewNew(ctx context.Context, id int64, status string, current int64, amountB int64, biw int64, stopDate time.Time) error {
+	r.db.locations[id-1].Status = status
+	r.db.locations[id-1].Current += current
+	return nil
+}
+
+func (r *fakeLocationRepo) CreateLocationNew(ctx context.Context, rel *LocationNew, amount int64) (*LocationNew, error) {
+	if err := r.db.call("CreateLocationNew"); nil != err {
+		return nil, err
+	}
+
+	v := *rel
+	v.ID = int64(len(r.db.locations) + 1)
+	v.Usdt = amount
+	r.db.locations = append(r.db.locations, v)
+	return &v, nil
+}
+
+type fakeUserRecommendRepo struct {
+	UserRecommendRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeUserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error) {
+	return &UserRecommend{UserId: userId, RecommendCode: r.db.recommends[userId]}, nil
+}
+
+func (r *fakeUserRecommendRepo) UpdateUserRecommendTotal(ctx context.Context, userId int64, total int64) error {
+	if err := r.db.call("UpdateUserRecommendTotal"); nil != err {
+		return err
+	}
+
+	r.db.teamTotals[userId] += total
+	return nil
+}
+
+type fakeUserBalanceRepo struct {
+	UserBalanceRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeUserBalanceRepo) RecommendLocationRewardBiw(ctx context.Context, userId int64, rewardAmount int64, recommendNum int64, stop string, tmpMaxNew int64, feeRate int64) (int64, error) {
+	if err := r.db.call("RecommendLocationRewardBiw"); nil != err {
+		return 0, err
+	}
+
+	r.db.rewards[userId] += rewardAmount
+	return 0, nil
+}
+
+func (r *fakeUserBalanceRepo) InRecordNew(ctx context.Context, userId int64, address string, amount int64, originTotal int64) error {
+	if err := r.db.call("InRecordNew"); nil != err {
+		return err
+	}
+
+	r.db.inRecords = append(r.db.inRecords, amount)
+	return nil
+}
+
+type fakeUserInfoRepo struct {
+	UserInfoRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeUserInfoRepo) UpdateUserNewTwo(ctx context.Context, userId int64, amount uint64, originTotal uint64, strUpdate string, uudt int64, kkdt int64) error {
+	r.db.userTotals[userId] += amount
+	return nil
+}
+
+func (r *fakeUserInfoRepo) UpdateUserNewTwoNewTwo(ctx context.Context, userId int64, amount uint64, last int64) error {
+	r.db.userTotals[userId] += amount
+	r.db.userLast[userId] = last
+	return nil
+}
+
+type fakeLocationTreeRepo struct {
+	LocationTreeRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeLocationTreeRepo) GetLocationTreePath(ctx context.Context, id int64) (string, error) {
+	return r.db.locations[id-1].Path, nil
+}
+
+func (r *fakeLocationTreeRepo) AddLocationTreeTotal(ctx context.Context, edges []*LocationTreeEdge, total int64) error {
+	for _, v := range edges {
+		r.db.areaTotals[v.ID] += total
+	}
+
+	return nil
+}
+
+type fakeLocationProductRepo struct {
+	LocationProductRepo
+}
+
+func (r *fakeLocationProductRepo) GetLocationProducts(ctx context.Context) ([]*LocationProduct, error) {
+	return nil, nil
+}
+
+type fakeLocationEventRepo struct {
+	LocationEventRepo
+	db *fakeDepositDB
+}
+
+func (r *fakeLocationEventRepo) CreateLocationEvents(ctx context.Context, events ...*LocationEvent) error {
+	r.db.events += len(events)
+	return nil
+}
+
+type fakeConfigRepo struct {
+	ConfigRepo
+	configs map[string]string
+}
+
+func (r *fakeConfigRepo) GetConfigs(ctx context.Context) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for k, v := range r.configs {
+		res = append(res, &Config{ID: int64(len(res) + 1), KeyName: k, Value: v})
+	}
+
+	return res, nil
+}
+
+type fakeConfigVersionRepo struct {
+	ConfigVersionRepo
+}
+
+func (r *fakeConfigVersionRepo) GetConfigVersions(ctx context.Context, keys ...string) ([]*ConfigVersion, error) {
+	return nil, nil
+}
+
+type fakeConfigNotifier struct {
+	ConfigNotifier
+}
+
+func (n *fakeConfigNotifier) SubscribeConfigChange(ctx context.Context, fn func(key string)) {}
+
+// newFakeDeposit 用户1、2各有一个运行中的占位，2挂在1下面；用户3由2直推，2由1直推
+func newFakeDeposit() (*RecordUseCase, *fakeDepositDB) {
+	db := &fakeDepositDB{
+		locations: []LocationNew{
+			{ID: 1, UserId: 1, Status: "running", Usdt: 30000000, CurrentMax: 75000000, Count: 1, Path: "/"},
+			{ID: 2, UserId: 2, Status: "running", Usdt: 30000000, CurrentMax: 75000000, Top: 1, TopNum: 1, Path: "/1:1/"},
+		},
+		recommends: map[int64]string{2: "D1", 3: "D1D2"},
+		teamTotals: make(map[int64]int64, 0),
+		areaTotals: make(map[int64]int64, 0),
+		rewards:    make(map[int64]int64, 0),
+		userTotals: make(map[int64]uint64, 0),
+		userLast:   make(map[int64]int64, 0),
+	}
+
+	configRepo := &fakeConfigRepo{configs: map[string]string{
+		"buy_one":           "10",
+		"recommend_new_one": "100",
+		"recommend_new_two": "50",
+		"b_price":           "1000",
+		"b_price_base":      "1000",
+		"area_one":          "1000000",
+	}}
+	registry := NewConfigRegistry(configRepo, &fakeConfigVersionRepo{}, &fakeConfigNotifier{})
+
+	ruc := NewRecordUseCase(
+		&fakeEthUserRecordRepo{db: db},
+		&fakeLocationRepo{db: db},
+		&fakeUserBalanceRepo{db: db},
+		&fakeUserRecommendRepo{db: db},
+		&fakeUserInfoRepo{db: db},
+		configRepo,
+		nil,
+		&fakeLocationTreeRepo{db: db},
+		&fakeLocationProductRepo{},
+		&fakeLocationEventRepo{db: db},
+		nil,
+		nil,
+		nil,
+		registry,
+		&fakeDepositTx{db: db},
+		log.DefaultLogger,
+	)
+
+	return ruc, db
+}
+
+func depositReportStage(res *DepositReport) string {
+	for _, v := range append(res.Skipped, res.Failed...) {
+		return v.Stage
+	}
+
+	return ""
+}
+
+// 入金前的数据没有变化
+func checkDepositUntouched(t *testing.T, db *fakeDepositDB) {
+	if 0 != len(db.records) || 2 != len(db.locations) || 0 != db.locations[1].Count || 0 != db.locations[1].Current {
+		t.Fatalf("records = %d, locations = %+v", len(db.records), db.locations)
+	}
+	if 0 != len(db.teamTotals) || 0 != len(db.areaTotals) || 0 != len(db.rewards) || 0 != db.events {
+		t.Fatalf("team = %v, area = %v, rewards = %v, events = %d", db.teamTotals, db.areaTotals, db.rewards, db.events)
+	}
+}
+
+func TestRunDepositLocation(t *testing.T) {
+	newRecord := func(hash string, amount int64) *EthUserRecord {
+		return &EthUserRecord{Hash: hash, UserId: 3, Status: "success", Type: "deposit", Amount: "300", RelAmount: amount, CoinType: "USDT"}
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(db *fakeDepositDB)
+		records []*EthUserRecord
+		done    int
+		skipped int
+		failed  int
+		stage   string
+		check   func(t *testing.T, db *fakeDepositDB)
+	}{
+		{
+			name:    "入金",
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			done:    1,
+			check: func(t *testing.T, db *fakeDepositDB) {
+				if 1 != len(db.records) || 3 != len(db.locations) {
+					t.Fatalf("records = %d, locations = %d", len(db.records), len(db.locations))
+				}
+				if location := db.locations[2]; 3 != location.UserId || 2 != location.Top || 1 != location.TopNum || 75000000 != location.CurrentMax {
+					t.Fatalf("location = %+v", location)
+				}
+				if 1 != db.locations[1].Count || "/1:1/2:1/" != db.locations[2].Path {
+					t.Fatalf("parent count = %d, path = %q", db.locations[1].Count, db.locations[2].Path)
+				}
+				if 300 != db.areaTotals[1] || 1 != len(db.areaTotals) {
+					t.Fatalf("area = %v", db.areaTotals)
+				}
+				if 300 != db.teamTotals[2] {
+					t.Fatalf("team = %v", db.teamTotals)
+				}
+				if 3 != db.events {
+					t.Fatalf("events = %d", db.events)
+				}
+			},
+		},
+		{
+			name:    "推荐人奖励两代",
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			done:    1,
+			check: func(t *testing.T, db *fakeDepositDB) {
+				if 3000000 != db.rewards[2] || 1500000 != db.rewards[1] {
+					t.Fatalf("rewards = %v", db.rewards)
+				}
+				if 3000000 != db.locations[1].Current || 1500000 != db.locations[0].Current {
+					t.Fatalf("current = %d, %d", db.locations[1].Current, db.locations[0].Current)
+				}
+			},
+		},
+		{
+			name:    "推荐人奖励失败只回滚奖励",
+			setup:   func(db *fakeDepositDB) { db.fail = "RecommendLocationRewardBiw" },
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			done:    1,
+			check: func(t *testing.T, db *fakeDepositDB) {
+				if 0 != len(db.rewards) || 0 != db.locations[0].Current || 0 != db.locations[1].Current {
+					t.Fatalf("rewards = %v, locations = %+v", db.rewards, db.locations)
+				}
+				if 1 != len(db.records) || 3 != len(db.locations) || 1 != db.events {
+					t.Fatalf("records = %d, locations = %d, events = %d", len(db.records), len(db.locations), db.events)
+				}
+			},
+		},
+		{
+			name: "本批次hash重复",
+			records: []*EthUserRecord{
+				newRecord("0xa", 30000000),
+				{Hash: "0xa", UserId: 4, Status: "success", Type: "deposit", Amount: "300", RelAmount: 30000000, CoinType: "USDT"}, // 其他用户同一hash
+			},
+			done:    1,
+			skipped: 1,
+			stage:   "dedupe",
+		},
+		{
+			name:    "入金记录已存在",
+			setup:   func(db *fakeDepositDB) { db.records = append(db.records, EthUserRecord{ID: 1, Hash: "0xa"}) },
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			skipped: 1,
+			stage:   "dedupe",
+			check: func(t *testing.T, db *fakeDepositDB) {
+				if 1 != len(db.records) || 2 != len(db.locations) {
+					t.Fatalf("records = %d, locations = %d", len(db.records), len(db.locations))
+				}
+			},
+		},
+		{
+			name: "已有运行中的占位",
+			setup: func(db *fakeDepositDB) {
+				db.locations = append(db.locations, LocationNew{ID: 3, UserId: 3, Status: "running", CurrentMax: 75000000})
+			},
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			skipped: 1,
+			stage:   "validate",
+		},
+		{
+			name:    "金额没有匹配的档位",
+			records: []*EthUserRecord{newRecord("0xa", 12345)},
+			skipped: 1,
+			stage:   "validate",
+			check:   checkDepositUntouched,
+		},
+		{
+			name:    "排位失败回滚",
+			setup:   func(db *fakeDepositDB) { db.fail = "UpdateLocationNewCount" },
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			failed:  1,
+			stage:   "place",
+			check:   checkDepositUntouched,
+		},
+		{
+			name:    "占位失败回滚",
+			setup:   func(db *fakeDepositDB) { db.fail = "CreateLocationNew" },
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			failed:  1,
+			stage:   "place",
+			check:   checkDepositUntouched,
+		},
+		{
+			name:    "记录失败回滚",
+			setup:   func(db *fakeDepositDB) { db.fail = "CreateEthUserRecordListByHash" },
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			failed:  1,
+			stage:   "record",
+			check:   checkDepositUntouched,
+		},
+		{
+			name:    "团队业绩失败回滚",
+			setup:   func(db *fakeDepositDB) { db.fail = "UpdateUserRecommendTotal" },
+			records: []*EthUserRecord{newRecord("0xa", 30000000)},
+			failed:  1,
+			stage:   "team_volume",
+			check:   checkDepositUntouched,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruc, db := newFakeDeposit()
+			if nil != tt.setup {
+				tt.setup(db)
+			}
+
+			res, err := ruc.RunDeposit(context.Background(), DepositSourceLocation, tt.records...)
+			if nil != err {
+				t.Fatal(err)
+			}
+			if tt.done != len(res.Done) || tt.skipped != len(res.Skipped) || tt.failed != len(res.Failed) {
+				t.Fatalf("done = %d, skipped = %d, failed = %d", len(res.Done), len(res.Skipped), len(res.Failed))
+			}
+			if stage := depositReportStage(res); tt.stage != stage {
+				t.Fatalf("stage = %q, want %q", stage, tt.stage)
+			}
+			if nil != tt.check {
+				tt.check(t, db)
+			}
+		})
+	}
+}
+
+func TestRunDepositContract(t *testing.T) {
+	tests := []struct {
+		name      string
+		fail      string
+		amount    int64
+		stage     string
+		amountTwo uint64
+	}{
+		{name: "超过最高档按最高档", amount: 50000, amountTwo: 30000},
+		{name: "按档位取整", amount: 20000, amountTwo: 15000},
+		{name: "不足最低档", amount: 500, stage: "tier"},
+		{name: "充值记录失败回滚", fail: "InRecordNew", amount: 20000, stage: "tier"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruc, db := newFakeDeposit()
+			db.fail = tt.fail
+
+			res, err := ruc.RunDeposit(context.Background(), DepositSourceContract, &EthUserRecord{
+				UserId:      3,
+				Status:      "success",
+				Type:        "deposit",
+				RelAmount:   tt.amount,
+				CoinType:    "USDT",
+				OriginTotal: 100,
+			})
+			if nil != err {
+				t.Fatal(err)
+			}
+			if stage := depositReportStage(res); tt.stage != stage {
+				t.Fatalf("stage = %q, want %q", stage, tt.stage)
+			}
+
+			if 0 == tt.amountTwo {
+				if 0 != len(db.records) || 0 != len(db.userTotals) || 0 != len(db.inRecords) {
+					t.Fatalf("records = %d, totals = %v, in = %v", len(db.records), db.userTotals, db.inRecords)
+				}
+				return
+			}
+
+			if 1 != len(db.records) || tt.amountTwo != db.records[0].AmountTwo {
+				t.Fatalf("records = %+v", db.records)
+			}
+			if tt.amountTwo != db.userTotals[3] || 1 != len(db.inRecords) || int64(tt.amountTwo) != db.inRecords[0] {
+				t.Fatalf("totals = %v, in = %v", db.userTotals, db.inRecords)
+			}
+		})
+	}
+}
+
+func TestRunDepositBalance(t *testing.T) {
+	ruc, db := newFakeDeposit()
+
+	res, err := ruc.RunDeposit(context.Background(), DepositSourceBalance, &EthUserRecord{
+		UserId:    3,
+		Status:    "success",
+		Type:      "deposit",
+		RelAmount: 800,
+		CoinType:  "USDT",
+		Balance:   1800,
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 1 != len(res.Done) {
+		t.Fatalf("report = %+v", res)
+	}
+	if 800 != db.userTotals[3] || 1800 != db.userLast[3] {
+		t.Fatalf("totals = %v, last = %v", db.userTotals, db.userLast)
+	}
+	if 1 != len(db.records) || 800 != db.records[0].AmountTwo {
+		t.Fatalf("records = %+v", db.records)
+	}
+}
@@ -0,0 +1,580 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/export"
+	"dhb/app/app/internal/pkg/middleware/requestid"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// 可导出的列表
+const (
+	ExportKindUsers     = "users"
+	ExportKindRewards   = "rewards"
+	ExportKindWithdraws = "withdraws"
+	ExportKindRecords   = "records"
+	ExportKindLocations = "locations"
+	ExportKindTrades    = "trades"
+)
+
+// 导出任务状态
+const (
+	ExportJobPending = "pending"
+	ExportJobRunning = "running"
+	ExportJobDone    = "done"
+	ExportJobFailed  = "failed"
+)
+
+const (
+	exportBatch          = 500              // 每次按id读取的行数
+	exportJobProgress    = 20               // 每导出多少批更新一次任务进度
+	exportJobStaleAfter  = 10 * time.Minute // 进度超过这个时间未更新的任务视为中断
+	exportDownloadPrefix = "/api/admin_dhb/export_file?id="
+)
+
+// ExportRequest 导出条件，同步导出和导出任务共用
+type ExportRequest struct {
+	Kind    string `json:"kind"`
+	Format  string `json:"format"`
+	Address string `json:"address"`
+	Reason  string `json:"reason"`
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Start   string `json:"start"` // 北京时间日期，包含
+	End     string `json:"end"`   // 北京时间日期，包含
+}
+
+// ExportFilter 解析后的导出条件，各列表只使用自己有的字段，零值不筛选
+type ExportFilter struct {
+	UserId int64
+	Reason string
+	Type   string
+	Status string
+	Start  time.Time
+	End    time.Time // 不包含
+}
+
+// ExportJob 异步导出任务，文件写在处理任务的实例本地
+type ExportJob struct {
+	ID        int64
+	AdminId   int64
+	Kind      string
+	Format    string
+	Filter    string // ExportRequest的json
+	Status    string
+	Rows      int64
+	File      string
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ExportRepo 按id升序、从afterId之后读取，不使用offset
+type ExportRepo interface {
+	ExportUsers(ctx context.Context, f *ExportFilter, afterId int64, limit int) ([]*User, error)
+	ExportRewards(ctx context.Context, f *ExportFilter, afterId int64, limit int) ([]*Reward, error)
+	ExportWithdraws(ctx context.Context, f *ExportFilter, afterId int64, limit int) ([]*Withdraw, error)
+	ExportRecords(ctx context.Context, f *ExportFilter, afterId int64, limit int) ([]*EthUserRecord, error)
+	ExportLocations(ctx context.Context, f *ExportFilter, afterId int64, limit int) ([]*LocationNew, error)
+	ExportTrades(ctx context.Context, f *ExportFilter, afterId int64, limit int) ([]*Trade, error)
+	CreateExportJob(ctx context.Context, job *ExportJob) (*ExportJob, error)
+	GetExportJob(ctx context.Context, id int64) (*ExportJob, error)
+	// UpdateExportJob 只从from修改状态、进度和结果
+	UpdateExportJob(ctx context.Context, job *ExportJob, from string) (bool, error)
+}
+
+// exporter 一个列表的表头和按批读取，返回的行与表头对应，last为本批最后的id
+type exporter struct {
+	header []string
+	fetch  func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) (rows [][]string, last int64, err error)
+}
+
+func exportAmount(amount int64) string {
+	return fmt.Sprintf("%.2f", float64(amount)/float64(100000))
+}
+
+func exportTime(t time.Time) string {
+	return t.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+}
+
+var exporters = map[string]*exporter{
+	ExportKindUsers: {
+		header: []string{"id", "address", "balance_usdt", "created_at"},
+		fetch: func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) ([][]string, int64, error) {
+			users, err := uuc.exportRepo.ExportUsers(ctx, f, afterId, exportBatch)
+			if nil != err || 0 >= len(users) {
+				return nil, 0, err
+			}
+
+			userIds := make([]int64, 0, len(users))
+			for _, v := range users {
+				userIds = append(userIds, v.ID)
+			}
+			balances, err := uuc.ubRepo.GetUserBalanceByUserIds(ctx, userIds...)
+			if nil != err {
+				return nil, 0, err
+			}
+
+			rows := make([][]string, 0, len(users))
+			for _, v := range users {
+				var balance int64
+				if _, ok := balances[v.ID]; ok {
+					balance = balances[v.ID].BalanceUsdt
+				}
+				rows = append(rows, []string{strconv.FormatInt(v.ID, 10), v.Address, exportAmount(balance), exportTime(v.CreatedAt)})
+			}
+
+			return rows, users[len(users)-1].ID, nil
+		},
+	},
+	ExportKindRewards: {
+		header: []string{"id", "user_id", "address", "amount", "amount_b", "type", "reason", "created_at"},
+		fetch: func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) ([][]string, int64, error) {
+			rewards, err := uuc.exportRepo.ExportRewards(ctx, f, afterId, exportBatch)
+			if nil != err || 0 >= len(rewards) {
+				return nil, 0, err
+			}
+
+			userIds := make([]int64, 0, len(rewards))
+			for _, v := range rewards {
+				userIds = append(userIds, v.UserId)
+			}
+			addresses, err := uuc.exportAddresses(ctx, userIds)
+			if nil != err {
+				return nil, 0, err
+			}
+
+			rows := make([][]string, 0, len(rewards))
+			for _, v := range rewards {
+				rows = append(rows, []string{strconv.FormatInt(v.ID, 10), strconv.FormatInt(v.UserId, 10), addresses[v.UserId],
+					exportAmount(v.Amount), fmt.Sprintf("%.2f", v.AmountB), v.Type, v.Reason, exportTime(v.CreatedAt)})
+			}
+
+			return rows, rewards[len(rewards)-1].ID, nil
+		},
+	},
+	ExportKindWithdraws: {
+		header: []string{"id", "user_id", "address", "amount", "rel_amount", "type", "status", "created_at"},
+		fetch: func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) ([][]string, int64, error) {
+			withdraws, err := uuc.exportRepo.ExportWithdraws(ctx, f, afterId, exportBatch)
+			if nil != err || 0 >= len(withdraws) {
+				return nil, 0, err
+			}
+
+			userIds := make([]int64, 0, len(withdraws))
+			for _, v := range withdraws {
+				userIds = append(userIds, v.UserId)
+			}
+			addresses, err := uuc.exportAddresses(ctx, userIds)
+			if nil != err {
+				return nil, 0, err
+			}
+
+			rows := make([][]string, 0, len(withdraws))
+			for _, v := range withdraws {
+				rows = append(rows, []string{strconv.FormatInt(v.ID, 10), strconv.FormatInt(v.UserId, 10), addresses[v.UserId],
+					fmt.Sprintf("%.2f", v.AmountNew), fmt.Sprintf("%.2f", v.AmountNewRel), v.Type, v.Status, exportTime(v.CreatedAt)})
+			}
+
+			return rows, withdraws[len(withdraws)-1].ID, nil
+		},
+	},
+	ExportKindRecords: {
+		header: []string{"id", "user_id", "address", "hash", "type", "coin_type", "amount", "source", "created_at"},
+		fetch: func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) ([][]string, int64, error) {
+			records, err := uuc.exportRepo.ExportRecords(ctx, f, afterId, exportBatch)
+			if nil != err || 0 >= len(records) {
+				return nil, 0, err
+			}
+
+			userIds := make([]int64, 0, len(records))
+			for _, v := range records {
+				userIds = append(userIds, v.UserId)
+			}
+			addresses, err := uuc.exportAddresses(ctx, userIds)
+			if nil != err {
+				return nil, 0, err
+			}
+
+			rows := make([][]string, 0, len(records))
+			for _, v := range records {
+				rows = append(rows, []string{strconv.FormatInt(v.ID, 10), strconv.FormatInt(v.UserId, 10), addresses[v.UserId],
+					v.Hash, v.Type, v.CoinType, strconv.FormatUint(v.AmountTwo, 10), ethUserRecordSource(v), exportTime(v.CreatedAt)})
+			}
+
+			return rows, records[len(records)-1].ID, nil
+		},
+	},
+	ExportKindLocations: {
+		header: []string{"id", "user_id", "address", "status", "usdt", "current", "current_max", "created_at", "stop_date"},
+		fetch: func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) ([][]string, int64, error) {
+			locations, err := uuc.exportRepo.ExportLocations(ctx, f, afterId, exportBatch)
+			if nil != err || 0 >= len(locations) {
+				return nil, 0, err
+			}
+
+			userIds := make([]int64, 0, len(locations))
+			for _, v := range locations {
+				userIds = append(userIds, v.UserId)
+			}
+			addresses, err := uuc.exportAddresses(ctx, userIds)
+			if nil != err {
+				return nil, 0, err
+			}
+
+			rows := make([][]string, 0, len(locations))
+			for _, v := range locations {
+				stopDate := ""
+				if "stop" == v.Status {
+					stopDate = exportTime(v.StopDate)
+				}
+				rows = append(rows, []string{strconv.FormatInt(v.ID, 10), strconv.FormatInt(v.UserId, 10), addresses[v.UserId], v.Status,
+					exportAmount(v.Usdt), exportAmount(v.Current), exportAmount(v.CurrentMax), exportTime(v.CreatedAt), stopDate})
+			}
+
+			return rows, locations[len(locations)-1].ID, nil
+		},
+	},
+	ExportKindTrades: {
+		header: []string{"id", "user_id", "address", "amount_csd", "amount_hbs", "status", "created_at"},
+		fetch: func(uuc *UserUseCase, ctx context.Context, f *ExportFilter, afterId int64) ([][]string, int64, error) {
+			trades, err := uuc.exportRepo.ExportTrades(ctx, f, afterId, exportBatch)
+			if nil != err || 0 >= len(trades) {
+				return nil, 0, err
+			}
+
+			userIds := make([]int64, 0, len(trades))
+			for _, v := range trades {
+				userIds = append(userIds, v.UserId)
+			}
+			addresses, err := uuc.exportAddresses(ctx, userIds)
+			if nil != err {
+				return nil, 0, err
+			}
+
+			rows := make([][]string, 0, len(trades))
+			for _, v := range trades {
+				rows = append(rows, []string{strconv.FormatInt(v.ID, 10), strconv.FormatInt(v.UserId, 10), addresses[v.UserId],
+					exportAmount(v.AmountCsd), exportAmount(v.AmountHbs), v.Status, exportTime(v.CreatedAt)})
+			}
+
+			return rows, trades[len(trades)-1].ID, nil
+		},
+	},
+}
+
+// exportAddresses 一批行的用户地址
+func (uuc *UserUseCase) exportAddresses(ctx context.Context, userIds []int64) (map[int64]string, error) {
+	users, err := uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make(map[int64]string, len(users))
+	for k, v := range users {
+		res[k] = v.Address
+	}
+
+	return res, nil
+}
+
+// exportFilter 校验列表和格式，地址转为用户id，日期按北京时间
+func (uuc *UserUseCase) exportFilter(ctx context.Context, req *ExportRequest) (*ExportFilter, error) {
+	if _, ok := exporters[req.Kind]; !ok {
+		return nil, errors.New(500, "ERROR", "不支持导出的列表 "+req.Kind)
+	}
+	if export.FormatCSV != req.Format && export.FormatXLSX != req.Format {
+		return nil, errors.New(500, "ERROR", "不支持的导出格式 "+req.Format)
+	}
+
+	res := &ExportFilter{
+		Reason: req.Reason,
+		Type:   req.Type,
+		Status: req.Status,
+	}
+
+	if "" != req.Address {
+		user, err := uuc.repo.GetUserByAddress(ctx, req.Address)
+		if nil != err || nil == user {
+			return nil, errors.New(500, "ERROR", "用户不存在 "+req.Address)
+		}
+		res.UserId = user.ID
+	}
+
+	cst := time.FixedZone("CST", 8*3600)
+	if "" != req.Start {
+		start, err := time.ParseInLocation("2006-01-02", req.Start, cst)
+		if nil != err {
+			return nil, errors.New(500, "ERROR", "开始日期错误")
+		}
+		res.Start = start.UTC()
+	}
+	if "" != req.End {
+		end, err := time.ParseInLocation("2006-01-02", req.End, cst)
+		if nil != err {
+			return nil, errors.New(500, "ERROR", "结束日期错误")
+		}
+		res.End = end.AddDate(0, 0, 1).UTC()
+	}
+
+	return res, nil
+}
+
+// ExportCheck 开始写出前校验条件
+func (uuc *UserUseCase) ExportCheck(ctx context.Context, req *ExportRequest) error {
+	_, err := uuc.exportFilter(ctx, req)
+	return err
+}
+
+// Export 按id分批写出全部符合条件的行，每批写完调用flush，返回写出的行数
+func (uuc *UserUseCase) Export(ctx context.Context, req *ExportRequest, w export.Writer, flush func(rows int64) error) (int64, error) {
+	f, err := uuc.exportFilter(ctx, req)
+	if nil != err {
+		return 0, err
+	}
+	e := exporters[req.Kind]
+
+	if err = w.Write(e.header); nil != err {
+		return 0, err
+	}
+
+	var (
+		rows    int64
+		afterId int64
+	)
+	for {
+		if err = ctx.Err(); nil != err {
+			return rows, err
+		}
+
+		tmpRows, last, err := e.fetch(uuc, ctx, f, afterId)
+		if nil != err {
+			return rows, err
+		}
+		if 0 >= len(tmpRows) {
+			break
+		}
+
+		for _, v := range tmpRows {
+			if err = w.Write(v); nil != err {
+				return rows, err
+			}
+		}
+		rows += int64(len(tmpRows))
+		afterId = last
+
+		if nil != flush {
+			if err = flush(rows); nil != err {
+				return rows, err
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// ExportFileName 下载的文件名
+func ExportFileName(kind string, format string) string {
+	return kind + "_" + time.Now().Add(8*time.Hour).UTC().Format("20060102150405") + "." + format
+}
+
+func (uuc *UserUseCase) exportDir(ctx context.Context) (string, error) {
+	values, err := uuc.registry.Values(ctx, "export_dir")
+	if nil != err {
+		return "", err
+	}
+
+	if err = os.MkdirAll(values["export_dir"], 0o750); nil != err {
+		return "", errors.New(500, "ERROR", "导出目录创建失败 "+err.Error())
+	}
+
+	return values["export_dir"], nil
+}
+
+// AdminExportJobCreate 创建导出任务并在后台执行，条件先校验，错误时不创建任务
+func (uuc *UserUseCase) AdminExportJobCreate(ctx context.Context, req *v1.AdminExportJobCreateRequest) (*v1.AdminExportJobCreateReply, error) {
+	exportReq := &ExportRequest{
+		Kind:    req.SendBody.Kind,
+		Format:  req.SendBody.Format,
+		Address: req.SendBody.Address,
+		Reason:  req.SendBody.Reason,
+		Type:    req.SendBody.Type,
+		Status:  req.SendBody.Status,
+		Start:   req.SendBody.Start,
+		End:     req.SendBody.End,
+	}
+	if _, err := uuc.exportFilter(ctx, exportReq); nil != err {
+		return nil, err
+	}
+
+	dir, err := uuc.exportDir(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	filter, _ := json.Marshal(exportReq)
+	job, err := uuc.exportRepo.CreateExportJob(ctx, &ExportJob{
+		AdminId: adminIdFromContext(ctx),
+		Kind:    exportReq.Kind,
+		Format:  exportReq.Format,
+		Filter:  string(filter),
+		Status:  ExportJobPending,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	// 任务在请求结束后继续执行，只沿用请求id
+	go uuc.runExportJob(requestid.NewContext(context.Background(), requestid.FromContext(ctx)), job, exportReq, dir)
+
+	return &v1.AdminExportJobCreateReply{
+		Id:     job.ID,
+		Status: job.Status,
+	}, nil
+}
+
+// runExportJob 写到临时文件，完成后改名，失败时删除
+func (uuc *UserUseCase) runExportJob(ctx context.Context, job *ExportJob, req *ExportRequest, dir string) {
+	var (
+		file *os.File
+		w    export.Writer
+		err  error
+	)
+
+	job.Status = ExportJobRunning
+	if ok, err := uuc.exportRepo.UpdateExportJob(ctx, job, ExportJobPending); nil != err || !ok {
+		uuc.log.WithContext(ctx).Errorw("msg", "export job start", "job_id", job.ID, "err", err)
+		return
+	}
+
+	fail := func(err error) {
+		job.Status = ExportJobFailed
+		job.Error = err.Error()
+		if _, err := uuc.exportRepo.UpdateExportJob(ctx, job, ExportJobRunning); nil != err {
+			uuc.log.WithContext(ctx).Errorw("msg", "export job fail", "job_id", job.ID, "err", err)
+		}
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("export_%d.%s", job.ID, job.Format))
+	file, err = os.Create(path + ".tmp")
+	if nil != err {
+		fail(err)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(path + ".tmp")
+	}()
+
+	w, err = export.NewWriter(job.Format, file)
+	if nil != err {
+		fail(err)
+		return
+	}
+
+	batches := 0
+	job.Rows, err = uuc.Export(ctx, req, w, func(rows int64) error {
+		batches++
+		if 0 != batches%exportJobProgress {
+			return nil
+		}
+
+		job.Rows = rows
+		ok, err := uuc.exportRepo.UpdateExportJob(ctx, job, ExportJobRunning)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return errors.New(500, "ERROR", "导出任务状态已变化")
+		}
+
+		return nil
+	})
+	if nil != err {
+		fail(err)
+		return
+	}
+
+	if err = w.Close(); nil != err {
+		fail(err)
+		return
+	}
+	if err = file.Close(); nil != err {
+		fail(err)
+		return
+	}
+	if err = os.Rename(path+".tmp", path); nil != err {
+		fail(err)
+		return
+	}
+
+	job.Status = ExportJobDone
+	job.File = path
+	if _, err = uuc.exportRepo.UpdateExportJob(ctx, job, ExportJobRunning); nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "export job done", "job_id", job.ID, "err", err)
+	}
+}
+
+// getExportJob 进度长时间未更新的任务改为失败，实例重启后任务不会继续执行
+func (uuc *UserUseCase) getExportJob(ctx context.Context, id int64) (*ExportJob, error) {
+	job, err := uuc.exportRepo.GetExportJob(ctx, id)
+	if nil != err {
+		return nil, err
+	}
+
+	if (ExportJobRunning == job.Status || ExportJobPending == job.Status) && time.Since(job.UpdatedAt) > exportJobStaleAfter {
+		from := job.Status
+		job.Status = ExportJobFailed
+		job.Error = "导出中断"
+		if _, err = uuc.exportRepo.UpdateExportJob(ctx, job, from); nil != err {
+			return nil, err
+		}
+
+		return uuc.exportRepo.GetExportJob(ctx, id)
+	}
+
+	return job, nil
+}
+
+func (uuc *UserUseCase) AdminExportJob(ctx context.Context, req *v1.AdminExportJobRequest) (*v1.AdminExportJobReply, error) {
+	job, err := uuc.getExportJob(ctx, req.Id)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminExportJobReply{
+		Id:        job.ID,
+		Kind:      job.Kind,
+		Format:    job.Format,
+		Status:    job.Status,
+		Rows:      job.Rows,
+		Error:     job.Error,
+		CreatedAt: exportTime(job.CreatedAt),
+		UpdatedAt: exportTime(job.UpdatedAt),
+	}
+	if ExportJobDone == job.Status {
+		res.Download = exportDownloadPrefix + strconv.FormatInt(job.ID, 10)
+	}
+
+	return res, nil
+}
+
+// ExportJobFile 已完成任务的文件
+func (uuc *UserUseCase) ExportJobFile(ctx context.Context, id int64) (*ExportJob, error) {
+	job, err := uuc.getExportJob(ctx, id)
+	if nil != err {
+		return nil, err
+	}
+	if ExportJobDone != job.Status {
+		return nil, errors.New(500, "ERROR", "导出未完成")
+	}
+
+	return job, nil
+}
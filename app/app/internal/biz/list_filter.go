@@ -0,0 +1,225 @@
+package biz
+
+import (
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+// ListFilter 解析后的后台列表筛选，列名都来自listSpecs，零值不筛选
+type ListFilter struct {
+	UserColumn   string
+	DateColumn   string
+	Start        time.Time
+	End          time.Time // 不包含
+	Status       []string
+	AmountColumn string
+	AmountMin    *float64 // 列里的单位
+	AmountMax    *float64
+	VipMin       int64
+	CoinColumn   string
+	CoinType     string
+	TypeColumn   string
+	Type         string
+}
+
+// listSpec 列表支持的筛选和排序字段，以及对应的列，
+// 索引见migrations/000014_list_indexes.up.sql，按 (等值筛选, 排序列, id) 建立，新增字段时一起补索引
+type listSpec struct {
+	table        string
+	userColumn   string
+	dates        map[string]string // date_field -> 列
+	status       bool
+	amount       string
+	amountScaled bool // 金额列存的是乘以100000的整数
+	coin         string
+	typ          string
+	sorts        map[string]string // sort -> 列
+}
+
+var listSpecs = map[string]*listSpec{
+	"user": {
+		table:      "user",
+		userColumn: "id",
+		dates:      map[string]string{"created_at": "created_at"},
+		sorts:      map[string]string{"id": "id", "created_at": "created_at"},
+	},
+	"reward": {
+		table:        "reward",
+		userColumn:   "user_id",
+		dates:        map[string]string{"created_at": "created_at"},
+		amount:       "amount",
+		amountScaled: true,
+		typ:          "type",
+		sorts:        map[string]string{"id": "id", "created_at": "created_at", "amount": "amount"},
+	},
+	"location": {
+		table:        "location_new",
+		userColumn:   "user_id",
+		dates:        map[string]string{"created_at": "created_at", "stop_date": "stop_date"},
+		status:       true,
+		amount:       "usdt",
+		amountScaled: true,
+		sorts:        map[string]string{"id": "id", "created_at": "created_at", "amount": "usdt", "stop_date": "stop_date"},
+	},
+	"withdraw": {
+		table:      "withdraw",
+		userColumn: "user_id",
+		dates:      map[string]string{"created_at": "created_at"},
+		status:     true,
+		amount:     "amount_new",
+		coin:       "type",
+		sorts:      map[string]string{"id": "id", "created_at": "created_at", "amount": "amount_new"},
+	},
+}
+
+// listRequest 后台列表请求共有的分页和筛选字段
+type listRequest interface {
+	GetPage() int64
+	GetCursor() string
+	GetCountMode() string
+	GetFilter() *v1.ListFilter
+}
+
+func listFilterUnsupported(list string, field string) error {
+	return errors.BadRequest("FILTER_UNSUPPORTED", list+"列表不支持筛选"+field)
+}
+
+// listFilterTime 北京时间的日期或时间，只有日期时end取到第二天
+func listFilterTime(s string, end bool) (time.Time, error) {
+	if "" == s {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse("2006-01-02 15:04:05", s); nil == err {
+		return t.Add(-8 * time.Hour), nil
+	}
+
+	t, err := time.Parse("2006-01-02", s)
+	if nil != err {
+		return time.Time{}, errors.BadRequest("FILTER_TIME_INVALID", "时间格式错误")
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t.Add(-8 * time.Hour), nil
+}
+
+// listFilterAmount 转为金额列的单位
+func listFilterAmount(s string, scaled bool) (*float64, error) {
+	if "" == s {
+		return nil, nil
+	}
+
+	var amount float64
+	if scaled {
+		tmp, err := parseAmount(s)
+		if nil != err {
+			return nil, err
+		}
+		amount = float64(tmp)
+	} else {
+		tmp, err := strconv.ParseFloat(s, 64)
+		if nil != err {
+			return nil, errors.New(500, "ERROR", "金额格式错误")
+		}
+		amount = tmp
+	}
+
+	return &amount, nil
+}
+
+// parseListFilter 按列表的字段白名单解析，不支持的字段返回错误
+func parseListFilter(list string, req *v1.ListFilter) (*ListFilter, error) {
+	spec := listSpecs[list]
+	f := &ListFilter{UserColumn: spec.userColumn}
+	if nil == req {
+		return f, nil
+	}
+
+	var err error
+	dateField := req.DateField
+	if "" == dateField {
+		dateField = "created_at"
+	}
+	if f.DateColumn = spec.dates[dateField]; "" == f.DateColumn {
+		return nil, listFilterUnsupported(list, dateField)
+	}
+	if f.Start, err = listFilterTime(req.Start, false); nil != err {
+		return nil, err
+	}
+	if f.End, err = listFilterTime(req.End, true); nil != err {
+		return nil, err
+	}
+	if 0 < req.WithinHours {
+		since := time.Now().UTC().Add(-time.Duration(req.WithinHours) * time.Hour)
+		if since.After(f.Start) {
+			f.Start = since
+		}
+	}
+
+	if 0 < len(req.Status) {
+		if !spec.status {
+			return nil, listFilterUnsupported(list, "status")
+		}
+		f.Status = req.Status
+	}
+
+	if "" != req.AmountMin || "" != req.AmountMax {
+		if "" == spec.amount {
+			return nil, listFilterUnsupported(list, "amount")
+		}
+		f.AmountColumn = spec.amount
+		if f.AmountMin, err = listFilterAmount(req.AmountMin, spec.amountScaled); nil != err {
+			return nil, err
+		}
+		if f.AmountMax, err = listFilterAmount(req.AmountMax, spec.amountScaled); nil != err {
+			return nil, err
+		}
+	}
+
+	f.VipMin = req.VipMin
+
+	if "" != req.CoinType {
+		if "" == spec.coin {
+			return nil, listFilterUnsupported(list, "coin_type")
+		}
+		f.CoinColumn, f.CoinType = spec.coin, req.CoinType
+	}
+
+	if "" != req.Type {
+		if "" == spec.typ {
+			return nil, listFilterUnsupported(list, "type")
+		}
+		f.TypeColumn, f.Type = spec.typ, req.Type
+	}
+
+	return f, nil
+}
+
+// filtered 有筛选时总数不能用表统计估算
+func (f *ListFilter) filtered() bool {
+	return !f.Start.IsZero() || !f.End.IsZero() || 0 < len(f.Status) || nil != f.AmountMin || nil != f.AmountMax ||
+		0 < f.VipMin || "" != f.CoinType || "" != f.Type
+}
+
+// listSort 排序的列，id返回空
+func listSort(list string, req *v1.ListFilter) (string, bool, error) {
+	if nil == req || "" == req.Sort || "id" == req.Sort {
+		return "", "asc" == req.GetOrder(), nil
+	}
+
+	sort := listSpecs[list].sorts[req.Sort]
+	if "" == sort {
+		return "", false, listFilterUnsupported(list, "sort "+req.Sort)
+	}
+
+	return sort, "asc" == req.Order, nil
+}
+
+// cursorTime 排序键里的时间，和数据库里的值一致
+func cursorTime(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"strconv"
+	"time"
+)
+
+// 占位变动事件
+const (
+	LocationEventCreate   = "create"       // 入金创建
+	LocationEventReward   = "reward"       // 奖励计入
+	LocationEventStop     = "stop"         // 达到出局额度
+	LocationEventRestart  = "restart"      // 回滚后重新运行
+	LocationEventRollback = "rollback"     // 回滚奖励
+	LocationEventPrice    = "price_change" // 价格变动调整
+	LocationEventAdmin    = "admin"        // 后台修改
+)
+
+// LocationEvent 占位的一次变动，记录修改前后的状态和额度
+type LocationEvent struct {
+	ID               int64
+	LocationTable    string
+	LocationId       int64
+	UserId           int64
+	Event            string
+	Source           string // 触发的任务或操作
+	RunId            int64
+	AdminId          int64
+	StatusBefore     string
+	StatusAfter      string
+	CurrentBefore    int64
+	CurrentAfter     int64
+	CurrentMaxBefore int64
+	CurrentMaxAfter  int64
+	Amount           int64
+	Note             string
+	CreatedAt        time.Time
+}
+
+type LocationEventRepo interface {
+	CreateLocationEvents(ctx context.Context, events ...*LocationEvent) error
+	GetLocationEvents(ctx context.Context, table string, locationId int64) ([]*LocationEvent, error)
+	GetLocationState(ctx context.Context, table string, id int64) (*LocationNew, error)
+}
+
+// LocationEventEmitter 占位变动事件，与占位的修改在同一事务内写入
+type LocationEventEmitter struct {
+	repo LocationEventRepo
+}
+
+func NewLocationEventEmitter(repo LocationEventRepo) *LocationEventEmitter {
+	return &LocationEventEmitter{repo: repo}
+}
+
+// Emit 补充运行id和操作人后写入
+func (e *LocationEventEmitter) Emit(ctx context.Context, events ...*LocationEvent) error {
+	tmpEvents := make([]*LocationEvent, 0, len(events))
+	for _, v := range events {
+		if nil == v {
+			continue
+		}
+
+		if 0 >= v.RunId {
+			v.RunId = RewardRunIdFromContext(ctx)
+		}
+		if 0 >= v.AdminId {
+			v.AdminId = adminIdFromContext(ctx)
+		}
+		tmpEvents = append(tmpEvents, v)
+	}
+
+	if 0 >= len(tmpEvents) {
+		return nil
+	}
+
+	return e.repo.CreateLocationEvents(ctx, tmpEvents...)
+}
+
+// Change 按修改前的占位和修改后的状态、额度生成事件并写入
+func (e *LocationEventEmitter) Change(ctx context.Context, event string, table string, before *LocationNew, status string, current int64, currentMax int64, source string) error {
+	return e.Emit(ctx, newLocationEvent(event, table, before, status, current, currentMax, source))
+}
+
+// State 修改前读取占位当前状态
+func (e *LocationEventEmitter) State(ctx context.Context, table string, id int64) (*LocationNew, error) {
+	return e.repo.GetLocationState(ctx, table, id)
+}
+
+func newLocationEvent(event string, table string, before *LocationNew, status string, current int64, currentMax int64, source string) *LocationEvent {
+	res := &LocationEvent{
+		LocationTable:   table,
+		Event:           event,
+		Source:          source,
+		StatusAfter:     status,
+		CurrentAfter:    current,
+		CurrentMaxAfter: currentMax,
+	}
+
+	if nil != before {
+		res.LocationId = before.ID
+		res.UserId = before.UserId
+		res.StatusBefore = before.Status
+		res.CurrentBefore = before.Current
+		res.CurrentMaxBefore = before.CurrentMax
+	}
+	res.Amount = res.CurrentAfter - res.CurrentBefore
+
+	return res
+}
+
+// locationChangeEvent 运行中变为出局时记为出局，否则记为event
+func locationChangeEvent(event string, before *LocationNew, status string) string {
+	if "running" == before.Status && "stop" == status {
+		return LocationEventStop
+	}
+
+	return event
+}
+
+// rewardLocationEvent 奖励计入占位的事件，结算后、同步占位状态前生成
+func rewardLocationEvent(job string, intent *RewardIntent, settlement *rewardSettlement) *LocationEvent {
+	location := intent.Location
+	if nil == location || !("stop" == settlement.Status || "running" == settlement.PrevStatus) {
+		return nil
+	}
+
+	table := LocationTableNew
+	if RewardKindVipLocation == intent.Kind {
+		table = LocationTableNew2
+	}
+
+	return newLocationEvent(locationChangeEvent(LocationEventReward, location, settlement.Status), table, location, settlement.Status, location.Current+settlement.Charge, location.CurrentMax, job)
+}
+
+// adminIdFromContext 后台接口的管理员id，非后台调用为0
+func adminIdFromContext(ctx context.Context) int64 {
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c, ok := claims.(jwt2.MapClaims)
+		if !ok || nil == c["UserId"] {
+			return 0
+		}
+
+		if tmp, ok := c["UserId"].(float64); ok {
+			return int64(tmp)
+		}
+	}
+
+	return 0
+}
+
+func (uuc *UserUseCase) AdminLocationHistory(ctx context.Context, req *v1.AdminLocationHistoryRequest) (*v1.AdminLocationHistoryReply, error) {
+	var (
+		location *LocationNew
+		events   []*LocationEvent
+		err      error
+	)
+
+	table := req.Table
+	if "" == table {
+		table = LocationTableNew
+	}
+	if LocationTableNew != table && LocationTableNew2 != table {
+		return nil, errors.New(500, "ERROR", "占位表不存在")
+	}
+
+	location, err = uuc.events.State(ctx, table, req.LocationId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == location {
+		return nil, errors.New(500, "ERROR", "占位不存在")
+	}
+
+	events, err = uuc.eventRepo.GetLocationEvents(ctx, table, req.LocationId)
+	if nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "location history", "location_id", req.LocationId, "err", err)
+		return nil, err
+	}
+
+	res := &v1.AdminLocationHistoryReply{
+		LocationId: location.ID,
+		UserId:     location.UserId,
+		Status:     location.Status,
+		Current:    fmt.Sprintf("%.2f", float64(location.Current)/float64(100000)),
+		CurrentMax: fmt.Sprintf("%.2f", float64(location.CurrentMax)/float64(100000)),
+		Events:     make([]*v1.AdminLocationHistoryReply_Event, 0),
+	}
+	for _, v := range events {
+		res.Events = append(res.Events, &v1.AdminLocationHistoryReply_Event{
+			Id:               v.ID,
+			Event:            v.Event,
+			Source:           v.Source,
+			RunId:            v.RunId,
+			AdminId:          v.AdminId,
+			StatusBefore:     v.StatusBefore,
+			StatusAfter:      v.StatusAfter,
+			CurrentBefore:    fmt.Sprintf("%.2f", float64(v.CurrentBefore)/float64(100000)),
+			CurrentAfter:     fmt.Sprintf("%.2f", float64(v.CurrentAfter)/float64(100000)),
+			CurrentMaxBefore: fmt.Sprintf("%.2f", float64(v.CurrentMaxBefore)/float64(100000)),
+			CurrentMaxAfter:  fmt.Sprintf("%.2f", float64(v.CurrentMaxAfter)/float64(100000)),
+			Amount:           fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Note:             v.Note,
+			CreatedAt:        v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// locationAmountNote 金额变动说明
+func locationAmountNote(field string, amount int64) string {
+	return field + " " + strconv.FormatFloat(float64(amount)/float64(100000), 'f', 2, 64)
+}
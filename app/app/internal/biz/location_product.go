@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+	"time"
+)
+
+// 未配置占位产品时的出局倍率，25为2.5倍
+const locationProductDefaultOutRate = 25
+
+// LocationProduct 占位产品，入金时按金额匹配，决定出局额度、可参与的奖励和复投间隔
+type LocationProduct struct {
+	ID        int64
+	Name      string
+	MinAmount int64 // usdt，不含精度
+	MaxAmount int64 // 0为不限
+	OutRate   int64 // 出局倍率，25为2.5倍
+	Policies  string
+	TimeAgain int64 // 出局后再次入金间隔，分钟
+	Sort      int64
+	Status    int64 // 1启用
+	CreatedAt time.Time
+}
+
+type LocationProductRepo interface {
+	GetLocationProducts(ctx context.Context) ([]*LocationProduct, error)
+	CreateLocationProduct(ctx context.Context, product *LocationProduct) (*LocationProduct, error)
+	UpdateLocationProduct(ctx context.Context, product *LocationProduct) error
+}
+
+// PolicyList 可参与的奖励任务，空为全部
+func (p *LocationProduct) PolicyList() []string {
+	res := make([]string, 0)
+	for _, v := range strings.Split(p.Policies, ",") {
+		v = strings.TrimSpace(v)
+		if "" != v {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+// AllowPolicy 是否参与奖励任务job
+func (p *LocationProduct) AllowPolicy(job string) bool {
+	policies := p.PolicyList()
+	if 0 >= len(policies) {
+		return true
+	}
+
+	for _, v := range policies {
+		if job == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchLocationProduct 按排序取第一个金额区间包含amount的启用产品，amount带精度
+func MatchLocationProduct(products []*LocationProduct, amount int64) *LocationProduct {
+	for _, v := range products {
+		if 1 != v.Status {
+			continue
+		}
+
+		if amount < v.MinAmount*100000 {
+			continue
+		}
+		if 0 < v.MaxAmount && amount > v.MaxAmount*100000 {
+			continue
+		}
+
+		return v
+	}
+
+	return nil
+}
+
+// locationProductFixedAmount 有名额限制的固定档位，名额满时不能通过产品入金
+func locationProductFixedAmount(amount int64) bool {
+	switch amount {
+	case 10000000, 30000000, 100000000, 300000000, 500000000, 1000000000:
+		return true
+	}
+
+	return false
+}
+
+// productAllows 占位产品是否参与本次任务，没有产品的占位和非占位奖励都参与
+func (s *RewardSnapshot) productAllows(intent *RewardIntent) bool {
+	if nil == intent.Location || 0 >= intent.Location.ProductId || RewardKindVipLocation == intent.Kind {
+		return true
+	}
+
+	product, ok := s.products[intent.Location.ProductId]
+	if !ok {
+		return true
+	}
+
+	return product.AllowPolicy(s.Job)
+}
+
+func checkLocationProduct(product *LocationProduct, policies map[string]RewardPolicy) error {
+	if "" == product.Name {
+		return errors.New(500, "ERROR", "请填写产品名称")
+	}
+
+	if 0 > product.MinAmount || (0 < product.MaxAmount && product.MaxAmount < product.MinAmount) {
+		return errors.New(500, "ERROR", "金额区间错误")
+	}
+
+	if 10 > product.OutRate {
+		return errors.New(500, "ERROR", "出局倍率不能小于10")
+	}
+
+	if 0 > product.TimeAgain {
+		return errors.New(500, "ERROR", "复投间隔错误")
+	}
+
+	for _, v := range product.PolicyList() {
+		if _, ok := policies[v]; !ok {
+			return errors.New(500, "ERROR", "奖励任务不存在 "+v)
+		}
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) AdminLocationProductList(ctx context.Context, req *v1.AdminLocationProductListRequest) (*v1.AdminLocationProductListReply, error) {
+	var (
+		products []*LocationProduct
+		err      error
+	)
+
+	products, err = uuc.productRepo.GetLocationProducts(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminLocationProductListReply{Products: make([]*v1.LocationProduct, 0)}
+	for _, v := range products {
+		res.Products = append(res.Products, &v1.LocationProduct{
+			Id:        v.ID,
+			Name:      v.Name,
+			MinAmount: v.MinAmount,
+			MaxAmount: v.MaxAmount,
+			OutRate:   v.OutRate,
+			Policies:  v.PolicyList(),
+			TimeAgain: v.TimeAgain,
+			Sort:      v.Sort,
+			Status:    v.Status,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminLocationProductUpdate(ctx context.Context, req *v1.AdminLocationProductUpdateRequest) (*v1.AdminLocationProductUpdateReply, error) {
+	var (
+		product *LocationProduct
+		err     error
+	)
+
+	if nil == req.SendBody {
+		return nil, errors.New(500, "ERROR", "参数错误")
+	}
+
+	product = &LocationProduct{
+		ID:        req.SendBody.Id,
+		Name:      strings.TrimSpace(req.SendBody.Name),
+		MinAmount: req.SendBody.MinAmount,
+		MaxAmount: req.SendBody.MaxAmount,
+		OutRate:   req.SendBody.OutRate,
+		Policies:  strings.Join(req.SendBody.Policies, ","),
+		TimeAgain: req.SendBody.TimeAgain,
+		Sort:      req.SendBody.Sort,
+		Status:    req.SendBody.Status,
+	}
+
+	err = checkLocationProduct(product, uuc.rewardPolicies())
+	if nil != err {
+		return nil, err
+	}
+
+	if 0 >= product.ID {
+		product, err = uuc.productRepo.CreateLocationProduct(ctx, product)
+		if nil != err {
+			uuc.log.WithContext(ctx).Errorw("msg", "location product create", "err", err)
+			return nil, err
+		}
+
+		return &v1.AdminLocationProductUpdateReply{Id: product.ID}, nil
+	}
+
+	err = uuc.productRepo.UpdateLocationProduct(ctx, product)
+	if nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "location product update", "product_id", product.ID, "err", err)
+		return nil, err
+	}
+
+	return &v1.AdminLocationProductUpdateReply{Id: product.ID}, nil
+}
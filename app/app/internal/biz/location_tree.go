@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+)
+
+// 排位树，location_new按Top/TopNum三叉排位，Total/TotalTwo/TotalThree为三个区的业绩。
+// path记录从根到自己的所有上级和所在区，格式为 /上级id:区/上级id:区/，根为 /，空为未生成。
+
+// LocationTreeEdge 路径上的一个上级，Leg为自己所在的区
+type LocationTreeEdge struct {
+	ID  int64
+	Leg int64
+}
+
+// LocationTreeNode 一致性检查用到的占位字段
+type LocationTreeNode struct {
+	ID         int64
+	Top        int64
+	TopNum     int64
+	Status     string
+	Usdt       int64
+	Total      int64
+	TotalTwo   int64
+	TotalThree int64
+	Path       string
+}
+
+// LocationTreeDiff 业绩不一致的占位
+type LocationTreeDiff struct {
+	ID         int64
+	Total      int64
+	TotalTwo   int64
+	TotalThree int64
+	Expect     [3]int64
+}
+
+// LocationTreeReport 一致性检查报告
+type LocationTreeReport struct {
+	Count  int64
+	Paths  int64 // 重建的路径数
+	Diffs  []*LocationTreeDiff
+	Fixed  bool
+	Broken []int64 // 上级不存在或区号不合法
+}
+
+type LocationTreeRepo interface {
+	GetLocationTreePath(ctx context.Context, id int64) (string, error)
+	AddLocationTreeTotal(ctx context.Context, edges []*LocationTreeEdge, total int64) error
+	GetLocationTreeNodes(ctx context.Context) ([]*LocationTreeNode, error)
+	UpdateLocationTreePaths(ctx context.Context, paths map[int64]string) error
+	UpdateLocationTreeTotals(ctx context.Context, nodes []*LocationTreeNode) error
+}
+
+// LocationTreeChildPath 排在parent的leg区下的子占位路径
+func LocationTreeChildPath(parentPath string, parentId int64, leg int64) string {
+	if "" == parentPath {
+		return ""
+	}
+
+	return parentPath + strconv.FormatInt(parentId, 10) + ":" + strconv.FormatInt(leg, 10) + "/"
+}
+
+// LocationTreeEdges 解析路径，返回所有上级
+func LocationTreeEdges(path string) ([]*LocationTreeEdge, error) {
+	res := make([]*LocationTreeEdge, 0)
+	for _, v := range strings.Split(strings.Trim(path, "/"), "/") {
+		if "" == v {
+			continue
+		}
+
+		tmp := strings.Split(v, ":")
+		if 2 != len(tmp) {
+			return nil, errors.New(500, "ERROR", "排位路径错误 "+path)
+		}
+
+		id, err := strconv.ParseInt(tmp[0], 10, 64)
+		if nil != err {
+			return nil, errors.New(500, "ERROR", "排位路径错误 "+path)
+		}
+		leg, err := strconv.ParseInt(tmp[1], 10, 64)
+		if nil != err || 1 > leg || 3 < leg {
+			return nil, errors.New(500, "ERROR", "排位路径错误 "+path)
+		}
+
+		res = append(res, &LocationTreeEdge{ID: id, Leg: leg})
+	}
+
+	return res, nil
+}
+
+// addLocationTreeTotal 给location的所有上级在其所在区加业绩，一条sql；路径未生成时逐级向上
+func addLocationTreeTotal(ctx context.Context, treeRepo LocationTreeRepo, locationRepo LocationRepo, location *LocationNew, total int64) error {
+	if 0 == total || 0 >= location.Top {
+		return nil
+	}
+
+	path, err := treeRepo.GetLocationTreePath(ctx, location.ID)
+	if nil != err {
+		return err
+	}
+
+	return addLocationTreeTotalByPath(ctx, treeRepo, locationRepo, location, path, total)
+}
+
+// addLocationTreeTotalByPath 已取得路径时使用
+func addLocationTreeTotalByPath(ctx context.Context, treeRepo LocationTreeRepo, locationRepo LocationRepo, location *LocationNew, path string, total int64) error {
+	var (
+		edges []*LocationTreeEdge
+		err   error
+	)
+
+	if 0 == total || 0 >= location.Top {
+		return nil
+	}
+
+	if "" != path {
+		edges, err = LocationTreeEdges(path)
+		if nil != err {
+			return err
+		}
+
+		return treeRepo.AddLocationTreeTotal(ctx, edges, total)
+	}
+
+	tmpTop := location.Top
+	tmpTopNum := location.TopNum
+	for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
+		if 0 < total {
+			err = locationRepo.UpdateLocationNewTotalAdd(ctx, tmpTop, tmpTopNum, total)
+		} else {
+			err = locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, -total)
+		}
+		if nil != err {
+			return err
+		}
+
+		var (
+			currentLocation *LocationNew
+		)
+		currentLocation, err = locationRepo.GetLocationById(ctx, tmpTop)
+		if nil != err {
+			return err
+		}
+
+		if nil != currentLocation && 0 < currentLocation.Top {
+			tmpTop = currentLocation.Top
+			tmpTopNum = currentLocation.TopNum
+			continue
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// CheckLocationTree 从头计算每个占位三个区的业绩，出局的占位不计入，fix时写回；同时补全路径
+func (uuc *UserUseCase) CheckLocationTree(ctx context.Context, fix bool) (*LocationTreeReport, error) {
+	var (
+		nodes []*LocationTreeNode
+		err   error
+	)
+
+	nodes, err = uuc.treeRepo.GetLocationTreeNodes(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &LocationTreeReport{Count: int64(len(nodes)), Diffs: make([]*LocationTreeDiff, 0), Broken: make([]int64, 0), Fixed: fix}
+	nodeMap := make(map[int64]*LocationTreeNode, len(nodes))
+	for _, v := range nodes {
+		nodeMap[v.ID] = v
+	}
+
+	// 按id从小到大，上级一定先于下级生成路径
+	paths := make(map[int64]string, len(nodes))
+	newPaths := make(map[int64]string, 0)
+	expect := make(map[int64]*[3]int64, len(nodes))
+	for _, v := range nodes {
+		expect[v.ID] = &[3]int64{}
+
+		path := "/"
+		if 0 < v.Top {
+			parentPath, ok := paths[v.Top]
+			if _, exist := nodeMap[v.Top]; !exist || !ok || 1 > v.TopNum || 3 < v.TopNum {
+				res.Broken = append(res.Broken, v.ID)
+				continue
+			}
+
+			path = LocationTreeChildPath(parentPath, v.Top, v.TopNum)
+		}
+
+		paths[v.ID] = path
+		if path != v.Path {
+			newPaths[v.ID] = path
+		}
+	}
+
+	for _, v := range nodes {
+		path, ok := paths[v.ID]
+		if !ok || "stop" == v.Status {
+			continue
+		}
+
+		edges, _ := LocationTreeEdges(path)
+		for _, edge := range edges {
+			expect[edge.ID][edge.Leg-1] += v.Usdt / 100000
+		}
+	}
+
+	diffNodes := make([]*LocationTreeNode, 0)
+	for _, v := range nodes {
+		tmp := expect[v.ID]
+		if v.Total == tmp[0] && v.TotalTwo == tmp[1] && v.TotalThree == tmp[2] {
+			continue
+		}
+
+		res.Diffs = append(res.Diffs, &LocationTreeDiff{ID: v.ID, Total: v.Total, TotalTwo: v.TotalTwo, TotalThree: v.TotalThree, Expect: *tmp})
+		diffNodes = append(diffNodes, &LocationTreeNode{ID: v.ID, Total: tmp[0], TotalTwo: tmp[1], TotalThree: tmp[2]})
+	}
+
+	res.Paths = int64(len(newPaths))
+	if !fix {
+		return res, nil
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.treeRepo.UpdateLocationTreePaths(ctx, newPaths)
+		if nil != err {
+			return err
+		}
+
+		return uuc.treeRepo.UpdateLocationTreeTotals(ctx, diffNodes)
+	}); nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "location tree fix", "err", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminLocationTreeCheck(ctx context.Context, req *v1.AdminLocationTreeCheckRequest) (*v1.AdminLocationTreeCheckReply, error) {
+	var (
+		report *LocationTreeReport
+		err    error
+	)
+
+	report, err = uuc.CheckLocationTree(ctx, req.Fix)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminLocationTreeCheckReply{
+		Count:  report.Count,
+		Paths:  report.Paths,
+		Fixed:  report.Fixed,
+		Broken: report.Broken,
+		Diffs:  make([]*v1.AdminLocationTreeCheckReply_Diff, 0),
+	}
+	for _, v := range report.Diffs {
+		res.Diffs = append(res.Diffs, &v1.AdminLocationTreeCheckReply_Diff{
+			LocationId:       v.ID,
+			Total:            v.Total,
+			TotalTwo:         v.TotalTwo,
+			TotalThree:       v.TotalThree,
+			ExpectTotal:      v.Expect[0],
+			ExpectTotalTwo:   v.Expect[1],
+			ExpectTotalThree: v.Expect[2],
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,356 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 价格来源
+const (
+	PriceOracleManual = "manual" // 后台录入的price_manual
+	PriceOraclePair   = "pair"   // 交易对储备量
+	PriceOracleTwap   = "twap"   // 对price_twap_source按时间加权平均
+)
+
+// PriceQuote 一个币的usdt价格
+type PriceQuote struct {
+	Source string
+	Price  float64
+	At     time.Time
+}
+
+type PriceOracle interface {
+	Name() string
+	Quote(ctx context.Context) (*PriceQuote, error)
+}
+
+// PairReserves 交易对储备量，Decimals为对应币的精度
+type PairReserves struct {
+	Token0    string
+	Token1    string
+	Reserve0  *big.Int
+	Reserve1  *big.Int
+	Decimals0 uint8
+	Decimals1 uint8
+}
+
+// PriceSample 价格来源的一次报价，用于计算时间加权平均
+type PriceSample struct {
+	ID        int64
+	Source    string
+	Price     float64
+	CreatedAt time.Time
+}
+
+type PriceFeedRepo interface {
+	GetPairReserves(ctx context.Context, rpcUrl string, pair string) (*PairReserves, error)
+	CreatePriceSample(ctx context.Context, sample *PriceSample) error
+	GetPriceSamples(ctx context.Context, source string, from time.Time) ([]*PriceSample, error)
+}
+
+// manualPriceOracle 后台录入的价格
+type manualPriceOracle struct {
+	registry *ConfigRegistry
+}
+
+func (o *manualPriceOracle) Name() string {
+	return PriceOracleManual
+}
+
+func (o *manualPriceOracle) Quote(ctx context.Context) (*PriceQuote, error) {
+	price, err := o.registry.Float64(ctx, "price_manual")
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= price {
+		return nil, errors.New(500, "ERROR", "未录入价格")
+	}
+
+	return &PriceQuote{Source: o.Name(), Price: price, At: time.Now()}, nil
+}
+
+// pairPriceOracle 按交易对两边储备量计算token的价格
+type pairPriceOracle struct {
+	repo   PriceFeedRepo
+	rpcUrl string
+	pair   string
+	token  string
+}
+
+func (o *pairPriceOracle) Name() string {
+	return PriceOraclePair
+}
+
+func (o *pairPriceOracle) Quote(ctx context.Context) (*PriceQuote, error) {
+	reserves, err := o.repo.GetPairReserves(ctx, o.rpcUrl, o.pair)
+	if nil != err {
+		return nil, err
+	}
+
+	price, err := pairPrice(reserves, o.token)
+	if nil != err {
+		return nil, err
+	}
+
+	return &PriceQuote{Source: o.Name(), Price: price, At: time.Now()}, nil
+}
+
+// pairPrice token按另一边计价的价格
+func pairPrice(reserves *PairReserves, token string) (float64, error) {
+	var (
+		reserveToken, reserveQuote *big.Int
+		decToken, decQuote         uint8
+	)
+
+	if strings.EqualFold(reserves.Token0, token) {
+		reserveToken, reserveQuote = reserves.Reserve0, reserves.Reserve1
+		decToken, decQuote = reserves.Decimals0, reserves.Decimals1
+	} else if strings.EqualFold(reserves.Token1, token) {
+		reserveToken, reserveQuote = reserves.Reserve1, reserves.Reserve0
+		decToken, decQuote = reserves.Decimals1, reserves.Decimals0
+	} else {
+		return 0, errors.New(500, "ERROR", "交易对不包含该币")
+	}
+
+	if nil == reserveToken || nil == reserveQuote || 0 >= reserveToken.Sign() || 0 >= reserveQuote.Sign() {
+		return 0, errors.New(500, "ERROR", "交易对储备量为0")
+	}
+
+	tmpToken := new(big.Float).Quo(new(big.Float).SetInt(reserveToken), pow10(decToken))
+	tmpQuote := new(big.Float).Quo(new(big.Float).SetInt(reserveQuote), pow10(decQuote))
+	res, _ := new(big.Float).Quo(tmpQuote, tmpToken).Float64()
+
+	return res, nil
+}
+
+func pow10(n uint8) *big.Float {
+	return new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+}
+
+// twapPriceOracle 每次报价记录一次来源价格，返回窗口内按持续时间加权的平均价
+type twapPriceOracle struct {
+	source PriceOracle
+	repo   PriceFeedRepo
+	window time.Duration
+}
+
+func (o *twapPriceOracle) Name() string {
+	return PriceOracleTwap + "_" + o.source.Name()
+}
+
+func (o *twapPriceOracle) Quote(ctx context.Context) (*PriceQuote, error) {
+	quote, err := o.source.Quote(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	err = o.repo.CreatePriceSample(ctx, &PriceSample{Source: o.source.Name(), Price: quote.Price})
+	if nil != err {
+		return nil, err
+	}
+
+	now := time.Now()
+	samples, err := o.repo.GetPriceSamples(ctx, o.source.Name(), now.Add(-o.window))
+	if nil != err {
+		return nil, err
+	}
+
+	return &PriceQuote{Source: o.Name(), Price: twapPrice(samples, now, quote.Price), At: now}, nil
+}
+
+// twapPrice samples按时间升序，每个价格持续到下一次报价
+func twapPrice(samples []*PriceSample, now time.Time, last float64) float64 {
+	var (
+		total  float64
+		weight float64
+	)
+	for i, v := range samples {
+		end := now
+		if i+1 < len(samples) {
+			end = samples[i+1].CreatedAt
+		}
+
+		tmp := end.Sub(v.CreatedAt).Seconds()
+		if 0 >= tmp {
+			continue
+		}
+		total += v.Price * tmp
+		weight += tmp
+	}
+
+	if 0 >= weight {
+		return last
+	}
+
+	return total / weight
+}
+
+// priceOracle 按price_oracle配置的来源
+func (uuc *UserUseCase) priceOracle(ctx context.Context) (PriceOracle, error) {
+	configs, err := uuc.registry.Values(ctx, "price_oracle", "price_rpc_url", "price_pair", "price_token", "price_twap_source", "price_twap_window")
+	if nil != err {
+		return nil, err
+	}
+
+	newOracle := func(name string) (PriceOracle, error) {
+		switch name {
+		case PriceOracleManual:
+			return &manualPriceOracle{registry: uuc.registry}, nil
+		case PriceOraclePair:
+			if "" == configs["price_rpc_url"] || "" == configs["price_pair"] || "" == configs["price_token"] {
+				return nil, errors.New(500, "ERROR", "未配置交易对")
+			}
+
+			return &pairPriceOracle{repo: uuc.priceRepo, rpcUrl: configs["price_rpc_url"], pair: configs["price_pair"], token: configs["price_token"]}, nil
+		}
+
+		return nil, errors.New(500, "ERROR", "价格来源不存在 "+name)
+	}
+
+	if PriceOracleTwap != configs["price_oracle"] {
+		return newOracle(configs["price_oracle"])
+	}
+
+	source, err := newOracle(configs["price_twap_source"])
+	if nil != err {
+		return nil, err
+	}
+	window, _ := strconv.ParseInt(configs["price_twap_window"], 10, 64)
+
+	return &twapPriceOracle{source: source, repo: uuc.priceRepo, window: time.Duration(window) * time.Minute}, nil
+}
+
+// priceStep 从current向target移动，每次最多变动stepMax%，为0时不限
+func priceStep(current int64, target int64, stepMax int64) int64 {
+	if 0 >= stepMax || 0 >= current {
+		return target
+	}
+
+	maxDelta := current * stepMax / 100
+	if 1 > maxDelta {
+		maxDelta = 1
+	}
+
+	if target > current+maxDelta {
+		return current + maxDelta
+	}
+	if target < current-maxDelta {
+		return current - maxDelta
+	}
+
+	return target
+}
+
+// PriceTickReport 一次价格更新的结果
+type PriceTickReport struct {
+	Source        string
+	Quote         float64
+	Current       int64
+	Target        int64
+	Next          int64
+	Scheduled     bool
+	PriceChangeId int64
+	Reason        string
+}
+
+// PriceTick 按价格来源计算目标b_price，每次最多变动price_step_max%，
+// 写入新的b_price和待重估的price_change，上一次变动未重估完时不更新
+func (uuc *UserUseCase) PriceTick(ctx context.Context, dryRun bool) (*PriceTickReport, error) {
+	var (
+		pending *PriceChange
+		oracle  PriceOracle
+		quote   *PriceQuote
+		values  map[string]int64
+		configs map[string]*Config
+		err     error
+	)
+
+	res := &PriceTickReport{}
+	pending, err = uuc.ubRepo.GetPriceChangeConfig(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if nil != pending {
+		res.Reason = "上一次价格变动未重估"
+		res.PriceChangeId = pending.ID
+		return res, nil
+	}
+
+	oracle, err = uuc.priceOracle(ctx)
+	if nil != err {
+		return nil, err
+	}
+	quote, err = oracle.Quote(ctx)
+	if nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "price quote", "oracle", oracle.Name(), "err", err)
+		return nil, err
+	}
+	res.Source = quote.Source
+	res.Quote = quote.Price
+
+	values, err = uuc.registry.Int64s(ctx, "b_price", "b_price_base", "price_step_max")
+	if nil != err {
+		return nil, err
+	}
+	res.Current = values["b_price"]
+	res.Target = int64(math.Round(quote.Price * float64(values["b_price_base"])))
+	if 0 >= res.Target {
+		return nil, errors.New(500, "ERROR", "价格错误")
+	}
+
+	res.Next = priceStep(res.Current, res.Target, values["price_step_max"])
+	if res.Next == res.Current {
+		res.Reason = "价格未变化"
+		return res, nil
+	}
+	if dryRun {
+		return res, nil
+	}
+
+	configs, err = uuc.registry.Configs(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if _, ok := configs["b_price"]; !ok {
+		return nil, errors.New(500, "ERROR", "配置不存在 b_price")
+	}
+
+	_, err = uuc.registry.Update(ctx, uuc.tx, &ConfigChange{
+		ConfigId: configs["b_price"].ID,
+		Value:    strconv.FormatInt(res.Next, 10),
+		Note:     "price " + quote.Source + " " + strconv.FormatFloat(quote.Price, 'f', -1, 64),
+		Then: func(ctx context.Context) error {
+			return uuc.configRepo.CreatePriceChangeConfig(ctx, res.Current, res.Next)
+		},
+	})
+	if nil != err {
+		return nil, err
+	}
+	res.Scheduled = true
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminPriceTick(ctx context.Context, req *v1.AdminPriceTickRequest) (*v1.AdminPriceTickReply, error) {
+	report, err := uuc.PriceTick(ctx, req.DryRun)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminPriceTickReply{
+		Source:        report.Source,
+		Quote:         strconv.FormatFloat(report.Quote, 'f', -1, 64),
+		Current:       report.Current,
+		Target:        report.Target,
+		Next:          report.Next,
+		Scheduled:     report.Scheduled,
+		PriceChangeId: report.PriceChangeId,
+		Reason:        report.Reason,
+	}, nil
+}
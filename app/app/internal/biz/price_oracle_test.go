@@ -0,0 +1,266 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// fakePriceFeedRepo 模拟交易对和价格记录
+type fakePriceFeedRepo struct {
+	reserves *PairReserves
+	err      error
+	samples  []*PriceSample
+}
+
+func (r *fakePriceFeedRepo) GetPairReserves(ctx context.Context, rpcUrl string, pair string) (*PairReserves, error) {
+	if nil != r.err {
+		return nil, r.err
+	}
+
+	return r.reserves, nil
+}
+
+func (r *fakePriceFeedRepo) CreatePriceSample(ctx context.Context, sample *PriceSample) error {
+	sample.ID = int64(len(r.samples) + 1)
+	sample.CreatedAt = time.Now()
+	r.samples = append(r.samples, sample)
+	return nil
+}
+
+func (r *fakePriceFeedRepo) GetPriceSamples(ctx context.Context, source string, from time.Time) ([]*PriceSample, error) {
+	res := make([]*PriceSample, 0)
+	for _, v := range r.samples {
+		if source == v.Source && !v.CreatedAt.Before(from) {
+			res = append(res, v)
+		}
+	}
+
+	return res, nil
+}
+
+type fakePriceOracle struct {
+	price float64
+	err   error
+}
+
+func (o *fakePriceOracle) Name() string {
+	return "fake"
+}
+
+func (o *fakePriceOracle) Quote(ctx context.Context) (*PriceQuote, error) {
+	if nil != o.err {
+		return nil, o.err
+	}
+
+	return &PriceQuote{Source: o.Name(), Price: o.price, At: time.Now()}, nil
+}
+
+func newFakePriceRegistry(configs map[string]string) *ConfigRegistry {
+	return NewConfigRegistry(&fakeConfigRepo{configs: configs}, &fakeConfigVersionRepo{}, &fakeConfigNotifier{})
+}
+
+// reserve 按精度放大
+func reserve(amount int64, decimals uint8) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+}
+
+func TestManualPriceOracle(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		price float64
+		err   bool
+	}{
+		{name: "录入的价格", value: "0.35", price: 0.35},
+		{name: "未录入", value: "0", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &manualPriceOracle{registry: newFakePriceRegistry(map[string]string{"price_manual": tt.value})}
+			quote, err := o.Quote(context.Background())
+			if tt.err {
+				if nil == err {
+					t.Fatal("want error")
+				}
+				return
+			}
+			if nil != err {
+				t.Fatal(err)
+			}
+			if tt.price != quote.Price || PriceOracleManual != quote.Source {
+				t.Fatalf("quote = %+v", quote)
+			}
+		})
+	}
+}
+
+func TestPairPriceOracle(t *testing.T) {
+	tests := []struct {
+		name     string
+		reserves *PairReserves
+		err      error
+		price    float64
+		wantErr  bool
+	}{
+		{
+			name:     "token在token0",
+			reserves: &PairReserves{Token0: "0xToken", Token1: "0xUsdt", Reserve0: reserve(1000, 18), Reserve1: reserve(500, 18), Decimals0: 18, Decimals1: 18},
+			price:    0.5,
+		},
+		{
+			name:     "token在token1，地址不区分大小写",
+			reserves: &PairReserves{Token0: "0xUsdt", Token1: "0xtoken", Reserve0: reserve(3000, 18), Reserve1: reserve(1000, 18), Decimals0: 18, Decimals1: 18},
+			price:    3,
+		},
+		{
+			name:     "两边精度不同",
+			reserves: &PairReserves{Token0: "0xToken", Token1: "0xUsdt", Reserve0: reserve(200, 8), Reserve1: reserve(50, 6), Decimals0: 8, Decimals1: 6},
+			price:    0.25,
+		},
+		{
+			name:     "交易对不包含该币",
+			reserves: &PairReserves{Token0: "0xOther", Token1: "0xUsdt", Reserve0: reserve(1, 18), Reserve1: reserve(1, 18)},
+			wantErr:  true,
+		},
+		{
+			name:     "储备量为0",
+			reserves: &PairReserves{Token0: "0xToken", Token1: "0xUsdt", Reserve0: big.NewInt(0), Reserve1: reserve(1, 18)},
+			wantErr:  true,
+		},
+		{
+			name:    "读取链上失败",
+			err:     errors.New(500, "ERROR", "rpc"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &pairPriceOracle{repo: &fakePriceFeedRepo{reserves: tt.reserves, err: tt.err}, pair: "0xPair", token: "0xToken"}
+			quote, err := o.Quote(context.Background())
+			if tt.wantErr {
+				if nil == err {
+					t.Fatal("want error")
+				}
+				return
+			}
+			if nil != err {
+				t.Fatal(err)
+			}
+			if 1e-9 < math.Abs(tt.price-quote.Price) || PriceOraclePair != quote.Source {
+				t.Fatalf("quote = %+v, want %v", quote, tt.price)
+			}
+		})
+	}
+}
+
+func TestTwapPriceOracle(t *testing.T) {
+	now := time.Now()
+	repo := &fakePriceFeedRepo{samples: []*PriceSample{
+		{ID: 1, Source: "fake", Price: 9, CreatedAt: now.Add(-2 * time.Hour)}, // 窗口外
+		{ID: 2, Source: "fake", Price: 1, CreatedAt: now.Add(-30 * time.Minute)},
+		{ID: 3, Source: "other", Price: 9, CreatedAt: now.Add(-20 * time.Minute)},
+		{ID: 4, Source: "fake", Price: 2, CreatedAt: now.Add(-10 * time.Minute)},
+	}}
+	o := &twapPriceOracle{source: &fakePriceOracle{price: 4}, repo: repo, window: time.Hour}
+
+	quote, err := o.Quote(context.Background())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// 1持续20分钟，2持续10分钟，本次报价刚记录
+	if 1e-3 < math.Abs(quote.Price-4.0/3) || "twap_fake" != quote.Source {
+		t.Fatalf("quote = %+v", quote)
+	}
+	if 5 != len(repo.samples) || 4 != repo.samples[4].Price {
+		t.Fatalf("samples = %d", len(repo.samples))
+	}
+
+	// 来源报价失败时不记录
+	o.source = &fakePriceOracle{err: errors.New(500, "ERROR", "quote")}
+	if _, err = o.Quote(context.Background()); nil == err || 5 != len(repo.samples) {
+		t.Fatalf("err = %v, samples = %d", err, len(repo.samples))
+	}
+}
+
+func TestTwapPrice(t *testing.T) {
+	now := time.Now()
+	if res := twapPrice(nil, now, 7); 7 != res {
+		t.Fatalf("没有样本 = %v", res)
+	}
+
+	samples := []*PriceSample{
+		{Price: 1, CreatedAt: now.Add(-3 * time.Minute)},
+		{Price: 4, CreatedAt: now.Add(-1 * time.Minute)},
+	}
+	if res := twapPrice(samples, now, 0); 2 != res {
+		t.Fatalf("加权平均 = %v", res)
+	}
+}
+
+func TestPriceOracleConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		configs map[string]string
+		oracle  string
+		wantErr bool
+	}{
+		{name: "默认后台录入", configs: map[string]string{}, oracle: PriceOracleManual},
+		{name: "交易对", configs: map[string]string{"price_oracle": "pair", "price_pair": "0xPair", "price_token": "0xToken"}, oracle: PriceOraclePair},
+		{name: "交易对未配置", configs: map[string]string{"price_oracle": "pair"}, wantErr: true},
+		{name: "交易对的时间加权", configs: map[string]string{"price_oracle": "twap", "price_pair": "0xPair", "price_token": "0xToken"}, oracle: "twap_pair"},
+		{name: "后台录入的时间加权", configs: map[string]string{"price_oracle": "twap", "price_twap_source": "manual"}, oracle: "twap_manual"},
+		{name: "来源不存在", configs: map[string]string{"price_oracle": "unknown"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuc := &UserUseCase{registry: newFakePriceRegistry(tt.configs), priceRepo: &fakePriceFeedRepo{}}
+			oracle, err := uuc.priceOracle(context.Background())
+			if tt.wantErr {
+				if nil == err {
+					t.Fatal("want error")
+				}
+				return
+			}
+			if nil != err {
+				t.Fatal(err)
+			}
+			if tt.oracle != oracle.Name() {
+				t.Fatalf("oracle = %s, want %s", oracle.Name(), tt.oracle)
+			}
+		})
+	}
+}
+
+func TestPriceStep(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int64
+		target  int64
+		stepMax int64
+		next    int64
+	}{
+		{name: "不限制", current: 1000, target: 3000, stepMax: 0, next: 3000},
+		{name: "没有当前价", current: 0, target: 3000, stepMax: 10, next: 3000},
+		{name: "上涨超过限制", current: 1000, target: 3000, stepMax: 10, next: 1100},
+		{name: "下跌超过限制", current: 1000, target: 100, stepMax: 10, next: 900},
+		{name: "限制内", current: 1000, target: 1050, stepMax: 10, next: 1050},
+		{name: "最少变动1", current: 5, target: 9, stepMax: 10, next: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if next := priceStep(tt.current, tt.target, tt.stepMax); tt.next != next {
+				t.Fatalf("next = %d, want %d", next, tt.next)
+			}
+		})
+	}
+}
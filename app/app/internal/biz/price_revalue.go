@@ -0,0 +1,568 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 价格重估运行状态
+const (
+	PriceRevalueRunning   = "running"
+	PriceRevalueCompleted = "completed"
+	PriceRevalueFailed    = "failed"
+)
+
+// 重估条目状态，失败的在下次运行时重试
+const (
+	PriceRevalueItemDone   = "done"
+	PriceRevalueItemFailed = "failed"
+)
+
+// 运行中超过该时间没有进度视为进程中断，允许接管
+const priceRevalueStaleAfter = time.Hour
+
+// 没有变动的用户每隔多少个保存一次进度
+const priceRevalueCursorEvery = 100
+
+// PriceRevalueRun 一条价格变动的重估运行，LastUserId之前的用户都已处理，
+// 全部用户重估成功后与price_change状态在同一事务中完成
+type PriceRevalueRun struct {
+	ID            int64
+	PriceChangeId int64
+	Status        string
+	LastUserId    int64 // 已处理到的用户id
+	Users         int64
+	Up            int64
+	Down          int64
+	Stopped       int64
+	Amount        int64
+	Failed        int64
+	Error         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// PriceRevalueItem 一个用户的重估结果，与占位修改在同一事务中写入
+type PriceRevalueItem struct {
+	ID         int64
+	RunId      int64
+	UserId     int64
+	LocationId int64
+	Direction  string // up down
+	Amount     int64
+	Stopped    bool
+	Status     string
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type PriceRevalueRepo interface {
+	CreatePriceRevalueRun(ctx context.Context, priceChangeId int64) (*PriceRevalueRun, error)
+	GetPriceRevalueRun(ctx context.Context, priceChangeId int64) (*PriceRevalueRun, error)
+	UpdatePriceRevalueRun(ctx context.Context, run *PriceRevalueRun, from string) (bool, error)
+	UpdatePriceRevalueCursor(ctx context.Context, id int64, cursor int64) error
+	SavePriceRevalueItem(ctx context.Context, item *PriceRevalueItem) error
+	GetPriceRevalueItems(ctx context.Context, runId int64) ([]*PriceRevalueItem, error)
+}
+
+// PriceRevalueReport 一次价格变动重估的结果，非DryRun时为整个运行的汇总
+type PriceRevalueReport struct {
+	PriceChangeId int64
+	Origin        int64
+	Price         int64
+	DryRun        bool
+	RunId         int64
+	Status        string
+	Resumed       bool  // 从上次中断或失败处继续
+	Retried       int64 // 本次重试的失败用户
+	Users         int64 // 有变动的用户
+	Up            int64
+	Down          int64
+	Stopped       int64
+	Amount        int64 // 占位额度变动合计，降价为负
+	Failed        []int64
+}
+
+// priceRevaluePlan 一个用户待执行的重估
+type priceRevaluePlan struct {
+	Location  *LocationNew
+	Direction string
+	Amount    int64
+	Status    string
+	StopDate  time.Time
+}
+
+// priceRevalueAmount 用户dhb余额按新旧价格计算的占位额度变动，降价为负
+func priceRevalueAmount(balanceDhb int64, bPriceBase int64, origin int64, price int64) int64 {
+	if price > origin {
+		return (balanceDhb*100/bPriceBase*price - balanceDhb*100/bPriceBase*origin) / 100
+	}
+
+	return -((balanceDhb*100/bPriceBase*origin - balanceDhb*100/bPriceBase*price) / 100)
+}
+
+// RevaluePriceChange 按最早一条未处理的价格变动，重估有运行中占位用户的dhb余额，
+// 涨价计入占位额度，降价扣减占位额度。DryRun时只计算不修改。
+// 每个用户一个事务并记录进度，中断后再次调用从进度处继续，并重试失败的用户
+func (uuc *UserUseCase) RevaluePriceChange(ctx context.Context, dryRun bool) (*PriceRevalueReport, error) {
+	var (
+		priceChange *PriceChange
+		configs     map[string]int64
+		users       []*User
+		err         error
+	)
+
+	res := &PriceRevalueReport{DryRun: dryRun, Failed: make([]int64, 0)}
+	priceChange, err = uuc.ubRepo.GetPriceChangeConfig(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if nil == priceChange {
+		return res, nil
+	}
+	res.PriceChangeId = priceChange.ID
+	res.Origin = priceChange.Origin
+	res.Price = priceChange.Price
+
+	if 0 >= priceChange.Price {
+		return nil, errors.New(500, "ERROR", "价格错误")
+	}
+
+	configs, err = uuc.registry.Int64s(ctx, "b_price_base", "exchange_rate")
+	if nil != err {
+		return nil, err
+	}
+	bPriceBase := configs["b_price_base"]
+	feeRate := configs["exchange_rate"]
+
+	users, err = uuc.repo.GetAllUsers(ctx)
+	if nil != err {
+		return nil, err
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	if dryRun {
+		for _, v := range users {
+			var plan *priceRevaluePlan
+			plan, err = uuc.planPriceRevalue(ctx, v.ID, priceChange, bPriceBase)
+			if nil != err {
+				uuc.log.WithContext(ctx).Errorw("msg", "price revalue plan", "price_change_id", priceChange.ID, "user_id", v.ID, "err", err)
+				res.Failed = append(res.Failed, v.ID)
+				continue
+			}
+			if nil == plan {
+				continue
+			}
+
+			res.Users++
+			if "up" == plan.Direction {
+				res.Up++
+				res.Amount += plan.Amount
+			} else {
+				res.Down++
+				res.Amount -= plan.Amount
+			}
+			if "stop" == plan.Status {
+				res.Stopped++
+			}
+		}
+
+		return res, nil
+	}
+
+	var (
+		run   *PriceRevalueRun
+		items []*PriceRevalueItem
+	)
+	run, items, err = uuc.beginPriceRevalue(ctx, priceChange.ID)
+	if nil != err {
+		return nil, err
+	}
+	res.RunId = run.ID
+	res.Resumed = 0 < run.LastUserId || 0 < len(items)
+
+	// 先重试上次失败的用户，再从进度处继续
+	done := make(map[int64]bool, 0)
+	retry := make([]int64, 0)
+	for _, v := range items {
+		if PriceRevalueItemDone == v.Status {
+			done[v.UserId] = true
+		} else {
+			retry = append(retry, v.UserId)
+		}
+	}
+	res.Retried = int64(len(retry))
+
+	process := func(userId int64, cursor int64) bool {
+		if done[userId] {
+			return false
+		}
+
+		plan, err := uuc.planPriceRevalue(ctx, userId, priceChange, bPriceBase)
+		if nil == err && nil == plan {
+			if 0 < cursor {
+				return false
+			}
+
+			// 重试时已没有变动，覆盖失败记录
+			err = uuc.priceRevalueRepo.SavePriceRevalueItem(ctx, &PriceRevalueItem{RunId: run.ID, UserId: userId, Status: PriceRevalueItemDone})
+		} else if nil == err {
+			item := &PriceRevalueItem{
+				RunId:      run.ID,
+				UserId:     userId,
+				LocationId: plan.Location.ID,
+				Direction:  plan.Direction,
+				Amount:     plan.Amount,
+				Stopped:    "stop" == plan.Status,
+				Status:     PriceRevalueItemDone,
+			}
+			// plan在事务外算好，事务内只按plan写入，死锁时可以重新执行
+			err = uuc.tx.ExecTxRetry(ctx, func(ctx context.Context) error { // 事务
+				err := uuc.applyPriceRevalue(ctx, userId, plan, feeRate)
+				if nil != err {
+					return err
+				}
+
+				err = uuc.priceRevalueRepo.SavePriceRevalueItem(ctx, item)
+				if nil != err {
+					return err
+				}
+
+				if 0 < cursor {
+					return uuc.priceRevalueRepo.UpdatePriceRevalueCursor(ctx, run.ID, cursor)
+				}
+
+				return nil
+			})
+		}
+
+		if nil != err {
+			uuc.log.WithContext(ctx).Errorw("msg", "price change", "price_change_id", priceChange.ID, "user_id", userId, "err", err)
+			tmpErr := err.Error()
+			if 500 < len(tmpErr) {
+				tmpErr = tmpErr[:500]
+			}
+
+			err = uuc.priceRevalueRepo.SavePriceRevalueItem(ctx, &PriceRevalueItem{RunId: run.ID, UserId: userId, Status: PriceRevalueItemFailed, Error: tmpErr})
+			if nil != err {
+				uuc.log.WithContext(ctx).Errorw("msg", "price revalue item", "price_change_id", priceChange.ID, "user_id", userId, "err", err)
+			}
+		}
+		done[userId] = true
+
+		return true
+	}
+
+	for _, userId := range retry {
+		process(userId, 0)
+	}
+
+	var skipped int64
+	cursor := run.LastUserId
+	for _, v := range users {
+		if v.ID <= run.LastUserId {
+			continue
+		}
+
+		cursor = v.ID
+		if process(v.ID, cursor) {
+			skipped = 0
+			continue
+		}
+
+		skipped++
+		if priceRevalueCursorEvery <= skipped {
+			skipped = 0
+			err = uuc.priceRevalueRepo.UpdatePriceRevalueCursor(ctx, run.ID, cursor)
+			if nil != err {
+				uuc.log.WithContext(ctx).Warnw("msg", "price revalue cursor", "price_change_id", priceChange.ID, "err", err)
+			}
+		}
+	}
+
+	return uuc.finishPriceRevalue(ctx, res, run, cursor)
+}
+
+// beginPriceRevalue 取得价格变动的重估运行，同一价格变动只有一个运行，
+// 运行中的拒绝执行，失败或中断的接管续跑
+func (uuc *UserUseCase) beginPriceRevalue(ctx context.Context, priceChangeId int64) (*PriceRevalueRun, []*PriceRevalueItem, error) {
+	var (
+		run   *PriceRevalueRun
+		items []*PriceRevalueItem
+		ok    bool
+		err   error
+	)
+
+	run, err = uuc.priceRevalueRepo.GetPriceRevalueRun(ctx, priceChangeId)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	if nil == run {
+		run, err = uuc.priceRevalueRepo.CreatePriceRevalueRun(ctx, priceChangeId)
+		if nil != err {
+			// 并发创建时唯一索引冲突
+			return nil, nil, errors.New(500, "ERROR", "价格重估正在运行")
+		}
+
+		return run, make([]*PriceRevalueItem, 0), nil
+	}
+
+	switch run.Status {
+	case PriceRevalueCompleted:
+		return nil, nil, errors.New(500, "ERROR", "该价格变动已重估")
+	case PriceRevalueRunning:
+		if time.Since(run.UpdatedAt) < priceRevalueStaleAfter {
+			return nil, nil, errors.New(500, "ERROR", "价格重估正在运行")
+		}
+	}
+
+	from := run.Status
+	run.Status = PriceRevalueRunning
+	ok, err = uuc.priceRevalueRepo.UpdatePriceRevalueRun(ctx, run, from)
+	if nil != err {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, errors.New(500, "ERROR", "价格重估正在运行")
+	}
+
+	items, err = uuc.priceRevalueRepo.GetPriceRevalueItems(ctx, run.ID)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	return run, items, nil
+}
+
+// finishPriceRevalue 按全部条目汇总，没有失败的用户时与price_change状态一起完成
+func (uuc *UserUseCase) finishPriceRevalue(ctx context.Context, res *PriceRevalueReport, run *PriceRevalueRun, cursor int64) (*PriceRevalueReport, error) {
+	items, err := uuc.priceRevalueRepo.GetPriceRevalueItems(ctx, run.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	run.LastUserId = cursor
+	run.Users, run.Up, run.Down, run.Stopped, run.Amount, run.Failed = 0, 0, 0, 0, 0, 0
+	failed := make([]string, 0)
+	for _, v := range items {
+		if PriceRevalueItemFailed == v.Status {
+			run.Failed++
+			res.Failed = append(res.Failed, v.UserId)
+			failed = append(failed, fmt.Sprintf("%d:%s", v.UserId, v.Error))
+			continue
+		}
+		if 0 >= v.Amount && !v.Stopped {
+			continue
+		}
+
+		run.Users++
+		if "up" == v.Direction {
+			run.Up++
+			run.Amount += v.Amount
+		} else {
+			run.Down++
+			run.Amount -= v.Amount
+		}
+		if v.Stopped {
+			run.Stopped++
+		}
+	}
+
+	run.Error = ""
+	run.Status = PriceRevalueCompleted
+	if 0 < run.Failed {
+		run.Status = PriceRevalueFailed
+		run.Error = strings.Join(failed, ";")
+		if 500 < len(run.Error) {
+			run.Error = run.Error[:500]
+		}
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		ok, err := uuc.priceRevalueRepo.UpdatePriceRevalueRun(ctx, run, PriceRevalueRunning)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return errors.New(500, "ERROR", "价格重估已被接管")
+		}
+
+		if PriceRevalueCompleted == run.Status {
+			_, err = uuc.configRepo.UpdatePriceChangeStatus(ctx, run.PriceChangeId, 1)
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		return nil, err
+	}
+
+	res.Status = run.Status
+	res.Users = run.Users
+	res.Up = run.Up
+	res.Down = run.Down
+	res.Stopped = run.Stopped
+	res.Amount = run.Amount
+
+	return res, nil
+}
+
+// planPriceRevalue 计算用户的重估，没有运行中的占位或没有变动时为nil
+func (uuc *UserUseCase) planPriceRevalue(ctx context.Context, userId int64, priceChange *PriceChange, bPriceBase int64) (*priceRevaluePlan, error) {
+	runningLocation, err := uuc.locationRepo.GetMyLocationLastRunning(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == runningLocation {
+		return nil, nil
+	}
+
+	userBalance, err := uuc.ubRepo.GetUserBalance(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	tmp := priceRevalueAmount(userBalance.BalanceDhb, bPriceBase, priceChange.Origin, priceChange.Price)
+	if 0 < tmp {
+		// 涨价，占位分红人分满停止
+		res := &priceRevaluePlan{Location: runningLocation, Direction: "up", Amount: tmp, Status: "running", StopDate: runningLocation.StopDate}
+		if runningLocation.Current+tmp >= runningLocation.CurrentMax {
+			res.Status = "stop"
+			res.StopDate = time.Now().UTC().Add(8 * time.Hour)
+			res.Amount = runningLocation.CurrentMax - runningLocation.Current
+		}
+
+		return res, nil
+	}
+
+	// 降价，最多扣到0
+	tmp = -tmp
+	if runningLocation.Current <= tmp {
+		tmp = runningLocation.Current
+	}
+	if 0 >= tmp {
+		return nil, nil
+	}
+
+	return &priceRevaluePlan{Location: runningLocation, Direction: "down", Amount: tmp, Status: runningLocation.Status}, nil
+}
+
+// applyPriceRevalue 在调用方的事务中执行
+func (uuc *UserUseCase) applyPriceRevalue(ctx context.Context, userId int64, plan *priceRevaluePlan, feeRate int64) error {
+	var err error
+	runningLocation := plan.Location
+
+	if "down" == plan.Direction {
+		err = uuc.locationRepo.UpdateLocationNewNewNew(ctx, runningLocation.ID, plan.Amount) // 分红占位数据修改
+		if nil != err {
+			return err
+		}
+
+		err = uuc.events.Change(ctx, LocationEventPrice, LocationTableNew, runningLocation, runningLocation.Status, runningLocation.Current-plan.Amount, runningLocation.CurrentMax, "price_change_down")
+		if nil != err {
+			return err
+		}
+
+		return uuc.ubRepo.PriceChange(ctx, runningLocation.UserId, plan.Amount, "down")
+	}
+
+	if 0 < plan.Amount {
+		var tmpMaxNew int64
+		if runningLocation.CurrentMax >= runningLocation.CurrentMaxNew {
+			tmpMaxNew = runningLocation.CurrentMax - runningLocation.CurrentMaxNew
+		}
+		err = uuc.locationRepo.UpdateLocationNewNew(ctx, runningLocation.ID, plan.Status, plan.Amount, tmpMaxNew, 0, plan.StopDate) // 分红占位数据修改
+		if nil != err {
+			return err
+		}
+
+		err = uuc.events.Change(ctx, locationChangeEvent(LocationEventPrice, runningLocation, plan.Status), LocationTableNew, runningLocation, plan.Status, runningLocation.Current+plan.Amount, runningLocation.CurrentMax, "price_change_up")
+		if nil != err {
+			return err
+		}
+
+		err = uuc.ubRepo.PriceChange(ctx, runningLocation.UserId, plan.Amount, "up")
+		if nil != err {
+			return err
+		}
+	}
+
+	// 业绩减掉
+	if "stop" == plan.Status {
+		if runningLocation.CurrentMax >= runningLocation.CurrentMaxNew {
+			_, err = uuc.ubRepo.ExchangeBiw(ctx, userId, runningLocation.CurrentMax-runningLocation.CurrentMaxNew, feeRate)
+			if nil != err {
+				return err
+			}
+		}
+
+		err = addLocationTreeTotal(ctx, uuc.treeRepo, uuc.locationRepo, runningLocation, -runningLocation.Usdt/100000)
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AdminPriceRevalue 价格变动的重估进度和每个用户的结果
+func (uuc *UserUseCase) AdminPriceRevalue(ctx context.Context, req *v1.AdminPriceRevalueRequest) (*v1.AdminPriceRevalueReply, error) {
+	var (
+		run   *PriceRevalueRun
+		items []*PriceRevalueItem
+		err   error
+	)
+
+	run, err = uuc.priceRevalueRepo.GetPriceRevalueRun(ctx, req.PriceChangeId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == run {
+		return nil, errors.New(500, "ERROR", "该价格变动未重估")
+	}
+
+	items, err = uuc.priceRevalueRepo.GetPriceRevalueItems(ctx, run.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminPriceRevalueReply{
+		RunId:         run.ID,
+		PriceChangeId: run.PriceChangeId,
+		Status:        run.Status,
+		LastUserId:    run.LastUserId,
+		Users:         run.Users,
+		Up:            run.Up,
+		Down:          run.Down,
+		Stopped:       run.Stopped,
+		Amount:        fmt.Sprintf("%.2f", float64(run.Amount)/float64(100000)),
+		Failed:        run.Failed,
+		Error:         run.Error,
+		CreatedAt:     run.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		UpdatedAt:     run.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		Items:         make([]*v1.AdminPriceRevalueReply_Item, 0),
+	}
+	for _, v := range items {
+		res.Items = append(res.Items, &v1.AdminPriceRevalueReply_Item{
+			UserId:     v.UserId,
+			LocationId: v.LocationId,
+			Direction:  v.Direction,
+			Amount:     fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Stopped:    v.Stopped,
+			Status:     v.Status,
+			Error:      v.Error,
+			UpdatedAt:  v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
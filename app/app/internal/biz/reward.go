@@ -0,0 +1,867 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/metrics"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 奖励类型，决定发放时调用的写入方法
+const (
+	RewardKindLocation      = "location"       // 静态分红
+	RewardKindRecommend     = "recommend"      // 推荐分红
+	RewardKindArea          = "area"           // 区域分红
+	RewardKindFour          = "four"           // 直推入金前四名
+	RewardKindFirst         = "first"          // 认购奖励
+	RewardKindSecond        = "second"         // 节点，超级节点
+	RewardKindBalance       = "balance"        // 余额分红
+	RewardKindRecommendArea = "recommend_area" // 伞下业绩分红
+	RewardKindVip           = "vip"            // 手动设置的vip手续费分红
+	RewardKindVipLocation   = "vip_location"   // vip手续费分红
+)
+
+// 奖励运行状态
+const (
+	RewardRunRunning    = "running"
+	RewardRunCompleted  = "completed"
+	RewardRunFailed     = "failed"
+	RewardRunRolledBack = "rolled_back"
+)
+
+// 运行中超过该时间未更新视为进程中断，允许接管
+const rewardRunStaleAfter = time.Hour
+
+// 占位表
+const (
+	LocationTableNew  = "location_new"
+	LocationTableNew2 = "location_new_2"
+)
+
+// RewardIntent 一笔待发放的奖励，由策略计算，执行器落库
+type RewardIntent struct {
+	Kind        string
+	UserId      int64
+	Location    *LocationNew // 为空时不计入占位额度
+	Amount      int64        // 计入占位额度的金额
+	AmountFloat float64      // 不计入占位额度的奖励
+	Num         int64        // 代数，区域，名次
+	Reason      string
+	RecordId    int64
+	PrevDate    time.Time // 余额分红发放前的上次发放日期，回滚时恢复
+}
+
+// RewardRun 一个任务在一个业务日的运行记录
+type RewardRun struct {
+	ID           int64
+	Job          string
+	BusinessDate string
+	Status       string
+	Count        int64
+	Amount       int64
+	Error        string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// RewardRunItem 已发放的一笔奖励，带运行id
+type RewardRunItem struct {
+	ID            int64
+	RunId         int64
+	ItemKey       string
+	Kind          string
+	UserId        int64
+	LocationId    int64
+	LocationTable string
+	Num           int64
+	Amount        int64
+	AmountFloat   float64
+	Charge        int64
+	Paid          int64
+	AmountB       int64
+	Usdt          int64
+	Coin          int64
+	MaxNew        int64
+	PrevStatus    string
+	Status        string
+	RecordId      int64
+	PrevDate      time.Time // 零值为旧数据，没有记录
+	CreatedAt     time.Time
+}
+
+type RewardRunRepo interface {
+	CreateRewardRun(ctx context.Context, job string, businessDate string) (*RewardRun, error)
+	GetRewardRun(ctx context.Context, job string, businessDate string) (*RewardRun, error)
+	UpdateRewardRunStatus(ctx context.Context, id int64, from string, status string, errMsg string) (bool, error)
+	AddRewardRunTotal(ctx context.Context, id int64, count int64, amount int64) error
+	CreateRewardRunItem(ctx context.Context, item *RewardRunItem) error
+	GetRewardRunItemKeys(ctx context.Context, runId int64) (map[string]bool, error)
+	GetRewardRunById(ctx context.Context, id int64) (*RewardRun, error)
+	GetRewardRuns(ctx context.Context, job string, startDate string, endDate string) ([]*RewardRun, error)
+	GetRewardRunItems(ctx context.Context, runId int64) ([]*RewardRunItem, error)
+	GetRewardRunExchanges(ctx context.Context, runId int64) (map[int64]int64, error)
+	RevertRewardRunLocation(ctx context.Context, item *RewardRunItem) error
+	RevertRewardRunBalance(ctx context.Context, item *RewardRunItem, exchanged bool) error
+	RevertRewardRunExchange(ctx context.Context, userId int64, amount int64) error
+	RevertBalanceRewardDate(ctx context.Context, id int64, date time.Time) error
+	DeleteRewardRunRecords(ctx context.Context, runId int64) (int64, int64, error)
+	RollbackRewardRun(ctx context.Context, id int64, from string, reason string) error
+	LockRewardBalances(ctx context.Context, userIds ...int64) (map[int64]*RewardBalance, error)
+	ApplyRewardBatch(ctx context.Context, batch *RewardBatch) (int64, error)
+}
+
+// RewardPolicy 每日奖励策略，根据快照计算待发放的奖励
+type RewardPolicy interface {
+	// Name 任务名称
+	Name() string
+	// ConfigKeys 策略用到的配置
+	ConfigKeys() []string
+	// Stages 阶段数，后一阶段能看到前一阶段发放后的占位状态
+	Stages() int
+	// Intents 计算某一阶段的待发放奖励
+	Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error)
+}
+
+// RewardDayPolicy 按Day选择发放哪一天数据的策略，运行记录按实际发放的那天区分
+type RewardDayPolicy interface {
+	// DayOffset 相对默认运行多发的天数，默认发放昨天的数据时为0
+	DayOffset(day int64) int
+}
+
+// RewardRunOption 运行参数，DryRun只计算不落库
+type RewardRunOption struct {
+	Date   string
+	Day    int64
+	DryRun bool
+}
+
+// RewardReport 运行报告
+type RewardReport struct {
+	Job         string
+	DryRun      bool
+	RunId       int64
+	Status      string
+	Resumed     int64 // 续跑时跳过的已发放条目
+	Excluded    int64 // 占位产品不参与本任务跳过的条目
+	Count       int64
+	Budget      int64 // 奖励池
+	Intended    int64 // 出局截断前的应发
+	Amount      int64 // 本次实发
+	AmountFloat float64
+	Users       []*RewardReportUser
+	Vips        []*RewardReportVip
+	Stopped     []int64 // 本次出局的占位
+	Chunks      int64   // 落库批次
+	Statements  int64   // 执行的sql语句数
+	Elapsed     time.Duration
+	Error       string // 失败原因，Status为failed时有值
+}
+
+type RewardReportUser struct {
+	UserId      int64
+	Address     string
+	Vip         int64
+	Amount      int64
+	AmountFloat float64
+}
+
+type RewardReportVip struct {
+	Vip         int64
+	Count       int64
+	Amount      int64
+	AmountFloat float64
+}
+
+// RewardSnapshot 一次运行中共享的配置和占位状态
+type RewardSnapshot struct {
+	Job       string
+	Date      string
+	Day       int64
+	DryRun    bool
+	Now       time.Time
+	Budget    int64 // 奖励池，策略未设置时为应发总额
+	configs   map[string]string
+	locations map[string]*LocationNew
+	products  map[int64]*LocationProduct
+	running   []*LocationNew
+	loaded    bool
+}
+
+// rewardSettlement 按出局规则结算后的金额
+type rewardSettlement struct {
+	Charge     int64 // 计入占位
+	Paid       int64 // 实发
+	AmountB    int64 // 折算b
+	Usdt       int64
+	Coin       int64
+	MaxNew     int64
+	PrevStatus string
+	Status     string
+	StopDate   time.Time
+	Skip       bool
+}
+
+// 执行器用到的配置
+var rewardExecutorConfigKeys = []string{
+	"b_price", "b_price_base", "exchange_rate", "reward_rate", "coin_reward_rate", "coin_price",
+}
+
+func (s *RewardSnapshot) Int64(key string) int64 {
+	v, _ := strconv.ParseInt(s.configs[key], 10, 64)
+	return v
+}
+
+func (s *RewardSnapshot) Float64(key string) float64 {
+	v, _ := strconv.ParseFloat(s.configs[key], 10)
+	return v
+}
+
+// Track 返回本次运行中跟踪的占位，同一占位多次发放看到的是同一份状态
+func (s *RewardSnapshot) Track(table string, location *LocationNew) *LocationNew {
+	if nil == location {
+		return nil
+	}
+
+	key := table + ":" + strconv.FormatInt(location.ID, 10)
+	if tracked, ok := s.locations[key]; ok {
+		return tracked
+	}
+
+	s.locations[key] = location
+	return location
+}
+
+// Locations 运行开始时的运行中占位
+func (s *RewardSnapshot) Locations(ctx context.Context, repo LocationRepo) ([]*LocationNew, error) {
+	if s.loaded {
+		return s.running, nil
+	}
+
+	locations, err := repo.GetRunningLocations(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	s.running = make([]*LocationNew, 0, len(locations))
+	for _, v := range locations {
+		s.running = append(s.running, s.Track(LocationTableNew, v))
+	}
+	s.loaded = true
+
+	return s.running, nil
+}
+
+// RunningLocations 当前仍在运行中的占位
+func (s *RewardSnapshot) RunningLocations(ctx context.Context, repo LocationRepo) ([]*LocationNew, error) {
+	locations, err := s.Locations(ctx, repo)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]*LocationNew, 0, len(locations))
+	for _, v := range locations {
+		if "running" == v.Status {
+			res = append(res, v)
+		}
+	}
+
+	return res, nil
+}
+
+// rewardPolicies 已注册的奖励策略，新增奖励类型在这里注册即可通过AdminRewardRun运行
+func (uuc *UserUseCase) rewardPolicies() map[string]RewardPolicy {
+	policies := []RewardPolicy{
+		&balanceRewardPolicy{uuc: uuc},
+		&buyRewardPolicy{uuc: uuc},
+		&locationRewardPolicy{uuc: uuc},
+		&areaRewardPolicy{uuc: uuc},
+		&vipRewardPolicy{uuc: uuc},
+		&recommendAreaRewardPolicy{uuc: uuc},
+	}
+
+	res := make(map[string]RewardPolicy, len(policies))
+	for _, v := range policies {
+		res[v.Name()] = v
+	}
+
+	return res
+}
+
+// RunRewardPolicy 按名称运行奖励策略
+func (uuc *UserUseCase) RunRewardPolicy(ctx context.Context, job string, opt *RewardRunOption) (*RewardReport, error) {
+	policy, ok := uuc.rewardPolicies()[job]
+	if !ok {
+		return nil, errors.New(500, "ERROR", "奖励任务不存在")
+	}
+
+	return uuc.ExecuteRewardPolicy(ctx, policy, opt)
+}
+
+// ExecuteRewardPolicy 逐阶段计算奖励，按出局规则逐笔落库，DryRun时只在快照中模拟
+func (uuc *UserUseCase) ExecuteRewardPolicy(ctx context.Context, policy RewardPolicy, opt *RewardRunOption) (*RewardReport, error) {
+	var (
+		err error
+	)
+
+	if nil == opt {
+		opt = &RewardRunOption{}
+	}
+
+	// 策略按当天的数据结算，指定日期只用于试算，实际发放时换个日期会重复发放
+	if !opt.DryRun && "" != opt.Date {
+		return nil, errors.New(500, "ERROR", "只有试算可以指定日期")
+	}
+
+	s := &RewardSnapshot{
+		Job:       policy.Name(),
+		Date:      opt.Date,
+		Day:       opt.Day,
+		DryRun:    opt.DryRun,
+		Now:       time.Now(),
+		locations: make(map[string]*LocationNew, 0),
+		products:  make(map[int64]*LocationProduct, 0),
+	}
+
+	keys := append(policy.ConfigKeys(), rewardExecutorConfigKeys...)
+	s.configs, err = uuc.registry.ValuesAt(ctx, rewardConfigTime(rewardBusinessDate(opt, s.Now)), keys...) // 按业务日生效的配置
+	if nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "分红,配置", "job", policy.Name(), "err", err)
+		return nil, err
+	}
+
+	// 占位产品，按产品限制可参与的奖励任务
+	var products []*LocationProduct
+	products, err = uuc.productRepo.GetLocationProducts(ctx)
+	if nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "分红,占位产品", "job", policy.Name(), "err", err)
+		return nil, err
+	}
+	for _, v := range products {
+		s.products[v.ID] = v
+	}
+
+	res := &RewardReport{Job: policy.Name(), DryRun: opt.DryRun, Stopped: make([]int64, 0)}
+
+	// 同一任务同一业务日只发放一次，失败的运行跳过已发放的条目续跑
+	var (
+		run  *RewardRun
+		done = make(map[string]bool, 0)
+	)
+	if !s.DryRun {
+		run, done, err = uuc.beginRewardRun(ctx, policy.Name(), rewardRunDate(policy, opt, s.Now))
+		if nil != err {
+			return nil, err
+		}
+		res.RunId = run.ID
+		res.Status = run.Status
+	}
+
+	var (
+		failed  []string
+		pending []*rewardPending
+		shadows = make(map[*LocationNew]*LocationNew, 0) // 本批未落库的占位状态
+		broken  bool
+	)
+	users := make(map[int64]*RewardReportUser, 0)
+	occurrence := make(map[string]int64, 0)
+	start := time.Now()
+
+	// flush 一批在一个事务中落库，失败时停止本次运行，续跑时从失败的批次开始
+	flush := func() {
+		if 0 >= len(pending) {
+			return
+		}
+
+		batch := pending
+		pending = make([]*rewardPending, 0, rewardBatchSize)
+		shadows = make(map[*LocationNew]*LocationNew, 0)
+		res.Chunks++
+
+		if !s.DryRun {
+			var (
+				statements int64
+				tmpFailed  []string
+				err        error
+			)
+			batch, statements, tmpFailed, err = uuc.applyRewardBatch(ctx, s, run, batch)
+			res.Statements += statements
+			if nil != err {
+				uuc.log.WithContext(ctx).Errorw("msg", "reward daily", "job", policy.Name(), "run_id", run.ID, "err", err)
+				failed = append(failed, err.Error())
+				broken = true
+				return
+			}
+			failed = append(failed, tmpFailed...)
+		}
+
+		// 落库成功的条目同步到跟踪的占位，未落库的用户（余额不存在）保持原状态，续跑时重新结算
+		for _, p := range batch {
+			if nil != p.Location {
+				*p.Location = *p.Intent.Location
+				p.Intent.Location = p.Location
+			}
+		}
+
+		for _, p := range batch {
+			intent, settlement := p.Intent, p.Settlement
+			res.Count++
+			res.Amount += settlement.Paid
+			res.AmountFloat += intent.AmountFloat
+			if nil != intent.Location && "stop" == settlement.Status && "running" == settlement.PrevStatus {
+				res.Stopped = append(res.Stopped, intent.Location.ID)
+			}
+
+			if _, ok := users[intent.UserId]; !ok {
+				users[intent.UserId] = &RewardReportUser{UserId: intent.UserId}
+				res.Users = append(res.Users, users[intent.UserId])
+			}
+			users[intent.UserId].Amount += settlement.Paid
+			users[intent.UserId].AmountFloat += intent.AmountFloat
+		}
+	}
+
+	for stage := 0; stage < policy.Stages() && !broken; stage++ {
+		var intents []*RewardIntent
+		intents, err = policy.Intents(ctx, s, stage)
+		if nil != err {
+			uuc.log.WithContext(ctx).Errorw("msg", "reward intents", "job", policy.Name(), "stage", stage, "err", err)
+			failed = append(failed, err.Error())
+			break
+		}
+
+		for _, intent := range intents {
+			key := rewardIntentKey(stage, intent, occurrence)
+			if done[key] {
+				res.Resumed++
+				continue
+			}
+
+			if !s.productAllows(intent) {
+				res.Excluded++
+				continue
+			}
+
+			// 本批内按副本结算，后面的奖励按结算后的额度计算，落库成功后再同步到跟踪的占位
+			location := intent.Location
+			if nil != location {
+				if _, ok := shadows[location]; !ok {
+					tmp := *location
+					shadows[location] = &tmp
+				}
+				intent.Location = shadows[location]
+			}
+
+			res.Intended += intent.Amount
+			settlement := uuc.settleRewardIntent(s, intent)
+			if settlement.Skip {
+				intent.Location = location
+				continue
+			}
+
+			event := rewardLocationEvent(s.Job, intent, settlement)
+			commitRewardIntent(intent, settlement)
+			pending = append(pending, &rewardPending{Key: key, Intent: intent, Settlement: settlement, Event: event, Location: location})
+			if rewardBatchSize <= len(pending) {
+				flush()
+				if broken {
+					break
+				}
+			}
+		}
+
+		// 阶段结束时落库，下一阶段能查到本阶段的发放
+		if !broken {
+			flush()
+		}
+	}
+
+	res.Elapsed = time.Since(start)
+
+	res.Budget = s.Budget
+	if 0 >= res.Budget {
+		res.Budget = res.Intended
+	}
+	uuc.fillRewardReport(ctx, res)
+
+	// 有失败时运行保持failed，调用方按状态判断是否发放完成，之后可以续跑
+	if 0 < len(failed) {
+		res.Status = RewardRunFailed
+		res.Error = strings.Join(failed, ";")
+	}
+
+	if nil != run {
+		if 0 >= len(failed) {
+			res.Status = RewardRunCompleted
+		}
+
+		_, err = uuc.rewardRunRepo.UpdateRewardRunStatus(ctx, run.ID, RewardRunRunning, res.Status, res.Error)
+		if nil != err {
+			uuc.log.WithContext(ctx).Errorw("msg", "reward run status", "job", policy.Name(), "run_id", run.ID, "err", err)
+		}
+
+		// 只统计实际发放的运行，金额按usdt计
+		metrics.RewardAmount.With(policy.Name()).Add(float64(res.Amount) / 100000)
+		metrics.RewardRunSeconds.With(policy.Name(), res.Status).Observe(res.Elapsed.Seconds())
+	}
+
+	return res, nil
+}
+
+// rewardBusinessDate 计算用的日期，试算时可以指定，否则为北京时间当天
+func rewardBusinessDate(opt *RewardRunOption, now time.Time) string {
+	if 10 <= len(opt.Date) {
+		return opt.Date[:10]
+	}
+
+	return now.UTC().Add(8 * time.Hour).Format("2006-01-02")
+}
+
+// rewardRunDate 运行记录的业务日，按实际结算的数据取北京时间当天，不受参数中的日期影响；
+// 提前发放的数据记到默认会发放它的那一天，避免重复发放
+func rewardRunDate(policy RewardPolicy, opt *RewardRunOption, now time.Time) string {
+	date := now.UTC().Add(8 * time.Hour).Format("2006-01-02")
+
+	p, ok := policy.(RewardDayPolicy)
+	if !ok || 0 == p.DayOffset(opt.Day) {
+		return date
+	}
+
+	tmpDate, err := time.Parse("2006-01-02", date)
+	if nil != err {
+		return date
+	}
+
+	return tmpDate.AddDate(0, 0, p.DayOffset(opt.Day)).Format("2006-01-02")
+}
+
+// beginRewardRun 取得本次运行，已完成的拒绝执行，失败或中断的接管续跑
+func (uuc *UserUseCase) beginRewardRun(ctx context.Context, job string, businessDate string) (*RewardRun, map[string]bool, error) {
+	var (
+		run  *RewardRun
+		done map[string]bool
+		ok   bool
+		err  error
+	)
+
+	run, err = uuc.rewardRunRepo.GetRewardRun(ctx, job, businessDate)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	if nil == run {
+		run, err = uuc.rewardRunRepo.CreateRewardRun(ctx, job, businessDate)
+		if nil != err {
+			// 并发创建时唯一索引冲突
+			return nil, nil, errors.New(500, "ERROR", "奖励任务正在运行")
+		}
+
+		return run, make(map[string]bool, 0), nil
+	}
+
+	switch run.Status {
+	case RewardRunCompleted:
+		return nil, nil, errors.New(500, "ERROR", "该业务日奖励已发放")
+	case RewardRunRunning:
+		if time.Since(run.UpdatedAt) < rewardRunStaleAfter {
+			return nil, nil, errors.New(500, "ERROR", "奖励任务正在运行")
+		}
+	}
+
+	ok, err = uuc.rewardRunRepo.UpdateRewardRunStatus(ctx, run.ID, run.Status, RewardRunRunning, run.Error)
+	if nil != err {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, errors.New(500, "ERROR", "奖励任务正在运行")
+	}
+	run.Status = RewardRunRunning
+
+	done, err = uuc.rewardRunRepo.GetRewardRunItemKeys(ctx, run.ID)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	return run, done, nil
+}
+
+// rewardIntentKey 条目在本次运行中的唯一标识，重复的组合按出现次序区分
+func rewardIntentKey(stage int, intent *RewardIntent, occurrence map[string]int64) string {
+	var locationId int64
+	if nil != intent.Location {
+		locationId = intent.Location.ID
+	}
+
+	key := fmt.Sprintf("%d:%s:%d:%d:%d:%d", stage, intent.Kind, intent.UserId, locationId, intent.Num, intent.RecordId)
+	occurrence[key]++
+
+	return key + ":" + strconv.FormatInt(occurrence[key], 10)
+}
+
+func rewardRunItem(runId int64, key string, intent *RewardIntent, settlement *rewardSettlement) *RewardRunItem {
+	res := &RewardRunItem{
+		RunId:       runId,
+		ItemKey:     key,
+		Kind:        intent.Kind,
+		UserId:      intent.UserId,
+		Num:         intent.Num,
+		Amount:      intent.Amount,
+		AmountFloat: intent.AmountFloat,
+		Charge:      settlement.Charge,
+		Paid:        settlement.Paid,
+		AmountB:     settlement.AmountB,
+		Usdt:        settlement.Usdt,
+		Coin:        settlement.Coin,
+		MaxNew:      settlement.MaxNew,
+		PrevStatus:  settlement.PrevStatus,
+		Status:      settlement.Status,
+		RecordId:    intent.RecordId,
+		PrevDate:    intent.PrevDate,
+	}
+
+	if nil != intent.Location {
+		res.LocationId = intent.Location.ID
+		res.LocationTable = LocationTableNew
+		if RewardKindVipLocation == intent.Kind {
+			res.LocationTable = LocationTableNew2
+		}
+	}
+
+	return res
+}
+
+type rewardRunKey struct{}
+
+// NewRewardRunContext 发放时把运行id带到数据层，写入奖励和余额记录
+func NewRewardRunContext(ctx context.Context, runId int64) context.Context {
+	return context.WithValue(ctx, rewardRunKey{}, runId)
+}
+
+// RewardRunIdFromContext .
+func RewardRunIdFromContext(ctx context.Context) int64 {
+	runId, _ := ctx.Value(rewardRunKey{}).(int64)
+	return runId
+}
+
+// fillRewardReport 补充地址和vip级别，按vip级别汇总
+func (uuc *UserUseCase) fillRewardReport(ctx context.Context, report *RewardReport) {
+	var (
+		userIds   []int64
+		users     map[int64]*User
+		userInfos map[int64]*UserInfo
+	)
+
+	for _, v := range report.Users {
+		userIds = append(userIds, v.UserId)
+	}
+	if 0 >= len(userIds) {
+		return
+	}
+
+	users, _ = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	userInfos, _ = uuc.uiRepo.GetUserInfoByUserIds(ctx, userIds...)
+
+	vips := make(map[int64]*RewardReportVip, 0)
+	for _, v := range report.Users {
+		if user, ok := users[v.UserId]; ok {
+			v.Address = user.Address
+		}
+		if userInfo, ok := userInfos[v.UserId]; ok {
+			v.Vip = userInfo.Vip
+		}
+
+		if _, ok := vips[v.Vip]; !ok {
+			vips[v.Vip] = &RewardReportVip{Vip: v.Vip}
+			report.Vips = append(report.Vips, vips[v.Vip])
+		}
+		vips[v.Vip].Count++
+		vips[v.Vip].Amount += v.Amount
+		vips[v.Vip].AmountFloat += v.AmountFloat
+	}
+
+	sort.Slice(report.Vips, func(i, j int) bool {
+		return report.Vips[i].Vip < report.Vips[j].Vip
+	})
+}
+
+// rewardAmountString 金额格式化，float类奖励不带精度
+func rewardAmountString(amount int64, amountFloat float64) string {
+	return fmt.Sprintf("%.2f", float64(amount)/float64(100000)+amountFloat)
+}
+
+// rewardItemsPerSecond 吞吐量
+func rewardItemsPerSecond(count int64, elapsed time.Duration) string {
+	if 0 >= elapsed {
+		return "0"
+	}
+
+	return fmt.Sprintf("%.2f", float64(count)/elapsed.Seconds())
+}
+
+func rewardReportReply(report *RewardReport) *v1.RewardReport {
+	if nil == report {
+		return nil
+	}
+
+	res := &v1.RewardReport{
+		Job:            report.Job,
+		DryRun:         report.DryRun,
+		RunId:          report.RunId,
+		Status:         report.Status,
+		Resumed:        report.Resumed,
+		Excluded:       report.Excluded,
+		Chunks:         report.Chunks,
+		Statements:     report.Statements,
+		ElapsedMs:      report.Elapsed.Milliseconds(),
+		ItemsPerSecond: rewardItemsPerSecond(report.Count, report.Elapsed),
+		Count:          report.Count,
+		Budget:         rewardAmountString(report.Budget, 0),
+		Amount:         rewardAmountString(report.Amount, report.AmountFloat),
+		Users:          make([]*v1.RewardReport_User, 0),
+		Vips:           make([]*v1.RewardReport_Vip, 0),
+		Stopped:        report.Stopped,
+		Error:          report.Error,
+	}
+
+	for _, v := range report.Users {
+		res.Users = append(res.Users, &v1.RewardReport_User{
+			UserId:  v.UserId,
+			Address: v.Address,
+			Vip:     v.Vip,
+			Amount:  rewardAmountString(v.Amount, v.AmountFloat),
+		})
+	}
+
+	for _, v := range report.Vips {
+		res.Vips = append(res.Vips, &v1.RewardReport_Vip{
+			Vip:    v.Vip,
+			Count:  v.Count,
+			Amount: rewardAmountString(v.Amount, v.AmountFloat),
+		})
+	}
+
+	return res
+}
+
+// settleRewardIntent 出局规则：占位当前额度达到CurrentMax停止，超出部分不发
+func (uuc *UserUseCase) settleRewardIntent(s *RewardSnapshot, intent *RewardIntent) *rewardSettlement {
+	res := &rewardSettlement{Paid: intent.Amount, Status: "running"}
+
+	switch intent.Kind {
+	case RewardKindLocation, RewardKindRecommend, RewardKindArea:
+		// 只给运行中的占位发，超出部分截断
+		location := intent.Location
+		if nil == location || "running" != location.Status || 0 >= intent.Amount {
+			res.Skip = true
+			return res
+		}
+
+		res.PrevStatus = location.Status
+		res.StopDate = time.Now().UTC().Add(8 * time.Hour)
+		if location.Current+res.Paid >= location.CurrentMax { // 占位分红人分满停止
+			res.Status = "stop"
+			res.Paid = location.CurrentMax - location.Current
+		}
+
+		bPrice := s.Int64("b_price")
+		if 0 < bPrice {
+			res.AmountB = res.Paid * s.Int64("b_price_base") / bPrice
+		}
+		if 0 >= res.Paid || 0 >= res.AmountB {
+			res.Skip = true
+			return res
+		}
+
+		if location.CurrentMaxNew < location.CurrentMax {
+			res.MaxNew = location.CurrentMax - location.CurrentMaxNew
+		}
+		res.Charge = res.Paid
+
+	case RewardKindBalance, RewardKindRecommendArea, RewardKindVipLocation:
+		// 全额计入占位，只有运行中的占位实发，实发截断到CurrentMax
+		location := intent.Location
+		if nil == location || 0 >= intent.Amount {
+			res.Skip = true
+			return res
+		}
+
+		res.PrevStatus = location.Status
+		res.StopDate = location.StopDate
+		res.Charge = intent.Amount
+		if location.Current+intent.Amount >= location.CurrentMax { // 占位分红人分满停止
+			res.Status = "stop"
+			if "running" == res.PrevStatus {
+				res.StopDate = time.Now().UTC().Add(8 * time.Hour)
+				res.Paid = location.CurrentMax - location.Current
+			} else {
+				res.Paid = 0
+			}
+		}
+
+		res.Usdt = res.Paid * s.Int64("reward_rate") / 100
+		coinPrice := s.Int64("coin_price")
+		if 0 < coinPrice {
+			res.Coin = res.Paid * s.Int64("coin_reward_rate") / 100 * 1000 / coinPrice
+		}
+
+	case RewardKindFirst, RewardKindSecond:
+		if 0 >= intent.AmountFloat {
+			res.Skip = true
+		}
+
+	default:
+		if 0 >= intent.Amount {
+			res.Skip = true
+		}
+	}
+
+	return res
+}
+
+// commitRewardIntent 结算后更新本批的占位副本
+func commitRewardIntent(intent *RewardIntent, settlement *rewardSettlement) {
+	location := intent.Location
+	if nil == location {
+		return
+	}
+
+	location.Current += settlement.Charge
+	location.Status = settlement.Status
+	location.Biw += settlement.AmountB
+	if "stop" == settlement.Status {
+		location.StopDate = settlement.StopDate
+		location.CurrentMaxNew += settlement.MaxNew
+	}
+}
+
+// subLocationNewTotal 占位出局后，从上级的区域业绩中减掉
+func (uuc *UserUseCase) subLocationNewTotal(ctx context.Context, location *LocationNew) error {
+	return addLocationTreeTotal(ctx, uuc.treeRepo, uuc.locationRepo, location, -location.Usdt/100000)
+}
+
+// addLocationNewTotal 占位重新运行时业绩加回
+func (uuc *UserUseCase) addLocationNewTotal(ctx context.Context, location *LocationNew) error {
+	return addLocationTreeTotal(ctx, uuc.treeRepo, uuc.locationRepo, location, location.Usdt/100000)
+}
+
+func (uuc *UserUseCase) AdminRewardRun(ctx context.Context, req *v1.AdminRewardRunRequest) (*v1.AdminRewardRunReply, error) {
+	var (
+		report *RewardReport
+		err    error
+	)
+
+	report, err = uuc.RunRewardPolicy(ctx, req.Job, &RewardRunOption{
+		Date:   req.Date,
+		Day:    req.Day,
+		DryRun: req.DryRun,
+	})
+	if nil == report {
+		return nil, err
+	}
+
+	return &v1.AdminRewardRunReply{Report: rewardReportReply(report)}, err
+}
@@ -0,0 +1,378 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// 每个事务落库的奖励条数
+const rewardBatchSize = 500
+
+// RewardBalance 发放前锁定的用户余额
+type RewardBalance struct {
+	UserId         int64
+	BalanceUsdt    int64
+	BalanceUsdtNew int64
+	BalanceDhb     float64
+}
+
+// RewardBalanceDelta 一批发放中一个用户的余额变动
+type RewardBalanceDelta struct {
+	UserId         int64
+	BalanceUsdt    int64
+	BalanceUsdtNew int64
+	BalanceDhb     float64
+	LocationTotal  int64
+	RecommendTotal int64
+	AreaTotal      int64
+	FourTotal      int64
+}
+
+// RewardLocationDelta 一批发放中一个占位的变动
+type RewardLocationDelta struct {
+	Table         string
+	ID            int64
+	Current       int64
+	Biw           int64
+	CurrentMaxNew int64
+	Stop          bool
+	StopDate      time.Time
+}
+
+// RewardLedger 一条奖励记录，HasRecord时先写余额记录再关联
+type RewardLedger struct {
+	UserId           int64
+	HasRecord        bool
+	RecordType       string
+	RecordCoinType   string
+	RecordAmount     int64
+	RecordBalance    int64
+	RecordAsType     bool // 余额记录id写入TypeRecordId，否则写入BalanceRecordId
+	Amount           int64
+	AmountB          int64
+	AmountNew        float64
+	Type             string
+	Reason           string
+	TypeRecordId     int64
+	ReasonLocationId int64
+}
+
+// RewardBatch 一个事务内批量写入的数据
+type RewardBatch struct {
+	RunId            int64
+	Balances         []*RewardBalanceDelta
+	Locations        []*RewardLocationDelta
+	Ledgers          []*RewardLedger
+	Items            []*RewardRunItem
+	Events           []*LocationEvent
+	BalanceRewardIds []int64 // 更新上次发放日期的余额分红
+	Count            int64
+	Amount           int64
+}
+
+// rewardPending 已结算待落库的奖励
+type rewardPending struct {
+	Key        string
+	Intent     *RewardIntent
+	Settlement *rewardSettlement
+	Event      *LocationEvent // 占位变动，未变动为nil
+	Location   *LocationNew   // 快照中跟踪的占位，Intent.Location是本批的副本，落库成功后才同步回来
+}
+
+// rewardBatchBuilder 按发放顺序在内存中模拟余额变动，得到批量写入的数据
+type rewardBatchBuilder struct {
+	s         *RewardSnapshot
+	batch     *RewardBatch
+	balances  map[int64]*RewardBalance
+	deltas    map[int64]*RewardBalanceDelta
+	locations map[string]*RewardLocationDelta
+}
+
+func newRewardBatchBuilder(s *RewardSnapshot, runId int64, balances map[int64]*RewardBalance) *rewardBatchBuilder {
+	return &rewardBatchBuilder{
+		s:         s,
+		batch:     &RewardBatch{RunId: runId},
+		balances:  balances,
+		deltas:    make(map[int64]*RewardBalanceDelta, 0),
+		locations: make(map[string]*RewardLocationDelta, 0),
+	}
+}
+
+func (b *rewardBatchBuilder) delta(userId int64) *RewardBalanceDelta {
+	if _, ok := b.deltas[userId]; !ok {
+		b.deltas[userId] = &RewardBalanceDelta{UserId: userId}
+		b.batch.Balances = append(b.batch.Balances, b.deltas[userId])
+	}
+
+	return b.deltas[userId]
+}
+
+func (b *rewardBatchBuilder) location(table string, id int64) *RewardLocationDelta {
+	key := table + ":" + strconv.FormatInt(id, 10)
+	if _, ok := b.locations[key]; !ok {
+		b.locations[key] = &RewardLocationDelta{Table: table, ID: id}
+		b.batch.Locations = append(b.batch.Locations, b.locations[key])
+	}
+
+	return b.locations[key]
+}
+
+// add 与逐笔发放时各repo方法的写入一致
+func (b *rewardBatchBuilder) add(p *rewardPending) {
+	var (
+		intent     = p.Intent
+		settlement = p.Settlement
+		balance    = b.balances[intent.UserId]
+		delta      = b.delta(intent.UserId)
+	)
+
+	// 占位
+	if nil != intent.Location {
+		table := LocationTableNew
+		if RewardKindVipLocation == intent.Kind {
+			table = LocationTableNew2
+		}
+
+		// 未出局的只更新运行中的占位
+		if "stop" == settlement.Status || "running" == settlement.PrevStatus {
+			location := b.location(table, intent.Location.ID)
+			location.Current += settlement.Charge
+			location.Biw += settlement.AmountB
+			if "stop" == settlement.Status {
+				location.Stop = true
+				location.StopDate = settlement.StopDate
+				location.CurrentMaxNew += settlement.MaxNew
+			}
+		}
+	}
+
+	switch intent.Kind {
+	case RewardKindLocation, RewardKindRecommend, RewardKindArea:
+		balance.BalanceDhb += float64(settlement.AmountB)
+		delta.BalanceDhb += float64(settlement.AmountB)
+
+		ledger := &RewardLedger{
+			UserId:         intent.UserId,
+			HasRecord:      true,
+			RecordType:     "reward",
+			RecordCoinType: "dhb",
+			RecordAmount:   settlement.AmountB,
+			Amount:         settlement.AmountB,
+		}
+		if RewardKindLocation == intent.Kind {
+			delta.LocationTotal += settlement.AmountB
+			ledger.Type = "system_reward_location_daily" // 本次分红的行为类型
+			ledger.Reason = "location"                   // 给我分红的理由
+		} else if RewardKindRecommend == intent.Kind {
+			delta.RecommendTotal += settlement.AmountB
+			ledger.Type = "system_reward_recommend_daily"
+			ledger.Reason = "recommend"
+			ledger.ReasonLocationId = intent.Num
+		} else {
+			delta.AreaTotal += settlement.AmountB
+			ledger.RecordBalance = settlement.Paid
+			ledger.Type = "system_reward_area_daily"
+			ledger.Reason = "area"
+			ledger.ReasonLocationId = intent.Num
+		}
+
+		// 出局，dhb全部兑换成usdt
+		if "stop" == settlement.Status && 0 < balance.BalanceDhb {
+			tmp := settlement.MaxNew
+			tmp -= tmp * b.s.Int64("exchange_rate") / 1000
+
+			delta.BalanceDhb -= balance.BalanceDhb
+			delta.BalanceUsdt += tmp
+			balance.BalanceDhb = 0
+			balance.BalanceUsdt += tmp
+
+			b.batch.Ledgers = append(b.batch.Ledgers, &RewardLedger{
+				UserId:         intent.UserId,
+				HasRecord:      true,
+				RecordType:     "exchange",
+				RecordCoinType: "dhb",
+				RecordAmount:   tmp,
+				RecordAsType:   true,
+				AmountB:        tmp,
+				Type:           "exchange_system",
+				Reason:         "exchange_2",
+			})
+		}
+
+		b.batch.Ledgers = append(b.batch.Ledgers, ledger)
+
+	case RewardKindBalance, RewardKindRecommendArea:
+		if "running" == settlement.PrevStatus {
+			delta.BalanceUsdt += settlement.Usdt
+			delta.BalanceDhb += float64(settlement.Coin)
+			balance.BalanceUsdt += settlement.Usdt
+			balance.BalanceDhb += float64(settlement.Coin)
+		}
+
+		ledger := &RewardLedger{
+			UserId:        intent.UserId,
+			HasRecord:     true,
+			RecordType:    "reward",
+			RecordAmount:  intent.Amount,
+			RecordBalance: balance.BalanceUsdt,
+			Amount:        intent.Amount,
+			Type:          "system_reward_daily",
+			Reason:        "daily_recommend_area",
+		}
+		if RewardKindBalance == intent.Kind {
+			ledger.Reason = "daily_balance_reward"
+			b.batch.BalanceRewardIds = append(b.batch.BalanceRewardIds, intent.RecordId)
+		}
+		b.batch.Ledgers = append(b.batch.Ledgers, ledger)
+
+	case RewardKindVipLocation, RewardKindVip:
+		var (
+			amount       = intent.Amount
+			typeRecordId int64
+		)
+		if RewardKindVipLocation == intent.Kind {
+			typeRecordId = intent.Location.ID
+			if "running" != settlement.PrevStatus {
+				amount = 0
+			} else if "stop" == settlement.Status {
+				amount = settlement.Paid
+			}
+		}
+		delta.BalanceUsdtNew += amount
+		balance.BalanceUsdtNew += amount
+
+		b.batch.Ledgers = append(b.batch.Ledgers, &RewardLedger{
+			UserId:        intent.UserId,
+			HasRecord:     true,
+			RecordType:    "reward_withdraw",
+			RecordAmount:  intent.Amount,
+			RecordBalance: balance.BalanceUsdtNew,
+			Amount:        intent.Amount,
+			Type:          "reward_withdraw",
+			Reason:        "reward_withdraw",
+			TypeRecordId:  typeRecordId,
+		})
+
+	case RewardKindFour:
+		delta.BalanceUsdt += intent.Amount
+		delta.FourTotal += intent.Amount
+		balance.BalanceUsdt += intent.Amount
+
+		b.batch.Ledgers = append(b.batch.Ledgers, &RewardLedger{
+			UserId:           intent.UserId,
+			HasRecord:        true,
+			RecordType:       "reward",
+			RecordCoinType:   "usdt",
+			RecordAmount:     intent.Amount,
+			Amount:           intent.Amount,
+			Type:             "system_reward_four_daily",
+			Reason:           "four",
+			ReasonLocationId: intent.Num,
+		})
+
+	case RewardKindFirst, RewardKindSecond:
+		delta.BalanceDhb += intent.AmountFloat
+		balance.BalanceDhb += intent.AmountFloat
+
+		ledger := &RewardLedger{
+			UserId:    intent.UserId,
+			AmountNew: intent.AmountFloat,
+			Type:      "reward_first",
+			Reason:    "reward_first",
+		}
+		if RewardKindSecond == intent.Kind {
+			ledger.Type = intent.Reason
+			ledger.Reason = "reward_third"
+			if "four" == intent.Reason {
+				ledger.Reason = "reward_second"
+			}
+		}
+		b.batch.Ledgers = append(b.batch.Ledgers, ledger)
+	}
+
+	if nil != p.Event {
+		p.Event.RunId = b.batch.RunId
+		b.batch.Events = append(b.batch.Events, p.Event)
+	}
+
+	b.batch.Items = append(b.batch.Items, rewardRunItem(b.batch.RunId, p.Key, intent, settlement))
+	b.batch.Count++
+	b.batch.Amount += settlement.Paid
+}
+
+// applyRewardBatch 一个事务内锁定余额、批量写入，返回实际落库的条目和执行的语句数
+func (uuc *UserUseCase) applyRewardBatch(ctx context.Context, s *RewardSnapshot, run *RewardRun, pending []*rewardPending) ([]*rewardPending, int64, []string, error) {
+	var (
+		applied    []*rewardPending
+		failed     []string
+		statements int64
+		err        error
+	)
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var (
+			balances map[int64]*RewardBalance
+			userIds  []int64
+			stopped  []*LocationNew
+			err      error
+		)
+
+		applied = make([]*rewardPending, 0, len(pending))
+		failed = make([]string, 0)
+		statements = 0
+
+		tmpUserIds := make(map[int64]bool, 0)
+		for _, p := range pending {
+			if !tmpUserIds[p.Intent.UserId] {
+				tmpUserIds[p.Intent.UserId] = true
+				userIds = append(userIds, p.Intent.UserId)
+			}
+		}
+
+		balances, err = uuc.rewardRunRepo.LockRewardBalances(ctx, userIds...)
+		if nil != err {
+			return err
+		}
+		statements++
+
+		builder := newRewardBatchBuilder(s, run.ID, balances)
+		for _, p := range pending {
+			if _, ok := balances[p.Intent.UserId]; !ok {
+				failed = append(failed, fmt.Sprintf("用户%d余额不存在", p.Intent.UserId))
+				continue
+			}
+
+			builder.add(p)
+			applied = append(applied, p)
+
+			// 出局的占位从上级业绩中减掉
+			intent := p.Intent
+			if (RewardKindLocation == intent.Kind || RewardKindRecommend == intent.Kind || RewardKindArea == intent.Kind) && "stop" == p.Settlement.Status {
+				stopped = append(stopped, intent.Location)
+			}
+		}
+
+		var tmpStatements int64
+		tmpStatements, err = uuc.rewardRunRepo.ApplyRewardBatch(ctx, builder.batch)
+		if nil != err {
+			return err
+		}
+		statements += tmpStatements
+
+		for _, location := range stopped {
+			err = uuc.subLocationNewTotal(ctx, location)
+			if nil != err {
+				return err
+			}
+			statements++
+		}
+
+		return nil
+	}); nil != err {
+		return nil, statements, nil, err
+	}
+
+	return applied, statements, failed, nil
+}
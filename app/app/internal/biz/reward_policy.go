@@ -0,0 +1,850 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// balanceRewardPolicy 余额分红
+type balanceRewardPolicy struct {
+	uuc *UserUseCase
+}
+
+func (p *balanceRewardPolicy) Name() string { return "daily_balance" }
+
+func (p *balanceRewardPolicy) Stages() int { return 1 }
+
+func (p *balanceRewardPolicy) ConfigKeys() []string {
+	return []string{"balance_reward_rate"}
+}
+
+func (p *balanceRewardPolicy) Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error) {
+	var (
+		balanceRewards []*BalanceReward
+		err            error
+	)
+
+	now := s.Now
+	if "" != s.Date { // 测试条件
+		now, err = time.Parse("2006-01-02 15:04:05", s.Date) // 时间进行格式校验
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	balanceRewards, err = p.uuc.ubRepo.GetBalanceRewardCurrent(ctx, now.UTC())
+	if nil != err {
+		return nil, err
+	}
+
+	timeLimit := time.Now().UTC().Add(-23 * time.Hour)
+	balanceRewardRate := s.Int64("balance_reward_rate")
+
+	res := make([]*RewardIntent, 0)
+	for _, vBalanceRewards := range balanceRewards {
+		if "" == s.Date { // 测试条件
+			if vBalanceRewards.LastRewardDate.After(timeLimit) {
+				continue
+			}
+		}
+
+		var myLocationLast *LocationNew
+		// 获取当前用户的占位信息
+		myLocationLast, _ = p.uuc.locationRepo.GetMyLocationLast(ctx, vBalanceRewards.UserId)
+		if nil == myLocationLast { // 无占位信息
+			continue
+		}
+
+		res = append(res, &RewardIntent{
+			Kind:     RewardKindBalance,
+			UserId:   vBalanceRewards.UserId,
+			Location: s.Track(LocationTableNew, myLocationLast),
+			Amount:   vBalanceRewards.Amount * balanceRewardRate / 1000,
+			RecordId: vBalanceRewards.ID,
+			PrevDate: vBalanceRewards.LastRewardDate,
+		})
+	}
+
+	return res, nil
+}
+
+// buyRewardPolicy 认购奖励，节点和超级节点分昨日入金
+type buyRewardPolicy struct {
+	uuc *UserUseCase
+}
+
+func (p *buyRewardPolicy) Name() string { return "daily_buy" }
+
+func (p *buyRewardPolicy) Stages() int { return 1 }
+
+func (p *buyRewardPolicy) ConfigKeys() []string {
+	return []string{
+		"one", "two", "three", "four", "five", "six",
+		"one_two", "two_two", "three_two", "four_three", "five_three", "today", "seven", "eight", "nine", "seven_two", "eight_two", "nine_two",
+	}
+}
+
+// 不参与超级节点分红的地址
+var buyRewardFiveUsersNo = map[string]int64{
+	"bKY3uVvmEo1SPC3LEsp4GzmofBMfz1GewJ": 1,
+	"b8ejsna15818PGy8AbT2coD2GZSHaJ1xRp": 1,
+	"bPLvww5wQPDM4DqHgAeTiMsHh8PQirFVza": 1,
+	"b9xNhfVxwePX9YZuupUuwRw8EmcD2s7dAn": 1,
+	"b4zqvcq883vdAdctYom76dnm2MGzhx7yTD": 1,
+	"bMwH72jPD2e7Dq3y5JKbbrRUdc2fguUGRa": 1,
+	"b5jashkZafpamL8UYALTWd2yt1hHjUKpG4": 1,
+	"bGb82dqZRQzz1wBSNu3FuSpwQ7UiirMTLJ": 1,
+	"bPFcA5GLXawJY1ek6U3KA2RZ7y9AEBKNQj": 1,
+	"b87Qpju42hk4YCFBRC6Zv8afqcd2d7xHZ1": 1,
+	"b2yja3Xp5xy28MVh3LCczt32zQNkmZKinJ": 1,
+	"b66qjHTvZHSfqReGtkFGSTZ2szpyHp8tjM": 1,
+	"b2F47WsjyVPaJQYzWwVopbu3vN6hu5Cz6P": 1,
+	"b88VG3L3PDXskUe149sGrhtJGVZe6xgNXM": 1,
+	"bMqNB61Ej3bnxJjLgW5REnaJyqhsysH4wC": 1,
+}
+
+func (p *buyRewardPolicy) Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error) {
+	var (
+		users []*User
+		err   error
+	)
+
+	users, err = p.uuc.repo.GetUsersNew(ctx)
+	if nil != err {
+		p.uuc.log.WithContext(ctx).Errorw("msg", "分红", "job", p.Name(), "err", err)
+		return nil, err
+	}
+
+	var (
+		one       = s.Float64("one")
+		two       = s.Float64("two")
+		three     = s.Float64("three")
+		four      = s.Float64("four")
+		five      = s.Float64("five")
+		six       = s.Float64("six")
+		seven     = s.Float64("seven")
+		eight     = s.Float64("eight")
+		nine      = s.Float64("nine")
+		oneTwo    = s.Float64("one_two")
+		twoTwo    = s.Float64("two_two")
+		threeTwo  = s.Float64("three_two")
+		sevenTwo  = s.Float64("seven_two")
+		eightTwo  = s.Float64("eight_two")
+		nineTwo   = s.Float64("nine_two")
+		fourThree = s.Float64("four_three")
+		fiveThree = s.Float64("five_three")
+		today     = s.Float64("today")
+	)
+
+	fourUsers := make([]*User, 0)
+	fiveUsers := make([]*User, 0)
+	res := make([]*RewardIntent, 0)
+
+	var (
+		amountSecond float64 // 节点超级节点奖励额度
+	)
+	for _, vUsers := range users {
+		amounts := make([]float64, 0)
+		// 超级节点，节点
+		number := vUsers.Total
+		if 15000 <= number {
+			if 1000000 > number {
+				if 30000 <= number {
+					if _, ok := buyRewardFiveUsersNo[vUsers.Address]; !ok {
+						fiveUsers = append(fiveUsers, vUsers)
+					}
+				} else {
+					fourUsers = append(fourUsers, vUsers)
+				}
+			}
+		}
+
+		for i := 0; i < int(vUsers.TotalD); i++ {
+			amounts = append(amounts, four)
+		}
+		for i := 0; i < int(vUsers.TotalF); i++ {
+			amounts = append(amounts, five)
+		}
+		for i := 0; i < int(vUsers.TotalG); i++ {
+			amounts = append(amounts, six)
+		}
+
+		// 普通
+		for i := 0; i < int(vUsers.TotalA); i++ {
+			amounts = append(amounts, one)
+			amountSecond += oneTwo
+		}
+		for i := 0; i < int(vUsers.TotalB); i++ {
+			amounts = append(amounts, two)
+			amountSecond += twoTwo
+		}
+		for i := 0; i < int(vUsers.TotalC); i++ {
+			amounts = append(amounts, three)
+			amountSecond += threeTwo
+		}
+		for i := 0; i < int(vUsers.TotalH); i++ {
+			if 0 < nine {
+				amounts = append(amounts, nine)
+			}
+			if 0 < nineTwo {
+				amountSecond += nineTwo
+			}
+		}
+		for i := 0; i < int(vUsers.TotalI); i++ {
+			if 0 < eight {
+				amounts = append(amounts, eight)
+			}
+			if 0 < eightTwo {
+				amountSecond += eightTwo
+			}
+		}
+		for i := 0; i < int(vUsers.TotalJ); i++ {
+			if 0 < seven {
+				amounts = append(amounts, seven)
+			}
+			if 0 < sevenTwo {
+				amountSecond += sevenTwo
+			}
+		}
+
+		for _, amount := range amounts {
+			res = append(res, &RewardIntent{
+				Kind:        RewardKindFirst,
+				UserId:      vUsers.ID,
+				AmountFloat: amount,
+			})
+		}
+	}
+
+	if 0 < today {
+		amountSecond = today
+	}
+
+	if 0 >= amountSecond {
+		return res, nil
+	}
+
+	if 0 < len(fourUsers) {
+		perFour := amountSecond * fourThree / 100 / float64(len(fourUsers))
+		p.uuc.log.WithContext(ctx).Debugw("msg", "four", "amount", amountSecond, "rate", fourThree, "per", perFour)
+		for _, vFourUser := range fourUsers {
+			res = append(res, &RewardIntent{
+				Kind:        RewardKindSecond,
+				UserId:      vFourUser.ID,
+				AmountFloat: perFour,
+				Reason:      "four",
+			})
+		}
+	}
+
+	if 0 < len(fiveUsers) {
+		perFive := amountSecond * fiveThree / 100 / float64(len(fiveUsers))
+		p.uuc.log.WithContext(ctx).Debugw("msg", "five", "amount", amountSecond, "rate", fiveThree, "per", perFive)
+		for _, vFiveUser := range fiveUsers {
+			res = append(res, &RewardIntent{
+				Kind:        RewardKindSecond,
+				UserId:      vFiveUser.ID,
+				AmountFloat: perFive,
+				Reason:      "five",
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// locationRewardPolicy 静态分红，第二阶段按静态分红给上八代推荐人分红
+type locationRewardPolicy struct {
+	uuc *UserUseCase
+}
+
+func (p *locationRewardPolicy) Name() string { return "daily_location" }
+
+func (p *locationRewardPolicy) Stages() int { return 2 }
+
+func (p *locationRewardPolicy) ConfigKeys() []string {
+	return []string{
+		"location_reward_rate",
+		"recommend_one_rate", "recommend_two_rate",
+		"recommend_three_rate", "recommend_four_rate",
+		"recommend_five_rate", "recommend_six_rate",
+		"recommend_seven_rate", "recommend_eight_rate",
+	}
+}
+
+func (p *locationRewardPolicy) Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error) {
+	var (
+		userLocations []*LocationNew
+		err           error
+	)
+
+	// 每日，两个阶段都按运行开始时的占位计算
+	userLocations, err = s.Locations(ctx, p.uuc.locationRepo)
+	if nil != err {
+		return nil, err
+	}
+
+	locationRewardRate := s.Int64("location_reward_rate")
+	res := make([]*RewardIntent, 0)
+	if 0 == stage {
+		for _, vUserLocations := range userLocations {
+			// 奖励
+			tmpCurrentReward := vUserLocations.Usdt / 1000 * locationRewardRate
+			res = append(res, &RewardIntent{
+				Kind:     RewardKindLocation,
+				UserId:   vUserLocations.UserId,
+				Location: vUserLocations,
+				Amount:   tmpCurrentReward / 6,
+			})
+		}
+
+		return res, nil
+	}
+
+	recommendRates := []int64{
+		s.Int64("recommend_one_rate"), s.Int64("recommend_two_rate"),
+		s.Int64("recommend_three_rate"), s.Int64("recommend_four_rate"),
+		s.Int64("recommend_five_rate"), s.Int64("recommend_six_rate"),
+		s.Int64("recommend_seven_rate"), s.Int64("recommend_eight_rate"),
+	}
+
+	for _, vUserLocations := range userLocations {
+		var (
+			userRecommend       *UserRecommend
+			tmpRecommendUserIds []string
+		)
+
+		// 推荐人
+		userRecommend, _ = p.uuc.urRepo.GetUserRecommendByUserId(ctx, vUserLocations.UserId)
+		if nil == userRecommend {
+			continue
+		}
+		if "" != userRecommend.RecommendCode {
+			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		}
+
+		lastKey := len(tmpRecommendUserIds) - 1
+		for i := 0; i <= 7; i++ {
+			// 有占位信息，推荐人推荐人的上一代
+			if lastKey-i <= 0 {
+				break
+			}
+
+			tmpMyTopUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[lastKey-i], 10, 64) // 最后一位是直推人
+			if 0 >= tmpMyTopUserRecommendUserId {
+				break
+			}
+
+			var myUserRecommendUserLocationsLast []*LocationNew
+			myUserRecommendUserLocationsLast, _ = p.uuc.locationRepo.GetLocationsNewByUserId(ctx, tmpMyTopUserRecommendUserId)
+			if 0 >= len(myUserRecommendUserLocationsLast) {
+				continue
+			}
+
+			var tmpMyTopUserRecommendUserLocationLast *LocationNew
+			for _, vMyUserRecommendUserLocationLast := range myUserRecommendUserLocationsLast {
+				tracked := s.Track(LocationTableNew, vMyUserRecommendUserLocationLast)
+				if "running" == tracked.Status {
+					tmpMyTopUserRecommendUserLocationLast = tracked
+					break
+				}
+			}
+
+			if nil == tmpMyTopUserRecommendUserLocationLast { // 无位
+				continue
+			}
+
+			// 3代起需要复投，第i+1代需要i个占位
+			if 2 <= i && i > len(myUserRecommendUserLocationsLast) {
+				continue
+			}
+
+			tmpMinUsdt := tmpMyTopUserRecommendUserLocationLast.Usdt
+			if vUserLocations.Usdt < tmpMinUsdt {
+				tmpMinUsdt = vUserLocations.Usdt
+			}
+
+			tmpMyRecommendAmount := tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendRates[i]
+			res = append(res, &RewardIntent{
+				Kind:     RewardKindRecommend,
+				UserId:   tmpMyTopUserRecommendUserId,
+				Location: tmpMyTopUserRecommendUserLocationLast,
+				Amount:   tmpMyRecommendAmount / 6,
+				Num:      int64(i + 1),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// areaRewardPolicy 五个区域级别平分昨日入金，最后直推入金前四名分全球奖励
+type areaRewardPolicy struct {
+	uuc *UserUseCase
+}
+
+func (p *areaRewardPolicy) Name() string { return "daily_area" }
+
+func (p *areaRewardPolicy) Stages() int { return 6 }
+
+func (p *areaRewardPolicy) ConfigKeys() []string {
+	return []string{
+		"area_one", "area_two", "area_three", "area_four", "area_five",
+		"area_num_one", "area_num_two", "area_num_three", "area_num_four", "area_num_five",
+		"one", "two", "three", "four", "total",
+	}
+}
+
+// areaRewardQualified 小区业绩达标或手动设置了级别
+func areaRewardQualified(location *LocationNew, area int64, level int64) bool {
+	maxArea := location.Total
+	if location.TotalTwo > maxArea {
+		maxArea = location.TotalTwo
+	}
+	if location.TotalThree > maxArea {
+		maxArea = location.TotalThree
+	}
+
+	return area <= location.Total+location.TotalTwo+location.TotalThree-maxArea || level <= location.LastLevel
+}
+
+func (p *areaRewardPolicy) Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error) {
+	var (
+		day               = -1
+		userLocationsYes  []*LocationNew
+		rewardLocationYes int64
+		err               error
+	)
+
+	// 全网
+	userLocationsYes, err = p.uuc.locationRepo.GetLocationDailyYesterday(ctx, day)
+	if nil != err {
+		return nil, err
+	}
+	for _, userLocationYes := range userLocationsYes {
+		rewardLocationYes += userLocationYes.Usdt
+	}
+
+	if 0 >= rewardLocationYes {
+		return nil, nil
+	}
+	s.Budget = rewardLocationYes
+
+	res := make([]*RewardIntent, 0)
+	if 5 > stage {
+		var (
+			areas    = []string{"area_one", "area_two", "area_three", "area_four", "area_five"}
+			areaNums = []string{"area_num_one", "area_num_two", "area_num_three", "area_num_four", "area_num_five"}
+			level    = int64(stage + 1)
+		)
+
+		// 团队奖励，上一级别出局的不再参与
+		userLocations, err := s.RunningLocations(ctx, p.uuc.locationRepo)
+		if nil != err {
+			return nil, err
+		}
+
+		qualified := make([]*LocationNew, 0)
+		for _, vUserLocations := range userLocations {
+			if areaRewardQualified(vUserLocations, s.Int64(areas[stage]), level) {
+				qualified = append(qualified, vUserLocations)
+			}
+		}
+
+		if 0 >= len(qualified) {
+			return res, nil
+		}
+
+		rewardLocationYesLevel := rewardLocationYes / 1000 * s.Int64(areaNums[stage]) / int64(len(qualified))
+		for _, vUserLocationsItem := range qualified {
+			res = append(res, &RewardIntent{
+				Kind:     RewardKindArea,
+				UserId:   vUserLocationsItem.UserId,
+				Location: vUserLocationsItem,
+				Amount:   rewardLocationYesLevel,
+				Num:      level,
+			})
+		}
+
+		return res, nil
+	}
+
+	// 全网前天
+	var (
+		userLocationsBef  []*LocationNew
+		rewardLocationBef int64
+	)
+	userLocationsBef, err = p.uuc.locationRepo.GetLocationDailyYesterday(ctx, day-1)
+	if nil != err {
+		return nil, err
+	}
+	for _, userLocationBef := range userLocationsBef {
+		rewardLocationBef += userLocationBef.Usdt
+	}
+	// 全球
+	total := s.Int64("total")
+	totalReward := rewardLocationYes/100/100*70*total + rewardLocationBef/100/100*30*total
+
+	fourUserRecommendTotal := make(map[int64]int64, 0)
+	for _, userLocationYes := range userLocationsYes {
+		// 获取直推
+		var (
+			fourUserRecommend         *UserRecommend
+			myFourUserRecommendUserId int64
+		)
+		fourUserRecommend, err = p.uuc.urRepo.GetUserRecommendByUserId(ctx, userLocationYes.UserId)
+		if nil == fourUserRecommend {
+			continue
+		}
+
+		if "" != fourUserRecommend.RecommendCode {
+			tmpFourRecommendUserIds := strings.Split(fourUserRecommend.RecommendCode, "D")
+			if 2 <= len(tmpFourRecommendUserIds) {
+				myFourUserRecommendUserId, _ = strconv.ParseInt(tmpFourRecommendUserIds[len(tmpFourRecommendUserIds)-1], 10, 64) // 最后一位是直推人
+			}
+
+			fourUserRecommendTotal[myFourUserRecommendUserId] += userLocationYes.Usdt
+		}
+	}
+
+	// 前四名
+	type KeyValuePair struct {
+		Key   int64
+		Value int64
+	}
+	var keyValuePairs []KeyValuePair
+	for key, value := range fourUserRecommendTotal {
+		keyValuePairs = append(keyValuePairs, KeyValuePair{key, value})
+	}
+
+	// 按值排序切片
+	sort.Slice(keyValuePairs, func(i, j int) bool {
+		return keyValuePairs[i].Value > keyValuePairs[j].Value
+	})
+
+	// 获取前四项
+	topFour := keyValuePairs
+	if 4 <= len(keyValuePairs) {
+		topFour = keyValuePairs[:4]
+	}
+
+	rates := []int64{s.Int64("one"), s.Int64("two"), s.Int64("three"), s.Int64("four")}
+	for k, vTopFour := range topFour {
+		res = append(res, &RewardIntent{
+			Kind:   RewardKindFour,
+			UserId: vTopFour.Key,
+			Amount: totalReward / 100 * rates[k],
+			Num:    int64(k + 1),
+		})
+	}
+
+	return res, err
+}
+
+// vipRewardPolicy vip按级别平分今日提现手续费
+type vipRewardPolicy struct {
+	uuc *UserUseCase
+}
+
+func (p *vipRewardPolicy) Name() string { return "daily_location_new" }
+
+func (p *vipRewardPolicy) Stages() int { return 1 }
+
+func (p *vipRewardPolicy) ConfigKeys() []string {
+	return []string{"v1", "v2", "v3"}
+}
+
+func (p *vipRewardPolicy) Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error) {
+	var (
+		userLocations    []*LocationNew
+		userLocationsMap map[int64]*LocationNew
+		userLocations1   []*LocationNew
+		userLocations2   []*LocationNew
+		userLocations3   []*LocationNew
+		amount           int64
+		amountV1         int64
+		amountV2         int64
+		amountV3         int64
+		v1Count          int64
+		v2Count          int64
+		v3Count          int64
+		userInfos        []*UserInfo
+		err              error
+	)
+
+	amount, err = p.uuc.ubRepo.GetSystemWithdrawUsdtFeeTotalToday(ctx)
+	if nil != err {
+		return nil, err
+	}
+	s.Budget = amount
+
+	// 获取手动设置的
+	userInfos, err = p.uuc.uiRepo.GetUserInfosByVipAndLockVip(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range userInfos {
+		if 3 == v.Vip {
+			v3Count += 1
+		} else if 2 == v.Vip {
+			v2Count += 1
+		} else if 1 == v.Vip {
+			v1Count += 1
+		}
+	}
+
+	userLocations, err = p.uuc.locationRepo.GetAllLocationsNew2(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	userLocationsMap = make(map[int64]*LocationNew, 0)
+	userLocations3 = make([]*LocationNew, 0)
+	userLocations2 = make([]*LocationNew, 0)
+	userLocations1 = make([]*LocationNew, 0)
+	for _, vUserLocations := range userLocations {
+		if _, ok := userLocationsMap[vUserLocations.UserId]; ok {
+			continue
+		}
+
+		var (
+			userInfo *UserInfo
+		)
+
+		userLocationsMap[vUserLocations.UserId] = vUserLocations
+
+		userInfo, err = p.uuc.uiRepo.GetUserInfoByUserId(ctx, vUserLocations.UserId)
+		if nil != err {
+			continue
+		}
+
+		tracked := s.Track(LocationTableNew2, vUserLocations)
+		if 3 == userInfo.Vip {
+			v3Count += 1
+			userLocations3 = append(userLocations3, tracked)
+		} else if 2 == userInfo.Vip {
+			v2Count += 1
+			userLocations2 = append(userLocations2, tracked)
+		} else if 1 == userInfo.Vip {
+			v1Count += 1
+			userLocations1 = append(userLocations1, tracked)
+		}
+	}
+
+	if v1Count > 0 {
+		amountV1 = amount * s.Int64("v1") / 100 / v1Count
+	}
+
+	if v2Count > 0 {
+		amountV2 = amount * s.Int64("v2") / 100 / v2Count
+	}
+
+	if v3Count > 0 {
+		amountV3 = amount * s.Int64("v3") / 100 / v3Count
+	}
+
+	res := make([]*RewardIntent, 0)
+	for _, v := range userInfos {
+		var tmpAmount int64
+		if 3 == v.Vip {
+			tmpAmount = amountV3
+		} else if 2 == v.Vip {
+			tmpAmount = amountV2
+		} else if 1 == v.Vip {
+			tmpAmount = amountV1
+		}
+
+		res = append(res, &RewardIntent{
+			Kind:   RewardKindVip,
+			UserId: v.UserId,
+			Amount: tmpAmount,
+		})
+	}
+
+	for _, vLevel := range []struct {
+		locations []*LocationNew
+		amount    int64
+	}{{userLocations1, amountV1}, {userLocations3, amountV3}, {userLocations2, amountV2}} {
+		for _, vUserLocations := range vLevel.locations {
+			res = append(res, &RewardIntent{
+				Kind:     RewardKindVipLocation,
+				UserId:   vUserLocations.UserId,
+				Location: vUserLocations,
+				Amount:   vLevel.amount,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// recommendAreaRewardPolicy 伞下小区业绩达标按级别平分全网手续费
+type recommendAreaRewardPolicy struct {
+	uuc *UserUseCase
+}
+
+func (p *recommendAreaRewardPolicy) Name() string { return "daily_recommend" }
+
+func (p *recommendAreaRewardPolicy) Stages() int { return 1 }
+
+// DayOffset Day为1时发放今天的手续费，即明天默认运行要发的数据
+func (p *recommendAreaRewardPolicy) DayOffset(day int64) int {
+	if 1 == day {
+		return 1
+	}
+
+	return 0
+}
+
+func (p *recommendAreaRewardPolicy) ConfigKeys() []string {
+	return []string{
+		"recommend_area_one", "recommend_area_two", "recommend_area_three", "recommend_area_four",
+		"recommend_area_one_rate", "recommend_area_two_rate", "recommend_area_three_rate", "recommend_area_four_rate",
+	}
+}
+
+func (p *recommendAreaRewardPolicy) Intents(ctx context.Context, s *RewardSnapshot, stage int) ([]*RewardIntent, error) {
+	var (
+		users         []*User
+		userLocations []*LocationNew
+		fee           int64
+		day           = -1
+		err           error
+	)
+
+	if 1 == s.Day {
+		day = 0
+	}
+
+	// 全网手续费
+	userLocations, err = p.uuc.locationRepo.GetLocationDailyYesterday(ctx, day)
+	if nil != err {
+		return nil, err
+	}
+	for _, userLocation := range userLocations {
+		fee += userLocation.CurrentMax * 100 / userLocation.OutRate
+	}
+	if 0 >= fee {
+		return nil, nil
+	}
+	s.Budget = fee
+
+	users, err = p.uuc.repo.GetAllUsers(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	var (
+		areaAmounts = []int64{
+			s.Int64("recommend_area_one"), s.Int64("recommend_area_two"),
+			s.Int64("recommend_area_three"), s.Int64("recommend_area_four"),
+		}
+		areaRates = []int64{
+			s.Int64("recommend_area_one_rate"), s.Int64("recommend_area_two_rate"),
+			s.Int64("recommend_area_three_rate"), s.Int64("recommend_area_four_rate"),
+		}
+		levels = make([][]int64, 4)
+	)
+
+	for _, user := range users {
+		var userArea *UserArea
+		userArea, err = p.uuc.urRepo.GetUserArea(ctx, user.ID)
+		if nil != err {
+			continue
+		}
+
+		// 手动设置的级别
+		if userArea.Level > 0 {
+			for k := range levels {
+				if userArea.Level >= int64(k+1) {
+					levels[k] = append(levels[k], user.ID)
+				}
+			}
+			continue
+		}
+
+		var userRecommend *UserRecommend
+		userRecommend, err = p.uuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
+		if nil != err {
+			continue
+		}
+
+		// 伞下业绩
+		var (
+			myRecommendUsers   []*UserRecommend
+			userAreas          []*UserArea
+			maxAreaAmount      int64
+			areaAmount         int64
+			myRecommendUserIds []int64
+		)
+		myCode := userRecommend.RecommendCode + "D" + strconv.FormatInt(user.ID, 10)
+		myRecommendUsers, err = p.uuc.urRepo.GetUserRecommendByCode(ctx, myCode)
+		if nil == err {
+			// 找直推
+			for _, vMyRecommendUsers := range myRecommendUsers {
+				myRecommendUserIds = append(myRecommendUserIds, vMyRecommendUsers.UserId)
+			}
+		}
+		if 0 < len(myRecommendUserIds) {
+			userAreas, err = p.uuc.urRepo.GetUserAreas(ctx, myRecommendUserIds)
+			if nil == err {
+				var (
+					tmpTotalAreaAmount int64
+				)
+				for _, vUserAreas := range userAreas {
+					tmpAreaAmount := vUserAreas.Amount + vUserAreas.SelfAmount
+					tmpTotalAreaAmount += tmpAreaAmount
+					if tmpAreaAmount > maxAreaAmount {
+						maxAreaAmount = tmpAreaAmount
+					}
+				}
+
+				areaAmount = tmpTotalAreaAmount - maxAreaAmount
+			}
+		}
+
+		// 比较级别
+		for k := range levels {
+			if areaAmount >= areaAmounts[k]*100000 {
+				levels[k] = append(levels[k], user.ID)
+			}
+		}
+	}
+	p.uuc.log.WithContext(ctx).Debugw("msg", "recommend levels", "levels", levels)
+
+	// 分红
+	fee /= 100000 // 这里多除五个0
+	p.uuc.log.WithContext(ctx).Debugw("msg", "recommend fee", "fee", fee)
+
+	res := make([]*RewardIntent, 0)
+	for k, vLevel := range levels {
+		if 0 >= len(vLevel) {
+			continue
+		}
+
+		feeLevel := fee * areaRates[k] / 100 / int64(len(vLevel))
+		feeLevel *= 100000
+		for _, vUserId := range vLevel {
+			var myLocationLast *LocationNew
+			// 获取当前用户的占位信息
+			myLocationLast, _ = p.uuc.locationRepo.GetMyLocationLast(ctx, vUserId)
+			if nil == myLocationLast { // 无占位信息
+				continue
+			}
+
+			res = append(res, &RewardIntent{
+				Kind:     RewardKindRecommendArea,
+				UserId:   vUserId,
+				Location: s.Track(LocationTableNew, myLocationLast),
+				Amount:   feeLevel,
+				Num:      int64(k + 1),
+			})
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,298 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// RewardRollbackReport 回滚报告
+type RewardRollbackReport struct {
+	Reason    string
+	Runs      []*RewardRollbackRun
+	Users     []*RewardReportUser // 扣回的金额
+	Restarted []int64             // 重新运行的占位
+	Warnings  []string            // 无法精确恢复的变动
+}
+
+type RewardRollbackRun struct {
+	RunId        int64
+	Job          string
+	BusinessDate string
+	Items        int64
+	Rewards      int64 // 删除的奖励记录
+	Records      int64 // 删除的余额记录
+	Amount       int64
+	AmountFloat  float64
+}
+
+// RollbackRewardRuns 在一个事务中撤回运行产生的奖励、余额记录和占位变动
+func (uuc *UserUseCase) RollbackRewardRuns(ctx context.Context, runs []*RewardRun, reason string) (*RewardRollbackReport, error) {
+	var (
+		res   *RewardRollbackReport
+		users map[int64]*RewardReportUser
+		err   error
+	)
+
+	for _, run := range runs {
+		if RewardRunCompleted != run.Status && RewardRunFailed != run.Status {
+			return nil, errors.New(500, "ERROR", fmt.Sprintf("运行%d状态为%s，不能回滚", run.ID, run.Status))
+		}
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		res = &RewardRollbackReport{Reason: reason, Restarted: make([]int64, 0), Warnings: make([]string, 0)}
+		users = make(map[int64]*RewardReportUser, 0)
+		for _, run := range runs {
+			var (
+				items     []*RewardRunItem
+				exchanges map[int64]int64
+				err       error
+			)
+
+			items, err = uuc.rewardRunRepo.GetRewardRunItems(ctx, run.ID)
+			if nil != err {
+				return err
+			}
+
+			exchanges, err = uuc.rewardRunRepo.GetRewardRunExchanges(ctx, run.ID)
+			if nil != err {
+				return err
+			}
+
+			rollbackRun := &RewardRollbackRun{RunId: run.ID, Job: run.Job, BusinessDate: run.BusinessDate}
+			res.Runs = append(res.Runs, rollbackRun)
+
+			exchanged := rewardExchangedItems(items, exchanges)
+
+			// 倒序撤回
+			for i := len(items) - 1; i >= 0; i-- {
+				item := items[i]
+
+				err = uuc.rewardRunRepo.RevertRewardRunBalance(ctx, item, exchanged[i])
+				if nil != err {
+					return err
+				}
+
+				var before *LocationNew
+				if 0 < item.LocationId {
+					before, err = uuc.events.State(ctx, item.LocationTable, item.LocationId)
+					if nil != err {
+						return err
+					}
+				}
+
+				err = uuc.rewardRunRepo.RevertRewardRunLocation(ctx, item)
+				if nil != err {
+					return err
+				}
+
+				if nil != before {
+					err = uuc.events.Emit(ctx, rollbackLocationEvent(run, item, before, reason))
+					if nil != err {
+						return err
+					}
+				}
+
+				if 0 < item.LocationId && "stop" == item.Status && "running" == item.PrevStatus {
+					res.Restarted = append(res.Restarted, item.LocationId)
+
+					// 出局时减掉的业绩加回
+					if LocationTableNew == item.LocationTable && (RewardKindLocation == item.Kind || RewardKindRecommend == item.Kind || RewardKindArea == item.Kind) {
+						var location *LocationNew
+						location, err = uuc.locationRepo.GetLocationById(ctx, item.LocationId)
+						if nil != err {
+							return err
+						}
+
+						if nil != location {
+							err = uuc.addLocationNewTotal(ctx, location)
+							if nil != err {
+								return err
+							}
+						}
+					}
+				}
+
+				if RewardKindBalance == item.Kind {
+					if item.PrevDate.IsZero() || 0 >= item.RecordId {
+						res.Warnings = append(res.Warnings, fmt.Sprintf("运行%d 用户%d 余额分红没有记录上次发放日期，未恢复", run.ID, item.UserId))
+					} else {
+						err = uuc.rewardRunRepo.RevertBalanceRewardDate(ctx, item.RecordId, item.PrevDate)
+						if nil != err {
+							return err
+						}
+					}
+				}
+
+				rollbackRun.Items++
+				rollbackRun.Amount += item.Paid
+				rollbackRun.AmountFloat += item.AmountFloat
+				if _, ok := users[item.UserId]; !ok {
+					users[item.UserId] = &RewardReportUser{UserId: item.UserId}
+					res.Users = append(res.Users, users[item.UserId])
+				}
+				users[item.UserId].Amount += item.Paid
+				users[item.UserId].AmountFloat += item.AmountFloat
+			}
+
+			for userId, amount := range exchanges {
+				err = uuc.rewardRunRepo.RevertRewardRunExchange(ctx, userId, amount)
+				if nil != err {
+					return err
+				}
+
+				res.Warnings = append(res.Warnings, fmt.Sprintf("运行%d 用户%d 出局兑换时清零的dhb余额无法恢复，已扣回兑换的usdt %s", run.ID, userId, rewardAmountString(amount, 0)))
+			}
+
+			rollbackRun.Rewards, rollbackRun.Records, err = uuc.rewardRunRepo.DeleteRewardRunRecords(ctx, run.ID)
+			if nil != err {
+				return err
+			}
+
+			err = uuc.rewardRunRepo.RollbackRewardRun(ctx, run.ID, run.Status, reason)
+			if nil != err {
+				return err
+			}
+		}
+
+		return nil
+	}); nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "reward rollback", "err", err)
+		return nil, err
+	}
+
+	uuc.fillRewardReport(ctx, &RewardReport{Users: res.Users}) // 补充地址和vip
+
+	return res, nil
+}
+
+// rewardExchangedItems 每笔奖励的dhb是否已在出局兑换中清零。
+// 出局兑换会清零用户当时全部的dhb，所以同一用户在最后一次兑换及之前发放的dhb不用再扣，之后发放的仍要扣
+func rewardExchangedItems(items []*RewardRunItem, exchanges map[int64]int64) []bool {
+	last := make(map[int64]int, 0)
+	for i, item := range items {
+		if _, ok := exchanges[item.UserId]; !ok {
+			continue
+		}
+
+		if (RewardKindLocation == item.Kind || RewardKindRecommend == item.Kind || RewardKindArea == item.Kind) &&
+			"stop" == item.Status && "running" == item.PrevStatus {
+			last[item.UserId] = i + 1
+		}
+	}
+
+	res := make([]bool, len(items))
+	for i, item := range items {
+		res[i] = i < last[item.UserId]
+	}
+
+	return res
+}
+
+func (uuc *UserUseCase) AdminRewardRollback(ctx context.Context, req *v1.AdminRewardRollbackRequest) (*v1.AdminRewardRollbackReply, error) {
+	var (
+		runs   []*RewardRun
+		report *RewardRollbackReport
+		err    error
+	)
+
+	if "" == req.SendBody.Reason {
+		return nil, errors.New(500, "ERROR", "请填写回滚原因")
+	}
+
+	if 0 < req.SendBody.RunId {
+		var run *RewardRun
+		run, err = uuc.rewardRunRepo.GetRewardRunById(ctx, req.SendBody.RunId)
+		if nil != err {
+			return nil, err
+		}
+		if nil == run {
+			return nil, errors.New(500, "ERROR", "奖励运行记录不存在")
+		}
+
+		runs = append(runs, run)
+	} else {
+		if "" == req.SendBody.StartDate || "" == req.SendBody.EndDate {
+			return nil, errors.New(500, "ERROR", "请填写运行id或日期区间")
+		}
+
+		runs, err = uuc.rewardRunRepo.GetRewardRuns(ctx, req.SendBody.Job, req.SendBody.StartDate, req.SendBody.EndDate)
+		if nil != err {
+			return nil, err
+		}
+
+		// 区间回滚跳过已回滚的运行
+		tmpRuns := make([]*RewardRun, 0)
+		for _, v := range runs {
+			if RewardRunRolledBack == v.Status {
+				continue
+			}
+			tmpRuns = append(tmpRuns, v)
+		}
+		runs = tmpRuns
+	}
+
+	if 0 >= len(runs) {
+		return nil, errors.New(500, "ERROR", "没有可回滚的奖励运行")
+	}
+
+	report, err = uuc.RollbackRewardRuns(ctx, runs, req.SendBody.Reason)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.RewardRollbackReport{
+		Reason:    report.Reason,
+		Runs:      make([]*v1.RewardRollbackReport_Run, 0),
+		Users:     make([]*v1.RewardReport_User, 0),
+		Restarted: report.Restarted,
+		Warnings:  report.Warnings,
+	}
+
+	for _, v := range report.Runs {
+		res.Runs = append(res.Runs, &v1.RewardRollbackReport_Run{
+			RunId:        v.RunId,
+			Job:          v.Job,
+			BusinessDate: v.BusinessDate,
+			Items:        v.Items,
+			Rewards:      v.Rewards,
+			Records:      v.Records,
+			Amount:       rewardAmountString(v.Amount, v.AmountFloat),
+		})
+	}
+
+	for _, v := range report.Users {
+		res.Users = append(res.Users, &v1.RewardReport_User{
+			UserId:  v.UserId,
+			Address: v.Address,
+			Vip:     v.Vip,
+			Amount:  rewardAmountString(v.Amount, v.AmountFloat),
+		})
+	}
+
+	return &v1.AdminRewardRollbackReply{Report: res}, nil
+}
+
+// rollbackLocationEvent 与RevertRewardRunLocation的扣回规则一致
+func rollbackLocationEvent(run *RewardRun, item *RewardRunItem, before *LocationNew, reason string) *LocationEvent {
+	var (
+		event   = LocationEventRollback
+		status  = before.Status
+		current = before.Current - item.Charge
+	)
+
+	if RewardKindLocation == item.Kind || RewardKindRecommend == item.Kind || RewardKindArea == item.Kind {
+		current = before.Current - item.Paid
+	}
+	if "stop" == item.Status && "running" == item.PrevStatus {
+		event = LocationEventRestart
+		status = "running"
+	}
+
+	res := newLocationEvent(event, item.LocationTable, before, status, current, before.CurrentMax, "reward_rollback")
+	res.RunId = run.ID
+	res.Note = reason
+	return res
+}
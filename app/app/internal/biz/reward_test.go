@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRewardRunDate(t *testing.T) {
+	now := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC) // 北京时间20日凌晨
+
+	tests := []struct {
+		name   string
+		policy RewardPolicy
+		opt    *RewardRunOption
+		date   string
+	}{
+		{name: "北京时间当天", policy: &locationRewardPolicy{}, opt: &RewardRunOption{}, date: "2026-10-20"},
+		{name: "参数中的日期不影响业务日", policy: &locationRewardPolicy{}, opt: &RewardRunOption{Date: "2026-01-01 00:00:00"}, date: "2026-10-20"},
+		{name: "提前发放记到下一天", policy: &recommendAreaRewardPolicy{}, opt: &RewardRunOption{Day: 1}, date: "2026-10-21"},
+		{name: "默认发放", policy: &recommendAreaRewardPolicy{}, opt: &RewardRunOption{}, date: "2026-10-20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if date := rewardRunDate(tt.policy, tt.opt, now); tt.date != date {
+				t.Fatalf("date = %s, want %s", date, tt.date)
+			}
+		})
+	}
+}
+
+func TestRewardRunDateOnlyForDryRun(t *testing.T) {
+	uuc := &UserUseCase{}
+	_, err := uuc.ExecuteRewardPolicy(context.Background(), &balanceRewardPolicy{uuc: uuc}, &RewardRunOption{Date: "2026-01-01 00:00:00"})
+	if nil == err {
+		t.Fatal("want error")
+	}
+}
+
+func TestRewardExchangedItems(t *testing.T) {
+	items := []*RewardRunItem{
+		{UserId: 1, Kind: RewardKindBalance},
+		{UserId: 1, Kind: RewardKindLocation, Status: "running", PrevStatus: "running"},
+		{UserId: 2, Kind: RewardKindRecommend, Status: "running", PrevStatus: "running"},
+		{UserId: 1, Kind: RewardKindRecommend, Status: "stop", PrevStatus: "running"},
+		{UserId: 1, Kind: RewardKindArea, Status: "stop", PrevStatus: "stop"},
+		{UserId: 1, Kind: RewardKindBalance},
+		{UserId: 3, Kind: RewardKindLocation, Status: "stop", PrevStatus: "running"},
+	}
+
+	// 用户3没有兑换记录
+	exchanged := rewardExchangedItems(items, map[int64]int64{1: 500000})
+	want := []bool{true, true, false, true, false, false, false}
+	for i := range want {
+		if want[i] != exchanged[i] {
+			t.Fatalf("item %d exchanged = %v, want %v", i, exchanged[i], want[i])
+		}
+	}
+}
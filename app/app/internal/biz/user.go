@@ -10,6 +10,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	jwt2 "github.com/golang-jwt/jwt/v4"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,6 +35,7 @@ type User struct {
 	TotalJ     int64
 	Amount     uint64
 	Kkdt       int64
+	BindAt     time.Time // 地址或密码最后修改时间，未修改过为零值
 	CreatedAt  time.Time
 }
 
@@ -157,8 +159,18 @@ type UserUseCase struct {
 	repo                          UserRepo
 	urRepo                        UserRecommendRepo
 	configRepo                    ConfigRepo
+	registry                      *ConfigRegistry
 	uiRepo                        UserInfoRepo
 	ubRepo                        UserBalanceRepo
+	rewardRunRepo                 RewardRunRepo
+	treeRepo                      LocationTreeRepo
+	productRepo                   LocationProductRepo
+	eventRepo                     LocationEventRepo
+	priceRepo                     PriceFeedRepo
+	priceRevalueRepo              PriceRevalueRepo
+	withdrawReviewRepo            WithdrawReviewRepo
+	exportRepo                    ExportRepo
+	events                        *LocationEventEmitter
 	locationRepo                  LocationRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
 	tx                            Transaction
@@ -182,6 +194,11 @@ type Reward struct {
 type Pagination struct {
 	PageNum  int
 	PageSize int
+	Cursor   *Cursor     // 非空时从游标之后读取，不使用offset
+	NoCount  bool        // 不查总数
+	Filter   *ListFilter // 后台列表的筛选
+	Sort     string      // 排序的列，空为按id，默认倒序
+	Asc      bool
 }
 
 type UserArea struct {
@@ -255,6 +272,7 @@ type UserBalanceRepo interface {
 	GetUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
 	GetUserRewardByUserId(ctx context.Context, userId int64) ([]*Reward, error)
 	GetUserRewards(ctx context.Context, b *Pagination, userId int64, reason string) ([]*Reward, error, int64)
+	GetUserRewardsByReasons(ctx context.Context, b *Pagination, userId int64, reasons ...string) ([]*Reward, error, int64)
 	GetUserRewardById(ctx context.Context, id int64) (*Reward, error)
 	GetUserRewardsLastMonthFee(ctx context.Context) ([]*Reward, error)
 	GetUserBalanceByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserBalanceNew, error)
@@ -267,6 +285,16 @@ type UserBalanceRepo interface {
 	GetUserBalanceDHBTotal(ctx context.Context) (int64, error)
 	GreateWithdraw(ctx context.Context, userId int64, amount int64, coinType string) (*Withdraw, error)
 	WithdrawUsdt(ctx context.Context, userId int64, amount int64) error
+	// CreateWithdrawNew 创建提现记录，包含状态和到账金额
+	CreateWithdrawNew(ctx context.Context, w *Withdraw) (*Withdraw, error)
+	// AddWithdrawDaily 累加当日提现金额，userId为0时是全平台，max大于0且累加后超过时返回false
+	AddWithdrawDaily(ctx context.Context, day string, userId int64, amount int64, max int64) (bool, error)
+	// GetWithdrawsByStatus 按id升序分页
+	GetWithdrawsByStatus(ctx context.Context, b *Pagination, status string) ([]*Withdraw, error, int64)
+	// UpdateWithdrawStatus 只从from修改为to，状态已变化时返回false
+	UpdateWithdrawStatus(ctx context.Context, id int64, from string, to string) (bool, error)
+	// RefundWithdrawUsdt 退回提现扣除的余额并记账
+	RefundWithdrawUsdt(ctx context.Context, userId int64, amount int64) error
 	WithdrawDhb(ctx context.Context, userId int64, amount int64) error
 	GetWithdrawByUserId(ctx context.Context, userId int64) ([]*Withdraw, error)
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64, withdrawType string) ([]*Withdraw, error, int64)
@@ -380,6 +408,7 @@ type UserRepo interface {
 	GetUserByUserIds(ctx context.Context, userIds ...int64) (map[int64]*User, error)
 	GetAdmins(ctx context.Context) ([]*Admin, error)
 	GetUsers(ctx context.Context, b *Pagination, address string, isLocation bool, vip int64) ([]*User, error, int64)
+	EstimateCount(ctx context.Context, table string) (int64, error)
 	GetAllUsers(ctx context.Context) ([]*User, error)
 	GetAllUserInfos(ctx context.Context) ([]*UserInfo, error)
 	GetUserCount(ctx context.Context) (int64, error)
@@ -392,16 +421,26 @@ type UserRepo interface {
 	UpdateAdminPassword(ctx context.Context, account string, password string) (*Admin, error)
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, rewardRunRepo RewardRunRepo, treeRepo LocationTreeRepo, productRepo LocationProductRepo, eventRepo LocationEventRepo, priceRepo PriceFeedRepo, priceRevalueRepo PriceRevalueRepo, withdrawReviewRepo WithdrawReviewRepo, exportRepo ExportRepo, registry *ConfigRegistry, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo:                          repo,
 		tx:                            tx,
 		configRepo:                    configRepo,
+		registry:                      registry,
 		locationRepo:                  locationRepo,
 		userCurrentMonthRecommendRepo: userCurrentMonthRecommendRepo,
 		uiRepo:                        uiRepo,
 		urRepo:                        urRepo,
 		ubRepo:                        ubRepo,
+		rewardRunRepo:                 rewardRunRepo,
+		treeRepo:                      treeRepo,
+		productRepo:                   productRepo,
+		eventRepo:                     eventRepo,
+		priceRepo:                     priceRepo,
+		priceRevalueRepo:              priceRevalueRepo,
+		withdrawReviewRepo:            withdrawReviewRepo,
+		exportRepo:                    exportRepo,
+		events:                        NewLocationEventEmitter(eventRepo),
 		log:                           log.NewHelper(logger),
 	}
 }
@@ -415,7 +454,7 @@ func (uuc *UserUseCase) GetUsersNewTwo(ctx context.Context) ([]*User, error) {
 }
 
 func (uuc *UserUseCase) GetDhbConfig(ctx context.Context) ([]*Config, error) {
-	return uuc.configRepo.GetConfigByKeys(ctx, "level1Dhb", "level2Dhb", "level3Dhb")
+	return uuc.registry.ConfigsByKeys(ctx, "level1Dhb", "level2Dhb", "level3Dhb")
 }
 
 func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *User, req *v1.EthAuthorizeRequest) (*User, error) {
@@ -425,46 +464,286 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 	return user, nil
 }
 
+// 用户可查询的分红理由
+var (
+	recommendRewardReasons = []string{"recommend", "recommend_location", "recommend_team", "recommend_top", "recommend_vip", "recommend_vip_top", "second_recommend", "level_recommend", "area", "daily_recommend_area"}
+	feeRewardReasons       = []string{"fee", "fee_daily"}
+)
+
+// rewardReasons 未指定理由时查全部，指定的理由不在范围内时为空
+func rewardReasons(reasons []string, reason string) []string {
+	if "" == reason {
+		return reasons
+	}
+
+	for _, v := range reasons {
+		if v == reason {
+			return []string{reason}
+		}
+	}
+
+	return nil
+}
+
 func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoReply, error) {
-	return &v1.UserInfoReply{}, nil
+	var (
+		myUser           *User
+		userInfo         *UserInfo
+		userBalance      *UserBalance
+		locations        []*LocationNew
+		userRecommend    *UserRecommend
+		myRecommendUsers []*UserRecommend
+		myTeamUsers      []*UserRecommend
+		err              error
+	)
+
+	myUser, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	userInfo, err = uuc.uiRepo.GetUserInfoByUserId(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	locations, err = uuc.locationRepo.GetLocationsNewByUserId(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.UserInfoReply{
+		Address:     myUser.Address,
+		Level:       userInfo.Vip,
+		Status:      "no",
+		BalanceUsdt: fmt.Sprintf("%.2f", float64(userBalance.BalanceUsdt)/float64(100000)),
+	}
+
+	// 出局中的占位合计
+	var (
+		amount  int64
+		total   int64
+		current int64
+	)
+	for _, v := range locations {
+		if "running" != v.Status {
+			if "no" == res.Status {
+				res.Status = "stop"
+			}
+			continue
+		}
+
+		res.Status = "running"
+		amount += v.Usdt
+		total += v.CurrentMax
+		current += v.Current
+	}
+	res.Amount = fmt.Sprintf("%.2f", float64(amount)/float64(100000))
+	res.Total = fmt.Sprintf("%.2f", float64(total)/float64(100000))
+	res.LocationTotal = fmt.Sprintf("%.2f", float64(current)/float64(100000))
+	res.AmountB = fmt.Sprintf("%.2f", float64(total-current)/float64(100000))
+
+	// 推荐人和团队
+	userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	if "" != userRecommend.RecommendCode {
+		tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
+		tmpUserId, _ := strconv.ParseInt(tmpRecommendUserIds[len(tmpRecommendUserIds)-1], 10, 64)
+		if 0 < tmpUserId {
+			if inviteUser, err := uuc.repo.GetUserById(ctx, tmpUserId); nil == err {
+				res.InviteUserAddress = inviteUser.Address
+			}
+		}
+	}
+
+	myCode := userRecommend.RecommendCode + "D" + strconv.FormatInt(myUser.ID, 10)
+	myRecommendUsers, err = uuc.urRepo.GetUserRecommendByCode(ctx, myCode)
+	if nil != err {
+		return nil, err
+	}
+	res.RecommendNum = int64(len(myRecommendUsers))
+
+	myTeamUsers, err = uuc.urRepo.GetUserRecommendLikeCode(ctx, myCode)
+	if nil != err {
+		return nil, err
+	}
+	res.RecommendTeamNum = int64(len(myTeamUsers))
+
+	return res, nil
 }
 
 func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListRequest, user *User) (*v1.RewardListReply, error) {
+	var (
+		userRewards []*Reward
+		count       int64
+		err         error
+	)
 	res := &v1.RewardListReply{
 		Rewards: make([]*v1.RewardListReply_List, 0),
 	}
 
+	// 用户id为0时仓库会查全部用户
+	if 0 >= user.ID {
+		return res, nil
+	}
+
+	userRewards, err, count = uuc.ubRepo.GetUserRewards(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, user.ID, req.Reason)
+	if nil != err {
+		return nil, err
+	}
+	res.Count = count
+
+	for _, v := range userRewards {
+		res.Rewards = append(res.Rewards, &v1.RewardListReply_List{
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			AmountB:   fmt.Sprintf("%.2f", v.AmountB),
+			Type:      v.Type,
+			Reason:    v.Reason,
+		})
+	}
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v1.RecommendRewardListReply, error) {
+func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, req *v1.RecommendRewardListRequest, user *User) (*v1.RecommendRewardListReply, error) {
+	var (
+		userRewards []*Reward
+		count       int64
+		err         error
+	)
 	res := &v1.RecommendRewardListReply{
 		Rewards: make([]*v1.RecommendRewardListReply_List, 0),
 	}
 
+	reasons := rewardReasons(recommendRewardReasons, req.Reason)
+	if 0 >= user.ID || 0 >= len(reasons) {
+		return res, nil
+	}
+
+	userRewards, err, count = uuc.ubRepo.GetUserRewardsByReasons(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, user.ID, reasons...)
+	if nil != err {
+		return nil, err
+	}
+	res.Count = count
+
+	for _, v := range userRewards {
+		res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Type:      v.Type,
+			Reason:    v.Reason,
+		})
+	}
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeRewardListReply, error) {
+func (uuc *UserUseCase) FeeRewardList(ctx context.Context, req *v1.FeeRewardListRequest, user *User) (*v1.FeeRewardListReply, error) {
+	var (
+		userRewards []*Reward
+		count       int64
+		err         error
+	)
 	res := &v1.FeeRewardListReply{
 		Rewards: make([]*v1.FeeRewardListReply_List, 0),
 	}
 
+	reasons := rewardReasons(feeRewardReasons, req.Reason)
+	if 0 >= user.ID || 0 >= len(reasons) {
+		return res, nil
+	}
+
+	userRewards, err, count = uuc.ubRepo.GetUserRewardsByReasons(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, user.ID, reasons...)
+	if nil != err {
+		return nil, err
+	}
+	res.Count = count
+
+	for _, v := range userRewards {
+		res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Reason:    v.Reason,
+		})
+	}
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User) (*v1.WithdrawListReply, error) {
+func (uuc *UserUseCase) WithdrawList(ctx context.Context, req *v1.WithdrawListRequest, user *User) (*v1.WithdrawListReply, error) {
+	var (
+		userWithdraws []*Withdraw
+		err           error
+	)
 	res := &v1.WithdrawListReply{
 		Withdraw: make([]*v1.WithdrawListReply_List, 0),
 	}
 
-	return res, nil
-}
+	if 0 >= user.ID {
+		return res, nil
+	}
 
-func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, user *User) (*v1.WithdrawReply, error) {
-	return &v1.WithdrawReply{
-		Status: "ok",
-	}, nil
+	userWithdraws, err = uuc.ubRepo.GetWithdrawByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	// 按类型筛选后倒序分页
+	withdraws := make([]*Withdraw, 0)
+	for _, v := range userWithdraws {
+		if "" != req.Type && req.Type != v.Type {
+			continue
+		}
+		withdraws = append(withdraws, v)
+	}
+	sort.Slice(withdraws, func(i, j int) bool {
+		return withdraws[i].ID > withdraws[j].ID
+	})
+	res.Count = int64(len(withdraws))
+
+	page := req.Page
+	if 0 >= page {
+		page = 1
+	}
+	start := (page - 1) * 10
+	if start >= int64(len(withdraws)) {
+		return res, nil
+	}
+	end := start + 10
+	if end > int64(len(withdraws)) {
+		end = int64(len(withdraws))
+	}
+
+	for _, v := range withdraws[start:end] {
+		res.Withdraw = append(res.Withdraw, &v1.WithdrawListReply_List{
+			Id:        v.ID,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			RelAmount: fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(100000)),
+			Type:      v.Type,
+			Status:    v.Status,
+		})
+	}
+
+	return res, nil
 }
 
 func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
@@ -491,14 +770,23 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminReward
 		userId = userSearch.ID
 	}
 
-	userRewards, err, count = uuc.ubRepo.GetUserRewards(ctx, &Pagination{
-		PageNum:  int(req.Page),
-		PageSize: 10,
-	}, userId, req.Reason)
+	pagination, estimate, err := listPagination("reward", req, 0 < userId || "" != req.Reason)
+	if nil != err {
+		return nil, err
+	}
+
+	userRewards, err, count = uuc.ubRepo.GetUserRewards(ctx, pagination, userId, req.Reason)
 	if nil != err {
 		return res, nil
 	}
-	res.Count = count
+	res.Count, res.CountApprox = uuc.listCount(ctx, "reward", estimate, count)
+	if 0 < len(userRewards) {
+		last := userRewards[len(userRewards)-1]
+		res.NextCursor = nextCursor("reward", pagination, len(userRewards), last.ID, map[string]string{
+			"created_at": cursorTime(last.CreatedAt),
+			"amount":     strconv.FormatInt(last.Amount, 10),
+		})
+	}
 
 	userIdsMap = make(map[int64]int64, 0)
 	for _, vUserReward := range userRewards {
@@ -604,14 +892,22 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *v1.AdminUserList
 		Users: make([]*v1.AdminUserListReply_UserList, 0),
 	}
 
-	users, err, count = uuc.repo.GetUsers(ctx, &Pagination{
-		PageNum:  int(req.Page),
-		PageSize: 10,
-	}, req.Address, true, req.Vip)
+	pagination, estimate, err := listPagination("user", req, "" != req.Address)
+	if nil != err {
+		return nil, err
+	}
+
+	users, err, count = uuc.repo.GetUsers(ctx, pagination, req.Address, true, req.Vip)
 	if nil != err {
 		return res, nil
 	}
-	res.Count = count
+	res.Count, res.CountApprox = uuc.listCount(ctx, "user", estimate, count)
+	if 0 < len(users) {
+		last := users[len(users)-1]
+		res.NextCursor = nextCursor("user", pagination, len(users), last.ID, map[string]string{
+			"created_at": cursorTime(last.CreatedAt),
+		})
+	}
 
 	for _, vUsers := range users {
 		userIds = append(userIds, vUsers.ID)
@@ -891,14 +1187,29 @@ func (uuc *UserUseCase) AdminLocationList(ctx context.Context, req *v1.AdminLoca
 	if "running" == req.Status {
 		status = "running"
 	}
-	locations, err, count = uuc.locationRepo.GetLocations(ctx, &Pagination{
-		PageNum:  int(req.Page),
-		PageSize: 10,
-	}, userId, status)
+	// 总是按状态筛选，表统计的估算值没有意义
+	pagination, estimate, err := listPagination("location", req, true)
+	if nil != err {
+		return nil, err
+	}
+	// 筛选里给了状态时以筛选为准
+	if 0 < len(pagination.Filter.Status) {
+		status = ""
+	}
+
+	locations, err, count = uuc.locationRepo.GetLocations(ctx, pagination, userId, status)
 	if nil != err {
 		return res, nil
 	}
-	res.Count = count
+	res.Count, res.CountApprox = uuc.listCount(ctx, "location_new", estimate, count)
+	if 0 < len(locations) {
+		last := locations[len(locations)-1]
+		res.NextCursor = nextCursor("location", pagination, len(locations), last.ID, map[string]string{
+			"created_at": cursorTime(last.CreatedAt),
+			"usdt":       strconv.FormatInt(last.Usdt, 10),
+			"stop_date":  cursorTime(last.StopDate),
+		})
+	}
 
 	userIdsMap = make(map[int64]int64, 0)
 	for _, vLocations := range locations {
@@ -1274,274 +1585,125 @@ func (uuc *UserUseCase) AdminConfig(ctx context.Context, req *v1.AdminConfigRequ
 		Config: make([]*v1.AdminConfigReply_List, 0),
 	}
 
-	configs, _ = uuc.configRepo.GetConfigs(ctx)
+	configs, _ = uuc.registry.ConfigsByKeys(ctx) // 当前生效的值
 	if nil == configs {
 		return res, nil
 	}
 
 	for _, v := range configs {
-		res.Config = append(res.Config, &v1.AdminConfigReply_List{
-			Id:    v.ID,
-			Name:  v.Name,
-			Value: v.Value,
-		})
+		tmp := &v1.AdminConfigReply_List{
+			Id:      v.ID,
+			Name:    v.Name,
+			Value:   v.Value,
+			KeyName: v.KeyName,
+			Type:    ConfigTypeString,
+		}
+		if def := uuc.registry.Def(v.KeyName); nil != def {
+			tmp.Type = def.Type
+			tmp.Unit = def.Unit
+			tmp.Default = def.Default
+			if 0 != def.Min || 0 != def.Max {
+				tmp.Min = def.Bound(def.Min)
+				tmp.Max = def.Bound(def.Max)
+			}
+		}
+		res.Config = append(res.Config, tmp)
 	}
 
 	return res, nil
 }
 
 func (uuc *UserUseCase) AdminConfigUpdateListen(ctx context.Context, req *v1.AdminConfigUpdateListenRequest) (*v1.AdminConfigUpdateListenReply, error) {
-	var (
-		err         error
-		priceChange *PriceChange
-	)
-
-	res := &v1.AdminConfigUpdateListenReply{}
-	priceChange, err = uuc.ubRepo.GetPriceChangeConfig(ctx)
+	report, err := uuc.RevaluePriceChange(ctx, req.DryRun)
 	if nil != err {
 		return nil, err
 	}
 
-	if nil == priceChange {
-		return res, nil
-	}
-
-	_, err = uuc.configRepo.UpdatePriceChangeStatus(ctx, priceChange.ID, 1)
-	if nil != err {
-		return nil, err
-	}
+	return &v1.AdminConfigUpdateListenReply{
+		PriceChangeId: report.PriceChangeId,
+		Origin:        report.Origin,
+		Price:         report.Price,
+		DryRun:        report.DryRun,
+		Users:         report.Users,
+		Up:            report.Up,
+		Down:          report.Down,
+		Stopped:       report.Stopped,
+		Amount:        fmt.Sprintf("%.2f", float64(report.Amount)/float64(100000)),
+		Failed:        report.Failed,
+		RunId:         report.RunId,
+		Status:        report.Status,
+		Resumed:       report.Resumed,
+		Retried:       report.Retried,
+	}, nil
+}
 
+func (uuc *UserUseCase) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigUpdateRequest) (*v1.AdminConfigUpdateReply, error) {
 	var (
-		configs      []*Config
-		bPrice       int64
-		bPriceBase   int64
-		originBprice int64
-		feeRate      int64
-		users        []*User
+		err error
 	)
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "b_price_base", "exchange_rate")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "b_price_base" == vConfig.KeyName {
-				bPriceBase, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
 
-			if "exchange_rate" == vConfig.KeyName {
-				feeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
-	}
+	res := &v1.AdminConfigUpdateReply{}
 
-	bPrice = priceChange.Price
-	originBprice = priceChange.Origin
+	//configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "b_price", "b_price_base", "exchange_rate")
+	//if nil != configs {
+	//	for _, vConfig := range configs {
+	//		if "b_price" == vConfig.KeyName {
+	//			originBprice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+	//		}
+	//		//else if "b_price_base" == vConfig.KeyName {
+	//		//	bPriceBase, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+	//		//} else if "exchange_rate" == vConfig.KeyName {
+	//		//	feeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+	//		//}
+	//	}
+	//}
 
-	if 0 >= bPrice || 0 >= bPriceBase {
-		return nil, err
+	if nil == req.SendBody {
+		return nil, errors.New(500, "ERROR", "参数错误")
 	}
 
-	users, err = uuc.repo.GetAllUsers(ctx)
+	var (
+		effectiveFrom time.Time
+		version       *ConfigVersion
+	)
+	effectiveFrom, err = parseConfigEffectiveFrom(req.SendBody.EffectiveFrom)
 	if nil != err {
 		return nil, err
 	}
-	if nil == users {
-		return nil, nil
-	}
-	for _, v := range users {
-		var (
-			runningLocation *LocationNew
-			userBalance     *UserBalance
-		)
-		runningLocation, err = uuc.locationRepo.GetMyLocationLastRunning(ctx, v.ID)
-		if nil != err {
-			fmt.Println(err)
-			continue
-		}
 
-		if nil == runningLocation {
-			continue
-		}
+	// 按声明校验类型和范围，写入新版本，修改后通知所有实例清除缓存
+	version, err = uuc.registry.Update(ctx, uuc.tx, &ConfigChange{
+		ConfigId:      req.SendBody.Id,
+		Value:         req.SendBody.Value,
+		EffectiveFrom: effectiveFrom,
+		Note:          req.SendBody.Note,
+	})
+	if nil != err {
+		return nil, err
+	}
+	res.VersionId = version.ID
+	//
+	//_, err = uuc.configRepo.UpdateConfig(ctx, req.SendBody.Id, req.SendBody.Value)
+	//if nil != err {
+	//	return res, err
+	//}
 
-		userBalance, err = uuc.ubRepo.GetUserBalance(ctx, v.ID)
-		if nil != err {
-			fmt.Println(err)
-			continue
-		}
+	return res, nil
+}
 
-		if bPrice > originBprice {
-			// 涨价
-			tmp := userBalance.BalanceDhb*100/bPriceBase*bPrice - userBalance.BalanceDhb*100/bPriceBase*originBprice
-			tmp = tmp / 100
-			if tmp > 0 {
+func (uuc *UserUseCase) AdminPasswordUpdate(ctx context.Context, req *v1.AdminPasswordUpdateRequest) (*v1.AdminPasswordUpdateReply, error) {
 
-				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					runningLocation.Status = "running"
-					if runningLocation.Current+tmp >= runningLocation.CurrentMax { // 占位分红人分满停止
-						runningLocation.Status = "stop"
-						runningLocation.StopDate = time.Now().UTC().Add(8 * time.Hour)
+	_, _ = uuc.uiRepo.UpdateUserPassword(ctx, req.SendBody.UserId, req.SendBody.Password)
+	return &v1.AdminPasswordUpdateReply{}, nil
+}
 
-						tmp = runningLocation.CurrentMax - runningLocation.Current
-					}
-
-					if 0 < tmp {
-						var tmpMaxNew int64
-						if runningLocation.CurrentMax >= runningLocation.CurrentMaxNew {
-							tmpMaxNew = runningLocation.CurrentMax - runningLocation.CurrentMaxNew
-						}
-						err = uuc.locationRepo.UpdateLocationNewNew(ctx, runningLocation.ID, runningLocation.Status, tmp, tmpMaxNew, 0, runningLocation.StopDate) // 分红占位数据修改
-						if nil != err {
-							return err
-						}
-
-						err = uuc.ubRepo.PriceChange(ctx, runningLocation.UserId, tmp, "up")
-						if nil != err {
-							return err
-						}
-					}
-
-					// 业绩减掉
-					if "stop" == runningLocation.Status {
-						if runningLocation.CurrentMax >= runningLocation.CurrentMaxNew {
-							_, err = uuc.ubRepo.ExchangeBiw(ctx, v.ID, runningLocation.CurrentMax-runningLocation.CurrentMaxNew, feeRate)
-							if nil != err {
-								return err
-							}
-						}
-
-						tmpTop := runningLocation.Top
-						tmpTopNum := runningLocation.TopNum
-						for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-							err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, runningLocation.Usdt/100000)
-							if nil != err {
-								return err
-							}
-
-							var (
-								currentLocation *LocationNew
-							)
-							currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-							if nil != err {
-								return err
-							}
-
-							if nil != currentLocation && 0 < currentLocation.Top {
-								tmpTop = currentLocation.Top
-								tmpTopNum = currentLocation.TopNum
-								continue
-							}
-
-							break
-						}
-					}
-
-					return nil
-				}); nil != err {
-					fmt.Println("err price change", err, runningLocation)
-					continue
-				}
-			}
-
-		} else if bPrice < originBprice {
-			// 降价
-			tmp := userBalance.BalanceDhb*100/bPriceBase*originBprice - userBalance.BalanceDhb*100/bPriceBase*bPrice
-			tmp = tmp / 100
-			if tmp > 0 {
-				if runningLocation.Current <= tmp { // 占位分红人分满停止
-					tmp = runningLocation.Current
-				}
-
-				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					if 0 < tmp {
-						err = uuc.locationRepo.UpdateLocationNewNewNew(ctx, runningLocation.ID, tmp) // 分红占位数据修改
-						if nil != err {
-							return err
-						}
-
-						err = uuc.ubRepo.PriceChange(ctx, runningLocation.UserId, tmp, "down")
-						if nil != err {
-							return err
-						}
-					}
-
-					return nil
-				}); nil != err {
-					fmt.Println("err price change", err, runningLocation)
-					continue
-				}
-			}
-		}
-	}
-
-	return res, nil
-}
-
-func (uuc *UserUseCase) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigUpdateRequest) (*v1.AdminConfigUpdateReply, error) {
-	var (
-		err error
-	)
-
-	res := &v1.AdminConfigUpdateReply{}
-
-	//configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "b_price", "b_price_base", "exchange_rate")
-	//if nil != configs {
-	//	for _, vConfig := range configs {
-	//		if "b_price" == vConfig.KeyName {
-	//			originBprice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-	//		}
-	//		//else if "b_price_base" == vConfig.KeyName {
-	//		//	bPriceBase, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-	//		//} else if "exchange_rate" == vConfig.KeyName {
-	//		//	feeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-	//		//}
-	//	}
-	//}
-
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		_, err = uuc.configRepo.UpdateConfig(ctx, req.SendBody.Id, req.SendBody.Value)
-		if nil != err {
-			return err
-		}
-
-		return nil
-	}); err != nil {
-		return nil, err
-	}
-	//
-	//_, err = uuc.configRepo.UpdateConfig(ctx, req.SendBody.Id, req.SendBody.Value)
-	//if nil != err {
-	//	return res, err
-	//}
-
-	return res, nil
-}
-
-func (uuc *UserUseCase) AdminWithdrawPass(ctx context.Context, req *v1.AdminWithdrawPassRequest) (*v1.AdminWithdrawPassReply, error) {
-	//var (
-	//	err error
-	//)
-
-	//res := &v1.AdminWithdrawPassReply{}
-	//
-	//_, err = uuc.ubRepo.UpdateWithdrawPass(ctx, req.SendBody.Id)
-	//if nil != err {
-	//	return res, err
-	//}
-
-	return nil, nil
-}
-
-func (uuc *UserUseCase) AdminPasswordUpdate(ctx context.Context, req *v1.AdminPasswordUpdateRequest) (*v1.AdminPasswordUpdateReply, error) {
-
-	_, _ = uuc.uiRepo.UpdateUserPassword(ctx, req.SendBody.UserId, req.SendBody.Password)
-	return &v1.AdminPasswordUpdateReply{}, nil
-}
-
-func (uuc *UserUseCase) AdminVipUpdate(ctx context.Context, req *v1.AdminVipUpdateRequest) (*v1.AdminVipUpdateReply, error) {
-	var (
-		user      *User
-		err       error
-		strUpdate string
-		total     uint64
-	)
+func (uuc *UserUseCase) AdminVipUpdate(ctx context.Context, req *v1.AdminVipUpdateRequest) (*v1.AdminVipUpdateReply, error) {
+	var (
+		user      *User
+		err       error
+		strUpdate string
+		total     uint64
+	)
 
 	if 1000 == req.SendBody.Vip {
 		total = 1000
@@ -1594,7 +1756,7 @@ func (uuc *UserUseCase) AdminVipUpdate(ctx context.Context, req *v1.AdminVipUpda
 
 		return nil
 	}); nil != err {
-		fmt.Println(err, "错误投资3", req.SendBody.UserId, int64(total), int64(user.Total))
+		uuc.log.WithContext(ctx).Errorw("msg", "错误投资3", "user_id", req.SendBody.UserId, "total", int64(total), "user_total", int64(user.Total), "err", err)
 		return nil, err
 	}
 
@@ -1615,22 +1777,33 @@ func (uuc *UserUseCase) AdminKkdtUpdate(ctx context.Context, req *v1.AdminKkdtUp
 
 		return nil
 	}); nil != err {
-		fmt.Println(err, "错误投资3", req.SendBody.UserId, req.SendBody.Amount)
+		uuc.log.WithContext(ctx).Errorw("msg", "错误投资3", "user_id", req.SendBody.UserId, "amount", req.SendBody.Amount, "err", err)
 		return nil, err
 	}
 
 	return nil, nil
 }
 
+// parseAmount 后台输入的金额，按100000倍存储
+func parseAmount(s string) (int64, error) {
+	amountFloat, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if nil != err {
+		return 0, errors.New(500, "ERROR", "金额格式错误")
+	}
+
+	return int64(math.Round(amountFloat * 100000)), nil
+}
+
 func (uuc *UserUseCase) AdminBalanceUpdate(ctx context.Context, req *v1.AdminBalanceUpdateRequest) (*v1.AdminBalanceUpdateReply, error) {
 	var (
 		err error
 	)
 	res := &v1.AdminBalanceUpdateReply{}
 
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 100000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	amount, err := parseAmount(req.SendBody.Amount)
+	if nil != err {
+		return res, err
+	}
 
 	_, err = uuc.ubRepo.UpdateBalance(ctx, req.SendBody.UserId, amount) // 推荐人信息修改
 	if nil != err {
@@ -1648,7 +1821,6 @@ func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *v1.AdminLoginReques
 
 	res := &v1.AdminLoginReply{}
 	password := fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password)))
-	fmt.Println(password)
 	admin, err = uuc.repo.GetAdminByAccount(ctx, req.SendBody.Account, password)
 	if nil != err {
 		return res, err
@@ -2043,14 +2215,23 @@ func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWith
 		userId = userSearch.ID
 	}
 
-	withdraws, err, count = uuc.ubRepo.GetWithdraws(ctx, &Pagination{
-		PageNum:  int(req.Page),
-		PageSize: 10,
-	}, userId, req.WithDrawType)
+	pagination, estimate, err := listPagination("withdraw", req, 0 < userId)
+	if nil != err {
+		return nil, err
+	}
+
+	withdraws, err, count = uuc.ubRepo.GetWithdraws(ctx, pagination, userId, req.WithDrawType)
 	if nil != err {
 		return res, err
 	}
-	res.Count = count
+	res.Count, res.CountApprox = uuc.listCount(ctx, "withdraw", estimate, count)
+	if 0 < len(withdraws) {
+		last := withdraws[len(withdraws)-1]
+		res.NextCursor = nextCursor("withdraw", pagination, len(withdraws), last.ID, map[string]string{
+			"created_at": cursorTime(last.CreatedAt),
+			"amount_new": strconv.FormatFloat(last.AmountNew, 'f', -1, 64),
+		})
+	}
 
 	userIdsMap = make(map[int64]int64, 0)
 	for _, vWithdraws := range withdraws {
@@ -2131,12 +2312,12 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 		err       error
 	)
 
-	configs, err = uuc.configRepo.GetConfigByKeys(ctx,
+	configs, err = uuc.registry.ConfigsByKeys(ctx,
 		"one", "two", "three", "four", "five", "six",
 		"one_two", "two_two", "three_two", "four_two", "five_two", "four_three", "five_three", "today", "seven", "eight", "nine", "seven_two", "eight_two", "nine_two",
 	)
 	if nil != err {
-		fmt.Println("分红,配置", err)
+		uuc.log.WithContext(ctx).Errorw("msg", "分红,配置", "err", err)
 		return nil, err
 	}
 
@@ -2236,7 +2417,7 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 	)
 	users, err = uuc.repo.GetUsersNew(ctx)
 	if nil != err {
-		fmt.Println("分红", err)
+		uuc.log.WithContext(ctx).Errorw("msg", "分红", "err", err)
 		return nil, nil
 	}
 
@@ -2378,18 +2559,17 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 		TotalFive:         fiveAmounts,
 		TotalFour:         fourAmounts,
 	}, nil
-	return nil, nil
 }
 
 func (uuc *UserUseCase) GetConfigWithdrawDestroyRate(ctx context.Context) ([]*Config, error) {
-	return uuc.configRepo.GetConfigByKeys(ctx, "withdraw_destroy_rate")
+	return uuc.registry.ConfigsByKeys(ctx, "withdraw_destroy_rate")
 }
 
 func (uuc *UserUseCase) AdminTrade(ctx context.Context, req *v1.AdminTradeRequest) (*v1.AdminTradeReply, error) {
 	//time.Sleep(30 * time.Second) // 错开时间和充值
 	var (
 		tradeNotDeal                []*Trade
-		configs                     []*Config
+		configs                     map[string]int64
 		withdrawRate                int64
 		withdrawRecommendRate       int64
 		withdrawRecommendSecondRate int64
@@ -2403,38 +2583,26 @@ func (uuc *UserUseCase) AdminTrade(ctx context.Context, req *v1.AdminTradeReques
 		err                         error
 	)
 	// 配置
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "withdraw_rate",
+	configs, err = uuc.registry.Int64s(ctx, "withdraw_rate",
 		"withdraw_recommend_rate", "withdraw_recommend_second_rate",
 		"withdraw_team_vip_rate", "withdraw_team_vip_second_rate",
 		"withdraw_team_vip_third_rate", "withdraw_team_vip_fourth_rate",
 		"withdraw_team_vip_fifth_rate", "withdraw_team_vip_level_rate",
-		"withdraw_destroy_rate", "vip_0_balance",
+		"vip_0_balance",
 	)
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "withdraw_rate" == vConfig.KeyName {
-				withdrawRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_recommend_rate" == vConfig.KeyName {
-				withdrawRecommendRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_recommend_second_rate" == vConfig.KeyName {
-				withdrawRecommendSecondRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_team_vip_rate" == vConfig.KeyName {
-				withdrawTeamVipRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_team_vip_second_rate" == vConfig.KeyName {
-				withdrawTeamVipSecondRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_team_vip_third_rate" == vConfig.KeyName {
-				withdrawTeamVipThirdRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_team_vip_fourth_rate" == vConfig.KeyName {
-				withdrawTeamVipFourthRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_team_vip_fifth_rate" == vConfig.KeyName {
-				withdrawTeamVipFifthRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_team_vip_level_rate" == vConfig.KeyName {
-				withdrawTeamVipLevelRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_0_balance" == vConfig.KeyName {
-				vip0Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
+	if nil != err {
+		return nil, err
 	}
+	withdrawRate = configs["withdraw_rate"]
+	withdrawRecommendRate = configs["withdraw_recommend_rate"]
+	withdrawRecommendSecondRate = configs["withdraw_recommend_second_rate"]
+	withdrawTeamVipRate = configs["withdraw_team_vip_rate"]
+	withdrawTeamVipSecondRate = configs["withdraw_team_vip_second_rate"]
+	withdrawTeamVipThirdRate = configs["withdraw_team_vip_third_rate"]
+	withdrawTeamVipFourthRate = configs["withdraw_team_vip_fourth_rate"]
+	withdrawTeamVipFifthRate = configs["withdraw_team_vip_fifth_rate"]
+	withdrawTeamVipLevelRate = configs["withdraw_team_vip_level_rate"]
+	vip0Balance = configs["vip_0_balance"]
 
 	tradeNotDeal, err = uuc.ubRepo.GetTradeNotDeal(ctx)
 	if nil == tradeNotDeal {
@@ -2677,2044 +2845,103 @@ func (uuc *UserUseCase) AdminTrade(ctx context.Context, req *v1.AdminTradeReques
 }
 
 func (uuc *UserUseCase) AdminDailyBalanceReward(ctx context.Context, req *v1.AdminDailyBalanceRewardRequest) (*v1.AdminDailyBalanceRewardReply, error) {
-	var (
-		balanceRewards    []*BalanceReward
-		configs           []*Config
-		balanceRewardRate int64
-		coinPrice         int64
-		coinRewardRate    int64
-		rewardRate        int64
-		err               error
-	)
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "balance_reward_rate", "coin_price", "coin_reward_rate", "reward_rate")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "balance_reward_rate" == vConfig.KeyName {
-				balanceRewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "coin_price" == vConfig.KeyName {
-				coinPrice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "coin_reward_rate" == vConfig.KeyName {
-				coinRewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "reward_rate" == vConfig.KeyName {
-				rewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
+	report, err := uuc.RunRewardPolicy(ctx, "daily_balance", &RewardRunOption{Date: req.Date, DryRun: req.DryRun})
+	if nil != err {
+		return nil, err
 	}
 
-	now := time.Now()
-	if "" != req.Date { // 测试条件
-		now, err = time.Parse("2006-01-02 15:04:05", req.Date) // 时间进行格式校验
-		if nil != err {
-			return nil, err
-		}
+	return &v1.AdminDailyBalanceRewardReply{Report: rewardReportReply(report)}, nil
+}
+
+func (uuc *UserUseCase) AdminDailyBuyReward(ctx context.Context, req *v1.AdminDailyLocationRewardRequest) (*v1.AdminDailyLocationRewardReply, error) {
+	report, err := uuc.RunRewardPolicy(ctx, "daily_buy", &RewardRunOption{Date: req.Date, Day: req.Day, DryRun: req.DryRun})
+	if nil != err {
+		return nil, err
 	}
 
-	now = now.UTC()
-	balanceRewards, err = uuc.ubRepo.GetBalanceRewardCurrent(ctx, now)
+	return &v1.AdminDailyLocationRewardReply{Report: rewardReportReply(report)}, nil
+}
 
-	timeLimit := time.Now().UTC().Add(-23 * time.Hour)
+func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.AdminDailyLocationRewardRequest) (*v1.AdminDailyLocationRewardReply, error) {
+	report, err := uuc.RunRewardPolicy(ctx, "daily_location", &RewardRunOption{Date: req.Date, Day: req.Day, DryRun: req.DryRun})
+	if nil != err {
+		return nil, err
+	}
 
-	for _, vBalanceRewards := range balanceRewards {
-		if "" == req.Date { // 测试条件
-			if vBalanceRewards.LastRewardDate.After(timeLimit) {
-				continue
-			}
-		}
+	return &v1.AdminDailyLocationRewardReply{Report: rewardReportReply(report)}, nil
+}
 
-		// 今天发
-		tmpCurrentReward := vBalanceRewards.Amount * balanceRewardRate / 1000
-		var myLocationLast *LocationNew
-		// 获取当前用户的占位信息，已经有运行中的跳过
-		myLocationLast, err = uuc.locationRepo.GetMyLocationLast(ctx, vBalanceRewards.UserId)
-		if nil == myLocationLast { // 无占位信息
-			continue
-		}
+func (uuc *UserUseCase) AdminDailyAreaReward(ctx context.Context, req *v1.AdminDailyLocationRewardRequest) (*v1.AdminDailyLocationRewardReply, error) {
+	report, err := uuc.RunRewardPolicy(ctx, "daily_area", &RewardRunOption{Date: req.Date, Day: req.Day, DryRun: req.DryRun})
+	if nil != err {
+		return nil, err
+	}
 
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpCurrentStatus := myLocationLast.Status // 现在还在运行中
+	return &v1.AdminDailyLocationRewardReply{Report: rewardReportReply(report)}, nil
+}
 
-			tmpBalanceUsdtAmount := tmpCurrentReward * rewardRate / 100 // 记录下一次
-			tmpBalanceCoinAmount := tmpCurrentReward * coinRewardRate / 100 * 1000 / coinPrice
+func (uuc *UserUseCase) AdminUpdateLocationNewMax(ctx context.Context, req *v1.AdminUpdateLocationNewMaxRequest) (*v1.AdminUpdateLocationNewMaxReply, error) {
+	var (
+		err error
+	)
+	res := &v1.AdminUpdateLocationNewMaxReply{}
+	amount, err := parseAmount(req.SendBody.Amount)
+	if nil != err {
+		return res, err
+	}
 
-			myLocationLast.Status = "running"
-			myLocationLast.Current += tmpCurrentReward
-			if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
-				if "running" == tmpCurrentStatus {
-					myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
+	var location *LocationNew
+	location, err = uuc.locationRepo.GetMyLocationLast(ctx, req.SendBody.UserId)
+	if nil != err {
+		return res, err
+	}
 
-					lastRewardAmount := tmpCurrentReward - (myLocationLast.Current - myLocationLast.CurrentMax)
-					tmpBalanceUsdtAmount = lastRewardAmount * rewardRate / 100 // 记录下一次
-					tmpBalanceCoinAmount = lastRewardAmount * coinRewardRate / 100 * 1000 / coinPrice
-				}
-				myLocationLast.Status = "stop"
-			}
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, err = uuc.ubRepo.UpdateLocationNewMax(ctx, req.SendBody.UserId, amount)
+		if nil != err {
+			return err
+		}
 
-			if 0 < tmpCurrentReward {
-				err = uuc.locationRepo.UpdateLocationNew(ctx, myLocationLast.ID, myLocationLast.Status, tmpCurrentReward, myLocationLast.StopDate) // 分红占位数据修改
-				if nil != err {
-					return err
-				}
+		event := newLocationEvent(LocationEventAdmin, LocationTableNew, location, location.Status, location.Current, location.CurrentMax, "admin_update_location_new_max")
+		event.Amount = amount
+		event.Note = locationAmountNote("current_max_new", amount)
+		return uuc.events.Emit(ctx, event)
+	}); nil != err {
+		return res, err
+	}
 
-				_, err = uuc.ubRepo.UserDailyBalanceReward(ctx, vBalanceRewards.UserId, tmpCurrentReward, tmpBalanceUsdtAmount, tmpBalanceCoinAmount, tmpCurrentStatus)
-				if nil != err {
-					return err
-				}
+	return nil, err
+}
 
-				err = uuc.ubRepo.UpdateBalanceRewardLastRewardDate(ctx, vBalanceRewards.ID)
-				if nil != err {
-					return err
-				}
-			}
+func (uuc *UserUseCase) AdminDailyLocationRewardNew(ctx context.Context, req *v1.AdminDailyLocationRewardNewRequest) (*v1.AdminDailyLocationRewardNewReply, error) {
+	report, err := uuc.RunRewardPolicy(ctx, "daily_location_new", &RewardRunOption{DryRun: req.DryRun})
+	if nil != err {
+		return nil, err
+	}
 
-			return nil
-		}); nil != err {
-			continue
-		}
+	return &v1.AdminDailyLocationRewardNewReply{Report: rewardReportReply(report)}, nil
+}
 
+func (uuc *UserUseCase) AdminDailyRecommendReward(ctx context.Context, req *v1.AdminDailyRecommendRewardRequest) (*v1.AdminDailyRecommendRewardReply, error) {
+	report, err := uuc.RunRewardPolicy(ctx, "daily_recommend", &RewardRunOption{Day: req.Day, DryRun: req.DryRun})
+	if nil != err {
+		return nil, err
 	}
 
-	return &v1.AdminDailyBalanceRewardReply{}, nil
+	return &v1.AdminDailyRecommendRewardReply{Report: rewardReportReply(report)}, nil
 }
 
-func (uuc *UserUseCase) AdminDailyBuyReward(ctx context.Context, req *v1.AdminDailyLocationRewardRequest) (*v1.AdminDailyLocationRewardReply, error) {
-	var (
-		configs   []*Config
-		one       float64
-		two       float64
-		three     float64
-		four      float64
-		five      float64
-		six       float64
-		eight     float64
-		nine      float64
-		seven     float64
-		oneTwo    float64
-		twoTwo    float64
-		eightTwo  float64
-		sevenTwo  float64
-		nineTwo   float64
-		threeTwo  float64
-		fiveThree float64
-		fourThree float64
-		today     float64
-		err       error
-	)
+func (uuc *UserUseCase) CheckAndInsertRecommendArea(ctx context.Context, req *v1.CheckAndInsertRecommendAreaRequest) (*v1.CheckAndInsertRecommendAreaReply, error) {
 
-	configs, err = uuc.configRepo.GetConfigByKeys(ctx,
-		"one", "two", "three", "four", "five", "six",
-		"one_two", "two_two", "three_two", "four_two", "five_two", "four_three", "five_three", "today", "seven", "eight", "nine", "seven_two", "eight_two", "nine_two",
+	var (
+		userRecommends         []*UserRecommend
+		userRecommendAreaCodes []string
+		userRecommendAreas     []*UserRecommendArea
+		err                    error
 	)
+	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
 	if nil != err {
-		fmt.Println("分红,配置", err)
-		return nil, err
-	}
-
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "one" == vConfig.KeyName {
-				one, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "two" == vConfig.KeyName {
-				two, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "three" == vConfig.KeyName {
-				three, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "four" == vConfig.KeyName {
-				four, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "five" == vConfig.KeyName {
-				five, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "six" == vConfig.KeyName {
-				six, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "seven" == vConfig.KeyName {
-				seven, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "eight" == vConfig.KeyName {
-				eight, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "nine" == vConfig.KeyName {
-				nine, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "one_two" == vConfig.KeyName {
-				oneTwo, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "two_two" == vConfig.KeyName {
-				twoTwo, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "three_two" == vConfig.KeyName {
-				threeTwo, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "eight_two" == vConfig.KeyName {
-				eightTwo, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "seven_two" == vConfig.KeyName {
-				sevenTwo, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "nine_two" == vConfig.KeyName {
-				nineTwo, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "four_three" == vConfig.KeyName {
-				fourThree, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "five_three" == vConfig.KeyName {
-				fiveThree, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			} else if "today" == vConfig.KeyName {
-				today, err = strconv.ParseFloat(vConfig.Value, 10)
-				if nil != err {
-					return nil, err
-				}
-			}
-		}
-	}
-
-	var (
-		users []*User
-	)
-	users, err = uuc.repo.GetUsersNew(ctx)
-	if nil != err {
-		fmt.Println("分红", err)
-		return nil, nil
-	}
-
-	fourUsers := make([]*User, 0)
-	fiveUsers := make([]*User, 0)
-
-	fiveUsersNo := make(map[string]int64, 0)
-	fiveUsersNo["bKY3uVvmEo1SPC3LEsp4GzmofBMfz1GewJ"] = 1
-	fiveUsersNo["b8ejsna15818PGy8AbT2coD2GZSHaJ1xRp"] = 1
-	fiveUsersNo["bPLvww5wQPDM4DqHgAeTiMsHh8PQirFVza"] = 1
-	fiveUsersNo["b9xNhfVxwePX9YZuupUuwRw8EmcD2s7dAn"] = 1
-	fiveUsersNo["b4zqvcq883vdAdctYom76dnm2MGzhx7yTD"] = 1
-	fiveUsersNo["bMwH72jPD2e7Dq3y5JKbbrRUdc2fguUGRa"] = 1
-	fiveUsersNo["b5jashkZafpamL8UYALTWd2yt1hHjUKpG4"] = 1
-	fiveUsersNo["bGb82dqZRQzz1wBSNu3FuSpwQ7UiirMTLJ"] = 1
-	fiveUsersNo["bPFcA5GLXawJY1ek6U3KA2RZ7y9AEBKNQj"] = 1
-	fiveUsersNo["b87Qpju42hk4YCFBRC6Zv8afqcd2d7xHZ1"] = 1
-	fiveUsersNo["b2yja3Xp5xy28MVh3LCczt32zQNkmZKinJ"] = 1
-	fiveUsersNo["b66qjHTvZHSfqReGtkFGSTZ2szpyHp8tjM"] = 1
-	fiveUsersNo["b2F47WsjyVPaJQYzWwVopbu3vN6hu5Cz6P"] = 1
-	fiveUsersNo["b88VG3L3PDXskUe149sGrhtJGVZe6xgNXM"] = 1
-	fiveUsersNo["bMqNB61Ej3bnxJjLgW5REnaJyqhsysH4wC"] = 1
-
-	var (
-		amountSecond float64 // 节点超级节点奖励额度
-	)
-	for _, vUsers := range users {
-		// 定义阈值数组
-
-		amounts := make([]float64, 0)
-		// 超级节点，节点
-		number := vUsers.Total
-		if 15000 <= number {
-			if 1000000 > number {
-				if 30000 <= number {
-					if _, ok := fiveUsersNo[vUsers.Address]; !ok {
-						fiveUsers = append(fiveUsers, vUsers)
-					}
-				} else {
-					fourUsers = append(fourUsers, vUsers)
-				}
-			}
-		}
-
-		for i := 0; i < int(vUsers.TotalD); i++ {
-			amounts = append(amounts, four)
-		}
-		for i := 0; i < int(vUsers.TotalF); i++ {
-			amounts = append(amounts, five)
-		}
-		for i := 0; i < int(vUsers.TotalG); i++ {
-			amounts = append(amounts, six)
-		}
-
-		// 普通
-		for i := 0; i < int(vUsers.TotalA); i++ {
-			amounts = append(amounts, one)
-			amountSecond += oneTwo
-		}
-		for i := 0; i < int(vUsers.TotalB); i++ {
-			amounts = append(amounts, two)
-			amountSecond += twoTwo
-		}
-		for i := 0; i < int(vUsers.TotalC); i++ {
-			amounts = append(amounts, three)
-			amountSecond += threeTwo
-		}
-		for i := 0; i < int(vUsers.TotalH); i++ {
-			if 0 < nine {
-				amounts = append(amounts, nine)
-			}
-			if 0 < nineTwo {
-				amountSecond += nineTwo
-			}
-		}
-		for i := 0; i < int(vUsers.TotalI); i++ {
-			if 0 < eight {
-				amounts = append(amounts, eight)
-			}
-			if 0 < eightTwo {
-				amountSecond += eightTwo
-			}
-		}
-		for i := 0; i < int(vUsers.TotalJ); i++ {
-			if 0 < seven {
-				amounts = append(amounts, seven)
-			}
-			if 0 < sevenTwo {
-				amountSecond += sevenTwo
-			}
-		}
-
-		for _, amount := range amounts {
-			// 发奖励
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-
-				// 充值记录
-				err = uuc.ubRepo.FirstRewardBiw(ctx, vUsers.ID, amount)
-				if nil != err {
-					return err
-				}
-
-				return nil
-			}); nil != err {
-				fmt.Println(err, "错误发奖励1", vUsers, amount)
-				continue
-			}
-		}
-	}
-
-	// 查询昨日入金
-	// 全网
-	var (
-		perFour float64
-		perFive float64
-	)
-
-	if 0 < today {
-		amountSecond = today
-	}
-
-	if 0 >= amountSecond {
-		return nil, nil
-	}
-
-	if 0 < len(fourUsers) {
-		perFour = amountSecond * fourThree / 100 / float64(len(fourUsers))
-		fmt.Println(amountSecond, fourThree, perFour)
-		if 0 < perFour {
-			for _, vFourUser := range fourUsers {
-				// 发奖励
-				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-
-					err = uuc.ubRepo.SecondRewardBiw(ctx, vFourUser.ID, perFour, "four")
-					if nil != err {
-						return err
-					}
-
-					return nil
-				}); nil != err {
-					fmt.Println(err, "错误发奖励2,4", vFourUser, perFour, "four")
-					continue
-				}
-			}
-		}
-	}
-
-	if 0 < len(fiveUsers) {
-		perFive = amountSecond * fiveThree / 100 / float64(len(fiveUsers))
-		fmt.Println(amountSecond, fiveThree, perFive)
-
-		if 0 < perFive {
-			for _, vFiveUser := range fiveUsers {
-				// 发奖励
-				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-
-					err = uuc.ubRepo.SecondRewardBiw(ctx, vFiveUser.ID, perFive, "five")
-					if nil != err {
-						return err
-					}
-
-					return nil
-				}); nil != err {
-					fmt.Println(err, "错误发奖励2,5", vFiveUser, perFive)
-					continue
-				}
-			}
-		}
-	}
-
-	return nil, nil
-}
-
-func (uuc *UserUseCase) AdminDailyLocationReward(ctx context.Context, req *v1.AdminDailyLocationRewardRequest) (*v1.AdminDailyLocationRewardReply, error) {
-	var (
-		userLocations      []*LocationNew
-		configs            []*Config
-		locationRewardRate int64
-		bPrice             int64
-		bPriceBase         int64
-		recommendOneRate   int64
-		recommendTwoRate   int64
-		recommendThreeRate int64
-		recommendFourRate  int64
-		recommendFiveRate  int64
-		recommendSixRate   int64
-		recommendSevenRate int64
-		recommendEightRate int64
-		feeRate            int64
-		err                error
-	)
-
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx,
-		"location_reward_rate", "b_price", "b_price_base", "exchange_rate",
-		"recommend_one_rate", "recommend_two_rate",
-		"recommend_three_rate", "recommend_four_rate",
-		"recommend_five_rate", "recommend_six_rate",
-		"recommend_seven_rate", "recommend_eight_rate",
-		"area_one", "area_two", "area_three", "area_four", "area_five",
-		"area_num_one", "area_num_two", "area_num_three", "area_num_four", "area_num_five", "one", "two", "three", "four", "total",
-	)
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "location_reward_rate" == vConfig.KeyName {
-				locationRewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "b_price" == vConfig.KeyName {
-				bPrice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "b_price_base" == vConfig.KeyName {
-				bPriceBase, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_one_rate" == vConfig.KeyName {
-				recommendOneRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_two_rate" == vConfig.KeyName {
-				recommendTwoRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_three_rate" == vConfig.KeyName {
-				recommendThreeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_four_rate" == vConfig.KeyName {
-				recommendFourRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_five_rate" == vConfig.KeyName {
-				recommendFiveRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_six_rate" == vConfig.KeyName {
-				recommendSixRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_seven_rate" == vConfig.KeyName {
-				recommendSevenRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_eight_rate" == vConfig.KeyName {
-				recommendEightRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "exchange_rate" == vConfig.KeyName {
-				feeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
-	}
-
-	var (
-		stopLocationIds map[int64]int64
-	)
-
-	stopLocationIds = make(map[int64]int64, 0)
-	// 每日
-	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-
-	for _, vUserLocations := range userLocations {
-		// 奖励
-		tmpCurrentReward := vUserLocations.Usdt / 1000 * locationRewardRate
-		tmpCurrentReward = tmpCurrentReward / 6
-		bLocationRewardAmount := tmpCurrentReward * bPriceBase / bPrice
-
-		if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				tmpStatus := vUserLocations.Status
-				tmpStopDate := time.Now().UTC().Add(8 * time.Hour)
-				if vUserLocations.Current+tmpCurrentReward >= vUserLocations.CurrentMax { // 占位分红人分满停止
-					tmpStatus = "stop"
-					tmpStopDate = time.Now().UTC().Add(8 * time.Hour)
-
-					tmpCurrentReward = vUserLocations.CurrentMax - vUserLocations.Current
-					bLocationRewardAmount = tmpCurrentReward * bPriceBase / bPrice
-					stopLocationIds[vUserLocations.ID] = vUserLocations.ID
-				}
-
-				var tmpMaxNew int64
-				if vUserLocations.CurrentMaxNew < vUserLocations.CurrentMax {
-					tmpMaxNew = vUserLocations.CurrentMax - vUserLocations.CurrentMaxNew
-				}
-				if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-					err = uuc.locationRepo.UpdateLocationNewNew(ctx, vUserLocations.ID, tmpStatus, tmpCurrentReward, tmpMaxNew, bLocationRewardAmount, tmpStopDate) // 分红占位数据修改
-					if nil != err {
-						return err
-					}
-
-					_, err = uuc.ubRepo.LocationRewardBiw(ctx, vUserLocations.UserId, bLocationRewardAmount, tmpStatus, tmpMaxNew, feeRate)
-					if nil != err {
-						return err
-					}
-				}
-
-				// 业绩减掉
-				if "stop" == tmpStatus {
-					tmpTop := vUserLocations.Top
-					tmpTopNum := vUserLocations.TopNum
-					for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-						err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, vUserLocations.Usdt/100000)
-						if nil != err {
-							return err
-						}
-
-						var (
-							currentLocation *LocationNew
-						)
-						currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-						if nil != err {
-							return err
-						}
-
-						if nil != currentLocation && 0 < currentLocation.Top {
-							tmpTop = currentLocation.Top
-							tmpTopNum = currentLocation.TopNum
-							continue
-						}
-
-						break
-					}
-				}
-
-				return nil
-			}); nil != err {
-				fmt.Println("err reward daily", err, vUserLocations)
-				continue
-			}
-		}
-	}
-
-	for _, vUserLocations := range userLocations {
-		var (
-			userRecommend       *UserRecommend
-			tmpRecommendUserIds []string
-		)
-
-		// 推荐人
-		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, vUserLocations.UserId)
-		if nil != userRecommend {
-			if "" != userRecommend.RecommendCode {
-				tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
-			}
-
-			lastKey := len(tmpRecommendUserIds) - 1
-			if 1 <= lastKey {
-				for i := 0; i <= 7; i++ {
-					// 有占位信息，推荐人推荐人的上一代
-					if lastKey-i <= 0 {
-						break
-					}
-
-					tmpMyTopUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[lastKey-i], 10, 64) // 最后一位是直推人
-					if 0 >= tmpMyTopUserRecommendUserId {
-						break
-					}
-
-					var myUserRecommendUserLocationsLast []*LocationNew
-					myUserRecommendUserLocationsLast, err = uuc.locationRepo.GetLocationsNewByUserId(ctx, tmpMyTopUserRecommendUserId)
-					if nil != myUserRecommendUserLocationsLast {
-
-						var tmpMyTopUserRecommendUserLocationLast *LocationNew
-						if 1 <= len(myUserRecommendUserLocationsLast) {
-							for _, vMyUserRecommendUserLocationLast := range myUserRecommendUserLocationsLast {
-								if "running" == vMyUserRecommendUserLocationLast.Status {
-									tmpMyTopUserRecommendUserLocationLast = vMyUserRecommendUserLocationLast
-									break
-								}
-							}
-
-							if nil == tmpMyTopUserRecommendUserLocationLast { // 无位
-								continue
-							}
-
-							if _, ok := stopLocationIds[tmpMyTopUserRecommendUserLocationLast.ID]; ok { // 上一轮已经停止了
-								continue
-							}
-
-							tmpMinUsdt := tmpMyTopUserRecommendUserLocationLast.Usdt
-							if vUserLocations.Usdt < tmpMinUsdt {
-								tmpMinUsdt = vUserLocations.Usdt
-							}
-
-							var tmpMyRecommendAmount int64
-							if 0 == i { // 当前用户被此人直推
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendOneRate
-							} else if 1 == i {
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendTwoRate
-							} else if 2 == i && 2 <= len(myUserRecommendUserLocationsLast) { // 3代需要复投1次
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendThreeRate
-							} else if 3 == i && 3 <= len(myUserRecommendUserLocationsLast) { // 4代需要复投2次
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendFourRate
-							} else if 4 == i && 4 <= len(myUserRecommendUserLocationsLast) { // 5代需要复投3次
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendFiveRate
-							} else if 5 == i && 5 <= len(myUserRecommendUserLocationsLast) { // 6代需要复投4次
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendSixRate
-							} else if 6 == i && 6 <= len(myUserRecommendUserLocationsLast) { // 7代需要复投5次
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendSevenRate
-							} else if 7 == i && 7 <= len(myUserRecommendUserLocationsLast) { // 8代需要复投6次
-								tmpMyRecommendAmount = tmpMinUsdt / 1000 * locationRewardRate / 100 * recommendEightRate
-							} else {
-								continue
-							}
-
-							tmpMyRecommendAmount = tmpMyRecommendAmount / 6
-							if 0 < tmpMyRecommendAmount { // 扣除推荐人分红
-								bAmount := tmpMyRecommendAmount * bPriceBase / bPrice
-								tmpStatus := tmpMyTopUserRecommendUserLocationLast.Status
-								tmpStopDate := time.Now().UTC().Add(8 * time.Hour)
-								// 过了
-								if tmpMyTopUserRecommendUserLocationLast.Current+tmpMyRecommendAmount >= tmpMyTopUserRecommendUserLocationLast.CurrentMax { // 占位分红人分满停止
-									tmpStatus = "stop"
-									tmpStopDate = time.Now().UTC().Add(8 * time.Hour)
-
-									tmpMyRecommendAmount = tmpMyTopUserRecommendUserLocationLast.CurrentMax - tmpMyTopUserRecommendUserLocationLast.Current
-									bAmount = tmpMyRecommendAmount * bPriceBase / bPrice
-									stopLocationIds[vUserLocations.ID] = vUserLocations.ID
-								}
-
-								if 0 < tmpMyRecommendAmount && 0 < bAmount {
-									var tmpMaxNew int64
-									if tmpMyTopUserRecommendUserLocationLast.CurrentMaxNew < tmpMyTopUserRecommendUserLocationLast.CurrentMax {
-										tmpMaxNew = tmpMyTopUserRecommendUserLocationLast.CurrentMax - tmpMyTopUserRecommendUserLocationLast.CurrentMaxNew
-									}
-
-									if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-										err = uuc.locationRepo.UpdateLocationNewNew(ctx, tmpMyTopUserRecommendUserLocationLast.ID, tmpStatus, tmpMyRecommendAmount, tmpMaxNew, bAmount, tmpStopDate) // 分红占位数据修改
-										if nil != err {
-											return err
-										}
-
-										_, err = uuc.ubRepo.RecommendRewardBiw(ctx, tmpMyTopUserRecommendUserId, bAmount, int64(i+1), tmpStatus, tmpMaxNew, feeRate) // 推荐人奖励
-										if nil != err {
-											return err
-										}
-
-										// 业绩减掉
-										if "stop" == tmpStatus {
-											tmpTop := tmpMyTopUserRecommendUserLocationLast.Top
-											tmpTopNum := tmpMyTopUserRecommendUserLocationLast.TopNum
-											for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-												err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, tmpMyTopUserRecommendUserLocationLast.Usdt/100000)
-												if nil != err {
-													return err
-												}
-
-												var (
-													currentLocation *LocationNew
-												)
-												currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-												if nil != err {
-													return err
-												}
-
-												if nil != currentLocation && 0 < currentLocation.Top {
-													tmpTop = currentLocation.Top
-													tmpTopNum = currentLocation.TopNum
-													continue
-												}
-
-												break
-											}
-										}
-
-										return nil
-									}); nil != err {
-										fmt.Println("err reward daily recommend", err, myUserRecommendUserLocationsLast)
-										continue
-									}
-								}
-							}
-						}
-					}
-
-				}
-
-			}
-
-		}
-	}
-
-	return nil, err
-}
-
-func (uuc *UserUseCase) AdminDailyAreaReward(ctx context.Context, req *v1.AdminDailyLocationRewardRequest) (*v1.AdminDailyLocationRewardReply, error) {
-	var (
-		userLocations []*LocationNew
-		configs       []*Config
-		bPrice        int64
-		bPriceBase    int64
-		areaOne       int64
-		areaTwo       int64
-		areaThree     int64
-		areaFour      int64
-		areaFive      int64
-		areaNumOne    int64
-		areaNumTwo    int64
-		areaNumThree  int64
-		areaNumFour   int64
-		areaNumFive   int64
-		one           int64
-		two           int64
-		three         int64
-		four          int64
-		total         int64
-		feeRate       int64
-		err           error
-	)
-
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx,
-		"location_reward_rate", "b_price", "b_price_base", "exchange_rate",
-		"recommend_one_rate", "recommend_two_rate",
-		"recommend_three_rate", "recommend_four_rate",
-		"recommend_five_rate", "recommend_six_rate",
-		"recommend_seven_rate", "recommend_eight_rate",
-		"area_one", "area_two", "area_three", "area_four", "area_five",
-		"area_num_one", "area_num_two", "area_num_three", "area_num_four", "area_num_five", "one", "two", "three", "four", "total",
-	)
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "b_price" == vConfig.KeyName {
-				bPrice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "b_price_base" == vConfig.KeyName {
-				bPriceBase, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_one" == vConfig.KeyName {
-				areaOne, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_two" == vConfig.KeyName {
-				areaTwo, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_three" == vConfig.KeyName {
-				areaThree, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_four" == vConfig.KeyName {
-				areaFour, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_five" == vConfig.KeyName {
-				areaFive, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_num_one" == vConfig.KeyName {
-				areaNumOne, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_num_two" == vConfig.KeyName {
-				areaNumTwo, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_num_three" == vConfig.KeyName {
-				areaNumThree, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_num_four" == vConfig.KeyName {
-				areaNumFour, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "area_num_five" == vConfig.KeyName {
-				areaNumFive, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "one" == vConfig.KeyName {
-				one, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "two" == vConfig.KeyName {
-				two, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "three" == vConfig.KeyName {
-				three, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "four" == vConfig.KeyName {
-				four, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "total" == vConfig.KeyName {
-				total, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "exchange_rate" == vConfig.KeyName {
-				feeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
-	}
-
-	// 获取今日收益
-	var (
-		day               = -1
-		userLocationsYes  []*LocationNew
-		userLocationsBef  []*LocationNew
-		rewardLocationYes int64
-		rewardLocationBef int64
-	)
-	// 全网
-	userLocationsYes, err = uuc.locationRepo.GetLocationDailyYesterday(ctx, day)
-	for _, userLocationYes := range userLocationsYes {
-		rewardLocationYes += userLocationYes.Usdt
-	}
-
-	if 0 >= rewardLocationYes {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-
-	// 团队奖励
-	userLocationsOne := make([]*LocationNew, 0)
-	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-	for _, vUserLocations := range userLocations {
-		// 1大区
-		if vUserLocations.Total >= vUserLocations.TotalTwo && vUserLocations.Total >= vUserLocations.TotalThree {
-			if areaOne <= vUserLocations.TotalTwo+vUserLocations.TotalThree || 1 <= vUserLocations.LastLevel {
-				userLocationsOne = append(userLocationsOne, vUserLocations)
-			}
-
-		} else if vUserLocations.TotalTwo >= vUserLocations.Total && vUserLocations.TotalTwo >= vUserLocations.TotalThree {
-			if areaOne <= vUserLocations.Total+vUserLocations.TotalThree || 1 <= vUserLocations.LastLevel {
-				userLocationsOne = append(userLocationsOne, vUserLocations)
-			}
-
-		} else if vUserLocations.TotalThree >= vUserLocations.Total && vUserLocations.TotalThree >= vUserLocations.TotalTwo {
-			if areaOne <= vUserLocations.TotalTwo+vUserLocations.Total || 1 <= vUserLocations.LastLevel {
-				userLocationsOne = append(userLocationsOne, vUserLocations)
-			}
-		}
-	}
-
-	if 0 < len(userLocationsOne) {
-		rewardLocationYesOne := rewardLocationYes / 1000 * areaNumOne / int64(len(userLocationsOne))
-		if 0 < rewardLocationYesOne {
-			for _, vUserLocationsItem := range userLocationsOne {
-				// 奖励
-				tmpCurrentReward := rewardLocationYesOne
-				bLocationRewardAmount := tmpCurrentReward * bPriceBase / bPrice
-
-				if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						if vUserLocationsItem.Current+tmpCurrentReward >= vUserLocationsItem.CurrentMax { // 占位分红人分满停止
-							vUserLocationsItem.Status = "stop"
-							vUserLocationsItem.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-							tmpCurrentReward = vUserLocationsItem.CurrentMax - vUserLocationsItem.Current
-							bLocationRewardAmount = tmpCurrentReward * bPriceBase / bPrice
-						}
-
-						var tmpMaxNew int64
-						if vUserLocationsItem.CurrentMaxNew < vUserLocationsItem.CurrentMax {
-							tmpMaxNew = vUserLocationsItem.CurrentMax - vUserLocationsItem.CurrentMaxNew
-						}
-
-						if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-							err = uuc.locationRepo.UpdateLocationNewNew(ctx, vUserLocationsItem.ID, vUserLocationsItem.Status, tmpCurrentReward, tmpMaxNew, bLocationRewardAmount, vUserLocationsItem.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-
-							_, err = uuc.ubRepo.AreaRewardBiw(ctx, vUserLocationsItem.UserId, bLocationRewardAmount, tmpCurrentReward, 1, vUserLocationsItem.Status, tmpMaxNew, feeRate)
-							if nil != err {
-								return err
-							}
-						}
-
-						// 业绩减掉
-						if "stop" == vUserLocationsItem.Status {
-							tmpTop := vUserLocationsItem.Top
-							tmpTopNum := vUserLocationsItem.TopNum
-							for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-								err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, vUserLocationsItem.Usdt/100000)
-								if nil != err {
-									return err
-								}
-
-								var (
-									currentLocation *LocationNew
-								)
-								currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-								if nil != err {
-									return err
-								}
-
-								if nil != currentLocation && 0 < currentLocation.Top {
-									tmpTop = currentLocation.Top
-									tmpTopNum = currentLocation.TopNum
-									continue
-								}
-
-								break
-							}
-						}
-
-						return nil
-					}); nil != err {
-						fmt.Println("err reward daily three", err, vUserLocationsItem)
-						continue
-					}
-				}
-			}
-		}
-	}
-
-	// 团队奖励
-	userLocationsTwo := make([]*LocationNew, 0)
-	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-	for _, vUserLocations := range userLocations {
-		// 1大区
-		if vUserLocations.Total >= vUserLocations.TotalTwo && vUserLocations.Total >= vUserLocations.TotalThree {
-			if areaTwo <= vUserLocations.TotalTwo+vUserLocations.TotalThree || 2 <= vUserLocations.LastLevel {
-				userLocationsTwo = append(userLocationsTwo, vUserLocations)
-			}
-		} else if vUserLocations.TotalTwo >= vUserLocations.Total && vUserLocations.TotalTwo >= vUserLocations.TotalThree {
-			if areaTwo <= vUserLocations.Total+vUserLocations.TotalThree || 2 <= vUserLocations.LastLevel {
-				userLocationsTwo = append(userLocationsTwo, vUserLocations)
-			}
-		} else if vUserLocations.TotalThree >= vUserLocations.Total && vUserLocations.TotalThree >= vUserLocations.TotalTwo {
-			if areaTwo <= vUserLocations.TotalTwo+vUserLocations.Total || 2 <= vUserLocations.LastLevel {
-				userLocationsTwo = append(userLocationsTwo, vUserLocations)
-			}
-		}
-	}
-
-	if 0 < len(userLocationsTwo) {
-		rewardLocationYesTwo := rewardLocationYes / 1000 * areaNumTwo / int64(len(userLocationsTwo))
-		if 0 < rewardLocationYesTwo {
-			for _, vUserLocationsItem := range userLocationsTwo {
-				// 奖励
-				tmpCurrentReward := rewardLocationYesTwo
-				bLocationRewardAmount := tmpCurrentReward * bPriceBase / bPrice
-
-				if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						if vUserLocationsItem.Current+tmpCurrentReward >= vUserLocationsItem.CurrentMax { // 占位分红人分满停止
-							vUserLocationsItem.Status = "stop"
-							vUserLocationsItem.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-							tmpCurrentReward = vUserLocationsItem.CurrentMax - vUserLocationsItem.Current
-							bLocationRewardAmount = tmpCurrentReward * bPriceBase / bPrice
-						}
-
-						var tmpMaxNew int64
-						if vUserLocationsItem.CurrentMaxNew < vUserLocationsItem.CurrentMax {
-							tmpMaxNew = vUserLocationsItem.CurrentMax - vUserLocationsItem.CurrentMaxNew
-						}
-
-						if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-							err = uuc.locationRepo.UpdateLocationNewNew(ctx, vUserLocationsItem.ID, vUserLocationsItem.Status, tmpCurrentReward, tmpMaxNew, bLocationRewardAmount, vUserLocationsItem.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-
-							_, err = uuc.ubRepo.AreaRewardBiw(ctx, vUserLocationsItem.UserId, bLocationRewardAmount, tmpCurrentReward, 2, vUserLocationsItem.Status, tmpMaxNew, feeRate)
-							if nil != err {
-								return err
-							}
-						}
-
-						// 业绩减掉
-						if "stop" == vUserLocationsItem.Status {
-							tmpTop := vUserLocationsItem.Top
-							tmpTopNum := vUserLocationsItem.TopNum
-							for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-								err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, vUserLocationsItem.Usdt/100000)
-								if nil != err {
-									return err
-								}
-
-								var (
-									currentLocation *LocationNew
-								)
-								currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-								if nil != err {
-									return err
-								}
-
-								if nil != currentLocation && 0 < currentLocation.Top {
-									tmpTop = currentLocation.Top
-									tmpTopNum = currentLocation.TopNum
-									continue
-								}
-
-								break
-							}
-						}
-
-						return nil
-					}); nil != err {
-						fmt.Println("err reward daily three", err, vUserLocationsItem)
-						continue
-					}
-				}
-			}
-		}
-	}
-
-	userLocationsThree := make([]*LocationNew, 0)
-	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-	for _, vUserLocations := range userLocations {
-		// 1大区
-		if vUserLocations.Total >= vUserLocations.TotalTwo && vUserLocations.Total >= vUserLocations.TotalThree {
-			if areaThree <= vUserLocations.TotalTwo+vUserLocations.TotalThree || 3 <= vUserLocations.LastLevel {
-				userLocationsThree = append(userLocationsThree, vUserLocations)
-			}
-		} else if vUserLocations.TotalTwo >= vUserLocations.Total && vUserLocations.TotalTwo >= vUserLocations.TotalThree {
-			if areaThree <= vUserLocations.Total+vUserLocations.TotalThree || 3 <= vUserLocations.LastLevel {
-				userLocationsThree = append(userLocationsThree, vUserLocations)
-			}
-		} else if vUserLocations.TotalThree >= vUserLocations.Total && vUserLocations.TotalThree >= vUserLocations.TotalTwo {
-			if areaThree <= vUserLocations.TotalTwo+vUserLocations.Total || 3 <= vUserLocations.LastLevel {
-				userLocationsThree = append(userLocationsThree, vUserLocations)
-			}
-		}
-	}
-
-	if 0 < len(userLocationsThree) {
-		rewardLocationYesThree := rewardLocationYes / 1000 * areaNumThree / int64(len(userLocationsThree))
-		if 0 < rewardLocationYesThree {
-			for _, vUserLocationsItem := range userLocationsThree {
-				// 奖励
-				tmpCurrentReward := rewardLocationYesThree
-				bLocationRewardAmount := tmpCurrentReward * bPriceBase / bPrice
-
-				if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						if vUserLocationsItem.Current+tmpCurrentReward >= vUserLocationsItem.CurrentMax { // 占位分红人分满停止
-							vUserLocationsItem.Status = "stop"
-							vUserLocationsItem.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-							tmpCurrentReward = vUserLocationsItem.CurrentMax - vUserLocationsItem.Current
-							bLocationRewardAmount = tmpCurrentReward * bPriceBase / bPrice
-						}
-
-						var tmpMaxNew int64
-						if vUserLocationsItem.CurrentMaxNew < vUserLocationsItem.CurrentMax {
-							tmpMaxNew = vUserLocationsItem.CurrentMax - vUserLocationsItem.CurrentMaxNew
-						}
-
-						if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-							err = uuc.locationRepo.UpdateLocationNewNew(ctx, vUserLocationsItem.ID, vUserLocationsItem.Status, tmpCurrentReward, tmpMaxNew, bLocationRewardAmount, vUserLocationsItem.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-
-							_, err = uuc.ubRepo.AreaRewardBiw(ctx, vUserLocationsItem.UserId, bLocationRewardAmount, tmpCurrentReward, 3, vUserLocationsItem.Status, tmpMaxNew, feeRate)
-							if nil != err {
-								return err
-							}
-						}
-
-						// 业绩减掉
-						if "stop" == vUserLocationsItem.Status {
-							tmpTop := vUserLocationsItem.Top
-							tmpTopNum := vUserLocationsItem.TopNum
-							for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-								err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, vUserLocationsItem.Usdt/100000)
-								if nil != err {
-									return err
-								}
-
-								var (
-									currentLocation *LocationNew
-								)
-								currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-								if nil != err {
-									return err
-								}
-
-								if nil != currentLocation && 0 < currentLocation.Top {
-									tmpTop = currentLocation.Top
-									tmpTopNum = currentLocation.TopNum
-									continue
-								}
-
-								break
-							}
-						}
-
-						return nil
-					}); nil != err {
-						fmt.Println("err reward daily three", err, vUserLocationsItem)
-						continue
-					}
-				}
-			}
-		}
-	}
-
-	userLocationsFour := make([]*LocationNew, 0)
-	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-	for _, vUserLocations := range userLocations {
-		// 1大区
-		if vUserLocations.Total >= vUserLocations.TotalTwo && vUserLocations.Total >= vUserLocations.TotalThree {
-			if areaFour <= vUserLocations.TotalTwo+vUserLocations.TotalThree || 4 <= vUserLocations.LastLevel {
-				userLocationsFour = append(userLocationsFour, vUserLocations)
-			}
-		} else if vUserLocations.TotalTwo >= vUserLocations.Total && vUserLocations.TotalTwo >= vUserLocations.TotalThree {
-			if areaFour <= vUserLocations.Total+vUserLocations.TotalThree || 4 <= vUserLocations.LastLevel {
-				userLocationsFour = append(userLocationsFour, vUserLocations)
-			}
-		} else if vUserLocations.TotalThree >= vUserLocations.Total && vUserLocations.TotalThree >= vUserLocations.TotalTwo {
-			if areaFour <= vUserLocations.TotalTwo+vUserLocations.Total || 4 <= vUserLocations.LastLevel {
-				userLocationsFour = append(userLocationsFour, vUserLocations)
-			}
-		}
-	}
-
-	if 0 < len(userLocationsFour) {
-		rewardLocationYesFour := rewardLocationYes / 1000 * areaNumFour / int64(len(userLocationsFour))
-		if 0 < rewardLocationYesFour {
-			for _, vUserLocationsItem := range userLocationsFour {
-				// 奖励
-				tmpCurrentReward := rewardLocationYesFour
-				bLocationRewardAmount := tmpCurrentReward * bPriceBase / bPrice
-
-				if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						if vUserLocationsItem.Current+tmpCurrentReward >= vUserLocationsItem.CurrentMax { // 占位分红人分满停止
-							vUserLocationsItem.Status = "stop"
-							vUserLocationsItem.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-							tmpCurrentReward = vUserLocationsItem.CurrentMax - vUserLocationsItem.Current
-							bLocationRewardAmount = tmpCurrentReward * bPriceBase / bPrice
-						}
-
-						var tmpMaxNew int64
-						if vUserLocationsItem.CurrentMaxNew < vUserLocationsItem.CurrentMax {
-							tmpMaxNew = vUserLocationsItem.CurrentMax - vUserLocationsItem.CurrentMaxNew
-						}
-
-						if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-							err = uuc.locationRepo.UpdateLocationNewNew(ctx, vUserLocationsItem.ID, vUserLocationsItem.Status, tmpCurrentReward, tmpMaxNew, bLocationRewardAmount, vUserLocationsItem.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-
-							_, err = uuc.ubRepo.AreaRewardBiw(ctx, vUserLocationsItem.UserId, bLocationRewardAmount, tmpCurrentReward, 4, vUserLocationsItem.Status, tmpMaxNew, feeRate)
-							if nil != err {
-								return err
-							}
-						}
-
-						// 业绩减掉
-						if "stop" == vUserLocationsItem.Status {
-							tmpTop := vUserLocationsItem.Top
-							tmpTopNum := vUserLocationsItem.TopNum
-							for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-								err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, vUserLocationsItem.Usdt/100000)
-								if nil != err {
-									return err
-								}
-
-								var (
-									currentLocation *LocationNew
-								)
-								currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-								if nil != err {
-									return err
-								}
-
-								if nil != currentLocation && 0 < currentLocation.Top {
-									tmpTop = currentLocation.Top
-									tmpTopNum = currentLocation.TopNum
-									continue
-								}
-
-								break
-							}
-						}
-
-						return nil
-					}); nil != err {
-						fmt.Println("err reward daily three", err, vUserLocationsItem)
-						continue
-					}
-				}
-			}
-		}
-	}
-
-	userLocationsFive := make([]*LocationNew, 0)
-	userLocations, err = uuc.locationRepo.GetRunningLocations(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-	for _, vUserLocations := range userLocations {
-		// 1大区
-		if vUserLocations.Total >= vUserLocations.TotalTwo && vUserLocations.Total >= vUserLocations.TotalThree {
-			if areaFive <= vUserLocations.TotalTwo+vUserLocations.TotalThree || 5 <= vUserLocations.LastLevel {
-				userLocationsFive = append(userLocationsFive, vUserLocations)
-			}
-		} else if vUserLocations.TotalTwo >= vUserLocations.Total && vUserLocations.TotalTwo >= vUserLocations.TotalThree {
-			if areaFive <= vUserLocations.Total+vUserLocations.TotalThree || 5 <= vUserLocations.LastLevel {
-				userLocationsFive = append(userLocationsFive, vUserLocations)
-			}
-		} else if vUserLocations.TotalThree >= vUserLocations.Total && vUserLocations.TotalThree >= vUserLocations.TotalTwo {
-			if areaFive <= vUserLocations.TotalTwo+vUserLocations.Total || 5 <= vUserLocations.LastLevel {
-				userLocationsFive = append(userLocationsFive, vUserLocations)
-			}
-		}
-	}
-
-	if 0 < len(userLocationsFive) {
-		rewardLocationYesFive := rewardLocationYes / 1000 * areaNumFive / int64(len(userLocationsFive))
-		if 0 < rewardLocationYesFive {
-			for _, vUserLocationsItem := range userLocationsFive {
-				// 奖励
-				tmpCurrentReward := rewardLocationYesFive
-				bLocationRewardAmount := tmpCurrentReward * bPriceBase / bPrice
-
-				if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						if vUserLocationsItem.Current+tmpCurrentReward >= vUserLocationsItem.CurrentMax { // 占位分红人分满停止
-							vUserLocationsItem.Status = "stop"
-							vUserLocationsItem.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-							tmpCurrentReward = vUserLocationsItem.CurrentMax - vUserLocationsItem.Current
-							bLocationRewardAmount = tmpCurrentReward * bPriceBase / bPrice
-						}
-
-						var tmpMaxNew int64
-						if vUserLocationsItem.CurrentMaxNew < vUserLocationsItem.CurrentMax {
-							tmpMaxNew = vUserLocationsItem.CurrentMax - vUserLocationsItem.CurrentMaxNew
-						}
-
-						if 0 < tmpCurrentReward && 0 < bLocationRewardAmount {
-							err = uuc.locationRepo.UpdateLocationNewNew(ctx, vUserLocationsItem.ID, vUserLocationsItem.Status, tmpCurrentReward, tmpMaxNew, bLocationRewardAmount, vUserLocationsItem.StopDate) // 分红占位数据修改
-							if nil != err {
-								return err
-							}
-
-							_, err = uuc.ubRepo.AreaRewardBiw(ctx, vUserLocationsItem.UserId, bLocationRewardAmount, tmpCurrentReward, 5, vUserLocationsItem.Status, tmpMaxNew, feeRate)
-							if nil != err {
-								return err
-							}
-						}
-
-						// 业绩减掉
-						if "stop" == vUserLocationsItem.Status {
-							tmpTop := vUserLocationsItem.Top
-							tmpTopNum := vUserLocationsItem.TopNum
-							for j := 0; j < 10000 && 0 < tmpTop && 0 < tmpTopNum; j++ {
-								err = uuc.locationRepo.UpdateLocationNewTotalSub(ctx, tmpTop, tmpTopNum, vUserLocationsItem.Usdt/100000)
-								if nil != err {
-									return err
-								}
-
-								var (
-									currentLocation *LocationNew
-								)
-								currentLocation, err = uuc.locationRepo.GetLocationById(ctx, tmpTop)
-								if nil != err {
-									return err
-								}
-
-								if nil != currentLocation && 0 < currentLocation.Top {
-									tmpTop = currentLocation.Top
-									tmpTopNum = currentLocation.TopNum
-									continue
-								}
-
-								break
-							}
-						}
-
-						return nil
-					}); nil != err {
-						fmt.Println("err reward daily three", err, vUserLocationsItem)
-						continue
-					}
-				}
-			}
-		}
-	}
-
-	// 全网前天
-	userLocationsBef, err = uuc.locationRepo.GetLocationDailyYesterday(ctx, day-1)
-	for _, userLocationBef := range userLocationsBef {
-		rewardLocationBef += userLocationBef.Usdt
-	}
-	// 全球
-	totalReward := rewardLocationYes/100/100*70*total + rewardLocationBef/100/100*30*total
-
-	var (
-		fourUserRecommendTotal map[int64]int64
-	)
-
-	fourUserRecommendTotal = make(map[int64]int64, 0)
-	for _, userLocationYes := range userLocationsYes {
-		// 获取直推
-
-		var (
-			fourUserRecommend         *UserRecommend
-			myFourUserRecommendUserId int64
-			//myFourRecommendUser *User
-		)
-		fourUserRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, userLocationYes.UserId)
-		if nil == fourUserRecommend {
-			continue
-		}
-
-		if "" != fourUserRecommend.RecommendCode {
-			tmpFourRecommendUserIds := strings.Split(fourUserRecommend.RecommendCode, "D")
-			if 2 <= len(tmpFourRecommendUserIds) {
-				myFourUserRecommendUserId, _ = strconv.ParseInt(tmpFourRecommendUserIds[len(tmpFourRecommendUserIds)-1], 10, 64) // 最后一位是直推人
-			}
-			//myFourRecommendUser, err = uuc.repo.GetUserById(ctx, myFourUserRecommendUserId)
-			//if nil != err {
-			//	return nil, err
-			//}
-
-			if _, ok := fourUserRecommendTotal[myFourUserRecommendUserId]; ok {
-				fourUserRecommendTotal[myFourUserRecommendUserId] += userLocationYes.Usdt
-			} else {
-				fourUserRecommendTotal[myFourUserRecommendUserId] = userLocationYes.Usdt
-			}
-		}
-	}
-
-	if 0 >= len(fourUserRecommendTotal) {
-		return &v1.AdminDailyLocationRewardReply{}, nil
-	}
-
-	// 前四名
-	type KeyValuePair struct {
-		Key   int64
-		Value int64
-	}
-	var keyValuePairs []KeyValuePair
-	for key, value := range fourUserRecommendTotal {
-		keyValuePairs = append(keyValuePairs, KeyValuePair{key, value})
-	}
-
-	// 按值排序切片
-	sort.Slice(keyValuePairs, func(i, j int) bool {
-		return keyValuePairs[i].Value > keyValuePairs[j].Value
-	})
-
-	// 获取前四项
-	var topFour []KeyValuePair
-	if 4 <= len(keyValuePairs) {
-		topFour = keyValuePairs[:4]
-	} else {
-		topFour = keyValuePairs[:len(keyValuePairs)]
-	}
-
-	for k, vTopFour := range topFour {
-		var (
-			tmpMyRecommendAmount int64
-		)
-		if 0 == k {
-			tmpMyRecommendAmount = totalReward / 100 * one
-		} else if 1 == k {
-			tmpMyRecommendAmount = totalReward / 100 * two
-		} else if 2 == k {
-			tmpMyRecommendAmount = totalReward / 100 * three
-		} else if 3 == k {
-			tmpMyRecommendAmount = totalReward / 100 * four
-		}
-
-		if 0 >= tmpMyRecommendAmount {
-			continue
-		}
-
-		if 0 < tmpMyRecommendAmount {
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				_, err = uuc.ubRepo.FourRewardBiw(ctx, vTopFour.Key, tmpMyRecommendAmount, int64(k+1)) // 推荐人奖励
-				if nil != err {
-					return err
-				}
-
-				return nil
-			}); nil != err {
-				fmt.Println("err reward daily four", err, vTopFour)
-				continue
-			}
-		}
-	}
-
-	return &v1.AdminDailyLocationRewardReply{}, nil
-}
-
-func (uuc *UserUseCase) AdminUpdateLocationNewMax(ctx context.Context, req *v1.AdminUpdateLocationNewMaxRequest) (*v1.AdminUpdateLocationNewMaxReply, error) {
-	var (
-		err error
-	)
-	res := &v1.AdminUpdateLocationNewMaxReply{}
-	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
-	amountFloat *= 100000
-	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
-
-	_, err = uuc.ubRepo.UpdateLocationNewMax(ctx, req.SendBody.UserId, amount)
-
-	if nil != err {
-		return res, err
-	}
-
-	return nil, err
-}
-
-func (uuc *UserUseCase) AdminDailyLocationRewardNew(ctx context.Context, req *v1.AdminDailyLocationRewardNewRequest) (*v1.AdminDailyLocationRewardNewReply, error) {
-	var (
-		userLocations    []*LocationNew
-		userLocationsMap map[int64]*LocationNew
-		userLocations1   []*LocationNew
-		userLocations2   []*LocationNew
-		userLocations3   []*LocationNew
-		v1r              int64
-		v2r              int64
-		v3r              int64
-		configs          []*Config
-		amount           int64
-		amountV1         int64
-		amountV2         int64
-		amountV3         int64
-		v1Count          int64
-		v2Count          int64
-		v3Count          int64
-		err              error
-		userInfos        []*UserInfo
-	)
-
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx,
-		"v1", "v2", "v3",
-	)
-
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "v1" == vConfig.KeyName {
-				v1r, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "v2" == vConfig.KeyName {
-				v2r, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "v3" == vConfig.KeyName {
-				v3r, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
-	}
-
-	amount, err = uuc.ubRepo.GetSystemWithdrawUsdtFeeTotalToday(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardNewReply{}, nil
-	}
-
-	// 获取手动设置的
-	userInfos, err = uuc.uiRepo.GetUserInfosByVipAndLockVip(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardNewReply{}, nil
-	}
-
-	if nil != userInfos {
-		for _, v := range userInfos {
-			if 3 == v.Vip {
-				v3Count += 1
-			}
-
-			if 2 == v.Vip {
-				v2Count += 1
-			}
-
-			if 1 == v.Vip {
-				v1Count += 1
-			}
-		}
-	}
-
-	userLocations, err = uuc.locationRepo.GetAllLocationsNew2(ctx)
-	if nil != err {
-		return &v1.AdminDailyLocationRewardNewReply{}, nil
-	}
-
-	userLocationsMap = make(map[int64]*LocationNew, 0)
-	userLocations3 = make([]*LocationNew, 0)
-	userLocations2 = make([]*LocationNew, 0)
-	userLocations1 = make([]*LocationNew, 0)
-	for _, vUserLocations := range userLocations {
-
-		if _, ok := userLocationsMap[vUserLocations.UserId]; ok {
-			continue
-		}
-
-		var (
-			userInfo *UserInfo
-		)
-
-		userLocationsMap[vUserLocations.UserId] = vUserLocations
-
-		userInfo, err = uuc.uiRepo.GetUserInfoByUserId(ctx, vUserLocations.UserId)
-		if nil != err {
-			continue
-		}
-
-		if 3 == userInfo.Vip {
-			v3Count += 1
-			userLocations3 = append(userLocations3, vUserLocations)
-		}
-
-		if 2 == userInfo.Vip {
-			v2Count += 1
-			userLocations2 = append(userLocations2, vUserLocations)
-		}
-
-		if 1 == userInfo.Vip {
-			v1Count += 1
-			userLocations1 = append(userLocations1, vUserLocations)
-		}
-	}
-
-	if v1Count > 0 {
-		amountV1 = amount * v1r / 100 / v1Count
-	}
-
-	if v2Count > 0 {
-		amountV2 = amount * v2r / 100 / v2Count
-	}
-
-	if v3Count > 0 {
-		amountV3 = amount * v3r / 100 / v3Count
-	}
-
-	if nil != userInfos {
-		for _, v := range userInfos {
-			var tmpAmount int64
-			if 3 == v.Vip {
-				tmpAmount = amountV3
-			}
-
-			if 2 == v.Vip {
-				tmpAmount = amountV2
-			}
-
-			if 1 == v.Vip {
-				tmpAmount = amountV1
-			}
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
-				_, err = uuc.ubRepo.NormalReward4(ctx, v.UserId, tmpAmount, 0)
-				if nil != err {
-					return err
-				}
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
-	}
-
-	for _, vUserLocations1 := range userLocations1 {
-
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpStatus := vUserLocations1.Status // 现在还在运行中
-
-			// 奖励usdt
-			tmpRewardAmount := amountV1
-
-			vUserLocations1.Status = "running"
-			vUserLocations1.Current += tmpRewardAmount
-
-			tmpRewardAmount2 := tmpRewardAmount
-			if vUserLocations1.Current >= vUserLocations1.CurrentMax { // 占位分红人分满停止
-				vUserLocations1.Status = "stop"
-				if "running" == tmpStatus {
-					vUserLocations1.StopDate = time.Now().UTC().Add(8 * time.Hour)
-					tmpRewardAmount2 = tmpRewardAmount - (vUserLocations1.Current - vUserLocations1.CurrentMax)
-				} else {
-					tmpRewardAmount2 = 0
-				}
-			}
-
-			if 0 < tmpRewardAmount {
-				err = uuc.locationRepo.UpdateLocationNew2(ctx, vUserLocations1.ID, vUserLocations1.Status, tmpRewardAmount, vUserLocations1.StopDate) // 分红占位数据修改
-				if nil != err {
-					return err
-				}
-				_, err = uuc.ubRepo.NormalReward3(ctx, vUserLocations1.UserId, tmpRewardAmount, tmpRewardAmount2, vUserLocations1.ID, tmpStatus, vUserLocations1.Status) // 直推人奖励
-				if nil != err {
-					return err
-				}
-			}
-			return nil
-		}); nil != err {
-			continue
-		}
-	}
-
-	for _, vUserLocations3 := range userLocations3 {
-
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpStatus := vUserLocations3.Status // 现在还在运行中
-
-			// 奖励usdt
-			tmpRewardAmount := amountV3
-
-			vUserLocations3.Status = "running"
-			vUserLocations3.Current += tmpRewardAmount
-
-			tmpRewardAmount2 := tmpRewardAmount
-			if vUserLocations3.Current >= vUserLocations3.CurrentMax { // 占位分红人分满停止
-				vUserLocations3.Status = "stop"
-				if "running" == tmpStatus {
-					vUserLocations3.StopDate = time.Now().UTC().Add(8 * time.Hour)
-					tmpRewardAmount2 = tmpRewardAmount - (vUserLocations3.Current - vUserLocations3.CurrentMax)
-				} else {
-					tmpRewardAmount2 = 0
-				}
-			}
-
-			if 0 < tmpRewardAmount {
-				err = uuc.locationRepo.UpdateLocationNew2(ctx, vUserLocations3.ID, vUserLocations3.Status, tmpRewardAmount, vUserLocations3.StopDate) // 分红占位数据修改
-				if nil != err {
-					return err
-				}
-				_, err = uuc.ubRepo.NormalReward3(ctx, vUserLocations3.UserId, tmpRewardAmount, tmpRewardAmount2, vUserLocations3.ID, tmpStatus, vUserLocations3.Status) // 直推人奖励
-				if nil != err {
-					return err
-				}
-			}
-			return nil
-		}); nil != err {
-			continue
-		}
-	}
-
-	for _, vUserLocations2 := range userLocations2 {
-
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			tmpStatus := vUserLocations2.Status // 现在还在运行中
-
-			// 奖励usdt
-			tmpRewardAmount := amountV2
-
-			vUserLocations2.Status = "running"
-			vUserLocations2.Current += tmpRewardAmount
-
-			tmpRewardAmount2 := tmpRewardAmount
-			if vUserLocations2.Current >= vUserLocations2.CurrentMax { // 占位分红人分满停止
-				vUserLocations2.Status = "stop"
-				if "running" == tmpStatus {
-					vUserLocations2.StopDate = time.Now().UTC().Add(8 * time.Hour)
-					tmpRewardAmount2 = tmpRewardAmount - (vUserLocations2.Current - vUserLocations2.CurrentMax)
-				} else {
-					tmpRewardAmount2 = 0
-				}
-			}
-
-			if 0 < tmpRewardAmount {
-				err = uuc.locationRepo.UpdateLocationNew2(ctx, vUserLocations2.ID, vUserLocations2.Status, tmpRewardAmount, vUserLocations2.StopDate) // 分红占位数据修改
-				if nil != err {
-					return err
-				}
-				_, err = uuc.ubRepo.NormalReward3(ctx, vUserLocations2.UserId, tmpRewardAmount, tmpRewardAmount2, vUserLocations2.ID, tmpStatus, vUserLocations2.Status) // 直推人奖励
-				if nil != err {
-					return err
-				}
-			}
-			return nil
-		}); nil != err {
-			continue
-		}
-	}
-
-	return &v1.AdminDailyLocationRewardNewReply{}, nil
-}
-
-func (uuc *UserUseCase) AdminDailyRecommendReward(ctx context.Context, req *v1.AdminDailyRecommendRewardRequest) (*v1.AdminDailyRecommendRewardReply, error) {
-
-	var (
-		users                  []*User
-		userLocations          []*LocationNew
-		configs                []*Config
-		recommendAreaOne       int64
-		recommendAreaOneRate   int64
-		recommendAreaTwo       int64
-		recommendAreaTwoRate   int64
-		recommendAreaThree     int64
-		recommendAreaThreeRate int64
-		recommendAreaFour      int64
-		recommendAreaFourRate  int64
-		fee                    int64
-		rewardRate             int64
-		coinPrice              int64
-		coinRewardRate         int64
-		day                    = -1
-		err                    error
-	)
-
-	if 1 == req.Day {
-		day = 0
-	}
-
-	// 全网手续费
-	userLocations, err = uuc.locationRepo.GetLocationDailyYesterday(ctx, day)
-	if nil != err {
-		return nil, err
-	}
-	for _, userLocation := range userLocations {
-		fee += userLocation.CurrentMax * 100 / userLocation.OutRate
-	}
-	if 0 >= fee {
-		return &v1.AdminDailyRecommendRewardReply{}, nil
-	}
-
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "recommend_area_one",
-		"recommend_area_one_rate", "recommend_area_two_rate", "recommend_area_three_rate", "recommend_area_four_rate",
-		"recommend_area_two", "recommend_area_three", "recommend_area_four", "coin_price", "coin_reward_rate", "reward_rate")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "recommend_area_one" == vConfig.KeyName {
-				recommendAreaOne, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_one_rate" == vConfig.KeyName {
-				recommendAreaOneRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_two" == vConfig.KeyName {
-				recommendAreaTwo, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_two_rate" == vConfig.KeyName {
-				recommendAreaTwoRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_three" == vConfig.KeyName {
-				recommendAreaThree, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_three_rate" == vConfig.KeyName {
-				recommendAreaThreeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_four" == vConfig.KeyName {
-				recommendAreaFour, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "recommend_area_four_rate" == vConfig.KeyName {
-				recommendAreaFourRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "coin_price" == vConfig.KeyName {
-				coinPrice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "coin_reward_rate" == vConfig.KeyName {
-				coinRewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "reward_rate" == vConfig.KeyName {
-				rewardRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
-	}
-
-	users, err = uuc.repo.GetAllUsers(ctx)
-	if nil != err {
-		return nil, err
-	}
-
-	level1 := make(map[int64]int64, 0)
-	level2 := make(map[int64]int64, 0)
-	level3 := make(map[int64]int64, 0)
-	level4 := make(map[int64]int64, 0)
-
-	for _, user := range users {
-		var userArea *UserArea
-		userArea, err = uuc.urRepo.GetUserArea(ctx, user.ID)
-		if nil != err {
-			continue
-		}
-
-		if userArea.Level > 0 {
-			if userArea.Level >= 1 {
-				level1[user.ID] = user.ID
-			}
-			if userArea.Level >= 2 {
-				level2[user.ID] = user.ID
-			}
-			if userArea.Level >= 3 {
-				level3[user.ID] = user.ID
-			}
-			if userArea.Level >= 4 {
-				level4[user.ID] = user.ID
-			}
-			continue
-		}
-
-		var userRecommend *UserRecommend
-		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
-		if nil != err {
-			continue
-		}
-
-		// 伞下业绩
-		var (
-			myRecommendUsers   []*UserRecommend
-			userAreas          []*UserArea
-			maxAreaAmount      int64
-			areaAmount         int64
-			myRecommendUserIds []int64
-		)
-		myCode := userRecommend.RecommendCode + "D" + strconv.FormatInt(user.ID, 10)
-		myRecommendUsers, err = uuc.urRepo.GetUserRecommendByCode(ctx, myCode)
-		if nil == err {
-			// 找直推
-			for _, vMyRecommendUsers := range myRecommendUsers {
-				myRecommendUserIds = append(myRecommendUserIds, vMyRecommendUsers.UserId)
-			}
-		}
-		if 0 < len(myRecommendUserIds) {
-			userAreas, err = uuc.urRepo.GetUserAreas(ctx, myRecommendUserIds)
-			if nil == err {
-				var (
-					tmpTotalAreaAmount int64
-				)
-				for _, vUserAreas := range userAreas {
-					tmpAreaAmount := vUserAreas.Amount + vUserAreas.SelfAmount
-					tmpTotalAreaAmount += tmpAreaAmount
-					if tmpAreaAmount > maxAreaAmount {
-						maxAreaAmount = tmpAreaAmount
-					}
-				}
-
-				areaAmount = tmpTotalAreaAmount - maxAreaAmount
-			}
-		}
-
-		// 比较级别
-		if areaAmount >= recommendAreaOne*100000 {
-			level1[user.ID] = user.ID
-		}
-
-		if areaAmount >= recommendAreaTwo*100000 {
-			level2[user.ID] = user.ID
-		}
-
-		if areaAmount >= recommendAreaThree*100000 {
-			level3[user.ID] = user.ID
-		}
-
-		if areaAmount >= recommendAreaFour*100000 {
-			level4[user.ID] = user.ID
-		}
-	}
-	fmt.Println(level4, level3, level2, level1)
-	// 分红
-	fee /= 100000 // 这里多除五个0
-	fmt.Println(fee)
-	if 0 < len(level1) {
-		feeLevel1 := fee * recommendAreaOneRate / 100 / int64(len(level1))
-		feeLevel1 *= 100000
-
-		for _, vLevel1 := range level1 {
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				var myLocationLast *LocationNew
-				// 获取当前用户的占位信息，已经有运行中的跳过
-				myLocationLast, err = uuc.locationRepo.GetMyLocationLast(ctx, vLevel1)
-				if nil == myLocationLast { // 无占位信息
-					return err
-				}
-
-				feeLevel1Usdt := feeLevel1 * rewardRate / 100
-				feeLevel1Coin := feeLevel1 * coinRewardRate / 100 * 1000 / coinPrice
-
-				tmpCurrentStatus := myLocationLast.Status // 现在还在运行中
-				myLocationLast.Status = "running"
-				myLocationLast.Current += feeLevel1
-				if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
-					if "running" == tmpCurrentStatus {
-						myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-						tmpLastAmount := feeLevel1 - (myLocationLast.Current - myLocationLast.CurrentMax)
-						feeLevel1Usdt = tmpLastAmount * rewardRate / 100
-						feeLevel1Coin = tmpLastAmount * coinRewardRate / 100 * 1000 / coinPrice
-					}
-					myLocationLast.Status = "stop"
-				}
-
-				if 0 < feeLevel1 {
-					err = uuc.locationRepo.UpdateLocationNew(ctx, myLocationLast.ID, myLocationLast.Status, feeLevel1, myLocationLast.StopDate) // 分红占位数据修改
-					if nil != err {
-						return err
-					}
-
-					_, err = uuc.ubRepo.UserDailyRecommendArea(ctx, vLevel1, feeLevel1, feeLevel1Usdt, feeLevel1Coin, tmpCurrentStatus)
-					if nil != err {
-						return err
-					}
-
-				}
-
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
-	}
-
-	// 分红
-	if 0 < len(level2) {
-		feeLevel2 := fee * recommendAreaTwoRate / 100 / int64(len(level2))
-		feeLevel2 *= 100000
-		for _, vLevel2 := range level2 {
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				var myLocationLast *LocationNew
-				// 获取当前用户的占位信息，已经有运行中的跳过
-				myLocationLast, err = uuc.locationRepo.GetMyLocationLast(ctx, vLevel2)
-				if nil == myLocationLast { // 无占位信息
-					return err
-				}
-
-				feeLevel2Usdt := feeLevel2 * rewardRate / 100
-				feeLevel2Coin := feeLevel2 * coinRewardRate / 100 * 1000 / coinPrice
-
-				tmpCurrentStatus := myLocationLast.Status // 现在还在运行中
-				myLocationLast.Status = "running"
-				myLocationLast.Current += feeLevel2
-				if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
-					if "running" == tmpCurrentStatus {
-						myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-						tmpLastAmount := feeLevel2 - (myLocationLast.Current - myLocationLast.CurrentMax)
-						feeLevel2Usdt = tmpLastAmount * rewardRate / 100
-						feeLevel2Coin = tmpLastAmount * coinRewardRate / 100 * 1000 / coinPrice
-					}
-					myLocationLast.Status = "stop"
-				}
-
-				if 0 < feeLevel2 {
-					err = uuc.locationRepo.UpdateLocationNew(ctx, myLocationLast.ID, myLocationLast.Status, feeLevel2, myLocationLast.StopDate) // 分红占位数据修改
-					if nil != err {
-						return err
-					}
-
-					_, err = uuc.ubRepo.UserDailyRecommendArea(ctx, vLevel2, feeLevel2, feeLevel2Usdt, feeLevel2Coin, tmpCurrentStatus)
-					if nil != err {
-						return err
-					}
-
-				}
-
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
-	}
-
-	// 分红
-	if 0 < len(level3) {
-		feeLevel3 := fee * recommendAreaThreeRate / 100 / int64(len(level3))
-		feeLevel3 *= 100000
-		for _, vLevel3 := range level3 {
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				var myLocationLast *LocationNew
-				// 获取当前用户的占位信息，已经有运行中的跳过
-				myLocationLast, err = uuc.locationRepo.GetMyLocationLast(ctx, vLevel3)
-				if nil == myLocationLast { // 无占位信息
-					return err
-				}
-
-				feeLevel3Usdt := feeLevel3 * rewardRate / 100
-				feeLevel3Coin := feeLevel3 * coinRewardRate / 100 * 1000 / coinPrice
-
-				tmpCurrentStatus := myLocationLast.Status // 现在还在运行中
-				myLocationLast.Status = "running"
-				myLocationLast.Current += feeLevel3
-				if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
-					if "running" == tmpCurrentStatus {
-						myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-						tmpLastAmount := feeLevel3 - (myLocationLast.Current - myLocationLast.CurrentMax)
-						feeLevel3Usdt = tmpLastAmount * rewardRate / 100
-						feeLevel3Coin = tmpLastAmount * coinRewardRate / 100 * 1000 / coinPrice
-					}
-					myLocationLast.Status = "stop"
-				}
-
-				if 0 < feeLevel3 {
-					err = uuc.locationRepo.UpdateLocationNew(ctx, myLocationLast.ID, myLocationLast.Status, feeLevel3, myLocationLast.StopDate) // 分红占位数据修改
-					if nil != err {
-						return err
-					}
-
-					_, err = uuc.ubRepo.UserDailyRecommendArea(ctx, vLevel3, feeLevel3, feeLevel3Usdt, feeLevel3Coin, tmpCurrentStatus)
-					if nil != err {
-						return err
-					}
-
-				}
-
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
-	}
-
-	// 分红
-	if 0 < len(level4) {
-		feeLevel4 := fee * recommendAreaFourRate / 100 / int64(len(level4))
-		feeLevel4 *= 100000
-		for _, vLevel4 := range level4 {
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				var myLocationLast *LocationNew
-				// 获取当前用户的占位信息，已经有运行中的跳过
-				myLocationLast, err = uuc.locationRepo.GetMyLocationLast(ctx, vLevel4)
-				if nil == myLocationLast { // 无占位信息
-					return err
-				}
-
-				feeLevel4Usdt := feeLevel4 * rewardRate / 100
-				feeLevel4Coin := feeLevel4 * coinRewardRate / 100 * 1000 / coinPrice
-
-				tmpCurrentStatus := myLocationLast.Status // 现在还在运行中
-				myLocationLast.Status = "running"
-				myLocationLast.Current += feeLevel4
-				if myLocationLast.Current >= myLocationLast.CurrentMax { // 占位分红人分满停止
-					if "running" == tmpCurrentStatus {
-						myLocationLast.StopDate = time.Now().UTC().Add(8 * time.Hour)
-
-						tmpLastAmount := feeLevel4 - (myLocationLast.Current - myLocationLast.CurrentMax)
-						feeLevel4Usdt = tmpLastAmount * rewardRate / 100
-						feeLevel4Coin = tmpLastAmount * coinRewardRate / 100 * 1000 / coinPrice
-
-					}
-					myLocationLast.Status = "stop"
-				}
-
-				if 0 < feeLevel4 {
-					err = uuc.locationRepo.UpdateLocationNew(ctx, myLocationLast.ID, myLocationLast.Status, feeLevel4, myLocationLast.StopDate) // 分红占位数据修改
-					if nil != err {
-						return err
-					}
-
-					_, err = uuc.ubRepo.UserDailyRecommendArea(ctx, vLevel4, feeLevel4, feeLevel4Usdt, feeLevel4Coin, tmpCurrentStatus)
-					if nil != err {
-						return err
-					}
-
-				}
-
-				return nil
-			}); nil != err {
-				continue
-			}
-		}
-	}
-
-	return &v1.AdminDailyRecommendRewardReply{}, nil
-}
-
-func (uuc *UserUseCase) CheckAndInsertRecommendArea(ctx context.Context, req *v1.CheckAndInsertRecommendAreaRequest) (*v1.CheckAndInsertRecommendAreaReply, error) {
-
-	var (
-		userRecommends         []*UserRecommend
-		userRecommendAreaCodes []string
-		userRecommendAreas     []*UserRecommendArea
-		err                    error
-	)
-	userRecommends, err = uuc.urRepo.GetUserRecommends(ctx)
-	if nil != err {
-		return &v1.CheckAndInsertRecommendAreaReply{}, nil
+		return &v1.CheckAndInsertRecommendAreaReply{}, nil
 	}
 
 	for _, vUserRecommends := range userRecommends {
@@ -4760,7 +2987,7 @@ func (uuc *UserUseCase) VipCheck(ctx context.Context, req *v1.VipCheckRequest) (
 
 	var (
 		users           []*UserInfo
-		configs         []*Config
+		configs         map[string]int64
 		vip5Balance     int64
 		vip4Balance     int64
 		vip3Balance     int64
@@ -4779,36 +3006,23 @@ func (uuc *UserUseCase) VipCheck(ctx context.Context, req *v1.VipCheckRequest) (
 		return nil, err
 	}
 
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "vip_5_balance",
+	configs, err = uuc.registry.Int64s(ctx, "vip_5_balance",
 		"vip_4_balance", "vip_3_balance", "vip_2_balance", "vip_1_balance", "vip_0_balance",
 		"vip_5_balance_team", "vip_4_balance_team", "vip_3_balance_team", "vip_2_balance_team", "vip_1_balance_team")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "vip_5_balance" == vConfig.KeyName {
-				vip5Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_4_balance" == vConfig.KeyName {
-				vip4Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_3_balance" == vConfig.KeyName {
-				vip3Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_2_balance" == vConfig.KeyName {
-				vip2Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_0_balance" == vConfig.KeyName {
-				vip0Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_1_balance" == vConfig.KeyName {
-				vip1Balance, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_4_balance_team" == vConfig.KeyName {
-				vip4BalanceTeam, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_3_balance_team" == vConfig.KeyName {
-				vip3BalanceTeam, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_2_balance_team" == vConfig.KeyName {
-				vip2BalanceTeam, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_1_balance_team" == vConfig.KeyName {
-				vip1BalanceTeam, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "vip_5_balance_team" == vConfig.KeyName {
-				vip5BalanceTeam, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
+	if nil != err {
+		return nil, err
 	}
+	vip5Balance = configs["vip_5_balance"]
+	vip4Balance = configs["vip_4_balance"]
+	vip3Balance = configs["vip_3_balance"]
+	vip2Balance = configs["vip_2_balance"]
+	vip1Balance = configs["vip_1_balance"]
+	vip0Balance = configs["vip_0_balance"]
+	vip5BalanceTeam = configs["vip_5_balance_team"]
+	vip4BalanceTeam = configs["vip_4_balance_team"]
+	vip3BalanceTeam = configs["vip_3_balance_team"]
+	vip2BalanceTeam = configs["vip_2_balance_team"]
+	vip1BalanceTeam = configs["vip_1_balance_team"]
 
 	for _, user := range users {
 		if 0 < user.LockVip {
@@ -5039,7 +3253,7 @@ func (uuc *UserUseCase) AdminVipDelete(ctx context.Context, req *v1.AdminVipDele
 
 		return nil
 	}); nil != err {
-		fmt.Println(err, "错误删除", req.SendBody.Id)
+		uuc.log.WithContext(ctx).Errorw("msg", "错误删除", "id", req.SendBody.Id, "err", err)
 		return nil, err
 	}
 
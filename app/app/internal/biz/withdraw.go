@@ -0,0 +1,477 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/metrics"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+// 用户提现的状态，rewarded由打款任务处理
+const (
+	WithdrawStatusReview   = "review"
+	WithdrawStatusRewarded = "rewarded"
+	WithdrawStatusReject   = "reject"
+)
+
+// 审核操作
+const (
+	WithdrawReviewPass   = "pass"
+	WithdrawReviewReject = "reject"
+)
+
+// 提现风险标记
+const (
+	WithdrawRiskNewAccount    = "new_account"           // 注册未满天数
+	WithdrawRiskOverDeposit   = "withdraw_over_deposit" // 提现合计超过入金的比例
+	WithdrawRiskFirstWithdraw = "first_withdraw"        // 首次提现
+	WithdrawRiskLargeAmount   = "large_amount"          // 超过历史平均的倍数
+	WithdrawRiskRecentBind    = "recent_bind_change"    // 最近修改过地址或密码
+)
+
+// WithdrawReview 提现审核记录，每次审核操作一条
+type WithdrawReview struct {
+	ID           int64
+	WithdrawId   int64
+	UserId       int64
+	AdminId      int64
+	Action       string
+	StatusBefore string
+	StatusAfter  string
+	Amount       int64
+	Reason       string
+	Flags        []string // 审核时的风险标记
+	CreatedAt    time.Time
+}
+
+type WithdrawReviewRepo interface {
+	CreateWithdrawReview(ctx context.Context, r *WithdrawReview) error
+	GetWithdrawReviews(ctx context.Context, withdrawId int64) ([]*WithdrawReview, error)
+}
+
+// WithdrawRisk 审核参考的风险信息
+type WithdrawRisk struct {
+	AccountDays   int64
+	DepositTotal  int64
+	WithdrawTotal int64 // 之前的提现，不含驳回
+	WithdrawCount int64
+	Flags         []string
+}
+
+// withdrawDay 提现按北京时间计算日期
+func withdrawDay(t time.Time) string {
+	return t.UTC().Add(8 * time.Hour).Format("2006-01-02")
+}
+
+// Withdraw 用户提现，扣除余额、占用当日额度和创建提现记录在同一事务中，
+// 不超过自动审核额度的直接进入打款，其余等待后台审核
+func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, user *User) (*v1.WithdrawReply, error) {
+	var (
+		configs   map[string]int64
+		withdraws []*Withdraw
+		withdraw  *Withdraw
+		amount    int64
+		err       error
+	)
+
+	if "" != req.SendBody.Type && "usdt" != req.SendBody.Type {
+		return nil, errors.New(500, "ERROR", "不支持的提现类型")
+	}
+
+	amount, err = parseAmount(req.SendBody.Amount)
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= amount {
+		return nil, errors.New(500, "ERROR", "提现金额错误")
+	}
+
+	configs, err = uuc.registry.Int64s(ctx, "withdraw_rate", "withdraw_min", "withdraw_max",
+		"withdraw_user_daily_max", "withdraw_daily_max", "withdraw_cooldown", "withdraw_auto_max",
+	)
+	if nil != err {
+		return nil, err
+	}
+
+	if 0 < configs["withdraw_min"] && amount < configs["withdraw_min"]*100000 {
+		return nil, errors.New(500, "ERROR", fmt.Sprintf("最低提现%d", configs["withdraw_min"]))
+	}
+	if 0 < configs["withdraw_max"] && amount > configs["withdraw_max"]*100000 {
+		return nil, errors.New(500, "ERROR", fmt.Sprintf("最高提现%d", configs["withdraw_max"]))
+	}
+
+	fee := amount * configs["withdraw_rate"] / 100 // 手续费
+	relAmount := amount - fee
+	status := WithdrawStatusReview
+	if 0 < configs["withdraw_auto_max"] && amount <= configs["withdraw_auto_max"]*100000 {
+		status = WithdrawStatusRewarded
+	}
+
+	now := time.Now()
+	day := withdrawDay(now)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 先扣余额，锁住用户余额，同一用户的提现依次检查
+		err = uuc.ubRepo.WithdrawUsdt(ctx, user.ID, amount)
+		if nil != err {
+			return errors.New(500, "ERROR", "余额不足")
+		}
+
+		if 0 < configs["withdraw_cooldown"] {
+			withdraws, err = uuc.ubRepo.GetWithdrawByUserId(ctx, user.ID)
+			if nil != err {
+				return err
+			}
+
+			for _, v := range withdraws {
+				if now.Before(v.CreatedAt.Add(time.Duration(configs["withdraw_cooldown"]) * time.Minute)) {
+					return errors.New(500, "ERROR", fmt.Sprintf("%d分钟内只能提现一次", configs["withdraw_cooldown"]))
+				}
+			}
+		}
+
+		var ok bool
+		ok, err = uuc.ubRepo.AddWithdrawDaily(ctx, day, user.ID, amount, configs["withdraw_user_daily_max"]*100000)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return errors.New(500, "ERROR", "超过今日提现额度")
+		}
+
+		ok, err = uuc.ubRepo.AddWithdrawDaily(ctx, day, 0, amount, configs["withdraw_daily_max"]*100000)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return errors.New(500, "ERROR", "今日平台提现额度已满")
+		}
+
+		withdraw, err = uuc.ubRepo.CreateWithdrawNew(ctx, &Withdraw{
+			UserId:       user.ID,
+			Amount:       amount,
+			RelAmount:    relAmount,
+			Status:       status,
+			Type:         "usdt",
+			AmountNew:    float64(amount) / float64(100000),
+			AmountNewRel: float64(relAmount) / float64(100000),
+		})
+		if nil != err {
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "withdraw", "user_id", user.ID, "amount", amount, "err", err)
+		return nil, err
+	}
+	metrics.Payouts.With(withdraw.Status).Inc()
+
+	return &v1.WithdrawReply{
+		Status:    withdraw.Status,
+		Id:        withdraw.ID,
+		Amount:    fmt.Sprintf("%.2f", float64(amount)/float64(100000)),
+		Fee:       fmt.Sprintf("%.2f", float64(fee)/float64(100000)),
+		RelAmount: fmt.Sprintf("%.2f", float64(relAmount)/float64(100000)),
+	}, nil
+}
+
+// withdrawRisk 按注册时间、入金和之前的提现计算风险标记
+func (uuc *UserUseCase) withdrawRisk(ctx context.Context, withdraw *Withdraw, user *User, configs map[string]int64) (*WithdrawRisk, error) {
+	var (
+		withdraws []*Withdraw
+		locations []*LocationNew
+		err       error
+	)
+
+	withdraws, err = uuc.ubRepo.GetWithdrawByUserId(ctx, withdraw.UserId)
+	if nil != err {
+		return nil, err
+	}
+
+	locations, err = uuc.locationRepo.GetLocationsNewByUserId(ctx, withdraw.UserId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &WithdrawRisk{
+		AccountDays: int64(time.Since(user.CreatedAt).Hours() / 24),
+		Flags:       make([]string, 0),
+	}
+	for _, v := range locations {
+		res.DepositTotal += v.Usdt
+	}
+	for _, v := range withdraws {
+		if v.ID >= withdraw.ID || WithdrawStatusReject == v.Status {
+			continue
+		}
+		res.WithdrawTotal += v.Amount
+		res.WithdrawCount++
+	}
+
+	if res.AccountDays < configs["withdraw_risk_account_days"] {
+		res.Flags = append(res.Flags, WithdrawRiskNewAccount)
+	}
+	if 0 < configs["withdraw_risk_deposit_rate"] && (res.WithdrawTotal+withdraw.Amount)*100 > res.DepositTotal*configs["withdraw_risk_deposit_rate"] {
+		res.Flags = append(res.Flags, WithdrawRiskOverDeposit)
+	}
+	if 0 == res.WithdrawCount {
+		res.Flags = append(res.Flags, WithdrawRiskFirstWithdraw)
+	} else if 0 < configs["withdraw_risk_large_times"] && withdraw.Amount*res.WithdrawCount > res.WithdrawTotal*configs["withdraw_risk_large_times"] {
+		res.Flags = append(res.Flags, WithdrawRiskLargeAmount)
+	}
+	if 0 < configs["withdraw_risk_bind_days"] && !user.BindAt.IsZero() &&
+		time.Since(user.BindAt) < time.Duration(configs["withdraw_risk_bind_days"])*24*time.Hour {
+		res.Flags = append(res.Flags, WithdrawRiskRecentBind)
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) withdrawRiskConfigs(ctx context.Context) (map[string]int64, error) {
+	return uuc.registry.Int64s(ctx, "withdraw_risk_account_days", "withdraw_risk_deposit_rate", "withdraw_risk_large_times", "withdraw_risk_bind_days")
+}
+
+// reviewWithdraw 审核一笔待审核的提现，状态修改、退款和审核记录在同一事务中
+func (uuc *UserUseCase) reviewWithdraw(ctx context.Context, id int64, action string, reason string, configs map[string]int64) (*Withdraw, *WithdrawRisk, error) {
+	var (
+		withdraw *Withdraw
+		users    map[int64]*User
+		risk     *WithdrawRisk
+		err      error
+	)
+
+	withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, id)
+	if nil != err {
+		return nil, nil, err
+	}
+	if WithdrawStatusReview != withdraw.Status {
+		return nil, nil, errors.New(500, "ERROR", "提现不是待审核状态")
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, withdraw.UserId)
+	if nil != err {
+		return nil, nil, err
+	}
+	if _, ok := users[withdraw.UserId]; !ok {
+		return nil, nil, errors.New(500, "ERROR", "用户不存在")
+	}
+
+	risk, err = uuc.withdrawRisk(ctx, withdraw, users[withdraw.UserId], configs)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	status := WithdrawStatusRewarded
+	if WithdrawReviewReject == action {
+		status = WithdrawStatusReject
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		ok, err := uuc.ubRepo.UpdateWithdrawStatus(ctx, withdraw.ID, WithdrawStatusReview, status)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return errors.New(500, "ERROR", "提现状态已变化")
+		}
+
+		if WithdrawReviewReject == action {
+			// 退回余额，释放当日额度
+			err = uuc.ubRepo.RefundWithdrawUsdt(ctx, withdraw.UserId, withdraw.Amount)
+			if nil != err {
+				return err
+			}
+
+			day := withdrawDay(withdraw.CreatedAt)
+			_, err = uuc.ubRepo.AddWithdrawDaily(ctx, day, withdraw.UserId, -withdraw.Amount, 0)
+			if nil != err {
+				return err
+			}
+			_, err = uuc.ubRepo.AddWithdrawDaily(ctx, day, 0, -withdraw.Amount, 0)
+			if nil != err {
+				return err
+			}
+		}
+
+		return uuc.withdrawReviewRepo.CreateWithdrawReview(ctx, &WithdrawReview{
+			WithdrawId:   withdraw.ID,
+			UserId:       withdraw.UserId,
+			AdminId:      adminIdFromContext(ctx),
+			Action:       action,
+			StatusBefore: WithdrawStatusReview,
+			StatusAfter:  status,
+			Amount:       withdraw.Amount,
+			Reason:       reason,
+			Flags:        risk.Flags,
+		})
+	}); nil != err {
+		uuc.log.WithContext(ctx).Errorw("msg", "withdraw review", "withdraw_id", id, "action", action, "err", err)
+		return nil, nil, err
+	}
+	withdraw.Status = status
+	metrics.Payouts.With(status).Inc()
+
+	return withdraw, risk, nil
+}
+
+func (uuc *UserUseCase) AdminWithdrawReviewList(ctx context.Context, req *v1.AdminWithdrawReviewListRequest) (*v1.AdminWithdrawReviewListReply, error) {
+	var (
+		withdraws []*Withdraw
+		users     map[int64]*User
+		userIds   []int64
+		configs   map[string]int64
+		count     int64
+		err       error
+	)
+
+	res := &v1.AdminWithdrawReviewListReply{
+		Withdraw: make([]*v1.AdminWithdrawReviewListReply_List, 0),
+	}
+
+	configs, err = uuc.withdrawRiskConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	withdraws, err, count = uuc.ubRepo.GetWithdrawsByStatus(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, WithdrawStatusReview)
+	if nil != err {
+		return nil, err
+	}
+	res.Count = count
+	if 0 >= len(withdraws) {
+		return res, nil
+	}
+
+	for _, v := range withdraws {
+		userIds = append(userIds, v.UserId)
+	}
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range withdraws {
+		user, ok := users[v.UserId]
+		if !ok {
+			continue
+		}
+
+		risk, err := uuc.withdrawRisk(ctx, v, user, configs)
+		if nil != err {
+			return nil, err
+		}
+
+		var avg int64
+		if 0 < risk.WithdrawCount {
+			avg = risk.WithdrawTotal / risk.WithdrawCount
+		}
+
+		res.Withdraw = append(res.Withdraw, &v1.AdminWithdrawReviewListReply_List{
+			Id:            v.ID,
+			Address:       user.Address,
+			CreatedAt:     v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:        fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			RelAmount:     fmt.Sprintf("%.2f", float64(v.RelAmount)/float64(100000)),
+			AccountDays:   risk.AccountDays,
+			DepositTotal:  fmt.Sprintf("%.2f", float64(risk.DepositTotal)/float64(100000)),
+			WithdrawTotal: fmt.Sprintf("%.2f", float64(risk.WithdrawTotal)/float64(100000)),
+			WithdrawCount: risk.WithdrawCount,
+			WithdrawAvg:   fmt.Sprintf("%.2f", float64(avg)/float64(100000)),
+			Flags:         risk.Flags,
+		})
+	}
+
+	return res, nil
+}
+
+// AdminWithdrawPass 审核通过，进入打款
+func (uuc *UserUseCase) AdminWithdrawPass(ctx context.Context, req *v1.AdminWithdrawPassRequest) (*v1.AdminWithdrawPassReply, error) {
+	configs, err := uuc.withdrawRiskConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	withdraw, risk, err := uuc.reviewWithdraw(ctx, req.SendBody.Id, WithdrawReviewPass, "", configs)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawPassReply{
+		Status: withdraw.Status,
+		Flags:  risk.Flags,
+	}, nil
+}
+
+// AdminWithdrawReject 驳回并退回余额
+func (uuc *UserUseCase) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	configs, err := uuc.withdrawRiskConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	withdraw, _, err := uuc.reviewWithdraw(ctx, req.SendBody.Id, WithdrawReviewReject, req.SendBody.Reason, configs)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawRejectReply{
+		Status: withdraw.Status,
+		Refund: fmt.Sprintf("%.2f", float64(withdraw.Amount)/float64(100000)),
+	}, nil
+}
+
+// AdminWithdrawBatchPass 逐笔审核通过，单笔失败不影响其他
+func (uuc *UserUseCase) AdminWithdrawBatchPass(ctx context.Context, req *v1.AdminWithdrawBatchPassRequest) (*v1.AdminWithdrawBatchPassReply, error) {
+	configs, err := uuc.withdrawRiskConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminWithdrawBatchPassReply{
+		Items: make([]*v1.AdminWithdrawBatchPassReply_Item, 0),
+	}
+	for _, id := range req.SendBody.Ids {
+		withdraw, risk, err := uuc.reviewWithdraw(ctx, id, WithdrawReviewPass, "", configs)
+		if nil != err {
+			res.Items = append(res.Items, &v1.AdminWithdrawBatchPassReply_Item{Id: id, Status: "failed", Error: err.Error()})
+			continue
+		}
+
+		res.Items = append(res.Items, &v1.AdminWithdrawBatchPassReply_Item{Id: id, Status: withdraw.Status, Flags: risk.Flags})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminWithdrawReviews(ctx context.Context, req *v1.AdminWithdrawReviewsRequest) (*v1.AdminWithdrawReviewsReply, error) {
+	reviews, err := uuc.withdrawReviewRepo.GetWithdrawReviews(ctx, req.WithdrawId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminWithdrawReviewsReply{
+		Reviews: make([]*v1.AdminWithdrawReviewsReply_List, 0),
+	}
+	for _, v := range reviews {
+		res.Reviews = append(res.Reviews, &v1.AdminWithdrawReviewsReply_List{
+			Id:           v.ID,
+			WithdrawId:   v.WithdrawId,
+			UserId:       v.UserId,
+			AdminId:      v.AdminId,
+			Action:       v.Action,
+			StatusBefore: v.StatusBefore,
+			StatusAfter:  v.StatusAfter,
+			Amount:       fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Reason:       v.Reason,
+			Flags:        v.Flags,
+			CreatedAt:    v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeWithdrawRepo 模拟用户之前的提现
+type fakeWithdrawRepo struct {
+	UserBalanceRepo
+	withdraws []*Withdraw
+}
+
+func (r *fakeWithdrawRepo) GetWithdrawByUserId(ctx context.Context, userId int64) ([]*Withdraw, error) {
+	res := make([]*Withdraw, 0)
+	for _, v := range r.withdraws {
+		if userId == v.UserId {
+			res = append(res, v)
+		}
+	}
+
+	return res, nil
+}
+
+func TestWithdrawRisk(t *testing.T) {
+	var (
+		now     = time.Now()
+		configs = map[string]int64{
+			"withdraw_risk_account_days": 7,
+			"withdraw_risk_deposit_rate": 100,
+			"withdraw_risk_large_times":  3,
+			"withdraw_risk_bind_days":    3,
+		}
+		withdraws = []*Withdraw{
+			{ID: 1, UserId: 1, Amount: 1000000, Status: WithdrawStatusRewarded},
+			{ID: 2, UserId: 1, Amount: 9000000, Status: WithdrawStatusReject}, // 驳回的不算
+		}
+	)
+
+	tests := []struct {
+		name     string
+		withdraw *Withdraw
+		user     *User
+		configs  map[string]int64
+		flags    []string
+	}{
+		{
+			name:     "没有风险",
+			withdraw: &Withdraw{ID: 3, UserId: 1, Amount: 1000000},
+			user:     &User{ID: 1, CreatedAt: now.AddDate(0, -1, 0)},
+			configs:  configs,
+			flags:    []string{},
+		},
+		{
+			name:     "新账户没有入金首次提现",
+			withdraw: &Withdraw{ID: 3, UserId: 2, Amount: 1000000},
+			user:     &User{ID: 2, CreatedAt: now.AddDate(0, 0, -1)},
+			configs:  configs,
+			flags:    []string{WithdrawRiskNewAccount, WithdrawRiskOverDeposit, WithdrawRiskFirstWithdraw},
+		},
+		{
+			name:     "超过入金且超过历史平均",
+			withdraw: &Withdraw{ID: 3, UserId: 1, Amount: 30000000},
+			user:     &User{ID: 1, CreatedAt: now.AddDate(0, -1, 0)},
+			configs:  configs,
+			flags:    []string{WithdrawRiskOverDeposit, WithdrawRiskLargeAmount},
+		},
+		{
+			name:     "入金比例为0时不检查",
+			withdraw: &Withdraw{ID: 3, UserId: 1, Amount: 3000000},
+			user:     &User{ID: 1, CreatedAt: now.AddDate(0, -1, 0)},
+			configs:  map[string]int64{"withdraw_risk_large_times": 3},
+			flags:    []string{},
+		},
+		{
+			name:     "最近修改过地址或密码",
+			withdraw: &Withdraw{ID: 3, UserId: 1, Amount: 1000000},
+			user:     &User{ID: 1, CreatedAt: now.AddDate(0, -1, 0), BindAt: now.Add(-time.Hour)},
+			configs:  configs,
+			flags:    []string{WithdrawRiskRecentBind},
+		},
+		{
+			name:     "修改超过窗口",
+			withdraw: &Withdraw{ID: 3, UserId: 1, Amount: 1000000},
+			user:     &User{ID: 1, CreatedAt: now.AddDate(0, -1, 0), BindAt: now.AddDate(0, 0, -4)},
+			configs:  configs,
+			flags:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuc := &UserUseCase{
+				ubRepo: &fakeWithdrawRepo{withdraws: withdraws},
+				locationRepo: &fakeLocationRepo{db: &fakeDepositDB{locations: []LocationNew{
+					{ID: 1, UserId: 1, Usdt: 10000000},
+				}}},
+			}
+
+			risk, err := uuc.withdrawRisk(context.Background(), tt.withdraw, tt.user, tt.configs)
+			if nil != err {
+				t.Fatal(err)
+			}
+			if len(tt.flags) != len(risk.Flags) {
+				t.Fatalf("flags = %v, want %v", risk.Flags, tt.flags)
+			}
+			for i := range tt.flags {
+				if tt.flags[i] != risk.Flags[i] {
+					t.Fatalf("flags = %v, want %v", risk.Flags, tt.flags)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/pkg/metrics"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// BuySomething合约同步用到的方法
+const buySomethingABI = `[
+{"inputs":[],"name":"getUserLength","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"internalType":"uint256","name":"startIndex","type":"uint256"},{"internalType":"uint256","name":"endIndex","type":"uint256"}],"name":"getUsersByIndex","outputs":[{"internalType":"string[]","name":"","type":"string[]"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"internalType":"uint256","name":"startIndex","type":"uint256"},{"internalType":"uint256","name":"endIndex","type":"uint256"}],"name":"getUsersAmountByIndex","outputs":[{"internalType":"uint256[]","name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"internalType":"uint256","name":"startIndex","type":"uint256"},{"internalType":"uint256","name":"endIndex","type":"uint256"}],"name":"getUserAddressByIndex","outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"stateMutability":"view","type":"function"}
+]`
+
+// buyCaller 合约调用，需要能取得最新区块以固定读取的区块
+type buyCaller interface {
+	bind.ContractCaller
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+type ChainCursor struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Name      string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Position  int64     `gorm:"type:bigint;not null;default:0"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type BuyContractRepo struct {
+	log  *log.Helper
+	dial func(rpcUrl string) (buyCaller, error) // 可替换为模拟链
+}
+
+type ChainCursorRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBuyContractRepo(logger log.Logger) biz.BuyContractRepo {
+	return &BuyContractRepo{
+		log: log.NewHelper(logger),
+		dial: func(rpcUrl string) (buyCaller, error) {
+			return ethclient.Dial(rpcUrl)
+		},
+	}
+}
+
+func NewChainCursorRepo(data *Data, logger log.Logger) biz.ChainCursorRepo {
+	return &ChainCursorRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetBuyPurchases 三个数组在同一区块读取，避免读取期间有新的购买导致错位
+func (b *BuyContractRepo) GetBuyPurchases(ctx context.Context, rpcUrl string, contract string, start int64, limit int64) (int64, []*biz.BuyPurchase, error) {
+	if !common.IsHexAddress(contract) {
+		return 0, nil, errors.New(500, "BUY CONTRACT ERROR", "合约地址错误")
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(buySomethingABI))
+	if nil != err {
+		return 0, nil, errors.New(500, "BUY CONTRACT ERROR", err.Error())
+	}
+
+	caller, err := b.dial(rpcUrl)
+	if nil != err {
+		return 0, nil, errors.New(500, "BUY CONTRACT ERROR", err.Error())
+	}
+	if closer, ok := caller.(interface{ Close() }); ok {
+		defer closer.Close()
+	}
+
+	begin := time.Now()
+	blockNumber, err := caller.BlockNumber(ctx)
+	metrics.RPC(rpcUrl, "block_number", begin, err)
+	if nil != err {
+		return 0, nil, errors.New(500, "BUY CONTRACT ERROR", err.Error())
+	}
+
+	instance := bind.NewBoundContract(common.HexToAddress(contract), parsed, caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)}
+	call := func(method string, params ...interface{}) (interface{}, error) {
+		var out []interface{}
+		begin := time.Now()
+		err := instance.Call(opts, &out, method, params...)
+		metrics.RPC(rpcUrl, method, begin, err)
+		if nil != err {
+			return nil, errors.New(500, "BUY CONTRACT ERROR", method+" "+err.Error())
+		}
+
+		return out[0], nil
+	}
+
+	tmpLength, err := call("getUserLength")
+	if nil != err {
+		return 0, nil, err
+	}
+	length := abi.ConvertType(tmpLength, new(big.Int)).(*big.Int).Int64()
+
+	res := make([]*biz.BuyPurchase, 0)
+	if start >= length || 0 >= limit {
+		return length, res, nil
+	}
+
+	end := start + limit - 1 // 合约按闭区间返回
+	if end >= length {
+		end = length - 1
+	}
+	startIndex, endIndex := big.NewInt(start), big.NewInt(end)
+
+	tmpAccounts, err := call("getUsersByIndex", startIndex, endIndex)
+	if nil != err {
+		return 0, nil, err
+	}
+	tmpAmounts, err := call("getUsersAmountByIndex", startIndex, endIndex)
+	if nil != err {
+		return 0, nil, err
+	}
+	tmpAddresses, err := call("getUserAddressByIndex", startIndex, endIndex)
+	if nil != err {
+		return 0, nil, err
+	}
+
+	accounts := *abi.ConvertType(tmpAccounts, new([]string)).(*[]string)
+	amounts := *abi.ConvertType(tmpAmounts, new([]*big.Int)).(*[]*big.Int)
+	addresses := *abi.ConvertType(tmpAddresses, new([]common.Address)).(*[]common.Address)
+	if int64(len(accounts)) != end-start+1 || len(accounts) != len(amounts) || len(accounts) != len(addresses) {
+		return 0, nil, errors.New(500, "BUY CONTRACT ERROR", "数量不一致")
+	}
+
+	for k, v := range accounts {
+		res = append(res, &biz.BuyPurchase{
+			Index:   start + int64(k),
+			Account: v,
+			Address: addresses[k].String(),
+			Amount:  amounts[k].Int64(),
+		})
+	}
+
+	return length, res, nil
+}
+
+// GetChainCursor 不存在时为0
+func (c *ChainCursorRepo) GetChainCursor(ctx context.Context, name string) (int64, error) {
+	var cursor ChainCursor
+	if err := c.data.DB(ctx).Table("chain_cursor").Where("name=?", name).First(&cursor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "CHAIN CURSOR ERROR", err.Error())
+	}
+
+	return cursor.Position, nil
+}
+
+// AdvanceChainCursor 不存在时先创建，按原进度条件修改
+func (c *ChainCursorRepo) AdvanceChainCursor(ctx context.Context, name string, from int64, to int64) (bool, error) {
+	now := time.Now()
+	if err := c.data.DB(ctx).Table("chain_cursor").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&ChainCursor{Name: name, CreatedAt: now, UpdatedAt: now}).Error; err != nil {
+		return false, errors.New(500, "CHAIN CURSOR ERROR", err.Error())
+	}
+
+	res := c.data.DB(ctx).Table("chain_cursor").
+		Where("name=? and position=?", name, from).
+		Updates(map[string]interface{}{"position": to, "updated_at": now})
+	if nil != res.Error {
+		return false, errors.New(500, "CHAIN CURSOR ERROR", res.Error.Error())
+	}
+
+	return 0 < res.RowsAffected, nil
+}
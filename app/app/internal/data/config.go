@@ -0,0 +1,136 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 配置修改通知的频道，消息内容为修改的key
+const configChangeChannel = "dhb:config:change"
+
+type ConfigVersion struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	ConfigId      int64     `gorm:"type:int;not null"`
+	KeyName       string    `gorm:"type:varchar(100);not null;index:idx_key_effective,priority:1"`
+	Value         string    `gorm:"type:varchar(500);not null"`
+	AdminId       int64     `gorm:"type:int;not null;default:0"`
+	EffectiveFrom time.Time `gorm:"type:datetime;not null;index:idx_key_effective,priority:2"`
+	Note          string    `gorm:"type:varchar(500);not null;default:''"`
+	RollbackOf    int64     `gorm:"type:int;not null;default:0"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type ConfigVersionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+type ConfigNotifier struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewConfigVersionRepo(data *Data, logger log.Logger) biz.ConfigVersionRepo {
+	return &ConfigVersionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func NewConfigNotifier(data *Data, logger log.Logger) biz.ConfigNotifier {
+	return &ConfigNotifier{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// PublishConfigChange 通知所有实例清除配置缓存
+func (c *ConfigNotifier) PublishConfigChange(ctx context.Context, key string) error {
+	return c.data.rdb.Publish(ctx, configChangeChannel, key).Err()
+}
+
+// SubscribeConfigChange 收到通知时调用fn，断线后由redis客户端自动重连
+func (c *ConfigNotifier) SubscribeConfigChange(ctx context.Context, fn func(key string)) {
+	pubSub := c.data.rdb.Subscribe(ctx, configChangeChannel)
+	go func() {
+		defer pubSub.Close()
+		for msg := range pubSub.Channel() {
+			fn(msg.Payload)
+		}
+		c.log.Info("config change subscription closed")
+	}()
+}
+
+// CreateConfigVersion .
+func (c *ConfigVersionRepo) CreateConfigVersion(ctx context.Context, version *biz.ConfigVersion) (*biz.ConfigVersion, error) {
+	now := time.Now()
+	tmp := &ConfigVersion{
+		ConfigId:      version.ConfigId,
+		KeyName:       version.KeyName,
+		Value:         version.Value,
+		AdminId:       version.AdminId,
+		EffectiveFrom: version.EffectiveFrom,
+		Note:          version.Note,
+		RollbackOf:    version.RollbackOf,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := c.data.DB(ctx).Table("config_version").Create(tmp).Error; err != nil {
+		return nil, errors.New(500, "CREATE_CONFIG_VERSION_ERROR", err.Error())
+	}
+
+	return configVersionToBiz(tmp), nil
+}
+
+// GetConfigVersions keys的全部版本，为空时取全部，按生效时间升序
+func (c *ConfigVersionRepo) GetConfigVersions(ctx context.Context, keys ...string) ([]*biz.ConfigVersion, error) {
+	var versions []*ConfigVersion
+	res := make([]*biz.ConfigVersion, 0)
+
+	db := c.data.DB(ctx).Table("config_version")
+	if 0 < len(keys) {
+		db = db.Where("key_name IN (?)", keys)
+	}
+	if err := db.Order("effective_from asc, id asc").Find(&versions).Error; err != nil {
+		return nil, errors.New(500, "CONFIG VERSION ERROR", err.Error())
+	}
+
+	for _, v := range versions {
+		res = append(res, configVersionToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetConfigVersionById 不存在时为nil
+func (c *ConfigVersionRepo) GetConfigVersionById(ctx context.Context, id int64) (*biz.ConfigVersion, error) {
+	var version ConfigVersion
+	if err := c.data.DB(ctx).Table("config_version").Where("id=?", id).First(&version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CONFIG VERSION ERROR", err.Error())
+	}
+
+	return configVersionToBiz(&version), nil
+}
+
+func configVersionToBiz(v *ConfigVersion) *biz.ConfigVersion {
+	return &biz.ConfigVersion{
+		ID:            v.ID,
+		ConfigId:      v.ConfigId,
+		KeyName:       v.KeyName,
+		Value:         v.Value,
+		AdminId:       v.AdminId,
+		EffectiveFrom: v.EffectiveFrom,
+		Note:          v.Note,
+		RollbackOf:    v.RollbackOf,
+		CreatedAt:     v.CreatedAt,
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/metrics"
+	"errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -13,11 +16,12 @@ import (
 	"gorm.io/gorm/schema"
 	slog "log"
 	"os"
+	"strings"
 	"time"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo, NewRewardRunRepo, NewLocationTreeRepo, NewLocationProductRepo, NewLocationEventRepo, NewConfigNotifier, NewConfigVersionRepo, NewPriceFeedRepo, NewPriceRevalueRepo, NewBuyContractRepo, NewChainCursorRepo, NewWithdrawReviewRepo, NewExportRepo)
 
 type Data struct {
 	db  *gorm.DB
@@ -31,6 +35,9 @@ type contextTxKey struct{}
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
 	}
 	return &Data{
 		db:  db,
@@ -43,12 +50,63 @@ func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
 
-// ExecTx gorm Transaction
+// ExecTx gorm Transaction，死锁或锁等待超时只计数，是否重新执行由调用方决定
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		ctx = context.WithValue(ctx, contextTxKey{}, tx)
-		return fn(ctx)
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
 	})
+
+	if reason := txConflictReason(err); "" != reason {
+		metrics.TxConflicts.With(reason).Inc()
+	}
+
+	return err
+}
+
+// ExecTxRetry 死锁或锁等待超时时整个事务已回滚，重新执行，
+// fn只能读写事务内的数据，不能修改闭包外的变量，需要可以重复执行
+func (d *Data) ExecTxRetry(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	for i := 1; ; i++ {
+		err = d.ExecTx(ctx, fn)
+
+		reason := txConflictReason(err)
+		if "" == reason || txAttempts <= i {
+			return err
+		}
+		metrics.TxRetries.With(reason).Inc()
+	}
+}
+
+// txAttempts ExecTxRetry最多执行的次数
+const txAttempts = 3
+
+// txConflictReason 死锁和锁等待超时，仓库里的错误多数已转成文本，按错误号匹配
+func txConflictReason(err error) string {
+	if nil == err {
+		return ""
+	}
+
+	var mysqlErr *mysqlDriver.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1213:
+			return "deadlock"
+		case 1205:
+			return "lock_wait_timeout"
+		}
+		return ""
+	}
+
+	msg := err.Error()
+	if strings.Contains(msg, "Error 1213") {
+		return "deadlock"
+	}
+	if strings.Contains(msg, "Error 1205") {
+		return "lock_wait_timeout"
+	}
+
+	return ""
 }
 
 // DB 根据此方法来判断当前的 db 是不是使用 事务的 DB
@@ -64,7 +122,7 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 func NewDB(c *conf.Data) *gorm.DB {
 	f, err := os.OpenFile("../../log/sql.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
-		log.Errorf("failed opening sql.log", err)
+		log.Errorf("failed opening sql.log: %v", err)
 		panic("failed opening sql.log")
 	}
 
@@ -103,9 +161,6 @@ func NewRedis(c *conf.Data) *redis.Client {
 		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
 	})
 
-	if err := rdb.Close(); err != nil {
-		log.Error(err)
-	}
 	return rdb
 }
 
@@ -126,3 +181,125 @@ func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 		return db.Offset(offset).Limit(pageSize)
 	}
 }
+
+// Page 后台列表的筛选、排序和分页：有游标时从游标之后读取，不使用offset；NoCount时不查总数。
+// 列名都来自biz的白名单，不会拼入请求里的字符串
+func Page(instance *gorm.DB, b *biz.Pagination, count *int64) *gorm.DB {
+	instance = instance.Scopes(ListScope(b.Filter))
+	if !b.NoCount {
+		instance = instance.Count(count)
+	}
+
+	sort, order, cmp := "id", "desc", "<"
+	if "" != b.Sort {
+		sort = b.Sort
+	}
+	if b.Asc {
+		order, cmp = "asc", ">"
+	}
+
+	if nil != b.Cursor {
+		if "id" == sort {
+			instance = instance.Where("id"+cmp+"?", b.Cursor.Id)
+		} else {
+			instance = instance.Where("("+sort+cmp+"? or ("+sort+"=? and id"+cmp+"?))", b.Cursor.Key, b.Cursor.Key, b.Cursor.Id)
+		}
+		instance = instance.Scopes(Paginate(1, b.PageSize))
+	} else {
+		instance = instance.Scopes(Paginate(b.PageNum, b.PageSize))
+	}
+
+	if "id" != sort {
+		instance = instance.Order(sort + " " + order)
+	}
+
+	return instance.Order("id " + order)
+}
+
+// ListScope 后台列表的筛选
+func ListScope(f *biz.ListFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if nil == f {
+			return db
+		}
+
+		if !f.Start.IsZero() {
+			db = db.Where(f.DateColumn+">=?", f.Start)
+		}
+		if !f.End.IsZero() {
+			db = db.Where(f.DateColumn+"<?", f.End)
+		}
+		if 0 < len(f.Status) {
+			db = db.Where("status in ?", f.Status)
+		}
+		if nil != f.AmountMin {
+			db = db.Where(f.AmountColumn+">=?", *f.AmountMin)
+		}
+		if nil != f.AmountMax {
+			db = db.Where(f.AmountColumn+"<=?", *f.AmountMax)
+		}
+		if 0 < f.VipMin {
+			db = db.Where(f.UserColumn+" in (?)", db.Session(&gorm.Session{NewDB: true}).
+				Table("user_info").Select("user_id").Where("vip>=?", f.VipMin))
+		}
+		if "" != f.CoinType {
+			db = db.Where(f.CoinColumn+"=?", f.CoinType)
+		}
+		if "" != f.Type {
+			db = db.Where(f.TypeColumn+"=?", f.Type)
+		}
+
+		return db
+	}
+}
+
+// caseColumn 按主键分别赋值的列，add为true时在原值上累加
+type caseColumn struct {
+	name   string
+	add    bool
+	values map[int64]interface{}
+}
+
+// bulkUpdateCase 一条UPDATE ... CASE语句修改多行
+func bulkUpdateCase(db *gorm.DB, table string, key string, ids []int64, columns []*caseColumn) (int64, error) {
+	var (
+		sets []string
+		args []interface{}
+	)
+
+	for _, column := range columns {
+		if 0 >= len(column.values) {
+			continue
+		}
+
+		var sql strings.Builder
+		sql.WriteString(column.name + " = ")
+		if column.add {
+			sql.WriteString(column.name + " + ")
+		}
+		sql.WriteString("CASE " + key)
+		for _, id := range ids {
+			if v, ok := column.values[id]; ok {
+				sql.WriteString(" WHEN ? THEN ?")
+				args = append(args, id, v)
+			}
+		}
+		if column.add {
+			sql.WriteString(" ELSE 0 END")
+		} else {
+			sql.WriteString(" ELSE " + column.name + " END")
+		}
+		sets = append(sets, sql.String())
+	}
+
+	if 0 >= len(sets) {
+		return 0, nil
+	}
+
+	args = append(args, ids)
+	if err := db.Exec("UPDATE "+table+" SET "+strings.Join(sets, ", ")+" WHERE "+key+" IN (?)", args...).Error; err != nil {
+		return 0, err
+	}
+
+	return 1, nil
+}
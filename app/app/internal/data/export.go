@@ -0,0 +1,299 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ExportJob struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	AdminId   int64     `gorm:"type:int;not null;default:0"`
+	Kind      string    `gorm:"type:varchar(45);not null"`
+	Format    string    `gorm:"type:varchar(10);not null"`
+	Filter    string    `gorm:"type:varchar(1000);not null;default:''"`
+	Status    string    `gorm:"type:varchar(45);not null"`
+	Exported  int64     `gorm:"type:int;not null;default:0"` // 已导出行数，rows是mysql保留字
+	File      string    `gorm:"type:varchar(500);not null;default:''"`
+	Error     string    `gorm:"type:varchar(500);not null;default:''"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type ExportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewExportRepo(data *Data, logger log.Logger) biz.ExportRepo {
+	return &ExportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// keyset 从afterId之后按id升序读取，按创建时间和用户筛选
+func (e *ExportRepo) keyset(ctx context.Context, table string, f *biz.ExportFilter, afterId int64, limit int) *gorm.DB {
+	instance := e.data.DB(ctx).Table(table).Where("id>?", afterId)
+	if 0 < f.UserId {
+		if "user" == table {
+			instance = instance.Where("id=?", f.UserId)
+		} else {
+			instance = instance.Where("user_id=?", f.UserId)
+		}
+	}
+	if !f.Start.IsZero() {
+		instance = instance.Where("created_at>=?", f.Start)
+	}
+	if !f.End.IsZero() {
+		instance = instance.Where("created_at<?", f.End)
+	}
+
+	return instance.Order("id asc").Limit(limit)
+}
+
+// ExportUsers .
+func (e *ExportRepo) ExportUsers(ctx context.Context, f *biz.ExportFilter, afterId int64, limit int) ([]*biz.User, error) {
+	var users []*User
+	res := make([]*biz.User, 0)
+	if err := e.keyset(ctx, "user", f, afterId, limit).Find(&users).Error; err != nil {
+		return nil, errors.New(500, "EXPORT ERROR", err.Error())
+	}
+
+	for _, item := range users {
+		res = append(res, &biz.User{
+			ID:        item.ID,
+			Address:   item.Address,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// ExportRewards .
+func (e *ExportRepo) ExportRewards(ctx context.Context, f *biz.ExportFilter, afterId int64, limit int) ([]*biz.Reward, error) {
+	var rewards []*Reward
+	res := make([]*biz.Reward, 0)
+
+	instance := e.keyset(ctx, "reward", f, afterId, limit)
+	if "" != f.Reason {
+		instance = instance.Where("reason=?", f.Reason)
+	}
+	if "" != f.Type {
+		instance = instance.Where("type=?", f.Type)
+	}
+	if err := instance.Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "EXPORT ERROR", err.Error())
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			AmountB:          reward.AmountNew,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// ExportWithdraws .
+func (e *ExportRepo) ExportWithdraws(ctx context.Context, f *biz.ExportFilter, afterId int64, limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+
+	instance := e.keyset(ctx, "withdraw", f, afterId, limit)
+	if "" != f.Type {
+		instance = instance.Where("type=?", f.Type)
+	}
+	if "" != f.Status {
+		instance = instance.Where("status=?", f.Status)
+	}
+	if err := instance.Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "EXPORT ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			AmountNew:       withdraw.AmountNew,
+			AmountNewRel:    withdraw.AmountNewRel,
+			CreatedAt:       withdraw.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// ExportRecords .
+func (e *ExportRepo) ExportRecords(ctx context.Context, f *biz.ExportFilter, afterId int64, limit int) ([]*biz.EthUserRecord, error) {
+	var records []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+
+	instance := e.keyset(ctx, "eth_user_record", f, afterId, limit)
+	if "" != f.Type {
+		instance = instance.Where("type=?", f.Type)
+	}
+	if "" != f.Status {
+		instance = instance.Where("status=?", f.Status)
+	}
+	if err := instance.Find(&records).Error; err != nil {
+		return nil, errors.New(500, "EXPORT ERROR", err.Error())
+	}
+
+	for _, v := range records {
+		res = append(res, &biz.EthUserRecord{
+			ID:        v.ID,
+			UserId:    v.UserId,
+			Hash:      v.Hash,
+			Status:    v.Status,
+			Type:      v.Type,
+			Amount:    v.Amount,
+			AmountTwo: v.AmountTwo,
+			CoinType:  v.CoinType,
+			Last:      v.Last,
+			Source:    v.Source,
+			AdminId:   v.AdminId,
+			Note:      v.Note,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// ExportLocations .
+func (e *ExportRepo) ExportLocations(ctx context.Context, f *biz.ExportFilter, afterId int64, limit int) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	res := make([]*biz.LocationNew, 0)
+
+	instance := e.keyset(ctx, "location_new", f, afterId, limit)
+	if "" != f.Status {
+		instance = instance.Where("status=?", f.Status)
+	}
+	if err := instance.Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "EXPORT ERROR", err.Error())
+	}
+
+	for _, v := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:         v.ID,
+			UserId:     v.UserId,
+			Status:     v.Status,
+			Current:    v.Current,
+			CurrentMax: v.CurrentMax,
+			Usdt:       v.Usdt,
+			ProductId:  v.ProductId,
+			StopDate:   v.StopDate,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// ExportTrades .
+func (e *ExportRepo) ExportTrades(ctx context.Context, f *biz.ExportFilter, afterId int64, limit int) ([]*biz.Trade, error) {
+	var trades []*Trade
+	res := make([]*biz.Trade, 0)
+
+	instance := e.keyset(ctx, "trade", f, afterId, limit)
+	if "" != f.Status {
+		instance = instance.Where("status=?", f.Status)
+	}
+	if err := instance.Find(&trades).Error; err != nil {
+		return nil, errors.New(500, "EXPORT ERROR", err.Error())
+	}
+
+	for _, v := range trades {
+		res = append(res, &biz.Trade{
+			ID:           v.ID,
+			UserId:       v.UserId,
+			AmountCsd:    v.AmountCsd,
+			RelAmountCsd: v.RelAmountCsd,
+			AmountHbs:    v.AmountHbs,
+			RelAmountHbs: v.RelAmountHbs,
+			CsdReward:    v.CsdReward,
+			Status:       v.Status,
+			CreatedAt:    v.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+func exportJobToBiz(job *ExportJob) *biz.ExportJob {
+	return &biz.ExportJob{
+		ID:        job.ID,
+		AdminId:   job.AdminId,
+		Kind:      job.Kind,
+		Format:    job.Format,
+		Filter:    job.Filter,
+		Status:    job.Status,
+		Rows:      job.Exported,
+		File:      job.File,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+}
+
+// CreateExportJob .
+func (e *ExportRepo) CreateExportJob(ctx context.Context, job *biz.ExportJob) (*biz.ExportJob, error) {
+	tmpJob := &ExportJob{
+		AdminId: job.AdminId,
+		Kind:    job.Kind,
+		Format:  job.Format,
+		Filter:  job.Filter,
+		Status:  job.Status,
+	}
+	if err := e.data.DB(ctx).Table("export_job").Create(tmpJob).Error; err != nil {
+		return nil, errors.New(500, "CREATE_EXPORT_JOB_ERROR", err.Error())
+	}
+
+	return exportJobToBiz(tmpJob), nil
+}
+
+// GetExportJob .
+func (e *ExportRepo) GetExportJob(ctx context.Context, id int64) (*biz.ExportJob, error) {
+	var job ExportJob
+	if err := e.data.DB(ctx).Table("export_job").Where("id=?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("EXPORT_JOB_NOT_FOUND", "export job not found")
+		}
+
+		return nil, errors.New(500, "EXPORT JOB ERROR", err.Error())
+	}
+
+	return exportJobToBiz(&job), nil
+}
+
+// UpdateExportJob 按原状态条件修改
+func (e *ExportRepo) UpdateExportJob(ctx context.Context, job *biz.ExportJob, from string) (bool, error) {
+	res := e.data.DB(ctx).Table("export_job").
+		Where("id=? and status=?", job.ID, from).
+		Updates(map[string]interface{}{
+			"status":     job.Status,
+			"exported":   job.Rows,
+			"file":       job.File,
+			"error":      job.Error,
+			"updated_at": time.Now(),
+		})
+	if nil != res.Error {
+		return false, errors.New(500, "UPDATE_EXPORT_JOB_ERROR", res.Error.Error())
+	}
+
+	return 0 < res.RowsAffected, nil
+}
@@ -3,7 +3,6 @@ package data
 import (
 	"context"
 	"dhb/app/app/internal/biz"
-	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
@@ -50,6 +49,8 @@ type LocationNew struct {
 	Biw               int64     `gorm:"type:bigint;not null"`
 	StopCoin          int64     `gorm:"type:bigint;not null"`
 	LastLevel         int64     `gorm:"type:bigint;not null"`
+	Path              string    `gorm:"type:varchar(2000);not null;default:''"`
+	ProductId         int64     `gorm:"type:int;not null;default:0"`
 	StopDate          time.Time `gorm:"type:datetime;not null"`
 	CreatedAt         time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt         time.Time `gorm:"type:datetime;not null"`
@@ -150,6 +151,8 @@ func (lr *LocationRepo) CreateLocationNew(ctx context.Context, rel *biz.Location
 	location.Top = rel.Top
 	location.TopNum = rel.TopNum
 	location.LastLevel = rel.LastLevel
+	location.Path = rel.Path
+	location.ProductId = rel.ProductId
 	res := lr.data.DB(ctx).Table("location_new").Create(&location)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "占位信息创建失败")
@@ -197,12 +200,11 @@ func (lr *LocationRepo) GetBuyYesterday(ctx context.Context, day int) ([]*biz.Re
 
 	// 16点之后执行
 	now := time.Now().UTC().AddDate(0, 0, day)
-	fmt.Println(now, day)
 	startDate := now
 	endDate := now.AddDate(0, 0, 1)
 	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
 	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 16, 0, 0, 0, time.UTC)
-	fmt.Println(todayStart, todayEnd)
+	lr.log.WithContext(ctx).Debugw("msg", "buy yesterday", "start", todayStart, "end", todayEnd)
 	instance = instance.Where("created_at>=?", todayStart)
 	instance = instance.Where("created_at<?", todayEnd)
 	instance = instance.Where("reason=?", "buy")
@@ -257,6 +259,7 @@ func (lr *LocationRepo) GetLocationDailyYesterday(ctx context.Context, day int)
 			CurrentMax: v.CurrentMax,
 			OutRate:    v.OutRate,
 			Usdt:       v.Usdt,
+			ProductId:  v.ProductId,
 		})
 	}
 
@@ -304,6 +307,7 @@ func (lr *LocationRepo) GetMyLocationLast(ctx context.Context, userId int64) (*b
 		Current:    location.Current,
 		CurrentMax: location.CurrentMax,
 		StopDate:   location.StopDate,
+		ProductId:  location.ProductId,
 	}, nil
 }
 
@@ -354,6 +358,7 @@ func (lr *LocationRepo) GetLocationById(ctx context.Context, id int64) (*biz.Loc
 		StopDate:   location.StopDate,
 		Top:        location.Top,
 		TopNum:     location.TopNum,
+		ProductId:  location.ProductId,
 	}, nil
 }
 
@@ -736,6 +741,7 @@ func (lr *LocationRepo) GetLocationsNewByUserId(ctx context.Context, userId int6
 			Total:         location.Total,
 			TotalTwo:      location.TotalTwo,
 			TotalThree:    location.TotalThree,
+			ProductId:     location.ProductId,
 		})
 	}
 
@@ -1097,6 +1103,34 @@ func (lr *LocationRepo) UpdateLocationNewTotalSub(ctx context.Context, id int64,
 	return nil
 }
 
+// UpdateLocationNewTotalAdd 只加业绩，不修改count
+func (lr *LocationRepo) UpdateLocationNewTotalAdd(ctx context.Context, id int64, count int64, total int64) error {
+	if 1 == count {
+		res := lr.data.DB(ctx).Table("location_new").
+			Where("id=?", id).
+			Updates(map[string]interface{}{"total": gorm.Expr("total + ?", total)})
+		if 0 == res.RowsAffected || res.Error != nil {
+			return res.Error
+		}
+	} else if 2 == count {
+		res := lr.data.DB(ctx).Table("location_new").
+			Where("id=?", id).
+			Updates(map[string]interface{}{"total_two": gorm.Expr("total_two + ?", total)})
+		if 0 == res.RowsAffected || res.Error != nil {
+			return res.Error
+		}
+	} else if 3 == count {
+		res := lr.data.DB(ctx).Table("location_new").
+			Where("id=?", id).
+			Updates(map[string]interface{}{"total_three": gorm.Expr("total_three + ?", total)})
+		if 0 == res.RowsAffected || res.Error != nil {
+			return res.Error
+		}
+	}
+
+	return nil
+}
+
 // UpdateLocationNewCurrent .
 func (lr *LocationRepo) UpdateLocationNewCurrent(ctx context.Context, id int64, current int64) error {
 
@@ -1142,6 +1176,7 @@ func (lr *LocationRepo) GetRunningLocations(ctx context.Context) ([]*biz.Locatio
 			TotalTwo:      location.TotalTwo,
 			TotalThree:    location.TotalThree,
 			LastLevel:     location.LastLevel,
+			ProductId:     location.ProductId,
 		})
 	}
 
@@ -1296,14 +1331,16 @@ func (lr *LocationRepo) GetLocations(ctx context.Context, b *biz.Pagination, use
 		locations []*LocationNew
 		count     int64
 	)
-	instance := lr.data.db.Table("location_new").Where("status=?", status)
+	instance := lr.data.db.Table("location_new")
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
 
 	if 0 < userId {
 		instance = instance.Where("user_id=?", userId)
 	}
 
-	instance = instance.Count(&count)
-	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&locations).Error; err != nil {
+	if err := Page(instance, b, &count).Find(&locations).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found"), 0
 		}
@@ -1322,6 +1359,7 @@ func (lr *LocationRepo) GetLocations(ctx context.Context, b *biz.Pagination, use
 			CreatedAt:  location.CreatedAt,
 			Num:        location.Num,
 			Usdt:       location.Usdt,
+			StopDate:   location.StopDate,
 		})
 	}
 
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type LocationEvent struct {
+	ID               int64     `gorm:"primarykey;type:int"`
+	LocationTable    string    `gorm:"type:varchar(45);not null;index:idx_location,priority:1"`
+	LocationId       int64     `gorm:"type:int;not null;index:idx_location,priority:2"`
+	UserId           int64     `gorm:"type:int;not null;index"`
+	Event            string    `gorm:"type:varchar(45);not null"`
+	Source           string    `gorm:"type:varchar(100);not null;default:''"`
+	RunId            int64     `gorm:"type:int;not null;default:0"`
+	AdminId          int64     `gorm:"type:int;not null;default:0"`
+	StatusBefore     string    `gorm:"type:varchar(45);not null;default:''"`
+	StatusAfter      string    `gorm:"type:varchar(45);not null;default:''"`
+	CurrentBefore    int64     `gorm:"type:bigint;not null"`
+	CurrentAfter     int64     `gorm:"type:bigint;not null"`
+	CurrentMaxBefore int64     `gorm:"type:bigint;not null"`
+	CurrentMaxAfter  int64     `gorm:"type:bigint;not null"`
+	Amount           int64     `gorm:"type:bigint;not null"`
+	Note             string    `gorm:"type:varchar(500);not null;default:''"`
+	CreatedAt        time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
+}
+
+type LocationEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLocationEventRepo(data *Data, logger log.Logger) biz.LocationEventRepo {
+	return &LocationEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// createLocationEvents 一条语句写入，奖励批量落库时共用
+func createLocationEvents(db *gorm.DB, events []*biz.LocationEvent) error {
+	if 0 >= len(events) {
+		return nil
+	}
+
+	now := time.Now()
+	tmpEvents := make([]*LocationEvent, 0, len(events))
+	for _, v := range events {
+		tmpEvents = append(tmpEvents, &LocationEvent{
+			LocationTable:    v.LocationTable,
+			LocationId:       v.LocationId,
+			UserId:           v.UserId,
+			Event:            v.Event,
+			Source:           v.Source,
+			RunId:            v.RunId,
+			AdminId:          v.AdminId,
+			StatusBefore:     v.StatusBefore,
+			StatusAfter:      v.StatusAfter,
+			CurrentBefore:    v.CurrentBefore,
+			CurrentAfter:     v.CurrentAfter,
+			CurrentMaxBefore: v.CurrentMaxBefore,
+			CurrentMaxAfter:  v.CurrentMaxAfter,
+			Amount:           v.Amount,
+			Note:             v.Note,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		})
+	}
+
+	if err := db.Table("location_event").CreateInBatches(&tmpEvents, len(tmpEvents)).Error; err != nil {
+		return errors.New(500, "CREATE_LOCATION_EVENT_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// CreateLocationEvents .
+func (lr *LocationEventRepo) CreateLocationEvents(ctx context.Context, events ...*biz.LocationEvent) error {
+	return createLocationEvents(lr.data.DB(ctx), events)
+}
+
+// GetLocationEvents 占位的全部事件，按发生顺序
+func (lr *LocationEventRepo) GetLocationEvents(ctx context.Context, table string, locationId int64) ([]*biz.LocationEvent, error) {
+	var events []*LocationEvent
+	res := make([]*biz.LocationEvent, 0)
+	if err := lr.data.DB(ctx).Table("location_event").
+		Where("location_table=? and location_id=?", table, locationId).
+		Order("id asc").Find(&events).Error; err != nil {
+		return nil, errors.New(500, "LOCATION EVENT ERROR", err.Error())
+	}
+
+	for _, v := range events {
+		res = append(res, &biz.LocationEvent{
+			ID:               v.ID,
+			LocationTable:    v.LocationTable,
+			LocationId:       v.LocationId,
+			UserId:           v.UserId,
+			Event:            v.Event,
+			Source:           v.Source,
+			RunId:            v.RunId,
+			AdminId:          v.AdminId,
+			StatusBefore:     v.StatusBefore,
+			StatusAfter:      v.StatusAfter,
+			CurrentBefore:    v.CurrentBefore,
+			CurrentAfter:     v.CurrentAfter,
+			CurrentMaxBefore: v.CurrentMaxBefore,
+			CurrentMaxAfter:  v.CurrentMaxAfter,
+			Amount:           v.Amount,
+			Note:             v.Note,
+			CreatedAt:        v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetLocationState 占位当前的状态和额度，不存在时为nil
+func (lr *LocationEventRepo) GetLocationState(ctx context.Context, table string, id int64) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := lr.data.DB(ctx).Table(table).
+		Select("id", "user_id", "status", "current", "current_max", "current_max_new", "stop_date").
+		Where("id=?", id).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return &biz.LocationNew{
+		ID:            location.ID,
+		UserId:        location.UserId,
+		Status:        location.Status,
+		Current:       location.Current,
+		CurrentMax:    location.CurrentMax,
+		CurrentMaxNew: location.CurrentMaxNew,
+		StopDate:      location.StopDate,
+	}, nil
+}
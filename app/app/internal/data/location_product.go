@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+type LocationProduct struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Name      string    `gorm:"type:varchar(45);not null"`
+	MinAmount int64     `gorm:"type:bigint;not null"`
+	MaxAmount int64     `gorm:"type:bigint;not null;default:0"`
+	OutRate   int64     `gorm:"type:int;not null"`
+	Policies  string    `gorm:"type:varchar(500);not null;default:''"`
+	TimeAgain int64     `gorm:"type:int;not null;default:0"`
+	Sort      int64     `gorm:"type:int;not null;default:0"`
+	Status    int64     `gorm:"type:int;not null;default:1"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LocationProductRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLocationProductRepo(data *Data, logger log.Logger) biz.LocationProductRepo {
+	return &LocationProductRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetLocationProducts 全部产品，按排序
+func (lr *LocationProductRepo) GetLocationProducts(ctx context.Context) ([]*biz.LocationProduct, error) {
+	var products []*LocationProduct
+	res := make([]*biz.LocationProduct, 0)
+	if err := lr.data.DB(ctx).Table("location_product").Order("sort asc, id asc").Find(&products).Error; err != nil {
+		return nil, errors.New(500, "LOCATION PRODUCT ERROR", err.Error())
+	}
+
+	for _, v := range products {
+		res = append(res, &biz.LocationProduct{
+			ID:        v.ID,
+			Name:      v.Name,
+			MinAmount: v.MinAmount,
+			MaxAmount: v.MaxAmount,
+			OutRate:   v.OutRate,
+			Policies:  v.Policies,
+			TimeAgain: v.TimeAgain,
+			Sort:      v.Sort,
+			Status:    v.Status,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// CreateLocationProduct .
+func (lr *LocationProductRepo) CreateLocationProduct(ctx context.Context, product *biz.LocationProduct) (*biz.LocationProduct, error) {
+	var tmp LocationProduct
+	tmp.Name = product.Name
+	tmp.MinAmount = product.MinAmount
+	tmp.MaxAmount = product.MaxAmount
+	tmp.OutRate = product.OutRate
+	tmp.Policies = product.Policies
+	tmp.TimeAgain = product.TimeAgain
+	tmp.Sort = product.Sort
+	tmp.Status = product.Status
+	if err := lr.data.DB(ctx).Table("location_product").Create(&tmp).Error; err != nil {
+		return nil, errors.New(500, "CREATE_LOCATION_PRODUCT_ERROR", "占位产品创建失败")
+	}
+
+	product.ID = tmp.ID
+	product.CreatedAt = tmp.CreatedAt
+	return product, nil
+}
+
+// UpdateLocationProduct .
+func (lr *LocationProductRepo) UpdateLocationProduct(ctx context.Context, product *biz.LocationProduct) error {
+	res := lr.data.DB(ctx).Table("location_product").Where("id=?", product.ID).
+		Updates(map[string]interface{}{
+			"name":       product.Name,
+			"min_amount": product.MinAmount,
+			"max_amount": product.MaxAmount,
+			"out_rate":   product.OutRate,
+			"policies":   product.Policies,
+			"time_again": product.TimeAgain,
+			"sort":       product.Sort,
+			"status":     product.Status,
+			"updated_at": time.Now(),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_LOCATION_PRODUCT_ERROR", "占位产品修改失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_LOCATION_PRODUCT_ERROR", "占位产品不存在")
+	}
+
+	return nil
+}
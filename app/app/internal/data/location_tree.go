@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 批量修改时每条语句的行数
+const locationTreeChunk = 500
+
+type LocationTreeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLocationTreeRepo(data *Data, logger log.Logger) biz.LocationTreeRepo {
+	return &LocationTreeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetLocationTreePath .
+func (lr *LocationTreeRepo) GetLocationTreePath(ctx context.Context, id int64) (string, error) {
+	var location LocationNew
+	if err := lr.data.DB(ctx).Table("location_new").Select("id", "path").Where("id=?", id).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+
+		return "", errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return location.Path, nil
+}
+
+// AddLocationTreeTotal 所有上级在各自的区上加业绩，一条语句，total为负时减
+func (lr *LocationTreeRepo) AddLocationTreeTotal(ctx context.Context, edges []*biz.LocationTreeEdge, total int64) error {
+	var ids []int64
+	columns := []*caseColumn{
+		{name: "total", add: true, values: map[int64]interface{}{}},
+		{name: "total_two", add: true, values: map[int64]interface{}{}},
+		{name: "total_three", add: true, values: map[int64]interface{}{}},
+	}
+	for _, v := range edges {
+		ids = append(ids, v.ID)
+		columns[v.Leg-1].values[v.ID] = total
+	}
+	if 0 >= len(ids) {
+		return nil
+	}
+
+	if _, err := bulkUpdateCase(lr.data.DB(ctx), "location_new", "id", ids, columns); nil != err {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetLocationTreeNodes 全部占位，按id升序
+func (lr *LocationTreeRepo) GetLocationTreeNodes(ctx context.Context) ([]*biz.LocationTreeNode, error) {
+	var locations []*LocationNew
+	if err := lr.data.DB(ctx).Table("location_new").
+		Select("id", "top", "top_num", "status", "usdt", "total", "total_two", "total_three", "path").
+		Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationTreeNode, 0, len(locations))
+	for _, v := range locations {
+		res = append(res, &biz.LocationTreeNode{
+			ID:         v.ID,
+			Top:        v.Top,
+			TopNum:     v.TopNum,
+			Status:     v.Status,
+			Usdt:       v.Usdt,
+			Total:      v.Total,
+			TotalTwo:   v.TotalTwo,
+			TotalThree: v.TotalThree,
+			Path:       v.Path,
+		})
+	}
+
+	return res, nil
+}
+
+// UpdateLocationTreePaths 分批写入路径
+func (lr *LocationTreeRepo) UpdateLocationTreePaths(ctx context.Context, paths map[int64]string) error {
+	var ids []int64
+	values := make(map[int64]interface{}, len(paths))
+	for id, path := range paths {
+		ids = append(ids, id)
+		values[id] = path
+	}
+
+	for i := 0; i < len(ids); i += locationTreeChunk {
+		end := i + locationTreeChunk
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		if _, err := bulkUpdateCase(lr.data.DB(ctx), "location_new", "id", ids[i:end], []*caseColumn{
+			{name: "path", values: values},
+		}); nil != err {
+			return errors.New(500, "UPDATE_LOCATION_ERROR", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// UpdateLocationTreeTotals 分批覆盖三个区的业绩
+func (lr *LocationTreeRepo) UpdateLocationTreeTotals(ctx context.Context, nodes []*biz.LocationTreeNode) error {
+	for i := 0; i < len(nodes); i += locationTreeChunk {
+		end := i + locationTreeChunk
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+
+		var ids []int64
+		total := &caseColumn{name: "total", values: map[int64]interface{}{}}
+		totalTwo := &caseColumn{name: "total_two", values: map[int64]interface{}{}}
+		totalThree := &caseColumn{name: "total_three", values: map[int64]interface{}{}}
+		for _, v := range nodes[i:end] {
+			ids = append(ids, v.ID)
+			total.values[v.ID] = v.Total
+			totalTwo.values[v.ID] = v.TotalTwo
+			totalThree.values[v.ID] = v.TotalThree
+		}
+
+		if _, err := bulkUpdateCase(lr.data.DB(ctx), "location_new", "id", ids, []*caseColumn{total, totalTwo, totalThree}); nil != err {
+			return errors.New(500, "UPDATE_LOCATION_ERROR", err.Error())
+		}
+	}
+
+	return nil
+}
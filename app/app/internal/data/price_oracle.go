@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/pkg/metrics"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// 交易对和erc20只用到的方法
+const pairABI = `[
+{"constant":true,"inputs":[],"name":"getReserves","outputs":[{"name":"_reserve0","type":"uint112"},{"name":"_reserve1","type":"uint112"},{"name":"_blockTimestampLast","type":"uint32"}],"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[],"name":"token0","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[],"name":"token1","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"}
+]`
+
+type PriceSample struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Source    string    `gorm:"type:varchar(45);not null;index:idx_source_created,priority:1"`
+	Price     float64   `gorm:"type:decimal(65,20);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null;index:idx_source_created,priority:2"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type PriceFeedRepo struct {
+	data *Data
+	log  *log.Helper
+	dial func(rpcUrl string) (bind.ContractCaller, error) // 可替换为模拟链
+}
+
+func NewPriceFeedRepo(data *Data, logger log.Logger) biz.PriceFeedRepo {
+	return &PriceFeedRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+		dial: func(rpcUrl string) (bind.ContractCaller, error) {
+			return ethclient.Dial(rpcUrl)
+		},
+	}
+}
+
+// GetPairReserves 交易对两边的币、储备量和精度
+func (p *PriceFeedRepo) GetPairReserves(ctx context.Context, rpcUrl string, pair string) (*biz.PairReserves, error) {
+	if !common.IsHexAddress(pair) {
+		return nil, errors.New(500, "PRICE PAIR ERROR", "交易对地址错误")
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(pairABI))
+	if nil != err {
+		return nil, errors.New(500, "PRICE PAIR ERROR", err.Error())
+	}
+
+	caller, err := p.dial(rpcUrl)
+	if nil != err {
+		return nil, errors.New(500, "PRICE PAIR ERROR", err.Error())
+	}
+	if closer, ok := caller.(interface{ Close() }); ok {
+		defer closer.Close()
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	call := func(address common.Address, method string) ([]interface{}, error) {
+		var out []interface{}
+		start := time.Now()
+		err := bind.NewBoundContract(address, parsed, caller, nil, nil).Call(opts, &out, method)
+		metrics.RPC(rpcUrl, method, start, err)
+		if nil != err {
+			return nil, errors.New(500, "PRICE PAIR ERROR", method+" "+err.Error())
+		}
+
+		return out, nil
+	}
+
+	pairAddress := common.HexToAddress(pair)
+	reserves, err := call(pairAddress, "getReserves")
+	if nil != err {
+		return nil, err
+	}
+	token0, err := call(pairAddress, "token0")
+	if nil != err {
+		return nil, err
+	}
+	token1, err := call(pairAddress, "token1")
+	if nil != err {
+		return nil, err
+	}
+
+	res := &biz.PairReserves{
+		Token0:   abi.ConvertType(token0[0], new(common.Address)).(*common.Address).Hex(),
+		Token1:   abi.ConvertType(token1[0], new(common.Address)).(*common.Address).Hex(),
+		Reserve0: abi.ConvertType(reserves[0], new(big.Int)).(*big.Int),
+		Reserve1: abi.ConvertType(reserves[1], new(big.Int)).(*big.Int),
+	}
+
+	decimals0, err := call(common.HexToAddress(res.Token0), "decimals")
+	if nil != err {
+		return nil, err
+	}
+	decimals1, err := call(common.HexToAddress(res.Token1), "decimals")
+	if nil != err {
+		return nil, err
+	}
+	res.Decimals0 = *abi.ConvertType(decimals0[0], new(uint8)).(*uint8)
+	res.Decimals1 = *abi.ConvertType(decimals1[0], new(uint8)).(*uint8)
+
+	return res, nil
+}
+
+// CreatePriceSample .
+func (p *PriceFeedRepo) CreatePriceSample(ctx context.Context, sample *biz.PriceSample) error {
+	now := time.Now()
+	if err := p.data.DB(ctx).Table("price_sample").Create(&PriceSample{
+		Source:    sample.Source,
+		Price:     sample.Price,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}).Error; err != nil {
+		return errors.New(500, "CREATE_PRICE_SAMPLE_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetPriceSamples from之后的报价按时间升序，包含from之前最近的一次
+func (p *PriceFeedRepo) GetPriceSamples(ctx context.Context, source string, from time.Time) ([]*biz.PriceSample, error) {
+	var (
+		samples []*PriceSample
+		before  PriceSample
+	)
+	res := make([]*biz.PriceSample, 0)
+
+	if err := p.data.DB(ctx).Table("price_sample").Where("source=? and created_at<?", source, from).Order("created_at desc, id desc").First(&before).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(500, "PRICE SAMPLE ERROR", err.Error())
+		}
+	} else {
+		before.CreatedAt = from // 只算窗口内的时长
+		res = append(res, priceSampleToBiz(&before))
+	}
+
+	if err := p.data.DB(ctx).Table("price_sample").Where("source=? and created_at>=?", source, from).Order("created_at asc, id asc").Find(&samples).Error; err != nil {
+		return nil, errors.New(500, "PRICE SAMPLE ERROR", err.Error())
+	}
+
+	for _, v := range samples {
+		res = append(res, priceSampleToBiz(v))
+	}
+
+	return res, nil
+}
+
+func priceSampleToBiz(v *PriceSample) *biz.PriceSample {
+	return &biz.PriceSample{
+		ID:        v.ID,
+		Source:    v.Source,
+		Price:     v.Price,
+		CreatedAt: v.CreatedAt,
+	}
+}
@@ -0,0 +1,146 @@
+package data
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeChain 按合约地址和方法返回编码后的结果
+type fakeChain struct {
+	parsed  abi.ABI
+	returns map[common.Address]map[string][]interface{}
+	err     error
+}
+
+func (c *fakeChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *fakeChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if nil != c.err {
+		return nil, c.err
+	}
+
+	method, err := c.parsed.MethodById(call.Data[:4])
+	if nil != err {
+		return nil, err
+	}
+	values, ok := c.returns[*call.To][method.Name]
+	if !ok {
+		return nil, errors.New(500, "ERROR", "no "+method.Name)
+	}
+
+	return method.Outputs.Pack(values...)
+}
+
+func newFakeChain(t *testing.T, pair common.Address, token0 common.Address, decimals0 uint8, reserve0 *big.Int, token1 common.Address, decimals1 uint8, reserve1 *big.Int) *fakeChain {
+	parsed, err := abi.JSON(strings.NewReader(pairABI))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return &fakeChain{
+		parsed: parsed,
+		returns: map[common.Address]map[string][]interface{}{
+			pair: {
+				"getReserves": {reserve0, reserve1, uint32(1)},
+				"token0":      {token0},
+				"token1":      {token1},
+			},
+			token0: {"decimals": {decimals0}},
+			token1: {"decimals": {decimals1}},
+		},
+	}
+}
+
+func TestGetPairReserves(t *testing.T) {
+	var (
+		pair  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		token = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+		usdt  = common.HexToAddress("0x00000000000000000000000000000000000000c2")
+	)
+
+	tests := []struct {
+		name    string
+		pair    string
+		chain   *fakeChain
+		want    [2]common.Address
+		reserve [2]int64
+		decimal [2]uint8
+		wantErr bool
+	}{
+		{
+			name:    "token在token0",
+			pair:    pair.Hex(),
+			chain:   newFakeChain(t, pair, token, 18, big.NewInt(1000), usdt, 6, big.NewInt(500)),
+			want:    [2]common.Address{token, usdt},
+			reserve: [2]int64{1000, 500},
+			decimal: [2]uint8{18, 6},
+		},
+		{
+			name:    "token在token1，精度跟着各自的币",
+			pair:    pair.Hex(),
+			chain:   newFakeChain(t, pair, usdt, 6, big.NewInt(500), token, 18, big.NewInt(1000)),
+			want:    [2]common.Address{usdt, token},
+			reserve: [2]int64{500, 1000},
+			decimal: [2]uint8{6, 18},
+		},
+		{
+			name:    "交易对地址错误",
+			pair:    "pair",
+			chain:   newFakeChain(t, pair, token, 18, big.NewInt(1), usdt, 6, big.NewInt(1)),
+			wantErr: true,
+		},
+		{
+			name: "节点调用失败",
+			pair: pair.Hex(),
+			chain: func() *fakeChain {
+				c := newFakeChain(t, pair, token, 18, big.NewInt(1), usdt, 6, big.NewInt(1))
+				c.err = errors.New(500, "ERROR", "rpc")
+				return c
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &PriceFeedRepo{
+				log: log.NewHelper(log.DefaultLogger),
+				dial: func(rpcUrl string) (bind.ContractCaller, error) {
+					return tt.chain, nil
+				},
+			}
+
+			res, err := repo.GetPairReserves(context.Background(), "fake", tt.pair)
+			if tt.wantErr {
+				if nil == err {
+					t.Fatal("want error")
+				}
+				return
+			}
+			if nil != err {
+				t.Fatal(err)
+			}
+
+			if tt.want[0].Hex() != res.Token0 || tt.want[1].Hex() != res.Token1 {
+				t.Fatalf("tokens = %s %s", res.Token0, res.Token1)
+			}
+			if 0 != big.NewInt(tt.reserve[0]).Cmp(res.Reserve0) || 0 != big.NewInt(tt.reserve[1]).Cmp(res.Reserve1) {
+				t.Fatalf("reserves = %s %s", res.Reserve0, res.Reserve1)
+			}
+			if tt.decimal[0] != res.Decimals0 || tt.decimal[1] != res.Decimals1 {
+				t.Fatalf("decimals = %d %d", res.Decimals0, res.Decimals1)
+			}
+		})
+	}
+}
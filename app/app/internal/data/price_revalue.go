@@ -0,0 +1,184 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type PriceRevalueRun struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	PriceChangeId int64     `gorm:"type:int;not null;uniqueIndex:uk_price_change"`
+	Status        string    `gorm:"type:varchar(45);not null"`
+	LastUserId    int64     `gorm:"type:int;not null;default:0"`
+	Users         int64     `gorm:"type:int;not null;default:0"`
+	Up            int64     `gorm:"type:int;not null;default:0"`
+	Down          int64     `gorm:"type:int;not null;default:0"`
+	Stopped       int64     `gorm:"type:int;not null;default:0"`
+	Amount        int64     `gorm:"type:bigint;not null;default:0"`
+	Failed        int64     `gorm:"type:int;not null;default:0"`
+	Error         string    `gorm:"type:varchar(500);not null;default:''"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type PriceRevalueItem struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	RunId      int64     `gorm:"type:int;not null;uniqueIndex:uk_run_user"`
+	UserId     int64     `gorm:"type:int;not null;uniqueIndex:uk_run_user"`
+	LocationId int64     `gorm:"type:int;not null;default:0"`
+	Direction  string    `gorm:"type:varchar(45);not null;default:''"`
+	Amount     int64     `gorm:"type:bigint;not null;default:0"`
+	Stopped    bool      `gorm:"type:tinyint(1);not null;default:0"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	Error      string    `gorm:"type:varchar(500);not null;default:''"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type PriceRevalueRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPriceRevalueRepo(data *Data, logger log.Logger) biz.PriceRevalueRepo {
+	return &PriceRevalueRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func priceRevalueRunToBiz(run *PriceRevalueRun) *biz.PriceRevalueRun {
+	return &biz.PriceRevalueRun{
+		ID:            run.ID,
+		PriceChangeId: run.PriceChangeId,
+		Status:        run.Status,
+		LastUserId:    run.LastUserId,
+		Users:         run.Users,
+		Up:            run.Up,
+		Down:          run.Down,
+		Stopped:       run.Stopped,
+		Amount:        run.Amount,
+		Failed:        run.Failed,
+		Error:         run.Error,
+		CreatedAt:     run.CreatedAt,
+		UpdatedAt:     run.UpdatedAt,
+	}
+}
+
+// CreatePriceRevalueRun 同一价格变动只能创建一次
+func (p *PriceRevalueRepo) CreatePriceRevalueRun(ctx context.Context, priceChangeId int64) (*biz.PriceRevalueRun, error) {
+	var run PriceRevalueRun
+	run.PriceChangeId = priceChangeId
+	run.Status = biz.PriceRevalueRunning
+	if err := p.data.DB(ctx).Table("price_revalue_run").Create(&run).Error; err != nil {
+		return nil, errors.New(500, "CREATE_PRICE_REVALUE_RUN_ERROR", err.Error())
+	}
+
+	return priceRevalueRunToBiz(&run), nil
+}
+
+// GetPriceRevalueRun 不存在时为nil
+func (p *PriceRevalueRepo) GetPriceRevalueRun(ctx context.Context, priceChangeId int64) (*biz.PriceRevalueRun, error) {
+	var run PriceRevalueRun
+	if err := p.data.DB(ctx).Table("price_revalue_run").Where("price_change_id=?", priceChangeId).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "PRICE REVALUE RUN ERROR", err.Error())
+	}
+
+	return priceRevalueRunToBiz(&run), nil
+}
+
+// UpdatePriceRevalueRun 只从指定状态修改状态和汇总，防止两个进程同时接管同一次运行
+func (p *PriceRevalueRepo) UpdatePriceRevalueRun(ctx context.Context, run *biz.PriceRevalueRun, from string) (bool, error) {
+	res := p.data.DB(ctx).Table("price_revalue_run").
+		Where("id=? and status=?", run.ID, from).
+		Updates(map[string]interface{}{
+			"status":       run.Status,
+			"last_user_id": run.LastUserId,
+			"users":        run.Users,
+			"up":           run.Up,
+			"down":         run.Down,
+			"stopped":      run.Stopped,
+			"amount":       run.Amount,
+			"failed":       run.Failed,
+			"error":        run.Error,
+			"updated_at":   time.Now(),
+		})
+	if nil != res.Error {
+		return false, errors.New(500, "UPDATE_PRICE_REVALUE_RUN_ERROR", res.Error.Error())
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// UpdatePriceRevalueCursor 进度只前进
+func (p *PriceRevalueRepo) UpdatePriceRevalueCursor(ctx context.Context, id int64, cursor int64) error {
+	if err := p.data.DB(ctx).Table("price_revalue_run").
+		Where("id=? and last_user_id<?", id, cursor).
+		Updates(map[string]interface{}{"last_user_id": cursor, "updated_at": time.Now()}).Error; err != nil {
+		return errors.New(500, "UPDATE_PRICE_REVALUE_RUN_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// SavePriceRevalueItem run_id+user_id唯一，重试时覆盖上次的结果
+func (p *PriceRevalueRepo) SavePriceRevalueItem(ctx context.Context, item *biz.PriceRevalueItem) error {
+	now := time.Now()
+	if err := p.data.DB(ctx).Table("price_revalue_item").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "run_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"location_id", "direction", "amount", "stopped", "status", "error", "updated_at"}),
+		}).
+		Create(&PriceRevalueItem{
+			RunId:      item.RunId,
+			UserId:     item.UserId,
+			LocationId: item.LocationId,
+			Direction:  item.Direction,
+			Amount:     item.Amount,
+			Stopped:    item.Stopped,
+			Status:     item.Status,
+			Error:      item.Error,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}).Error; err != nil {
+		return errors.New(500, "SAVE_PRICE_REVALUE_ITEM_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetPriceRevalueItems 按用户id升序
+func (p *PriceRevalueRepo) GetPriceRevalueItems(ctx context.Context, runId int64) ([]*biz.PriceRevalueItem, error) {
+	var items []*PriceRevalueItem
+	res := make([]*biz.PriceRevalueItem, 0)
+	if err := p.data.DB(ctx).Table("price_revalue_item").Where("run_id=?", runId).Order("user_id asc").Find(&items).Error; err != nil {
+		return nil, errors.New(500, "PRICE REVALUE ITEM ERROR", err.Error())
+	}
+
+	for _, v := range items {
+		res = append(res, &biz.PriceRevalueItem{
+			ID:         v.ID,
+			RunId:      v.RunId,
+			UserId:     v.UserId,
+			LocationId: v.LocationId,
+			Direction:  v.Direction,
+			Amount:     v.Amount,
+			Stopped:    v.Stopped,
+			Status:     v.Status,
+			Error:      v.Error,
+			CreatedAt:  v.CreatedAt,
+			UpdatedAt:  v.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
@@ -10,17 +10,21 @@ import (
 )
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Status    string    `gorm:"type:varchar(45);not null"`
-	Type      string    `gorm:"type:varchar(45);not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	AmountTwo uint64    `gorm:"type:bigint;not null"`
-	CoinType  string    `gorm:"type:varchar(45);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
-	Last      int64     `gorm:"type:int;not null"`
+	ID          int64     `gorm:"primarykey;type:int"`
+	Hash        string    `gorm:"type:varchar(100);not null"`
+	UserId      int64     `gorm:"type:int;not null"`
+	Status      string    `gorm:"type:varchar(45);not null"`
+	Type        string    `gorm:"type:varchar(45);not null"`
+	Amount      string    `gorm:"type:varchar(45);not null"`
+	AmountTwo   uint64    `gorm:"type:bigint;not null"`
+	CoinType    string    `gorm:"type:varchar(45);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+	Last        int64     `gorm:"type:int;not null"`
+	Source      string    `gorm:"type:varchar(45);not null;default:'chain'"`
+	AdminId     int64     `gorm:"type:int;not null;default:0"`
+	Note        string    `gorm:"type:varchar(500);not null;default:''"`
+	ExternalRef *string   `gorm:"type:varchar(100);uniqueIndex"` // 补单的外部单号、合约购买的序号，链上入金为NULL
 }
 
 type EthUserRecordRepo struct {
@@ -123,6 +127,15 @@ func (e *EthUserRecordRepo) CreateEthUserRecordListByHash(ctx context.Context, r
 	ethUserRecord.AmountTwo = r.AmountTwo
 	ethUserRecord.CoinType = r.CoinType
 	ethUserRecord.Last = r.Last
+	ethUserRecord.Source = r.Source
+	if "" == ethUserRecord.Source {
+		ethUserRecord.Source = biz.EthUserRecordSourceChain
+	}
+	ethUserRecord.AdminId = r.AdminId
+	ethUserRecord.Note = r.Note
+	if "" != r.ExternalRef {
+		ethUserRecord.ExternalRef = &r.ExternalRef
+	}
 
 	res := e.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
 	if res.Error != nil {
@@ -0,0 +1,665 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type RewardRun struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	Job          string    `gorm:"type:varchar(45);not null;uniqueIndex:uk_job_date"`
+	BusinessDate string    `gorm:"type:varchar(10);not null;uniqueIndex:uk_job_date"`
+	Status       string    `gorm:"type:varchar(45);not null"`
+	Count        int64     `gorm:"type:int;not null"`
+	Amount       int64     `gorm:"type:bigint;not null"`
+	Error        string    `gorm:"type:varchar(500);not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type RewardRunItem struct {
+	ID            int64      `gorm:"primarykey;type:int"`
+	RunId         int64      `gorm:"type:int;not null;uniqueIndex:uk_run_key"`
+	ItemKey       string     `gorm:"type:varchar(100);not null;uniqueIndex:uk_run_key"`
+	Kind          string     `gorm:"type:varchar(45);not null"`
+	UserId        int64      `gorm:"type:int;not null"`
+	LocationId    int64      `gorm:"type:int;not null"`
+	LocationTable string     `gorm:"type:varchar(45);not null"`
+	Num           int64      `gorm:"type:int;not null"`
+	Amount        int64      `gorm:"type:bigint;not null"`
+	AmountFloat   float64    `gorm:"type:decimal(65,20);not null"`
+	Charge        int64      `gorm:"type:bigint;not null"`
+	Paid          int64      `gorm:"type:bigint;not null"`
+	AmountB       int64      `gorm:"type:bigint;not null"`
+	Usdt          int64      `gorm:"type:bigint;not null"`
+	Coin          int64      `gorm:"type:bigint;not null"`
+	MaxNew        int64      `gorm:"type:bigint;not null"`
+	PrevStatus    string     `gorm:"type:varchar(45);not null"`
+	Status        string     `gorm:"type:varchar(45);not null"`
+	RecordId      int64      `gorm:"type:int;not null;default:0"`
+	PrevDate      *time.Time `gorm:"type:datetime"` // 余额分红的上次发放日期，其他奖励为NULL
+	CreatedAt     time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time  `gorm:"type:datetime;not null"`
+}
+
+type RewardRunRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRewardRunRepo(data *Data, logger log.Logger) biz.RewardRunRepo {
+	return &RewardRunRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func rewardRunToBiz(run *RewardRun) *biz.RewardRun {
+	return &biz.RewardRun{
+		ID:           run.ID,
+		Job:          run.Job,
+		BusinessDate: run.BusinessDate,
+		Status:       run.Status,
+		Count:        run.Count,
+		Amount:       run.Amount,
+		Error:        run.Error,
+		CreatedAt:    run.CreatedAt,
+		UpdatedAt:    run.UpdatedAt,
+	}
+}
+
+// CreateRewardRun 同一任务同一业务日只能创建一次
+func (r *RewardRunRepo) CreateRewardRun(ctx context.Context, job string, businessDate string) (*biz.RewardRun, error) {
+	var run RewardRun
+	run.Job = job
+	run.BusinessDate = businessDate
+	run.Status = biz.RewardRunRunning
+	if err := r.data.DB(ctx).Table("reward_run").Create(&run).Error; err != nil {
+		return nil, errors.New(500, "CREATE_REWARD_RUN_ERROR", err.Error())
+	}
+
+	return rewardRunToBiz(&run), nil
+}
+
+// GetRewardRun .
+func (r *RewardRunRepo) GetRewardRun(ctx context.Context, job string, businessDate string) (*biz.RewardRun, error) {
+	var run RewardRun
+	if err := r.data.DB(ctx).Table("reward_run").Where("job=? and business_date=?", job, businessDate).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "REWARD RUN ERROR", err.Error())
+	}
+
+	return rewardRunToBiz(&run), nil
+}
+
+// UpdateRewardRunStatus 只从指定状态切换，防止两个进程同时接管同一次运行
+func (r *RewardRunRepo) UpdateRewardRunStatus(ctx context.Context, id int64, from string, status string, errMsg string) (bool, error) {
+	if 500 < len(errMsg) {
+		errMsg = errMsg[:500]
+	}
+
+	res := r.data.DB(ctx).Table("reward_run").
+		Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{"status": status, "error": errMsg, "updated_at": time.Now()})
+	if nil != res.Error {
+		return false, errors.New(500, "UPDATE_REWARD_RUN_ERROR", res.Error.Error())
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// AddRewardRunTotal .
+func (r *RewardRunRepo) AddRewardRunTotal(ctx context.Context, id int64, count int64, amount int64) error {
+	res := r.data.DB(ctx).Table("reward_run").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"count": gorm.Expr("count + ?", count), "amount": gorm.Expr("amount + ?", amount), "updated_at": time.Now()})
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_REWARD_RUN_ERROR", "奖励运行记录修改失败")
+	}
+
+	return nil
+}
+
+// CreateRewardRunItem 与发放在同一事务中写入，run_id+item_key唯一保证不重复发放
+func (r *RewardRunRepo) CreateRewardRunItem(ctx context.Context, item *biz.RewardRunItem) error {
+	var runItem RewardRunItem
+	runItem.RunId = item.RunId
+	runItem.ItemKey = item.ItemKey
+	runItem.Kind = item.Kind
+	runItem.UserId = item.UserId
+	runItem.LocationId = item.LocationId
+	runItem.LocationTable = item.LocationTable
+	runItem.Num = item.Num
+	runItem.Amount = item.Amount
+	runItem.AmountFloat = item.AmountFloat
+	runItem.Charge = item.Charge
+	runItem.Paid = item.Paid
+	runItem.AmountB = item.AmountB
+	runItem.Usdt = item.Usdt
+	runItem.Coin = item.Coin
+	runItem.MaxNew = item.MaxNew
+	runItem.PrevStatus = item.PrevStatus
+	runItem.Status = item.Status
+	runItem.RecordId = item.RecordId
+	runItem.PrevDate = nullTime(item.PrevDate)
+	if err := r.data.DB(ctx).Table("reward_run_item").Create(&runItem).Error; err != nil {
+		return errors.New(500, "CREATE_REWARD_RUN_ITEM_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetRewardRunItemKeys 已发放的条目
+func (r *RewardRunRepo) GetRewardRunItemKeys(ctx context.Context, runId int64) (map[string]bool, error) {
+	var keys []string
+	if err := r.data.DB(ctx).Table("reward_run_item").Where("run_id=?", runId).Pluck("item_key", &keys).Error; err != nil {
+		return nil, errors.New(500, "REWARD RUN ITEM ERROR", err.Error())
+	}
+
+	res := make(map[string]bool, len(keys))
+	for _, v := range keys {
+		res[v] = true
+	}
+
+	return res, nil
+}
+
+func rewardRunItemToBiz(item *RewardRunItem) *biz.RewardRunItem {
+	res := &biz.RewardRunItem{
+		ID:            item.ID,
+		RunId:         item.RunId,
+		ItemKey:       item.ItemKey,
+		Kind:          item.Kind,
+		UserId:        item.UserId,
+		LocationId:    item.LocationId,
+		LocationTable: item.LocationTable,
+		Num:           item.Num,
+		Amount:        item.Amount,
+		AmountFloat:   item.AmountFloat,
+		Charge:        item.Charge,
+		Paid:          item.Paid,
+		AmountB:       item.AmountB,
+		Usdt:          item.Usdt,
+		Coin:          item.Coin,
+		MaxNew:        item.MaxNew,
+		PrevStatus:    item.PrevStatus,
+		Status:        item.Status,
+		RecordId:      item.RecordId,
+		CreatedAt:     item.CreatedAt,
+	}
+	if nil != item.PrevDate {
+		res.PrevDate = *item.PrevDate
+	}
+
+	return res
+}
+
+// nullTime 零值存为NULL
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// GetRewardRunById .
+func (r *RewardRunRepo) GetRewardRunById(ctx context.Context, id int64) (*biz.RewardRun, error) {
+	var run RewardRun
+	if err := r.data.DB(ctx).Table("reward_run").Where("id=?", id).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "REWARD RUN ERROR", err.Error())
+	}
+
+	return rewardRunToBiz(&run), nil
+}
+
+// GetRewardRuns 业务日区间内的运行，job为空时不限任务
+func (r *RewardRunRepo) GetRewardRuns(ctx context.Context, job string, startDate string, endDate string) ([]*biz.RewardRun, error) {
+	var runs []*RewardRun
+	instance := r.data.DB(ctx).Table("reward_run").Where("business_date>=? and business_date<=?", startDate, endDate)
+	if "" != job {
+		instance = instance.Where("job=?", job)
+	}
+
+	if err := instance.Order("id asc").Find(&runs).Error; err != nil {
+		return nil, errors.New(500, "REWARD RUN ERROR", err.Error())
+	}
+
+	res := make([]*biz.RewardRun, 0)
+	for _, v := range runs {
+		res = append(res, rewardRunToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetRewardRunItems .
+func (r *RewardRunRepo) GetRewardRunItems(ctx context.Context, runId int64) ([]*biz.RewardRunItem, error) {
+	var items []*RewardRunItem
+	if err := r.data.DB(ctx).Table("reward_run_item").Where("run_id=?", runId).Order("id asc").Find(&items).Error; err != nil {
+		return nil, errors.New(500, "REWARD RUN ITEM ERROR", err.Error())
+	}
+
+	res := make([]*biz.RewardRunItem, 0)
+	for _, v := range items {
+		res = append(res, rewardRunItemToBiz(v))
+	}
+
+	return res, nil
+}
+
+// GetRewardRunExchanges 运行中出局兑换给用户的usdt
+func (r *RewardRunRepo) GetRewardRunExchanges(ctx context.Context, runId int64) (map[int64]int64, error) {
+	var rewards []*Reward
+	if err := r.data.DB(ctx).Table("reward").Where("run_id=? and type=?", runId, "exchange_system").Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	res := make(map[int64]int64, 0)
+	for _, v := range rewards {
+		res[v.UserId] += v.AmountB
+	}
+
+	return res, nil
+}
+
+// revertColumn 扣回不为0的变动，为0时不更新，否则影响行数为0会被当作回滚失败
+func revertColumn(updates map[string]interface{}, column string, amount int64) {
+	if 0 == amount {
+		return
+	}
+
+	updates[column] = gorm.Expr(column+" - ?", amount)
+}
+
+// RevertRewardRunLocation 撤回占位额度，本次出局的占位重新运行
+func (r *RewardRunRepo) RevertRewardRunLocation(ctx context.Context, item *biz.RewardRunItem) error {
+	if 0 >= item.LocationId {
+		return nil
+	}
+
+	updates := map[string]interface{}{}
+	switch item.Kind {
+	case biz.RewardKindLocation, biz.RewardKindRecommend, biz.RewardKindArea:
+		revertColumn(updates, "current", item.Paid)
+		revertColumn(updates, "biw", item.AmountB)
+		if "stop" == item.Status && "running" == item.PrevStatus {
+			revertColumn(updates, "current_max_new", item.MaxNew)
+		}
+	default:
+		revertColumn(updates, "current", item.Charge)
+	}
+
+	if "stop" == item.Status && "running" == item.PrevStatus {
+		updates["status"] = "running"
+	}
+
+	if 0 >= len(updates) {
+		return nil
+	}
+
+	res := r.data.DB(ctx).Table(item.LocationTable).Where("id=?", item.LocationId).Updates(updates)
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "占位信息回滚失败")
+	}
+
+	return nil
+}
+
+// RevertRewardRunBalance 按发放时的入账规则扣回余额，exchanged为true时这笔dhb已在出局兑换中清零，不再扣dhb
+func (r *RewardRunRepo) RevertRewardRunBalance(ctx context.Context, item *biz.RewardRunItem, exchanged bool) error {
+	updates := map[string]interface{}{}
+	switch item.Kind {
+	case biz.RewardKindLocation, biz.RewardKindRecommend, biz.RewardKindArea:
+		total := "location_total"
+		if biz.RewardKindRecommend == item.Kind {
+			total = "recommend_total"
+		} else if biz.RewardKindArea == item.Kind {
+			total = "area_total"
+		}
+		revertColumn(updates, total, item.AmountB)
+		if !exchanged {
+			revertColumn(updates, "balance_dhb", item.AmountB)
+		}
+	case biz.RewardKindBalance, biz.RewardKindRecommendArea:
+		if "running" == item.PrevStatus {
+			revertColumn(updates, "balance_usdt", item.Usdt)
+			if !exchanged {
+				revertColumn(updates, "balance_dhb", item.Coin)
+			}
+		}
+	case biz.RewardKindVipLocation:
+		if "running" == item.PrevStatus {
+			amount := item.Amount
+			if "stop" == item.Status {
+				amount = item.Paid
+			}
+			revertColumn(updates, "balance_usdt_new", amount)
+		}
+	case biz.RewardKindVip:
+		revertColumn(updates, "balance_usdt_new", item.Amount)
+	case biz.RewardKindFour:
+		revertColumn(updates, "balance_usdt", item.Amount)
+		revertColumn(updates, "four_total", item.Amount)
+	case biz.RewardKindFirst, biz.RewardKindSecond:
+		if 0 != item.AmountFloat && !exchanged {
+			updates["balance_dhb"] = gorm.Expr("balance_dhb - ?", item.AmountFloat)
+		}
+	}
+
+	if 0 >= len(updates) {
+		return nil
+	}
+
+	res := r.data.DB(ctx).Table("user_balance").Where("user_id=?", item.UserId).Updates(updates)
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_USER_BALANCE_ERROR", "用户余额回滚失败")
+	}
+
+	return nil
+}
+
+// RevertRewardRunExchange 扣回出局兑换的usdt
+func (r *RewardRunRepo) RevertRewardRunExchange(ctx context.Context, userId int64, amount int64) error {
+	if 0 == amount {
+		return nil
+	}
+
+	res := r.data.DB(ctx).Table("user_balance").Where("user_id=?", userId).
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)})
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_USER_BALANCE_ERROR", "用户余额回滚失败")
+	}
+
+	return nil
+}
+
+// RevertBalanceRewardDate 恢复余额分红的上次发放日期
+func (r *RewardRunRepo) RevertBalanceRewardDate(ctx context.Context, id int64, date time.Time) error {
+	res := r.data.DB(ctx).Table("balance_reward").Where("id=?", id).
+		Updates(map[string]interface{}{"last_reward_date": date.UTC()})
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_BALANCE_REWARD_ERROR", "余额分红发放日期恢复失败")
+	}
+
+	return nil
+}
+
+// DeleteRewardRunRecords 删除运行产生的奖励和余额记录
+func (r *RewardRunRepo) DeleteRewardRunRecords(ctx context.Context, runId int64) (int64, int64, error) {
+	rewards := r.data.DB(ctx).Table("reward").Where("run_id=?", runId).Delete(&Reward{})
+	if nil != rewards.Error {
+		return 0, 0, errors.New(500, "DELETE_REWARD_ERROR", rewards.Error.Error())
+	}
+
+	records := r.data.DB(ctx).Table("user_balance_record").Where("run_id=?", runId).Delete(&UserBalanceRecord{})
+	if nil != records.Error {
+		return 0, 0, errors.New(500, "DELETE_USER_BALANCE_RECORD_ERROR", records.Error.Error())
+	}
+
+	return rewards.RowsAffected, records.RowsAffected, nil
+}
+
+// RollbackRewardRun 清空发放条目，运行标记为已回滚，同一业务日可重新发放
+func (r *RewardRunRepo) RollbackRewardRun(ctx context.Context, id int64, from string, reason string) error {
+	if err := r.data.DB(ctx).Table("reward_run_item").Where("run_id=?", id).Delete(&RewardRunItem{}).Error; err != nil {
+		return errors.New(500, "DELETE_REWARD_RUN_ITEM_ERROR", err.Error())
+	}
+
+	if 500 < len(reason) {
+		reason = reason[:500]
+	}
+
+	res := r.data.DB(ctx).Table("reward_run").
+		Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{"status": biz.RewardRunRolledBack, "count": 0, "amount": 0, "error": reason, "updated_at": time.Now()})
+	if 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "UPDATE_REWARD_RUN_ERROR", "奖励运行记录修改失败")
+	}
+
+	return nil
+}
+
+// LockRewardBalances 锁定本批用户的余额，事务内使用
+func (r *RewardRunRepo) LockRewardBalances(ctx context.Context, userIds ...int64) (map[int64]*biz.RewardBalance, error) {
+	var userBalances []*UserBalance
+	res := make(map[int64]*biz.RewardBalance, 0)
+	if 0 >= len(userIds) {
+		return res, nil
+	}
+
+	if err := r.data.DB(ctx).Table("user_balance").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id IN (?)", userIds).
+		Find(&userBalances).Error; err != nil {
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	for _, v := range userBalances {
+		res[v.UserId] = &biz.RewardBalance{
+			UserId:         v.UserId,
+			BalanceUsdt:    v.BalanceUsdt,
+			BalanceUsdtNew: v.BalanceUsdtNew,
+			BalanceDhb:     v.BalanceDhb,
+		}
+	}
+
+	return res, nil
+}
+
+// ApplyRewardBatch 批量写入一批发放，返回执行的语句数
+func (r *RewardRunRepo) ApplyRewardBatch(ctx context.Context, batch *biz.RewardBatch) (int64, error) {
+	var (
+		statements int64
+		tmp        int64
+		err        error
+	)
+
+	db := r.data.DB(ctx)
+	now := time.Now()
+
+	// 占位
+	tables := make(map[string][]*biz.RewardLocationDelta, 0)
+	var tableNames []string
+	for _, v := range batch.Locations {
+		if _, ok := tables[v.Table]; !ok {
+			tableNames = append(tableNames, v.Table)
+		}
+		tables[v.Table] = append(tables[v.Table], v)
+	}
+	for _, table := range tableNames {
+		var ids []int64
+		current := &caseColumn{name: "current", add: true, values: map[int64]interface{}{}}
+		biw := &caseColumn{name: "biw", add: true, values: map[int64]interface{}{}}
+		currentMaxNew := &caseColumn{name: "current_max_new", add: true, values: map[int64]interface{}{}}
+		status := &caseColumn{name: "status", values: map[int64]interface{}{}}
+		stopDate := &caseColumn{name: "stop_date", values: map[int64]interface{}{}}
+		for _, v := range tables[table] {
+			ids = append(ids, v.ID)
+			if 0 != v.Current {
+				current.values[v.ID] = v.Current
+			}
+			if 0 != v.Biw {
+				biw.values[v.ID] = v.Biw
+			}
+			if 0 != v.CurrentMaxNew {
+				currentMaxNew.values[v.ID] = v.CurrentMaxNew
+			}
+			if v.Stop {
+				status.values[v.ID] = "stop"
+				stopDate.values[v.ID] = v.StopDate
+			}
+		}
+
+		tmp, err = bulkUpdateCase(db, table, "id", ids, []*caseColumn{current, biw, currentMaxNew, status, stopDate})
+		if nil != err {
+			return statements, errors.New(500, "UPDATE_LOCATION_ERROR", err.Error())
+		}
+		statements += tmp
+	}
+
+	// 余额
+	if 0 < len(batch.Balances) {
+		var userIds []int64
+		balanceUsdt := &caseColumn{name: "balance_usdt", add: true, values: map[int64]interface{}{}}
+		balanceUsdtNew := &caseColumn{name: "balance_usdt_new", add: true, values: map[int64]interface{}{}}
+		balanceDhb := &caseColumn{name: "balance_dhb", add: true, values: map[int64]interface{}{}}
+		locationTotal := &caseColumn{name: "location_total", add: true, values: map[int64]interface{}{}}
+		recommendTotal := &caseColumn{name: "recommend_total", add: true, values: map[int64]interface{}{}}
+		areaTotal := &caseColumn{name: "area_total", add: true, values: map[int64]interface{}{}}
+		fourTotal := &caseColumn{name: "four_total", add: true, values: map[int64]interface{}{}}
+		set := func(column *caseColumn, userId int64, amount int64) {
+			if 0 != amount {
+				column.values[userId] = amount
+			}
+		}
+		for _, v := range batch.Balances {
+			userIds = append(userIds, v.UserId)
+			set(balanceUsdt, v.UserId, v.BalanceUsdt)
+			set(balanceUsdtNew, v.UserId, v.BalanceUsdtNew)
+			set(locationTotal, v.UserId, v.LocationTotal)
+			set(recommendTotal, v.UserId, v.RecommendTotal)
+			set(areaTotal, v.UserId, v.AreaTotal)
+			set(fourTotal, v.UserId, v.FourTotal)
+			if 0 != v.BalanceDhb {
+				balanceDhb.values[v.UserId] = v.BalanceDhb
+			}
+		}
+
+		tmp, err = bulkUpdateCase(db, "user_balance", "user_id", userIds, []*caseColumn{balanceUsdt, balanceUsdtNew, balanceDhb, locationTotal, recommendTotal, areaTotal, fourTotal})
+		if nil != err {
+			return statements, errors.New(500, "UPDATE_USER_BALANCE_ERROR", err.Error())
+		}
+		statements += tmp
+	}
+
+	// 余额记录，批量插入后回填id再写奖励记录
+	records := make([]*UserBalanceRecord, 0)
+	recordIndex := make(map[int]int, 0)
+	for i, v := range batch.Ledgers {
+		if !v.HasRecord {
+			continue
+		}
+
+		recordIndex[i] = len(records)
+		records = append(records, &UserBalanceRecord{
+			UserId:    v.UserId,
+			Balance:   v.RecordBalance,
+			Amount:    v.RecordAmount,
+			Type:      v.RecordType,
+			CoinType:  v.RecordCoinType,
+			RunId:     batch.RunId,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	if 0 < len(records) {
+		if err = db.Table("user_balance_record").CreateInBatches(&records, len(records)).Error; err != nil {
+			return statements, errors.New(500, "CREATE_USER_BALANCE_RECORD_ERROR", err.Error())
+		}
+		statements++
+	}
+
+	rewards := make([]*Reward, 0, len(batch.Ledgers))
+	for i, v := range batch.Ledgers {
+		reward := &Reward{
+			UserId:           v.UserId,
+			Amount:           v.Amount,
+			AmountB:          v.AmountB,
+			AmountNew:        v.AmountNew,
+			Type:             v.Type,
+			TypeRecordId:     v.TypeRecordId,
+			Reason:           v.Reason,
+			ReasonLocationId: v.ReasonLocationId,
+			RunId:            batch.RunId,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+		if j, ok := recordIndex[i]; ok {
+			if v.RecordAsType {
+				reward.TypeRecordId = records[j].ID
+			} else {
+				reward.BalanceRecordId = records[j].ID
+			}
+		}
+		rewards = append(rewards, reward)
+	}
+	if 0 < len(rewards) {
+		if err = db.Table("reward").CreateInBatches(&rewards, len(rewards)).Error; err != nil {
+			return statements, errors.New(500, "CREATE_REWARD_ERROR", err.Error())
+		}
+		statements++
+	}
+
+	// 余额分红上次发放日期
+	if 0 < len(batch.BalanceRewardIds) {
+		if err = db.Table("balance_reward").Where("id IN (?)", batch.BalanceRewardIds).
+			Updates(map[string]interface{}{"last_reward_date": now.UTC()}).Error; err != nil {
+			return statements, errors.New(500, "UPDATE_BALANCE_REWARD_ERROR", err.Error())
+		}
+		statements++
+	}
+
+	// 占位变动事件
+	if 0 < len(batch.Events) {
+		if err = createLocationEvents(db, batch.Events); nil != err {
+			return statements, err
+		}
+		statements++
+	}
+
+	// 发放条目，run_id+item_key唯一保证不重复发放
+	if 0 < len(batch.Items) {
+		items := make([]*RewardRunItem, 0, len(batch.Items))
+		for _, item := range batch.Items {
+			items = append(items, &RewardRunItem{
+				RunId:         item.RunId,
+				ItemKey:       item.ItemKey,
+				Kind:          item.Kind,
+				UserId:        item.UserId,
+				LocationId:    item.LocationId,
+				LocationTable: item.LocationTable,
+				Num:           item.Num,
+				Amount:        item.Amount,
+				AmountFloat:   item.AmountFloat,
+				Charge:        item.Charge,
+				Paid:          item.Paid,
+				AmountB:       item.AmountB,
+				Usdt:          item.Usdt,
+				Coin:          item.Coin,
+				MaxNew:        item.MaxNew,
+				PrevStatus:    item.PrevStatus,
+				Status:        item.Status,
+				RecordId:      item.RecordId,
+				PrevDate:      nullTime(item.PrevDate),
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			})
+		}
+
+		if err = db.Table("reward_run_item").CreateInBatches(&items, len(items)).Error; err != nil {
+			return statements, errors.New(500, "CREATE_REWARD_RUN_ITEM_ERROR", err.Error())
+		}
+		statements++
+
+		err = r.AddRewardRunTotal(ctx, batch.RunId, batch.Count, batch.Amount)
+		if nil != err {
+			return statements, err
+		}
+		statements++
+	}
+
+	return statements, nil
+}
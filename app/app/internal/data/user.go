@@ -11,26 +11,27 @@ import (
 )
 
 type User struct {
-	ID         int64     `gorm:"primarykey;type:int"`
-	Address    string    `gorm:"type:varchar(100)"`
-	AddressTwo string    `gorm:"type:varchar(100)"`
-	PrivateKey string    `gorm:"type:varchar(200)"`
-	Password   string    `gorm:"type:varchar(100)"`
-	Last       uint64    `gorm:"type:bigint;not null"`
-	Total      uint64    `gorm:"type:bigint;not null"`
-	TotalA     int64     `gorm:"type:int;not null"`
-	TotalB     int64     `gorm:"type:int;not null"`
-	TotalC     int64     `gorm:"type:int;not null"`
-	TotalD     int64     `gorm:"type:int;not null"`
-	TotalF     int64     `gorm:"type:int;not null"`
-	TotalG     int64     `gorm:"type:int;not null"`
-	TotalH     int64     `gorm:"type:int;not null"`
-	TotalI     int64     `gorm:"type:int;not null"`
-	TotalJ     int64     `gorm:"type:int;not null"`
-	Kkdt       int64     `gorm:"type:int;not null"`
-	Amount     uint64    `gorm:"type:bigint;not null"`
-	CreatedAt  time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+	ID         int64      `gorm:"primarykey;type:int"`
+	Address    string     `gorm:"type:varchar(100)"`
+	AddressTwo string     `gorm:"type:varchar(100)"`
+	PrivateKey string     `gorm:"type:varchar(200)"`
+	Password   string     `gorm:"type:varchar(100)"`
+	BindAt     *time.Time `gorm:"type:datetime"` // 地址或密码最后修改时间，未修改过为NULL
+	Last       uint64     `gorm:"type:bigint;not null"`
+	Total      uint64     `gorm:"type:bigint;not null"`
+	TotalA     int64      `gorm:"type:int;not null"`
+	TotalB     int64      `gorm:"type:int;not null"`
+	TotalC     int64      `gorm:"type:int;not null"`
+	TotalD     int64      `gorm:"type:int;not null"`
+	TotalF     int64      `gorm:"type:int;not null"`
+	TotalG     int64      `gorm:"type:int;not null"`
+	TotalH     int64      `gorm:"type:int;not null"`
+	TotalI     int64      `gorm:"type:int;not null"`
+	TotalJ     int64      `gorm:"type:int;not null"`
+	Kkdt       int64      `gorm:"type:int;not null"`
+	Amount     uint64     `gorm:"type:bigint;not null"`
+	CreatedAt  time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time  `gorm:"type:datetime;not null"`
 }
 
 type UserArea struct {
@@ -144,6 +145,7 @@ type UserBalanceRecord struct {
 	Type      string    `gorm:"type:varchar(45);not null"`
 	CoinType  string    `gorm:"type:varchar(45);not null"`
 	AmountNew float64   `gorm:"type:decimal(65,20);not null"`
+	RunId     int64     `gorm:"type:int;not null;default:0;index"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
@@ -163,6 +165,7 @@ type Reward struct {
 	CreatedAt        time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
 	AmountNew        float64   `gorm:"type:decimal(65,20);not null"`
+	RunId            int64     `gorm:"type:int;not null;default:0;index"`
 }
 
 type Admin struct {
@@ -570,8 +573,12 @@ func (u *UserRepo) GetUserByUserIds(ctx context.Context, userIds ...int64) (map[
 	res := make(map[int64]*biz.User, 0)
 	for _, item := range users {
 		res[item.ID] = &biz.User{
-			ID:      item.ID,
-			Address: item.Address,
+			ID:        item.ID,
+			Address:   item.Address,
+			CreatedAt: item.CreatedAt,
+		}
+		if nil != item.BindAt {
+			res[item.ID].BindAt = *item.BindAt
 		}
 	}
 	return res, nil
@@ -725,8 +732,7 @@ func (u *UserRepo) GetUsers(ctx context.Context, b *biz.Pagination, address stri
 	//	instance = instance.Joins("inner join location_new on user.id = location_new.user_id").Group("user.id")
 	//}
 
-	instance = instance.Count(&count)
-	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&users).Error; err != nil {
+	if err := Page(instance, b, &count).Find(&users).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("USER_NOT_FOUND", "user not found"), 0
 		}
@@ -748,6 +754,17 @@ func (u *UserRepo) GetUsers(ctx context.Context, b *biz.Pagination, address stri
 	return res, nil, count
 }
 
+// EstimateCount 表统计里的行数，不扫表，只是估算值
+func (u *UserRepo) EstimateCount(ctx context.Context, table string) (int64, error) {
+	var count int64
+	if err := u.data.db.Raw("SELECT IFNULL(TABLE_ROWS, 0) FROM information_schema.TABLES WHERE TABLE_SCHEMA=DATABASE() AND TABLE_NAME=?", table).
+		Scan(&count).Error; err != nil {
+		return 0, errors.New(500, "ESTIMATE COUNT ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
 // CreateUser .
 func (u *UserRepo) CreateUser(ctx context.Context, uc *biz.User) (*biz.User, error) {
 	var user User
@@ -828,7 +845,9 @@ func (ui *UserInfoRepo) CreateUserInfo(ctx context.Context, u *biz.User) (*biz.U
 // UpdateUserPassword .
 func (ui *UserInfoRepo) UpdateUserPassword(ctx context.Context, userId int64, password string) (*biz.User, error) {
 	var user User
+	now := time.Now()
 	user.Password = password
+	user.BindAt = &now
 	res := ui.data.DB(ctx).Table("user").Where("id=?", userId).Updates(&user)
 	if res.Error != nil {
 		return nil, errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
@@ -2155,8 +2174,7 @@ func (ub *UserBalanceRepo) GetWithdraws(ctx context.Context, b *biz.Pagination,
 		instance = instance.Where("user_id=?", userId)
 	}
 
-	instance = instance.Count(&count)
-	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&withdraws).Error; err != nil {
+	if err := Page(instance, b, &count).Find(&withdraws).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found"), 0
 		}
@@ -2306,6 +2324,7 @@ func (ub *UserBalanceRepo) FourRewardBiw(ctx context.Context, userId int64, rewa
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.CoinType = "usdt"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -2318,6 +2337,7 @@ func (ub *UserBalanceRepo) FourRewardBiw(ctx context.Context, userId int64, rewa
 	reward.Type = "system_reward_four_daily" // 本次分红的行为类型
 	reward.Reason = "four"                   // 给我分红的理由
 	reward.ReasonLocationId = num
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -2433,6 +2453,7 @@ func (ub *UserBalanceRepo) SecondRewardBiw(ctx context.Context, userId int64, am
 	}
 
 	reward.AmountNew = amount
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return err
@@ -2461,6 +2482,7 @@ func (ub *UserBalanceRepo) FirstRewardBiw(ctx context.Context, userId int64, amo
 	reward.Type = "reward_first"   // 本次分红的行为类型
 	reward.Reason = "reward_first" // 给我分红的理由
 	reward.AmountNew = amount
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return err
@@ -2575,6 +2597,7 @@ func (ub *UserBalanceRepo) RecommendRewardBiw(ctx context.Context, userId int64,
 			userBalanceRecode.Type = "exchange"
 			userBalanceRecode.CoinType = "dhb"
 			userBalanceRecode.Amount = tmp
+			userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 			err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 			if err != nil {
 				return 0, nil
@@ -2589,6 +2612,7 @@ func (ub *UserBalanceRepo) RecommendRewardBiw(ctx context.Context, userId int64,
 			reward.Type = "exchange_system" // 本次分红的行为类型
 			reward.TypeRecordId = userBalanceRecode.ID
 			reward.Reason = "exchange_2" // 给我分红的理由
+			reward.RunId = biz.RewardRunIdFromContext(ctx)
 			err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 			if err != nil {
 				return 0, nil
@@ -2601,6 +2625,7 @@ func (ub *UserBalanceRepo) RecommendRewardBiw(ctx context.Context, userId int64,
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.CoinType = "dhb"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -2613,6 +2638,7 @@ func (ub *UserBalanceRepo) RecommendRewardBiw(ctx context.Context, userId int64,
 	reward.Type = "system_reward_recommend_daily" // 本次分红的行为类型
 	reward.Reason = "recommend"                   // 给我分红的理由
 	reward.ReasonLocationId = recommendNum
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -2651,6 +2677,7 @@ func (ub *UserBalanceRepo) LocationRewardBiw(ctx context.Context, userId int64,
 			userBalanceRecode.Type = "exchange"
 			userBalanceRecode.CoinType = "dhb"
 			userBalanceRecode.Amount = tmp
+			userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 			err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 			if err != nil {
 				return 0, nil
@@ -2665,6 +2692,7 @@ func (ub *UserBalanceRepo) LocationRewardBiw(ctx context.Context, userId int64,
 			reward.Type = "exchange_system" // 本次分红的行为类型
 			reward.TypeRecordId = userBalanceRecode.ID
 			reward.Reason = "exchange_2" // 给我分红的理由
+			reward.RunId = biz.RewardRunIdFromContext(ctx)
 			err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 			if err != nil {
 				return 0, nil
@@ -2677,6 +2705,7 @@ func (ub *UserBalanceRepo) LocationRewardBiw(ctx context.Context, userId int64,
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.CoinType = "dhb"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -2688,6 +2717,7 @@ func (ub *UserBalanceRepo) LocationRewardBiw(ctx context.Context, userId int64,
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward_location_daily" // 本次分红的行为类型
 	reward.Reason = "location"                   // 给我分红的理由
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -2728,7 +2758,7 @@ func (ub *UserBalanceRepo) GetPriceChangeConfig(ctx context.Context) (*biz.Price
 		ID:        priceChange.ID,
 		Origin:    priceChange.Origin,
 		Price:     priceChange.Price,
-		Status:    priceChange.Price,
+		Status:    priceChange.Status,
 		CreatedAt: priceChange.CreatedAt,
 	}, nil
 }
@@ -2791,6 +2821,7 @@ func (ub *UserBalanceRepo) AreaRewardBiw(ctx context.Context, userId int64, rewa
 			userBalanceRecode.Type = "exchange"
 			userBalanceRecode.CoinType = "dhb"
 			userBalanceRecode.Amount = tmp
+			userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 			err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 			if err != nil {
 				return 0, nil
@@ -2805,6 +2836,7 @@ func (ub *UserBalanceRepo) AreaRewardBiw(ctx context.Context, userId int64, rewa
 			reward.Type = "exchange_system" // 本次分红的行为类型
 			reward.TypeRecordId = userBalanceRecode.ID
 			reward.Reason = "exchange_2" // 给我分红的理由
+			reward.RunId = biz.RewardRunIdFromContext(ctx)
 			err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 			if err != nil {
 				return 0, nil
@@ -2818,6 +2850,7 @@ func (ub *UserBalanceRepo) AreaRewardBiw(ctx context.Context, userId int64, rewa
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.CoinType = "dhb"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -2830,6 +2863,7 @@ func (ub *UserBalanceRepo) AreaRewardBiw(ctx context.Context, userId int64, rewa
 	reward.Type = "system_reward_area_daily" // 本次分红的行为类型
 	reward.Reason = "area"                   // 给我分红的理由
 	reward.ReasonLocationId = areaType
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -3107,6 +3141,7 @@ func (ub *UserBalanceRepo) UserDailyRecommendArea(ctx context.Context, userId in
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -3118,6 +3153,7 @@ func (ub *UserBalanceRepo) UserDailyRecommendArea(ctx context.Context, userId in
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward_daily"    // 本次分红的行为类型
 	reward.Reason = "daily_recommend_area" // 给我分红的理由
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -3149,6 +3185,7 @@ func (ub *UserBalanceRepo) UserDailyBalanceReward(ctx context.Context, userId in
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -3160,6 +3197,7 @@ func (ub *UserBalanceRepo) UserDailyBalanceReward(ctx context.Context, userId in
 	reward.BalanceRecordId = userBalanceRecode.ID
 	reward.Type = "system_reward_daily"    // 本次分红的行为类型
 	reward.Reason = "daily_balance_reward" // 给我分红的理由
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -3456,6 +3494,7 @@ func (ub *UserBalanceRepo) NormalReward4(ctx context.Context, userId int64, rewa
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward_withdraw"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -3468,6 +3507,7 @@ func (ub *UserBalanceRepo) NormalReward4(ctx context.Context, userId int64, rewa
 	reward.Type = "reward_withdraw" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "reward_withdraw" // 给我分红的理由
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -3504,6 +3544,7 @@ func (ub *UserBalanceRepo) NormalReward3(ctx context.Context, userId int64, rewa
 	userBalanceRecode.UserId = userBalance.UserId
 	userBalanceRecode.Type = "reward_withdraw"
 	userBalanceRecode.Amount = rewardAmount
+	userBalanceRecode.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
 		return 0, err
@@ -3516,6 +3557,7 @@ func (ub *UserBalanceRepo) NormalReward3(ctx context.Context, userId int64, rewa
 	reward.Type = "reward_withdraw" // 本次分红的行为类型
 	reward.TypeRecordId = locationId
 	reward.Reason = "reward_withdraw" // 给我分红的理由
+	reward.RunId = biz.RewardRunIdFromContext(ctx)
 	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
 	if err != nil {
 		return 0, err
@@ -4148,8 +4190,7 @@ func (ub *UserBalanceRepo) GetUserRewards(ctx context.Context, b *biz.Pagination
 		instance = instance.Where("reason=?", reason)
 	}
 
-	instance = instance.Count(&count)
-	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&rewards).Error; err != nil {
+	if err := Page(instance, b, &count).Find(&rewards).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, errors.NotFound("REWARD_NOT_FOUND", "reward not found"), 0
 		}
@@ -4175,6 +4216,39 @@ func (ub *UserBalanceRepo) GetUserRewards(ctx context.Context, b *biz.Pagination
 	return res, nil, count
 }
 
+// GetUserRewardsByReasons 用户指定理由的分红，按id倒序分页
+func (ub *UserBalanceRepo) GetUserRewardsByReasons(ctx context.Context, b *biz.Pagination, userId int64, reasons ...string) ([]*biz.Reward, error, int64) {
+	var (
+		rewards []*Reward
+		count   int64
+	)
+	res := make([]*biz.Reward, 0)
+
+	instance := ub.data.db.Table("reward").Where("user_id=?", userId).Where("reason in (?)", reasons)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error()), 0
+	}
+
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			AmountB:          reward.AmountNew,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+	return res, nil, count
+}
+
 // GetUserRewardRecommendSort .
 func (ub *UserBalanceRepo) GetUserRewardRecommendSort(ctx context.Context) ([]*biz.UserSortRecommendReward, error) {
 	var total []*UserSortRecommendReward
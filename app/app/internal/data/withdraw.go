@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type WithdrawDaily struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Day       string    `gorm:"type:varchar(10);not null;uniqueIndex:uk_day_user"`
+	UserId    int64     `gorm:"type:int;not null;uniqueIndex:uk_day_user"`
+	Amount    int64     `gorm:"type:bigint;not null;default:0"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+// CreateWithdrawNew .
+func (ub *UserBalanceRepo) CreateWithdrawNew(ctx context.Context, w *biz.Withdraw) (*biz.Withdraw, error) {
+	var withdraw Withdraw
+	withdraw.UserId = w.UserId
+	withdraw.Amount = w.Amount
+	withdraw.RelAmount = w.RelAmount
+	withdraw.Status = w.Status
+	withdraw.Type = w.Type
+	withdraw.AmountNew = w.AmountNew
+	withdraw.AmountNewRel = w.AmountNewRel
+	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
+	}
+
+	return &biz.Withdraw{
+		ID:              withdraw.ID,
+		UserId:          withdraw.UserId,
+		Amount:          withdraw.Amount,
+		RelAmount:       withdraw.RelAmount,
+		BalanceRecordId: withdraw.BalanceRecordId,
+		Status:          withdraw.Status,
+		Type:            withdraw.Type,
+		AmountNew:       withdraw.AmountNew,
+		AmountNewRel:    withdraw.AmountNewRel,
+		CreatedAt:       withdraw.CreatedAt,
+	}, nil
+}
+
+// AddWithdrawDaily 不存在时先创建，按额度条件累加
+func (ub *UserBalanceRepo) AddWithdrawDaily(ctx context.Context, day string, userId int64, amount int64, max int64) (bool, error) {
+	now := time.Now()
+	if err := ub.data.DB(ctx).Table("withdraw_daily").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WithdrawDaily{Day: day, UserId: userId, CreatedAt: now, UpdatedAt: now}).Error; err != nil {
+		return false, errors.New(500, "WITHDRAW DAILY ERROR", err.Error())
+	}
+
+	instance := ub.data.DB(ctx).Table("withdraw_daily").Where("day=? and user_id=?", day, userId)
+	if 0 < max {
+		instance = instance.Where("amount+?<=?", amount, max)
+	}
+
+	res := instance.Updates(map[string]interface{}{"amount": gorm.Expr("amount + ?", amount), "updated_at": now})
+	if nil != res.Error {
+		return false, errors.New(500, "WITHDRAW DAILY ERROR", res.Error.Error())
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// GetWithdrawsByStatus .
+func (ub *UserBalanceRepo) GetWithdrawsByStatus(ctx context.Context, b *biz.Pagination, status string) ([]*biz.Withdraw, error, int64) {
+	var (
+		withdraws []*Withdraw
+		count     int64
+	)
+	res := make([]*biz.Withdraw, 0)
+
+	instance := ub.data.db.Table("withdraw").Where("status=?", status)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id asc").Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), 0
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			AmountNew:       withdraw.AmountNew,
+			AmountNewRel:    withdraw.AmountNewRel,
+			CreatedAt:       withdraw.CreatedAt,
+		})
+	}
+	return res, nil, count
+}
+
+// UpdateWithdrawStatus 按原状态条件修改
+func (ub *UserBalanceRepo) UpdateWithdrawStatus(ctx context.Context, id int64, from string, to string) (bool, error) {
+	res := ub.data.DB(ctx).Table("withdraw").
+		Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{"status": to, "updated_at": time.Now()})
+	if nil != res.Error {
+		return false, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// RefundWithdrawUsdt .
+func (ub *UserBalanceRepo) RefundWithdrawUsdt(ctx context.Context, userId int64, amount int64) error {
+	var err error
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return errors.NotFound("user balance err", "user balance error")
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "withdraw_refund"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
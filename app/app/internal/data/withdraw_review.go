@@ -0,0 +1,88 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strings"
+	"time"
+)
+
+type WithdrawReview struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	WithdrawId   int64     `gorm:"type:int;not null;index"`
+	UserId       int64     `gorm:"type:int;not null"`
+	AdminId      int64     `gorm:"type:int;not null;default:0"`
+	Action       string    `gorm:"type:varchar(45);not null"`
+	StatusBefore string    `gorm:"type:varchar(45);not null"`
+	StatusAfter  string    `gorm:"type:varchar(45);not null"`
+	Amount       int64     `gorm:"type:bigint;not null"`
+	Reason       string    `gorm:"type:varchar(200);not null;default:''"`
+	Flags        string    `gorm:"type:varchar(200);not null;default:''"` // 逗号分隔
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawReviewRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWithdrawReviewRepo(data *Data, logger log.Logger) biz.WithdrawReviewRepo {
+	return &WithdrawReviewRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateWithdrawReview .
+func (w *WithdrawReviewRepo) CreateWithdrawReview(ctx context.Context, r *biz.WithdrawReview) error {
+	if err := w.data.DB(ctx).Table("withdraw_review").Create(&WithdrawReview{
+		WithdrawId:   r.WithdrawId,
+		UserId:       r.UserId,
+		AdminId:      r.AdminId,
+		Action:       r.Action,
+		StatusBefore: r.StatusBefore,
+		StatusAfter:  r.StatusAfter,
+		Amount:       r.Amount,
+		Reason:       r.Reason,
+		Flags:        strings.Join(r.Flags, ","),
+	}).Error; err != nil {
+		return errors.New(500, "CREATE_WITHDRAW_REVIEW_ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetWithdrawReviews 按时间顺序
+func (w *WithdrawReviewRepo) GetWithdrawReviews(ctx context.Context, withdrawId int64) ([]*biz.WithdrawReview, error) {
+	var reviews []*WithdrawReview
+	res := make([]*biz.WithdrawReview, 0)
+	if err := w.data.DB(ctx).Table("withdraw_review").Where("withdraw_id=?", withdrawId).Order("id asc").Find(&reviews).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW REVIEW ERROR", err.Error())
+	}
+
+	for _, v := range reviews {
+		flags := make([]string, 0)
+		if "" != v.Flags {
+			flags = strings.Split(v.Flags, ",")
+		}
+
+		res = append(res, &biz.WithdrawReview{
+			ID:           v.ID,
+			WithdrawId:   v.WithdrawId,
+			UserId:       v.UserId,
+			AdminId:      v.AdminId,
+			Action:       v.Action,
+			StatusBefore: v.StatusBefore,
+			StatusAfter:  v.StatusAfter,
+			Amount:       v.Amount,
+			Reason:       v.Reason,
+			Flags:        flags,
+			CreatedAt:    v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,177 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 导出格式
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// Writer 逐行写出，Close后内容完整
+type Writer interface {
+	Write(row []string) error
+	Close() error
+}
+
+// NewWriter 按格式创建，格式不支持时返回错误
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w)
+	case FormatXLSX:
+		return NewXLSXWriter(w)
+	}
+
+	return nil, errors.New("unsupported export format " + format)
+}
+
+// ContentType 响应头
+func ContentType(format string) string {
+	if FormatXLSX == format {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "text/csv; charset=utf-8"
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// NewCSVWriter 带BOM，excel打开中文不乱码
+func NewCSVWriter(w io.Writer) (Writer, error) {
+	if _, err := w.Write([]byte("\xEF\xBB\xBF")); nil != err {
+		return nil, err
+	}
+
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Write(row []string) error {
+	return c.w.Write(row)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	xlsxSheetHead = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetTail = `</sheetData></worksheet>`
+)
+
+// xlsxWriter 单个工作表，单元格用内联字符串，工作表边写边压缩，不在内存中保留行
+type xlsxWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	row   int
+}
+
+func NewXLSXWriter(w io.Writer) (Writer, error) {
+	zw := zip.NewWriter(w)
+	for _, v := range [][2]string{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	} {
+		f, err := zw.Create(v[0])
+		if nil != err {
+			return nil, err
+		}
+		if _, err = io.WriteString(f, v[1]); nil != err {
+			return nil, err
+		}
+	}
+
+	// 工作表必须最后创建，之后不能再写其他文件
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if nil != err {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err = sheet.WriteString(xlsxSheetHead); nil != err {
+		return nil, err
+	}
+
+	return &xlsxWriter{zw: zw, sheet: sheet}, nil
+}
+
+func (x *xlsxWriter) Write(row []string) error {
+	x.row++
+	var b strings.Builder
+	b.WriteString(`<row r="`)
+	b.WriteString(strconv.Itoa(x.row))
+	b.WriteString(`">`)
+	for k, v := range row {
+		b.WriteString(`<c r="`)
+		b.WriteString(xlsxColumn(k))
+		b.WriteString(strconv.Itoa(x.row))
+		b.WriteString(`" t="inlineStr"><is><t xml:space="preserve">`)
+		xlsxEscape(&b, v)
+		b.WriteString(`</t></is></c>`)
+	}
+	b.WriteString(`</row>`)
+
+	_, err := x.sheet.WriteString(b.String())
+	return err
+}
+
+func (x *xlsxWriter) Close() error {
+	if _, err := x.sheet.WriteString(xlsxSheetTail); nil != err {
+		return err
+	}
+	if err := x.sheet.Flush(); nil != err {
+		return err
+	}
+
+	return x.zw.Close()
+}
+
+// xlsxColumn 0->A，26->AA
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; 0 < i; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}
+
+// xlsxEscape xml转义，去掉xml不允许的控制字符
+func xlsxEscape(b *strings.Builder, s string) {
+	for _, r := range s {
+		switch {
+		case '&' == r:
+			b.WriteString("&amp;")
+		case '<' == r:
+			b.WriteString("&lt;")
+		case '>' == r:
+			b.WriteString("&gt;")
+		case '"' == r:
+			b.WriteString("&quot;")
+		case r < 0x20 && '\t' != r && '\n' != r && '\r' != r:
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dhb"
+
+var (
+	serverRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Subsystem: "server", Name: "requests_total",
+		Help: "接口请求数，code为0时成功",
+	}, []string{"kind", "operation", "code", "reason"})
+	serverSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace, Subsystem: "server", Name: "request_seconds",
+		Help:    "接口耗时",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"kind", "operation"})
+	deposits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "deposits_total",
+		Help: "入金记录数，result为done、skipped或failed",
+	}, []string{"source", "coin", "result"})
+	rewardAmount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "reward_amount_total",
+		Help: "已发放的奖励金额，单位usdt",
+	}, []string{"policy"})
+	rewardRunSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace, Name: "reward_run_seconds",
+		Help:    "每日奖励任务耗时",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+	}, []string{"policy", "status"})
+	payouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Name: "payouts_total",
+		Help: "提现进入各状态的次数",
+	}, []string{"state"})
+	rpcCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Subsystem: "rpc", Name: "calls_total",
+		Help: "链上和第三方接口调用数，outcome为ok或error",
+	}, []string{"endpoint", "method", "outcome"})
+	rpcSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace, Subsystem: "rpc", Name: "call_seconds",
+		Help:    "链上和第三方接口耗时",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"endpoint", "method"})
+	txConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Subsystem: "db", Name: "tx_conflicts_total",
+		Help: "数据库事务因死锁或锁等待超时失败的次数",
+	}, []string{"reason"})
+	txRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace, Subsystem: "db", Name: "tx_retries_total",
+		Help: "数据库事务因死锁或锁等待超时重试的次数",
+	}, []string{"reason"})
+)
+
+// 供kratos中间件和业务使用，With的参数顺序和标签一致
+var (
+	ServerRequests   metrics.Counter  = &counter{cv: serverRequests}
+	ServerSeconds    metrics.Observer = &observer{hv: serverSeconds}
+	Deposits         metrics.Counter  = &counter{cv: deposits}          // source, coin, result
+	RewardAmount     metrics.Counter  = &counter{cv: rewardAmount}      // policy
+	RewardRunSeconds metrics.Observer = &observer{hv: rewardRunSeconds} // policy, status
+	Payouts          metrics.Counter  = &counter{cv: payouts}           // state
+	TxConflicts      metrics.Counter  = &counter{cv: txConflicts}       // reason
+	TxRetries        metrics.Counter  = &counter{cv: txRetries}         // reason
+)
+
+func init() {
+	prometheus.MustRegister(serverRequests, serverSeconds, deposits, rewardAmount, rewardRunSeconds, payouts, rpcCalls, rpcSeconds, txConflicts, txRetries)
+}
+
+// Handler /metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RPC 记录一次外部调用，endpoint只取主机名，避免地址里的key和参数进入标签
+func RPC(endpoint string, method string, start time.Time, err error) {
+	host := endpoint
+	if u, parseErr := url.Parse(endpoint); nil == parseErr && "" != u.Host {
+		host = u.Host
+	}
+
+	outcome := "ok"
+	if nil != err {
+		outcome = "error"
+	}
+
+	rpcCalls.WithLabelValues(host, method, outcome).Inc()
+	rpcSeconds.WithLabelValues(host, method).Observe(time.Since(start).Seconds())
+}
+
+type counter struct {
+	cv  *prometheus.CounterVec
+	lvs []string
+}
+
+func (c *counter) With(lvs ...string) metrics.Counter {
+	return &counter{cv: c.cv, lvs: lvs}
+}
+
+func (c *counter) Inc() {
+	c.cv.WithLabelValues(c.lvs...).Inc()
+}
+
+func (c *counter) Add(delta float64) {
+	c.cv.WithLabelValues(c.lvs...).Add(delta)
+}
+
+type observer struct {
+	hv  *prometheus.HistogramVec
+	lvs []string
+}
+
+func (o *observer) With(lvs ...string) metrics.Observer {
+	return &observer{hv: o.hv, lvs: lvs}
+}
+
+func (o *observer) Observe(value float64) {
+	o.hv.WithLabelValues(o.lvs...).Observe(value)
+}
@@ -0,0 +1,72 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Header 请求和响应里的请求id
+const Header = "X-Request-Id"
+
+var valid = regexp.MustCompile(`^[0-9a-zA-Z_-]{1,64}$`)
+
+type requestIdKey struct{}
+
+// New 随机生成
+func New() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// NewContext .
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, id)
+}
+
+// FromContext 没有时返回空
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey{}).(string)
+	return id
+}
+
+// Parse 沿用调用方传入的请求id，没有或格式不对时生成
+func Parse(id string) string {
+	if valid.MatchString(id) {
+		return id
+	}
+
+	return New()
+}
+
+// Server 请求id放入上下文并写回响应头
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				id := Parse(tr.RequestHeader().Get(Header))
+				tr.ReplyHeader().Set(Header, id)
+				ctx = NewContext(ctx, id)
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Valuer 日志字段
+func Valuer() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		if nil == ctx {
+			return ""
+		}
+
+		return FromContext(ctx)
+	}
+}
@@ -0,0 +1,87 @@
+package validate
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError protoc-gen-validate生成的字段错误
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validator 按proto中的validate规则校验请求，返回400，metadata为 字段路径 => 原因
+func Validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if v, ok := req.(validator); ok {
+				if err := v.ValidateAll(); err != nil {
+					fields := make(map[string]string, 0)
+					collect("", err, fields)
+					return nil, errors.BadRequest("VALIDATOR", "参数错误").WithMetadata(fields).WithCause(err)
+				}
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// collect 展开嵌套消息的错误，字段路径如 send_body.amount
+func collect(prefix string, err error, fields map[string]string) {
+	if multi, ok := err.(multiError); ok {
+		for _, v := range multi.AllErrors() {
+			collect(prefix, v, fields)
+		}
+		return
+	}
+
+	fe, ok := err.(fieldError)
+	if !ok {
+		fields[prefix] = err.Error()
+		return
+	}
+
+	field := fieldName(fe.Field())
+	if "" != prefix {
+		field = prefix + "." + field
+	}
+
+	if nil != fe.Cause() {
+		collect(field, fe.Cause(), fields)
+		return
+	}
+
+	fields[field] = fe.Reason()
+}
+
+// fieldName 生成代码中的字段名转为proto中的名称，SendBody => send_body
+func fieldName(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if 0 < i && '[' != rune(s[i-1]) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
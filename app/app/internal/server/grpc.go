@@ -1,17 +1,16 @@
 package server
 
 import (
+	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/conf"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"dhb/app/app/internal/service"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server) *grpc.Server {
+func NewGRPCServer(c *conf.Server, app *service.AppService) *grpc.Server {
 	var opts = []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-		),
+		grpc.Middleware(NewMiddleware()...),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -23,6 +22,6 @@ func NewGRPCServer(c *conf.Server) *grpc.Server {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
-
+	v1.RegisterAppServer(srv, app)
 	return srv
 }
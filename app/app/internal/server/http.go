@@ -5,27 +5,17 @@ import (
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/conf"
 	"dhb/app/app/internal/service"
-	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
-	jwt2 "github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/handlers"
+	"strings"
 
-	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, app *service.AppService) *http.Server {
 	var opts = []http.ServerOption{
-		http.Middleware(
-			recovery.Recovery(),
-			selector.Server( // jwt 验证
-				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
-					return []byte("77037723115d7687c63b258b3cb1d19b"), nil
-				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
-			).Match(NewWhiteListMatcher()).Build(),
-		),
+		http.Middleware(NewMiddleware()...),
 		http.Filter(handlers.CORS(
 			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
@@ -43,14 +33,42 @@ func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterAppHTTPServer(srv, app)
+	srv.HandleFunc("/api/admin_dhb/export", NewAdminHandler(app.Export))
+	srv.HandleFunc("/api/admin_dhb/export_file", NewAdminHandler(app.ExportFile))
 	return srv
 }
 
+// adminOperations /api/admin_dhb下不以Admin开头的接口
+var adminOperations = map[string]struct{}{
+	"/api.App/AuthList":        {},
+	"/api.App/MyAuthList":      {},
+	"/api.App/UserAuthList":    {},
+	"/api.App/AuthAdminCreate": {},
+	"/api.App/AuthAdminDelete": {},
+}
+
+// NewAdminMatcher 需要管理员token的后台接口，白名单里的接口不校验
+func NewAdminMatcher() selector.MatchFunc {
+	whiteList := NewWhiteListMatcher()
+	return func(ctx context.Context, operation string) bool {
+		if !whiteList(ctx, operation) {
+			return false
+		}
+
+		if strings.HasPrefix(operation, "/api.App/Admin") {
+			return true
+		}
+		_, ok := adminOperations[operation]
+		return ok
+	}
+}
+
 // NewWhiteListMatcher 设置白名单，不需要 token 验证的接口
 func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList := make(map[string]struct{})
 	whiteList["/api.App/AdminLogin"] = struct{}{}
 	whiteList["/api.App/Deposit"] = struct{}{}
+	whiteList["/api.App/DepositBuy"] = struct{}{}
 	whiteList["/api.App/Deposit5"] = struct{}{}
 	whiteList["/api.App/VipCheck"] = struct{}{}
 	whiteList["/api.App/Deposit3"] = struct{}{}
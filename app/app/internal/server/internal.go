@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/metrics"
+	"dhb/app/app/internal/pkg/middleware/requestid"
+	"dhb/app/app/internal/service"
+	nethttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// InternalServer 单独监听内网地址，提供/metrics和定时任务调用的接口，不经过对外接口的端口和鉴权
+type InternalServer struct {
+	srv *http.Server
+}
+
+// NewInternalServer 未配置监听地址时不开启
+func NewInternalServer(c *conf.Server, app *service.AppService) *InternalServer {
+	if "" == c.GetInternal().GetAddr() {
+		return &InternalServer{}
+	}
+
+	srv := http.NewServer(http.Address(c.Internal.Addr))
+	srv.Handle("/metrics", metrics.Handler())
+	srv.HandleFunc("/internal/reward_run", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		var req v1.AdminRewardRunRequest
+		internalCall(w, r, &req, func(ctx context.Context) (interface{}, error) {
+			return app.AdminRewardRun(ctx, &req)
+		})
+	})
+	srv.HandleFunc("/internal/price_tick", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		var req v1.AdminPriceTickRequest
+		internalCall(w, r, &req, func(ctx context.Context) (interface{}, error) {
+			return app.AdminPriceTick(ctx, &req)
+		})
+	})
+	return &InternalServer{srv: srv}
+}
+
+// internalCall 按query绑定请求，请求id放入上下文后调用
+func internalCall(w nethttp.ResponseWriter, r *nethttp.Request, req interface{}, call func(ctx context.Context) (interface{}, error)) {
+	id := requestid.Parse(r.Header.Get(requestid.Header))
+	w.Header().Set(requestid.Header, id)
+
+	if err := binding.BindQuery(r.URL.Query(), req); nil != err {
+		http.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	reply, err := call(requestid.NewContext(r.Context(), id))
+	if nil != err {
+		http.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	_ = http.DefaultResponseEncoder(w, r, reply)
+}
+
+func (s *InternalServer) Start(ctx context.Context) error {
+	if nil == s.srv {
+		return nil
+	}
+
+	return s.srv.Start(ctx)
+}
+
+func (s *InternalServer) Stop(ctx context.Context) error {
+	if nil == s.srv {
+		return nil
+	}
+
+	return s.srv.Stop(ctx)
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/pkg/metrics"
+	"dhb/app/app/internal/pkg/middleware/requestid"
+	"dhb/app/app/internal/pkg/middleware/validate"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	kmetrics "github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"net/http"
+	"strings"
+)
+
+func jwtKeyFunc(token *jwt2.Token) (interface{}, error) {
+	return []byte("77037723115d7687c63b258b3cb1d19b"), nil
+}
+
+// NewMiddleware http和grpc共用的中间件，两种方式的接口鉴权和参数校验一致
+func NewMiddleware() []middleware.Middleware {
+	return []middleware.Middleware{
+		recovery.Recovery(),
+		kmetrics.Server(kmetrics.WithRequests(metrics.ServerRequests), kmetrics.WithSeconds(metrics.ServerSeconds)),
+		tracing.Server(), // 沿用调用方传入的链路id
+		requestid.Server(),
+		selector.Server( // jwt 验证
+			jwt.Server(jwtKeyFunc, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
+		).Match(NewWhiteListMatcher()).Build(),
+		selector.Server(adminRole()).Match(NewAdminMatcher()).Build(),
+		validate.Validator(),
+	}
+}
+
+// adminRole 后台接口只接受管理员登录的token，用户token返回403
+func adminRole() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, jwt.ErrMissingJwtToken
+			}
+
+			c, ok := claims.(jwt2.MapClaims)
+			if !ok || "admin" != c["UserType"] {
+				return nil, errors.Forbidden("FORBIDDEN", "需要管理员权限")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// NewAdminHandler 直接注册的http接口不经过中间件，按同样的token校验管理员，请求id和claims放入上下文
+func NewAdminHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := requestid.Parse(r.Header.Get(requestid.Header))
+		w.Header().Set(requestid.Header, id)
+		r = r.WithContext(requestid.NewContext(r.Context(), id))
+
+		auths := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+		if 2 != len(auths) || !strings.EqualFold(auths[0], "Bearer") {
+			khttp.DefaultErrorEncoder(w, r, jwt.ErrMissingJwtToken)
+			return
+		}
+
+		token, err := jwt2.Parse(auths[1], jwtKeyFunc)
+		if nil != err || !token.Valid || jwt2.SigningMethodHS256 != token.Method {
+			khttp.DefaultErrorEncoder(w, r, jwt.ErrTokenInvalid)
+			return
+		}
+
+		claims, ok := token.Claims.(jwt2.MapClaims)
+		if !ok || "admin" != claims["UserType"] {
+			khttp.DefaultErrorEncoder(w, r, jwt.ErrTokenInvalid)
+			return
+		}
+
+		h(w, r.WithContext(jwt.NewContext(r.Context(), claims)))
+	}
+}
@@ -0,0 +1,347 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/middleware/requestid"
+	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeUserBalanceRepo 只提供奖励列表
+type fakeUserBalanceRepo struct {
+	biz.UserBalanceRepo
+}
+
+func (r *fakeUserBalanceRepo) GetUserRewards(ctx context.Context, b *biz.Pagination, userId int64, reason string) ([]*biz.Reward, error, int64) {
+	return []*biz.Reward{
+		{ID: 1, UserId: userId, Amount: 150000, Type: "location", Reason: "location", CreatedAt: time.Now()},
+	}, nil, 1
+}
+
+type fakeLocationProductRepo struct {
+	biz.LocationProductRepo
+}
+
+func (r *fakeLocationProductRepo) GetLocationProducts(ctx context.Context) ([]*biz.LocationProduct, error) {
+	return []*biz.LocationProduct{{ID: 1, Name: "A", MinAmount: 10000000, OutRate: 250, CreatedAt: time.Now()}}, nil
+}
+
+// 两种传输方式共用一条中间件链：白名单、jwt、管理员角色、参数校验、请求id，
+// 通过中间件的请求进入模拟仓库的usecase
+func newTestServers(t *testing.T) (httpAddr string, client v1.AppClient) {
+	c := &conf.Server{
+		Http: &conf.Server_HTTP{Addr: "127.0.0.1:0"},
+		Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"},
+	}
+	uuc := biz.NewUserUseCase(nil, nil, nil, nil, nil, nil, nil, &fakeUserBalanceRepo{}, nil, nil, &fakeLocationProductRepo{}, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+	app := service.NewAppService(uuc, nil, log.DefaultLogger, &conf.Auth{})
+	hs := NewHTTPServer(c, app)
+	gs := NewGRPCServer(c, app)
+
+	hu, err := hs.Endpoint()
+	if nil != err {
+		t.Fatal(err)
+	}
+	gu, err := gs.Endpoint()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	go func() { _ = hs.Start(context.Background()) }()
+	go func() { _ = gs.Start(context.Background()) }()
+
+	conn, err := kgrpc.DialInsecure(context.Background(), kgrpc.WithEndpoint(gu.Host), kgrpc.WithTimeout(5*time.Second))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = hs.Stop(context.Background())
+		_ = gs.Stop(context.Background())
+	})
+
+	return "http://" + hu.Host, v1.NewAppClient(conn)
+}
+
+func userToken(t *testing.T) string {
+	return signToken(t, "user")
+}
+
+func adminToken(t *testing.T) string {
+	return signToken(t, "admin")
+}
+
+func signToken(t *testing.T, userType string) string {
+	key, _ := jwtKeyFunc(nil)
+	token, err := jwt2.NewWithClaims(jwt2.SigningMethodHS256, jwt2.MapClaims{
+		"UserId":   1,
+		"UserType": userType,
+	}).SignedString(key)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	httpAddr, client := newTestServers(t)
+
+	var (
+		token = userToken(t)
+		admin = adminToken(t)
+		tests = []struct {
+			name   string
+			method string
+			path   string
+			token  string
+			grpc   func(ctx context.Context, opts ...grpc.CallOption) error
+			code   int
+			reason string
+		}{
+			{
+				name:   "没有token",
+				method: http.MethodGet,
+				path:   "/api/app_server/reward_list",
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.RewardList(ctx, &v1.RewardListRequest{}, opts...)
+					return err
+				},
+				code: 401,
+			},
+			{
+				name:   "定时任务接口不在白名单",
+				method: http.MethodGet,
+				path:   "/api/admin_dhb/price_tick",
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.AdminPriceTick(ctx, &v1.AdminPriceTickRequest{}, opts...)
+					return err
+				},
+				code: 401,
+			},
+			{
+				name:   "参数校验",
+				method: http.MethodGet,
+				path:   "/api/app_server/reward_list?page=-1",
+				token:  token,
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.RewardList(ctx, &v1.RewardListRequest{Page: -1}, opts...)
+					return err
+				},
+				code:   400,
+				reason: "VALIDATOR",
+			},
+			{
+				name:   "用户token不能调用后台接口",
+				method: http.MethodGet,
+				path:   "/api/admin_dhb/location_product_list",
+				token:  token,
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.AdminLocationProductList(ctx, &v1.AdminLocationProductListRequest{}, opts...)
+					return err
+				},
+				code:   403,
+				reason: "FORBIDDEN",
+			},
+			{
+				name:   "用户token不能调用后台权限接口",
+				method: http.MethodGet,
+				path:   "/api/admin_dhb/my_auth_list",
+				token:  token,
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.MyAuthList(ctx, &v1.MyAuthListRequest{}, opts...)
+					return err
+				},
+				code:   403,
+				reason: "FORBIDDEN",
+			},
+			{
+				name:   "管理员token参数校验",
+				method: http.MethodGet,
+				path:   "/api/admin_dhb/reward_list?page=-1",
+				token:  admin,
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.AdminRewardList(ctx, &v1.AdminRewardListRequest{Page: -1}, opts...)
+					return err
+				},
+				code:   400,
+				reason: "VALIDATOR",
+			},
+			{
+				name:   "白名单接口不需要token，仍然校验参数",
+				method: http.MethodPost,
+				path:   "/api/admin_dhb/login",
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) error {
+					_, err := client.AdminLogin(ctx, &v1.AdminLoginRequest{}, opts...)
+					return err
+				},
+				code:   400,
+				reason: "VALIDATOR",
+			},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run("http/"+tt.name, func(t *testing.T) {
+			// 空body时send_body为nil，由参数校验拦下
+			req, err := http.NewRequest(tt.method, httpAddr+tt.path, nil)
+			if nil != err {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(requestid.Header, "test-"+tt.method)
+			if "" != tt.token {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if nil != err {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if tt.code != resp.StatusCode {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.code)
+			}
+			if "test-"+tt.method != resp.Header.Get(requestid.Header) {
+				t.Fatalf("request id = %q", resp.Header.Get(requestid.Header))
+			}
+
+			var e errors.Error
+			if err = json.NewDecoder(resp.Body).Decode(&e); nil != err {
+				t.Fatal(err)
+			}
+			if "" != tt.reason && tt.reason != e.Reason {
+				t.Fatalf("reason = %q, want %q", e.Reason, tt.reason)
+			}
+		})
+
+		t.Run("grpc/"+tt.name, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), strings.ToLower(requestid.Header), "test-grpc")
+			if "" != tt.token {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tt.token)
+			}
+
+			var header metadata.MD
+			e := errors.FromError(tt.grpc(ctx, grpc.Header(&header)))
+			if nil == e {
+				t.Fatal("want error")
+			}
+			if tt.code != int(e.Code) {
+				t.Fatalf("code = %d, want %d", e.Code, tt.code)
+			}
+			if "" != tt.reason && tt.reason != e.Reason {
+				t.Fatalf("reason = %q, want %q", e.Reason, tt.reason)
+			}
+			if ids := header.Get(requestid.Header); 1 != len(ids) || "test-grpc" != ids[0] {
+				t.Fatalf("request id = %v", ids)
+			}
+		})
+	}
+}
+
+// TestMiddlewareChainPass 通过整条中间件链到达usecase
+func TestMiddlewareChainPass(t *testing.T) {
+	httpAddr, client := newTestServers(t)
+
+	var (
+		token = userToken(t)
+		admin = adminToken(t)
+		tests = []struct {
+			name  string
+			path  string
+			token string
+			grpc  func(ctx context.Context, opts ...grpc.CallOption) (interface{}, error)
+			check func(t *testing.T, reply interface{})
+			reply func() proto.Message
+		}{
+			{
+				name:  "用户token调用用户接口",
+				path:  "/api/app_server/reward_list?page=1",
+				token: token,
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) (interface{}, error) {
+					return client.RewardList(ctx, &v1.RewardListRequest{Page: 1}, opts...)
+				},
+				reply: func() proto.Message { return &v1.RewardListReply{} },
+				check: func(t *testing.T, reply interface{}) {
+					res := reply.(*v1.RewardListReply)
+					if 1 != res.Count || 1 != len(res.Rewards) || "1.50" != res.Rewards[0].Amount {
+						t.Fatalf("reply = %v", res)
+					}
+				},
+			},
+			{
+				name:  "管理员token调用后台接口",
+				path:  "/api/admin_dhb/location_product_list",
+				token: admin,
+				grpc: func(ctx context.Context, opts ...grpc.CallOption) (interface{}, error) {
+					return client.AdminLocationProductList(ctx, &v1.AdminLocationProductListRequest{}, opts...)
+				},
+				reply: func() proto.Message { return &v1.AdminLocationProductListReply{} },
+				check: func(t *testing.T, reply interface{}) {
+					res := reply.(*v1.AdminLocationProductListReply)
+					if 1 != len(res.Products) || "A" != res.Products[0].Name {
+						t.Fatalf("reply = %v", res)
+					}
+				},
+			},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run("http/"+tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, httpAddr+tt.path, nil)
+			if nil != err {
+				t.Fatal(err)
+			}
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			resp, err := http.DefaultClient.Do(req)
+			if nil != err {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if 200 != resp.StatusCode {
+				t.Fatalf("status = %d", resp.StatusCode)
+			}
+			// int64按proto的json规则编码为字符串
+			body, err := io.ReadAll(resp.Body)
+			if nil != err {
+				t.Fatal(err)
+			}
+			reply := tt.reply()
+			if err = protojson.Unmarshal(body, reply); nil != err {
+				t.Fatal(err)
+			}
+			tt.check(t, reply)
+		})
+
+		t.Run("grpc/"+tt.name, func(t *testing.T) {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+tt.token)
+			reply, err := tt.grpc(ctx)
+			if nil != err {
+				t.Fatal(err)
+			}
+			tt.check(t, reply)
+		})
+	}
+}
@@ -6,9 +6,9 @@ import (
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/metrics"
 	"encoding/hex"
 	"encoding/json"
-	"fmt"
 	sdk "github.com/BioforestChain/go-bfmeta-wallet-sdk"
 	"github.com/BioforestChain/go-bfmeta-wallet-sdk/entity/req/broadcastTra"
 	"github.com/BioforestChain/go-bfmeta-wallet-sdk/entity/req/createTransferAsset"
@@ -75,6 +75,7 @@ func (a *AppService) DepositBak(ctx context.Context, req *v1.DepositRequest) (*v
 			depositUsdtResultTwo map[string]string
 			depositUsers         map[string]*biz.User
 			fromAccount          []string
+			records              []*biz.EthUserRecord
 			userLength           int64
 			last                 int64
 			err                  error
@@ -82,18 +83,17 @@ func (a *AppService) DepositBak(ctx context.Context, req *v1.DepositRequest) (*v
 
 		last, err = a.ruc.GetEthUserRecordLast(ctx)
 		if nil != err {
-			fmt.Println(err)
+			a.log.WithContext(ctx).Warnw("msg", "入金同步进度", "job", "deposit_bak", "err", err)
 			continue
 		}
 
 		if -1 == last {
-			fmt.Println(err)
 			continue
 		}
 
 		userLength, err = getUserLength("0x008EC6D29A4Eb429eDD90dC394CC185E33F4e534")
 		if nil != err {
-			fmt.Println(err)
+			a.log.WithContext(ctx).Warnw("msg", "合约用户数", "job", "deposit_bak", "err", err)
 		}
 
 		if -1 == userLength {
@@ -114,7 +114,6 @@ func (a *AppService) DepositBak(ctx context.Context, req *v1.DepositRequest) (*v
 		}
 
 		now := time.Now().UTC()
-		//fmt.Println(now, end)
 		if end.Before(now) {
 			break
 		}
@@ -147,19 +146,25 @@ func (a *AppService) DepositBak(ctx context.Context, req *v1.DepositRequest) (*v
 				tmpValue = v
 				strValue = strconv.FormatInt(v, 10) + "000000000000000000"
 
-				err = a.ruc.Deposit(ctx, depositUsers[user].ID, depositUsdtResultTwo[user], uint64(tmpValue), depositUsers[user].Total, &biz.EthUserRecord{ // 两种币的记录
-					UserId:    depositUsers[user].ID,
-					Status:    "success",
-					Type:      "deposit",
-					Amount:    strValue,
-					RelAmount: tmpValue,
-					CoinType:  "USDT",
-					Last:      userLength,
+				records = append(records, &biz.EthUserRecord{ // 两种币的记录
+					UserId:      depositUsers[user].ID,
+					Status:      "success",
+					Type:        "deposit",
+					Amount:      strValue,
+					RelAmount:   tmpValue,
+					CoinType:    "USDT",
+					Last:        userLength,
+					Address:     depositUsdtResultTwo[user],
+					OriginTotal: depositUsers[user].Total,
 				})
+			}
+		}
 
-				if nil != err {
-					fmt.Println(err)
-				}
+		// 按档位累计，未处理的在入金流程里记日志
+		if 0 < len(records) {
+			_, err = a.ruc.RunDeposit(ctx, biz.DepositSourceContract, records...)
+			if nil != err {
+				a.log.WithContext(ctx).Errorw("msg", "入金", "job", "deposit_bak", "err", err)
 			}
 		}
 
@@ -169,6 +174,33 @@ func (a *AppService) DepositBak(ctx context.Context, req *v1.DepositRequest) (*v
 	return &v1.DepositReply{}, nil
 }
 
+// DepositBuy BuySomething合约购买同步
+func (a *AppService) DepositBuy(ctx context.Context, req *v1.DepositBuyRequest) (*v1.DepositBuyReply, error) {
+	report, err := a.ruc.IngestBuyPurchases(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.DepositBuyReply{
+		Contract: report.Contract,
+		Length:   report.Length,
+		From:     report.From,
+		To:       report.To,
+		Items:    make([]*v1.DepositBuyReply_Item, 0),
+	}
+	for _, v := range report.Done {
+		res.Items = append(res.Items, &v1.DepositBuyReply_Item{Hash: v.Hash, UserId: v.UserId, Status: "done", RecordId: v.RecordId, LocationId: v.LocationId})
+	}
+	for _, v := range report.Skipped {
+		res.Items = append(res.Items, &v1.DepositBuyReply_Item{Hash: v.Hash, UserId: v.UserId, Status: "skipped", Stage: v.Stage, Reason: v.Reason, RecordId: v.RecordId})
+	}
+	if nil != report.Failed {
+		res.Items = append(res.Items, &v1.DepositBuyReply_Item{Hash: report.Failed.Hash, UserId: report.Failed.UserId, Status: "failed", Stage: report.Failed.Stage, Reason: report.Failed.Reason})
+	}
+
+	return res, nil
+}
+
 // Deposit deposit.
 func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.DepositReply, error) {
 	end := time.Now().UTC().Add(55 * time.Second)
@@ -203,7 +235,7 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 
 		users, err = a.uuc.GetUsersNewTwo(ctx)
 		if nil != err {
-			fmt.Println(err)
+			a.log.WithContext(ctx).Errorw("msg", "deposit users", "job", "deposit", "err", err)
 			continue
 		}
 
@@ -256,7 +288,7 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 				//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
 				client, err = ethclient.Dial(url1)
 				if err != nil {
-					fmt.Println(err, "client")
+					a.log.WithContext(ctx).Warnw("msg", "rpc dial", "job", "deposit", "url", url1, "err", err)
 					continue
 				}
 
@@ -267,7 +299,9 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 				}
 
 				addressStr := common.HexToAddress(tmpUser.AddressTwo)
+				start := time.Now()
 				bal, err = instance.BalanceOf(&bind.CallOpts{}, addressStr)
+				metrics.RPC(url1, "balance_of", start, err)
 				if err != nil {
 					if 0 == j {
 						url1 = "https://binance.llamarpc.com/"
@@ -309,7 +343,7 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 			}
 
 			if (1 == i || 5 == i) && 25 == vUsers.ID {
-				fmt.Println(i, vUsers.ID, bal, url1, err)
+				a.log.WithContext(ctx).Debugw("msg", "deposit balance", "job", "deposit", "round", i, "user_id", vUsers.ID, "balance", bal, "url", url1, "err", err)
 			}
 
 			if 21 > len(bal.String()) { // 最小1000 todo 22 1000 18 0.1u当1000
@@ -323,7 +357,7 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 			numStr := bal.String()[:len(bal.String())-18] // 最小1000 todo 18 1000 14 0.1u当1000
 			num, err = strconv.ParseUint(numStr, 10, 64)
 			if nil != err {
-				fmt.Println(err)
+				a.log.WithContext(ctx).Errorw("msg", "deposit amount", "job", "deposit", "user_id", tmpUser.ID, "balance", numStr, "err", err)
 			}
 
 			// 提取过或未提取
@@ -442,16 +476,19 @@ func (a *AppService) Deposit(ctx context.Context, req *v1.DepositRequest) (*v1.D
 			strValue = strconv.FormatInt(tmpValue, 10) + "000000000000000000"
 
 			// 充值
-			err = a.ruc.DepositNew(ctx, tmpUser.ID, tmpUser.Address, amount, tmpLast, tmpUser.Total, &biz.EthUserRecord{ // 两种币的记录
-				UserId:    tmpUser.ID,
-				Status:    "success",
-				Type:      "deposit",
-				Amount:    strValue,
-				RelAmount: tmpValue,
-				CoinType:  "USDT",
+			_, err = a.ruc.RunDeposit(ctx, biz.DepositSourceBalance, &biz.EthUserRecord{ // 两种币的记录
+				UserId:      tmpUser.ID,
+				Status:      "success",
+				Type:        "deposit",
+				Amount:      strValue,
+				RelAmount:   tmpValue,
+				CoinType:    "USDT",
+				Address:     tmpUser.Address,
+				OriginTotal: tmpUser.Total,
+				Balance:     tmpLast,
 			})
 			if nil != err {
-				fmt.Println(err)
+				a.log.WithContext(ctx).Errorw("msg", "deposit", "job", "deposit", "user_id", tmpUser.ID, "amount", amount, "err", err)
 			}
 
 			continue
@@ -476,7 +513,7 @@ func (a *AppService) DepositWithdraw(ctx context.Context, req *v1.DepositRequest
 	for i := 0; i < 3; i++ {
 		users, err = a.uuc.GetUsersNewTwo(ctx)
 		if nil != err {
-			fmt.Println(err)
+			a.log.WithContext(ctx).Errorw("msg", "deposit withdraw users", "job", "deposit_withdraw", "err", err)
 			return nil, nil
 		}
 
@@ -498,18 +535,18 @@ func (a *AppService) DepositWithdraw(ctx context.Context, req *v1.DepositRequest
 		//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
 		client, err = ethclient.Dial("https://bsc-dataseed.binance.org/")
 		if err != nil {
-			fmt.Println(err)
+			a.log.WithContext(ctx).Errorw("msg", "rpc dial", "job", "deposit_withdraw", "err", err)
 			return nil, nil
 		}
 
 		tokenAddress := common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
 		instance, err = NewDfil(tokenAddress, client)
 		if err != nil {
-			fmt.Println(err)
+			a.log.WithContext(ctx).Errorw("msg", "token contract", "job", "deposit_withdraw", "err", err)
 			return nil, nil
 		}
 		for _, tmpUser := range needUsers {
-			fmt.Println("归集信息：", tmpUser)
+			a.log.WithContext(ctx).Infow("msg", "归集信息", "job", "deposit_withdraw", "user_id", tmpUser.ID, "address", tmpUser.AddressTwo)
 			var bal *big.Int
 			addressStr := common.HexToAddress(tmpUser.AddressTwo)
 			bal, err = instance.BalanceOf(&bind.CallOpts{}, addressStr)
@@ -540,7 +577,7 @@ func (a *AppService) DepositWithdraw(ctx context.Context, req *v1.DepositRequest
 			if 15 > len(balBnb) {
 				res, tx, err = toBnBNew(tmpUser.AddressTwo, addressPrivateKey, bnbAmount, "https://bsc-dataseed4.binance.org/")
 				if !res || 0 >= len(tx) || nil != err {
-					fmt.Println(tmpUser, "转bnb:", res, tx, err, time.Now())
+					a.log.WithContext(ctx).Errorw("msg", "转bnb", "job", "deposit_withdraw", "user_id", tmpUser.ID, "address", tmpUser.AddressTwo, "ok", res, "tx_hash", tx, "err", err)
 					continue
 				}
 				time.Sleep(6 * time.Second)
@@ -562,11 +599,11 @@ func (a *AppService) DepositWithdraw(ctx context.Context, req *v1.DepositRequest
 			firstInt := new(big.Int).Div(first.Num(), first.Denom())
 			secondInt := new(big.Int).Div(second.Num(), second.Denom())
 
-			fmt.Println(firstInt.String(), secondInt.String())
+			a.log.WithContext(ctx).Debugw("msg", "归集金额", "job", "deposit_withdraw", "user_id", tmpUser.ID, "first", firstInt.String(), "second", secondInt.String())
 
 			tx, err = toToken(tmpUser.PrivateKey, addressToToken, firstInt.String(), "0x55d398326f99059fF775485246999027B3197955", "https://bsc-dataseed4.binance.org/")
 			if 0 >= len(tx) || nil != err {
-				fmt.Println(tmpUser, "归集usdt:", res, tx, err.Error(), time.Now())
+				a.log.WithContext(ctx).Errorw("msg", "归集usdt", "job", "deposit_withdraw", "user_id", tmpUser.ID, "address", tmpUser.AddressTwo, "tx_hash", tx, "err", err)
 				continue
 			}
 			time.Sleep(6 * time.Second)
@@ -576,14 +613,14 @@ func (a *AppService) DepositWithdraw(ctx context.Context, req *v1.DepositRequest
 			if 15 > len(balBnb) {
 				res, tx, err = toBnBNew(tmpUser.AddressTwo, addressPrivateKey, bnbAmountTwo, "https://bsc-dataseed4.binance.org/")
 				if !res || 0 >= len(tx) || nil != err {
-					fmt.Println(tmpUser, "2, 转bnb:", res, tx, err, time.Now())
+					a.log.WithContext(ctx).Errorw("msg", "2, 转bnb", "job", "deposit_withdraw", "user_id", tmpUser.ID, "address", tmpUser.AddressTwo, "ok", res, "tx_hash", tx, "err", err)
 					continue
 				}
 				time.Sleep(5 * time.Second)
 			}
 			tx, err = toToken(tmpUser.PrivateKey, addressToTokenTwo, secondInt.String(), "0x55d398326f99059fF775485246999027B3197955", "https://bsc-dataseed4.binance.org/")
 			if 0 >= len(tx) || nil != err {
-				fmt.Println(tmpUser, "归集usdt 2:", res, tx, err.Error(), time.Now())
+				a.log.WithContext(ctx).Errorw("msg", "归集usdt 2", "job", "deposit_withdraw", "user_id", tmpUser.ID, "address", tmpUser.AddressTwo, "tx_hash", tx, "err", err)
 				continue
 			}
 
@@ -591,18 +628,18 @@ func (a *AppService) DepositWithdraw(ctx context.Context, req *v1.DepositRequest
 			// 重新查余额是否提干净
 			bal, err = instance.BalanceOf(&bind.CallOpts{}, addressStr)
 			if err != nil {
-				fmt.Println("尚未查询到归集成功，报错：", bal.String(), tmpUser, err)
+				a.log.WithContext(ctx).Warnw("msg", "尚未查询到归集成功，报错", "job", "deposit_withdraw", "user_id", tmpUser.ID, "err", err)
 				continue
 			}
 
 			if 19 < len(bal.String()) {
-				fmt.Println("尚未查询到归集成功：", bal.String(), tmpUser)
+				a.log.WithContext(ctx).Warnw("msg", "尚未查询到归集成功", "job", "deposit_withdraw", "user_id", tmpUser.ID, "balance", bal.String())
 				continue
 			}
 
 			err = a.ruc.DepositWithdraw(ctx, tmpUser.ID)
 			if nil != err {
-				fmt.Println(err)
+				a.log.WithContext(ctx).Errorw("msg", "deposit withdraw", "job", "deposit_withdraw", "user_id", tmpUser.ID, "err", err)
 			}
 		}
 	}
@@ -771,7 +808,7 @@ func (a *AppService) Deposit4(ctx context.Context, req *v1.DepositRequest) (*v1.
 		}
 
 		now := time.Now().UTC()
-		fmt.Println(now, end)
+		a.log.WithContext(ctx).Debugw("msg", "deposit round", "job", "deposit4", "page", i, "now", now, "end", end)
 		if end.Before(now) {
 			break
 		}
@@ -829,7 +866,7 @@ func (a *AppService) Deposit4(ctx context.Context, req *v1.DepositRequest) (*v1.
 
 			_, err = a.ruc.EthUserRecordHandle2(ctx, notExistDepositResult...)
 			if nil != err {
-				fmt.Println(err)
+				a.log.WithContext(ctx).Errorw("msg", "eth user record", "job", "deposit4", "err", err)
 			}
 		}
 	}
@@ -875,7 +912,7 @@ func (a *AppService) Deposit3(ctx context.Context, req *v1.DepositRequest) (*v1.
 		}
 
 		now := time.Now().UTC()
-		fmt.Println(now, end)
+		a.log.WithContext(ctx).Debugw("msg", "deposit round", "job", "deposit3", "page", i, "now", now, "end", end)
 		if end.Before(now) {
 			break
 		}
@@ -933,7 +970,7 @@ func (a *AppService) Deposit3(ctx context.Context, req *v1.DepositRequest) (*v1.
 
 			_, err = a.ruc.EthUserRecordHandle2(ctx, notExistDepositResult...)
 			if nil != err {
-				fmt.Println(err)
+				a.log.WithContext(ctx).Errorw("msg", "eth user record", "job", "deposit3", "err", err)
 			}
 		}
 	}
@@ -1000,7 +1037,7 @@ func (a *AppService) Deposit2(ctx context.Context, req *v1.DepositRequest) (*v1.
 		}
 
 		now := time.Now().UTC()
-		fmt.Println(now, end)
+		a.log.WithContext(ctx).Debugw("msg", "deposit round", "job", "deposit2", "page", i, "now", now, "end", end)
 		if end.Before(now) {
 			break
 		}
@@ -1112,7 +1149,7 @@ func (a *AppService) Deposit2(ctx context.Context, req *v1.DepositRequest) (*v1.
 
 			_, err = a.ruc.EthUserRecordHandle(ctx, notExistDepositResult...)
 			if nil != err {
-				fmt.Println(err)
+				a.log.WithContext(ctx).Errorw("msg", "eth user record", "job", "deposit2", "err", err)
 			}
 
 		}
@@ -1154,9 +1191,11 @@ func requestEthDepositResult(offset int64, page int64, contractAddress string, a
 	client := http.Client{
 		Timeout: 10 * time.Second,
 	}
-	fmt.Println(u.String())
+	log.Debugw("msg", "bscscan tokentx", "contract", contractAddress, "address", address, "page", page) // 地址里有apikey，不输出
 
+	start := time.Now()
 	resp, err := client.Get(u.String())
+	metrics.RPC(apiUrl, "tokentx", start, err)
 	if err != nil {
 		return nil, err
 	}
@@ -1193,16 +1232,31 @@ func requestEthDepositResult(offset int64, page int64, contractAddress string, a
 	return res, err
 }
 
+// userIdFromContext 用户接口只接受用户token，后台token的id不是用户id
+func userIdFromContext(ctx context.Context) (int64, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return 0, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+	}
+
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok || nil == c["UserId"] || "admin" == c["UserType"] {
+		return 0, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+	}
+
+	userId, ok := c["UserId"].(float64)
+	if !ok || 0 >= userId {
+		return 0, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+	}
+
+	return int64(userId), nil
+}
+
 // UserInfo userInfo.
 func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1.UserInfoReply, error) {
-	// 在上下文 context 中取出 claims 对象
-	var userId int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt2.MapClaims)
-		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
-		}
-		userId = int64(c["UserId"].(float64))
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
 	}
 
 	return a.uuc.UserInfo(ctx, &biz.User{
@@ -1212,14 +1266,9 @@ func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1
 
 // RewardList rewardList.
 func (a *AppService) RewardList(ctx context.Context, req *v1.RewardListRequest) (*v1.RewardListReply, error) {
-	// 在上下文 context 中取出 claims 对象
-	var userId int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt2.MapClaims)
-		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
-		}
-		userId = int64(c["UserId"].(float64))
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
 	}
 
 	return a.uuc.RewardList(ctx, req, &biz.User{
@@ -1228,63 +1277,43 @@ func (a *AppService) RewardList(ctx context.Context, req *v1.RewardListRequest)
 }
 
 func (a *AppService) RecommendRewardList(ctx context.Context, req *v1.RecommendRewardListRequest) (*v1.RecommendRewardListReply, error) {
-	// 在上下文 context 中取出 claims 对象
-	var userId int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt2.MapClaims)
-		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
-		}
-		userId = int64(c["UserId"].(float64))
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
 	}
 
-	return a.uuc.RecommendRewardList(ctx, &biz.User{
+	return a.uuc.RecommendRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
 
 func (a *AppService) FeeRewardList(ctx context.Context, req *v1.FeeRewardListRequest) (*v1.FeeRewardListReply, error) {
-	// 在上下文 context 中取出 claims 对象
-	var userId int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt2.MapClaims)
-		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
-		}
-		userId = int64(c["UserId"].(float64))
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
 	}
 
-	return a.uuc.FeeRewardList(ctx, &biz.User{
+	return a.uuc.FeeRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
 
 func (a *AppService) WithdrawList(ctx context.Context, req *v1.WithdrawListRequest) (*v1.WithdrawListReply, error) {
-	// 在上下文 context 中取出 claims 对象
-	var userId int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt2.MapClaims)
-		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
-		}
-		userId = int64(c["UserId"].(float64))
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
 	}
 
-	return a.uuc.WithdrawList(ctx, &biz.User{
+	return a.uuc.WithdrawList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
 
 // Withdraw withdraw.
 func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1.WithdrawReply, error) {
-	// 在上下文 context 中取出 claims 对象
-	var userId int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt2.MapClaims)
-		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
-		}
-		userId = int64(c["UserId"].(float64))
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
 	}
 
 	return a.uuc.Withdraw(ctx, req, &biz.User{
@@ -1337,6 +1366,22 @@ func (a *AppService) AdminWithdrawPass(ctx context.Context, req *v1.AdminWithdra
 	return a.uuc.AdminWithdrawPass(ctx, req)
 }
 
+func (a *AppService) AdminWithdrawReject(ctx context.Context, req *v1.AdminWithdrawRejectRequest) (*v1.AdminWithdrawRejectReply, error) {
+	return a.uuc.AdminWithdrawReject(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawBatchPass(ctx context.Context, req *v1.AdminWithdrawBatchPassRequest) (*v1.AdminWithdrawBatchPassReply, error) {
+	return a.uuc.AdminWithdrawBatchPass(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawReviewList(ctx context.Context, req *v1.AdminWithdrawReviewListRequest) (*v1.AdminWithdrawReviewListReply, error) {
+	return a.uuc.AdminWithdrawReviewList(ctx, req)
+}
+
+func (a *AppService) AdminWithdrawReviews(ctx context.Context, req *v1.AdminWithdrawReviewsRequest) (*v1.AdminWithdrawReviewsReply, error) {
+	return a.uuc.AdminWithdrawReviews(ctx, req)
+}
+
 func (a *AppService) CheckAdminUserArea(ctx context.Context, req *v1.CheckAdminUserAreaRequest) (*v1.CheckAdminUserAreaReply, error) {
 	return a.uuc.CheckAdminUserArea(ctx, req)
 }
@@ -1373,10 +1418,26 @@ func (a *AppService) AdminConfigUpdate(ctx context.Context, req *v1.AdminConfigU
 	return a.uuc.AdminConfigUpdate(ctx, req)
 }
 
+func (a *AppService) AdminConfigHistory(ctx context.Context, req *v1.AdminConfigHistoryRequest) (*v1.AdminConfigHistoryReply, error) {
+	return a.uuc.AdminConfigHistory(ctx, req)
+}
+
+func (a *AppService) AdminConfigRollback(ctx context.Context, req *v1.AdminConfigRollbackRequest) (*v1.AdminConfigRollbackReply, error) {
+	return a.uuc.AdminConfigRollback(ctx, req)
+}
+
 func (a *AppService) AdminConfigUpdateListen(ctx context.Context, req *v1.AdminConfigUpdateListenRequest) (*v1.AdminConfigUpdateListenReply, error) {
 	return a.uuc.AdminConfigUpdateListen(ctx, req)
 }
 
+func (a *AppService) AdminPriceTick(ctx context.Context, req *v1.AdminPriceTickRequest) (*v1.AdminPriceTickReply, error) {
+	return a.uuc.AdminPriceTick(ctx, req)
+}
+
+func (a *AppService) AdminPriceRevalue(ctx context.Context, req *v1.AdminPriceRevalueRequest) (*v1.AdminPriceRevalueReply, error) {
+	return a.uuc.AdminPriceRevalue(ctx, req)
+}
+
 func (a *AppService) AdminLogin(ctx context.Context, req *v1.AdminLoginRequest) (*v1.AdminLoginReply, error) {
 	return a.uuc.AdminLogin(ctx, req, a.ca.JwtKey)
 }
@@ -1444,11 +1505,7 @@ func (a *AppService) AdminAreaLevelUpdate(ctx context.Context, req *v1.AdminArea
 }
 
 func (a *AppService) AdminLocationInsert(ctx context.Context, req *v1.AdminLocationInsertRequest) (*v1.AdminLocationInsertReply, error) {
-	//_, err := a.ruc.AdminLocationInsert(ctx, req.SendBody.UserId, req.SendBody.Amount)
-	//if nil != err {
-	//	return &v1.AdminLocationInsertReply{}, err
-	//}
-	return &v1.AdminLocationInsertReply{}, nil
+	return a.ruc.AdminLocationInsert(ctx, req)
 }
 
 func (a *AppService) AdminBalanceUpdate(ctx context.Context, req *v1.AdminBalanceUpdateRequest) (*v1.AdminBalanceUpdateReply, error) {
@@ -1491,6 +1548,30 @@ func (a *AppService) AdminDailyBuyReward(ctx context.Context, req *v1.AdminDaily
 	return a.uuc.AdminDailyBuyReward(ctx, req)
 }
 
+func (a *AppService) AdminRewardRun(ctx context.Context, req *v1.AdminRewardRunRequest) (*v1.AdminRewardRunReply, error) {
+	return a.uuc.AdminRewardRun(ctx, req)
+}
+
+func (a *AppService) AdminRewardRollback(ctx context.Context, req *v1.AdminRewardRollbackRequest) (*v1.AdminRewardRollbackReply, error) {
+	return a.uuc.AdminRewardRollback(ctx, req)
+}
+
+func (a *AppService) AdminLocationTreeCheck(ctx context.Context, req *v1.AdminLocationTreeCheckRequest) (*v1.AdminLocationTreeCheckReply, error) {
+	return a.uuc.AdminLocationTreeCheck(ctx, req)
+}
+
+func (a *AppService) AdminLocationProductList(ctx context.Context, req *v1.AdminLocationProductListRequest) (*v1.AdminLocationProductListReply, error) {
+	return a.uuc.AdminLocationProductList(ctx, req)
+}
+
+func (a *AppService) AdminLocationProductUpdate(ctx context.Context, req *v1.AdminLocationProductUpdateRequest) (*v1.AdminLocationProductUpdateReply, error) {
+	return a.uuc.AdminLocationProductUpdate(ctx, req)
+}
+
+func (a *AppService) AdminLocationHistory(ctx context.Context, req *v1.AdminLocationHistoryRequest) (*v1.AdminLocationHistoryReply, error) {
+	return a.uuc.AdminLocationHistory(ctx, req)
+}
+
 func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdrawEthRequest) (*v1.AdminWithdrawEthReply, error) {
 	var (
 		withdraw *biz.Withdraw
@@ -1532,7 +1613,7 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 		}
 		_, err = a.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
 		if nil != err {
-			fmt.Println("提现处理：", err)
+			a.log.WithContext(ctx).Errorw("msg", "提现处理", "job", "withdraw", "withdraw_id", withdraw.ID, "err", err)
 			continue
 		}
 		var amount string
@@ -1568,9 +1649,11 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 
 		res, err = sendTransactionBiw(ctx, "", users[withdraw.UserId].Address, amount)
 		if !res {
-			fmt.Println(res, withdraw)
+			metrics.Payouts.With("send_failed").Inc()
+			a.log.WithContext(ctx).Errorw("msg", "withdraw send", "job", "withdraw", "withdraw_id", withdraw.ID, "user_id", withdraw.UserId, "amount", amount, "err", err)
 			continue
 		}
+		metrics.Payouts.With("success").Inc()
 		//if "dhb" == withdraw.Type {
 		//	tokenAddress = "0x6504631df9F6FF397b0ec442FB80685a7B1688d4"
 		//} else
@@ -1748,8 +1831,10 @@ func (a *AppService) AdminWithdrawEth(ctx context.Context, req *v1.AdminWithdraw
 }
 
 func toBnBNew(toAccount string, fromPrivateKey string, toAmount string, url1 string) (bool, string, error) {
+	start := time.Now()
 	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
 	client, err := ethclient.Dial(url1)
+	defer func() { metrics.RPC(url1, "transfer_bnb", start, err) }()
 	if err != nil {
 		return false, "", err
 	}
@@ -1817,8 +1902,7 @@ func toBnB(toAccount string, fromPrivateKey string, toAmount int64) (bool, strin
 	}
 
 	value := big.NewInt(toAmount) // in wei (1 eth) 最低0.03bnb才能转账
-	fmt.Println(value)
-	gasLimit := uint64(210000) // in units
+	gasLimit := uint64(210000)    // in units
 	gasPrice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
 		return false, "", err
@@ -1842,7 +1926,9 @@ func toBnB(toAccount string, fromPrivateKey string, toAmount int64) (bool, strin
 }
 
 func toToken(userPrivateKey string, toAccount string, withdrawAmount string, withdrawTokenAddress string, url1 string) (string, error) {
+	start := time.Now()
 	client, err := ethclient.Dial(url1)
+	defer func() { metrics.RPC(url1, "transfer", start, err) }()
 	//client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
 	if err != nil {
 		return "", err
@@ -1851,7 +1937,6 @@ func toToken(userPrivateKey string, toAccount string, withdrawAmount string, wit
 	tokenAddress := common.HexToAddress(withdrawTokenAddress)
 	instance, err := NewDfil(tokenAddress, client)
 	if err != nil {
-		fmt.Println(err)
 		return "", err
 	}
 
@@ -1860,7 +1945,6 @@ func toToken(userPrivateKey string, toAccount string, withdrawAmount string, wit
 	var privateKey *ecdsa.PrivateKey
 	privateKey, err = crypto.HexToECDSA(userPrivateKey)
 	if err != nil {
-		fmt.Println(err)
 		return "", err
 	}
 
@@ -1872,7 +1956,6 @@ func toToken(userPrivateKey string, toAccount string, withdrawAmount string, wit
 
 	authUser, err = bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetInt64(56))
 	if err != nil {
-		fmt.Println(err)
 		return "", err
 	}
 
@@ -1890,22 +1973,6 @@ func toToken(userPrivateKey string, toAccount string, withdrawAmount string, wit
 	return res.Hash().String(), nil
 }
 
-func BnbBalance(bnbAccount string) string {
-	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
-	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	account := common.HexToAddress(bnbAccount)
-	balance, err := client.BalanceAt(context.Background(), account, nil)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	return balance.String()
-}
-
 func getUserLength(address string) (int64, error) {
 	url1 := "https://bsc-dataseed4.binance.org/"
 
@@ -1922,9 +1989,11 @@ func getUserLength(address string) (int64, error) {
 			return -1, err
 		}
 
+		start := time.Now()
 		bals, err := instance.GetUserLength(&bind.CallOpts{})
+		metrics.RPC(url1, "getUserLength", start, err)
 		if err != nil {
-			fmt.Println(err)
+			log.Warnw("msg", "buy contract", "method", "getUserLength", "err", err)
 			//url1 = "https://bsc-dataseed4.binance.org"
 			continue
 		}
@@ -1955,9 +2024,11 @@ func getUserInfo(start int64, end int64, address string) (map[string]int64, map[
 			return nil, nil, err
 		}
 
+		begin := time.Now()
 		bals, err = instance.GetUsersByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		metrics.RPC(url1, "getUsersByIndex", begin, err)
 		if err != nil {
-			fmt.Println(err)
+			log.Warnw("msg", "buy contract", "method", "getUsersByIndex", "err", err)
 			//url1 = "https://bsc-dataseed4.binance.org"
 			continue
 		}
@@ -1976,9 +2047,11 @@ func getUserInfo(start int64, end int64, address string) (map[string]int64, map[
 			return nil, nil, err
 		}
 
+		begin := time.Now()
 		bals2, err = instance.GetUsersAmountByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		metrics.RPC(url1, "getUsersAmountByIndex", begin, err)
 		if err != nil {
-			fmt.Println(err)
+			log.Warnw("msg", "buy contract", "method", "getUsersAmountByIndex", "err", err)
 			//url1 = "https://bsc-dataseed4.binance.org"
 			continue
 		}
@@ -1997,9 +2070,11 @@ func getUserInfo(start int64, end int64, address string) (map[string]int64, map[
 			return nil, nil, err
 		}
 
+		begin := time.Now()
 		bals3, err = instance.GetUserAddressByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		metrics.RPC(url1, "getUserAddressByIndex", begin, err)
 		if err != nil {
-			fmt.Println(err)
+			log.Warnw("msg", "buy contract", "method", "getUserAddressByIndex", "err", err)
 			//url1 = "https://bsc-dataseed4.binance.org"
 			continue
 		}
@@ -2007,7 +2082,7 @@ func getUserInfo(start int64, end int64, address string) (map[string]int64, map[
 	}
 
 	if len(bals) != len(bals2) || len(bals) != len(bals3) {
-		fmt.Println("数量不一致，错误")
+		log.Warnw("msg", "buy contract", "start", start, "end", end, "err", "数量不一致")
 		return nil, nil, nil
 	}
 
@@ -2022,6 +2097,22 @@ func getUserInfo(start int64, end int64, address string) (map[string]int64, map[
 	return users, usersAddress, nil
 }
 
+func BnbBalance(bnbAccount string) string {
+	//client, err := ethclient.Dial("https://data-seed-prebsc-1-s3.binance.org:8545/")
+	client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	account := common.HexToAddress(bnbAccount)
+	balance, err := client.BalanceAt(context.Background(), account, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return balance.String()
+}
+
 func (a *AppService) TestCreateAccount(ctx context.Context, req *v1.TestCreateAccountRequest) (*v1.TestCreateAccountReply, error) {
 	//fmt.Println(usdtBalanceBiw("bCayKuHfHXaVf7YjHhfi9zNvpcHZTp4goh"))
 	//fmt.Println(createAccount())
@@ -2032,6 +2123,9 @@ var sdkClient = sdk.NewBCFWalletSDK()
 var bCFSignUtil = sdkClient.NewBCFSignUtil("b")
 var wallet = sdkClient.NewBCFWallet("35.213.66.234", 30003, "https://tracker.biw-meta.info/browser")
 
+// biwEndpoint 钱包节点，只用于统计
+const biwEndpoint = "35.213.66.234:30003"
+
 func sendTransactionBiw(ctx context.Context, secret string, toAddr string, toAmount string) (bool, error) {
 	bCFSignUtilCreateKeypair, _ := bCFSignUtil.CreateKeypair(secret)
 
@@ -2080,7 +2174,17 @@ func sendTransactionBiw(ctx context.Context, secret string, toAddr string, toAmo
 	var (
 		err error
 	)
+	start := time.Now()
 	success, err := wallet.BroadcastTransferAsset(req1)
+	metrics.RPC(biwEndpoint, "broadcast_transfer", start, err)
 
 	return success.Success, err
 }
+
+func (a *AppService) AdminExportJobCreate(ctx context.Context, req *v1.AdminExportJobCreateRequest) (*v1.AdminExportJobCreateReply, error) {
+	return a.uuc.AdminExportJobCreate(ctx, req)
+}
+
+func (a *AppService) AdminExportJob(ctx context.Context, req *v1.AdminExportJobRequest) (*v1.AdminExportJobReply, error) {
+	return a.uuc.AdminExportJob(ctx, req)
+}
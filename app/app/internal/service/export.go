@@ -0,0 +1,87 @@
+package service
+
+import (
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/pkg/export"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Export 按条件导出完整列表，查一批写一批，不在内存中保留全部结果。
+// 开始写出后出错时中断连接，客户端收到的文件不完整
+func (a *AppService) Export(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &biz.ExportRequest{
+		Kind:    q.Get("kind"),
+		Format:  q.Get("format"),
+		Address: q.Get("address"),
+		Reason:  q.Get("reason"),
+		Type:    q.Get("type"),
+		Status:  q.Get("status"),
+		Start:   q.Get("start"),
+		End:     q.Get("end"),
+	}
+	if "" == req.Format {
+		req.Format = export.FormatCSV
+	}
+
+	if err := a.uuc.ExportCheck(r.Context(), req); nil != err {
+		khttp.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(req.Format))
+	w.Header().Set("Content-Disposition", `attachment; filename="`+biz.ExportFileName(req.Kind, req.Format)+`"`)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	writer, err := export.NewWriter(req.Format, w)
+	if nil != err {
+		a.log.WithContext(r.Context()).Errorw("msg", "export", "kind", req.Kind, "err", err)
+		panic(http.ErrAbortHandler)
+	}
+
+	flusher, _ := w.(http.Flusher)
+	_, err = a.uuc.Export(r.Context(), req, writer, func(rows int64) error {
+		if nil != flusher {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if nil == err {
+		err = writer.Close()
+	}
+	if nil != err {
+		a.log.WithContext(r.Context()).Errorw("msg", "export", "kind", req.Kind, "err", err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// ExportFile 下载已完成的导出任务
+func (a *AppService) ExportFile(w http.ResponseWriter, r *http.Request) {
+	id, _ := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	job, err := a.uuc.ExportJobFile(r.Context(), id)
+	if nil != err {
+		khttp.DefaultErrorEncoder(w, r, err)
+		return
+	}
+
+	file, err := os.Open(job.File)
+	if nil != err {
+		http.Error(w, "导出文件不在当前实例", http.StatusNotFound)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if nil != err {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(job.Format))
+	w.Header().Set("Content-Disposition", `attachment; filename="`+job.Kind+"_"+strconv.FormatInt(job.ID, 10)+filepath.Ext(job.File)+`"`)
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
@@ -0,0 +1,10 @@
+ALTER TABLE `user_balance_record`
+  DROP KEY `idx_user_balance_record_run_id`,
+  DROP COLUMN `run_id`;
+
+ALTER TABLE `reward`
+  DROP KEY `idx_reward_run_id`,
+  DROP COLUMN `run_id`;
+
+DROP TABLE IF EXISTS `reward_run_item`;
+DROP TABLE IF EXISTS `reward_run`;
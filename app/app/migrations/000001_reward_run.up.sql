@@ -0,0 +1,48 @@
+-- 每日奖励按任务和业务日只发放一次，明细按run_id+item_key去重
+CREATE TABLE IF NOT EXISTS `reward_run` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `job` varchar(45) NOT NULL,
+  `business_date` varchar(10) NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `count` int NOT NULL,
+  `amount` bigint NOT NULL,
+  `error` varchar(500) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_job_date` (`job`, `business_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `reward_run_item` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `run_id` int NOT NULL,
+  `item_key` varchar(100) NOT NULL,
+  `kind` varchar(45) NOT NULL,
+  `user_id` int NOT NULL,
+  `location_id` int NOT NULL,
+  `location_table` varchar(45) NOT NULL,
+  `num` int NOT NULL,
+  `amount` bigint NOT NULL,
+  `amount_float` decimal(65,20) NOT NULL,
+  `charge` bigint NOT NULL,
+  `paid` bigint NOT NULL,
+  `amount_b` bigint NOT NULL,
+  `usdt` bigint NOT NULL,
+  `coin` bigint NOT NULL,
+  `max_new` bigint NOT NULL,
+  `prev_status` varchar(45) NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_run_key` (`run_id`, `item_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 回滚时按run_id找到本次发放写入的记录
+ALTER TABLE `reward`
+  ADD COLUMN `run_id` int NOT NULL DEFAULT 0,
+  ADD KEY `idx_reward_run_id` (`run_id`);
+
+ALTER TABLE `user_balance_record`
+  ADD COLUMN `run_id` int NOT NULL DEFAULT 0,
+  ADD KEY `idx_user_balance_record_run_id` (`run_id`);
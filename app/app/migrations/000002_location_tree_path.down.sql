@@ -0,0 +1,2 @@
+ALTER TABLE `location_new`
+  DROP COLUMN `path`;
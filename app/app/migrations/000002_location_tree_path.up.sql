@@ -0,0 +1,3 @@
+-- 占位在排位树中的路径，已有数据由 /api/admin_dhb/location_tree_check 重建
+ALTER TABLE `location_new`
+  ADD COLUMN `path` varchar(2000) NOT NULL DEFAULT '';
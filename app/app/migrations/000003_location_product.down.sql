@@ -0,0 +1,4 @@
+ALTER TABLE `location_new`
+  DROP COLUMN `product_id`;
+
+DROP TABLE IF EXISTS `location_product`;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS `location_product` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(45) NOT NULL,
+  `min_amount` bigint NOT NULL,
+  `max_amount` bigint NOT NULL DEFAULT 0,
+  `out_rate` int NOT NULL,
+  `policies` varchar(500) NOT NULL DEFAULT '',
+  `time_again` int NOT NULL DEFAULT 0,
+  `sort` int NOT NULL DEFAULT 0,
+  `status` int NOT NULL DEFAULT 1,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 0表示没有关联产品，按原来的出局倍数
+ALTER TABLE `location_new`
+  ADD COLUMN `product_id` int NOT NULL DEFAULT 0;
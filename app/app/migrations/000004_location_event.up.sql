@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS `location_event` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `location_table` varchar(45) NOT NULL,
+  `location_id` int NOT NULL,
+  `user_id` int NOT NULL,
+  `event` varchar(45) NOT NULL,
+  `source` varchar(100) NOT NULL DEFAULT '',
+  `run_id` int NOT NULL DEFAULT 0,
+  `admin_id` int NOT NULL DEFAULT 0,
+  `status_before` varchar(45) NOT NULL DEFAULT '',
+  `status_after` varchar(45) NOT NULL DEFAULT '',
+  `current_before` bigint NOT NULL,
+  `current_after` bigint NOT NULL,
+  `current_max_before` bigint NOT NULL,
+  `current_max_after` bigint NOT NULL,
+  `amount` bigint NOT NULL,
+  `note` varchar(500) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_location` (`location_table`, `location_id`),
+  KEY `idx_location_event_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
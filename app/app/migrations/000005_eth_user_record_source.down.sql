@@ -0,0 +1,6 @@
+ALTER TABLE `eth_user_record`
+  DROP KEY `idx_eth_user_record_external_ref`,
+  DROP COLUMN `external_ref`,
+  DROP COLUMN `note`,
+  DROP COLUMN `admin_id`,
+  DROP COLUMN `source`;
@@ -0,0 +1,7 @@
+-- 补单和合约购买的外部单号唯一，链上入金为NULL不参与唯一约束
+ALTER TABLE `eth_user_record`
+  ADD COLUMN `source` varchar(45) NOT NULL DEFAULT 'chain',
+  ADD COLUMN `admin_id` int NOT NULL DEFAULT 0,
+  ADD COLUMN `note` varchar(500) NOT NULL DEFAULT '',
+  ADD COLUMN `external_ref` varchar(100) NULL DEFAULT NULL,
+  ADD UNIQUE KEY `idx_eth_user_record_external_ref` (`external_ref`);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `config_version` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `config_id` int NOT NULL,
+  `key_name` varchar(100) NOT NULL,
+  `value` varchar(500) NOT NULL,
+  `admin_id` int NOT NULL DEFAULT 0,
+  `effective_from` datetime NOT NULL,
+  `note` varchar(500) NOT NULL DEFAULT '',
+  `rollback_of` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_key_effective` (`key_name`, `effective_from`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
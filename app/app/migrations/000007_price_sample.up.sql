@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `price_sample` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `source` varchar(45) NOT NULL,
+  `price` decimal(65,20) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_source_created` (`source`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,34 @@
+-- 每次价格变动只有一个重估任务，每个用户只处理一次
+CREATE TABLE IF NOT EXISTS `price_revalue_run` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `price_change_id` int NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `last_user_id` int NOT NULL DEFAULT 0,
+  `users` int NOT NULL DEFAULT 0,
+  `up` int NOT NULL DEFAULT 0,
+  `down` int NOT NULL DEFAULT 0,
+  `stopped` int NOT NULL DEFAULT 0,
+  `amount` bigint NOT NULL DEFAULT 0,
+  `failed` int NOT NULL DEFAULT 0,
+  `error` varchar(500) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_price_change` (`price_change_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `price_revalue_item` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `run_id` int NOT NULL,
+  `user_id` int NOT NULL,
+  `location_id` int NOT NULL DEFAULT 0,
+  `direction` varchar(45) NOT NULL DEFAULT '',
+  `amount` bigint NOT NULL DEFAULT 0,
+  `stopped` tinyint(1) NOT NULL DEFAULT 0,
+  `status` varchar(45) NOT NULL,
+  `error` varchar(500) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_run_user` (`run_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
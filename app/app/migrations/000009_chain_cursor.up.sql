@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `chain_cursor` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(100) NOT NULL,
+  `position` bigint NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_chain_cursor_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
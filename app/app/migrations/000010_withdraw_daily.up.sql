@@ -0,0 +1,11 @@
+-- 每人每天的提现额度累计
+CREATE TABLE IF NOT EXISTS `withdraw_daily` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `day` varchar(10) NOT NULL,
+  `user_id` int NOT NULL,
+  `amount` bigint NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_day_user` (`day`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `withdraw_review` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `withdraw_id` int NOT NULL,
+  `user_id` int NOT NULL,
+  `admin_id` int NOT NULL DEFAULT 0,
+  `action` varchar(45) NOT NULL,
+  `status_before` varchar(45) NOT NULL,
+  `status_after` varchar(45) NOT NULL,
+  `amount` bigint NOT NULL,
+  `reason` varchar(200) NOT NULL DEFAULT '',
+  `flags` varchar(200) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_withdraw_review_withdraw_id` (`withdraw_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
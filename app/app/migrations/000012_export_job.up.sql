@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `export_job` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `admin_id` int NOT NULL DEFAULT 0,
+  `kind` varchar(45) NOT NULL,
+  `format` varchar(10) NOT NULL,
+  `filter` varchar(1000) NOT NULL DEFAULT '',
+  `status` varchar(45) NOT NULL,
+  `exported` int NOT NULL DEFAULT 0,
+  `file` varchar(500) NOT NULL DEFAULT '',
+  `error` varchar(500) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+-- 地址或密码最后修改时间，提现风险检查最近是否修改过
+ALTER TABLE `user`
+  ADD COLUMN `bind_at` datetime NULL DEFAULT NULL;
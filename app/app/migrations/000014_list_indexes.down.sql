@@ -0,0 +1,27 @@
+ALTER TABLE `withdraw`
+  DROP KEY `idx_type_created_id`,
+  DROP KEY `idx_status_created_id`,
+  DROP KEY `idx_user_created_id`,
+  DROP KEY `idx_amount_new_id`,
+  DROP KEY `idx_created_id`;
+
+ALTER TABLE `location_new`
+  DROP KEY `idx_status_stop_id`,
+  DROP KEY `idx_status_created_id`,
+  DROP KEY `idx_user_created_id`,
+  DROP KEY `idx_usdt_id`,
+  DROP KEY `idx_stop_date_id`,
+  DROP KEY `idx_created_id`;
+
+ALTER TABLE `reward`
+  DROP KEY `idx_reason_created_id`,
+  DROP KEY `idx_type_created_id`,
+  DROP KEY `idx_user_created_id`,
+  DROP KEY `idx_amount_id`,
+  DROP KEY `idx_created_id`;
+
+ALTER TABLE `user_info`
+  DROP KEY `idx_vip_user`;
+
+ALTER TABLE `user`
+  DROP KEY `idx_created_id`;
@@ -0,0 +1,46 @@
+-- 后台列表的筛选和排序索引，和biz/list_filter.go的listSpecs对应。
+-- 游标分页按 (排序列, id) 比较，索引顺序为 (等值筛选, 排序列, id)，
+-- 日期范围筛选走同一个排序列的索引，按id排序时走主键。
+--
+-- 上线前在从库用EXPLAIN确认，key为对应索引且Extra没有Using filesort，例如：
+--   EXPLAIN SELECT * FROM reward WHERE type='location' AND (created_at<'2026-10-01' OR (created_at='2026-10-01' AND id<100)) ORDER BY created_at DESC, id DESC LIMIT 20;
+--     -> key: idx_type_created_id
+--   EXPLAIN SELECT * FROM reward WHERE (amount<500000 OR (amount=500000 AND id<100)) ORDER BY amount DESC, id DESC LIMIT 20;
+--     -> key: idx_amount_id
+--   EXPLAIN SELECT * FROM location_new WHERE status IN ('running') AND stop_date>='2026-10-01' ORDER BY stop_date DESC, id DESC LIMIT 20;
+--     -> key: idx_status_stop_id
+--   EXPLAIN SELECT * FROM location_new WHERE (usdt<30000000 OR (usdt=30000000 AND id<100)) ORDER BY usdt DESC, id DESC LIMIT 20;
+--     -> key: idx_usdt_id
+--   EXPLAIN SELECT * FROM withdraw WHERE type='usdt' AND created_at>='2026-10-01' ORDER BY created_at DESC, id DESC LIMIT 20;
+--     -> key: idx_type_created_id
+--   EXPLAIN SELECT * FROM withdraw WHERE (amount_new<100 OR (amount_new=100 AND id<100)) ORDER BY amount_new DESC, id DESC LIMIT 20;
+--     -> key: idx_amount_new_id
+
+ALTER TABLE `user`
+  ADD KEY `idx_created_id` (`created_at`, `id`);
+
+-- vip_min筛选的子查询
+ALTER TABLE `user_info`
+  ADD KEY `idx_vip_user` (`vip`, `user_id`);
+
+ALTER TABLE `reward`
+  ADD KEY `idx_created_id` (`created_at`, `id`),
+  ADD KEY `idx_amount_id` (`amount`, `id`),
+  ADD KEY `idx_user_created_id` (`user_id`, `created_at`, `id`),
+  ADD KEY `idx_type_created_id` (`type`, `created_at`, `id`),
+  ADD KEY `idx_reason_created_id` (`reason`, `created_at`, `id`);
+
+ALTER TABLE `location_new`
+  ADD KEY `idx_created_id` (`created_at`, `id`),
+  ADD KEY `idx_stop_date_id` (`stop_date`, `id`),
+  ADD KEY `idx_usdt_id` (`usdt`, `id`),
+  ADD KEY `idx_user_created_id` (`user_id`, `created_at`, `id`),
+  ADD KEY `idx_status_created_id` (`status`, `created_at`, `id`),
+  ADD KEY `idx_status_stop_id` (`status`, `stop_date`, `id`);
+
+ALTER TABLE `withdraw`
+  ADD KEY `idx_created_id` (`created_at`, `id`),
+  ADD KEY `idx_amount_new_id` (`amount_new`, `id`),
+  ADD KEY `idx_user_created_id` (`user_id`, `created_at`, `id`),
+  ADD KEY `idx_status_created_id` (`status`, `created_at`, `id`),
+  ADD KEY `idx_type_created_id` (`type`, `created_at`, `id`);
@@ -0,0 +1,3 @@
+ALTER TABLE `reward_run_item`
+  DROP COLUMN `prev_date`,
+  DROP COLUMN `record_id`;
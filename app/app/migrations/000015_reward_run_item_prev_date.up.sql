@@ -0,0 +1,4 @@
+-- 余额分红发放前的记录id和上次发放日期，回滚时在同一事务内恢复
+ALTER TABLE `reward_run_item`
+  ADD COLUMN `record_id` int NOT NULL DEFAULT 0,
+  ADD COLUMN `prev_date` datetime NULL DEFAULT NULL;